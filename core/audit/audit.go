@@ -0,0 +1,231 @@
+// Package audit forwards structured audit and security events (logins,
+// mutations, key operations, job changes) to an external sink so operators
+// with compliance requirements can retain them outside the node.
+//
+// Events are buffered in memory and shipped in batches; failed shipments are
+// retried with exponential backoff before the batch is dropped.
+package audit
+
+import (
+	"net/url"
+	"time"
+
+	"github.com/jpillora/backoff"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// EventType classifies an audit event.
+type EventType string
+
+const (
+	EventLogin           EventType = "login"
+	EventLoginFailed     EventType = "login_failed"
+	EventLogout          EventType = "logout"
+	EventUserProvisioned EventType = "user_provisioned"
+	// EventMutation covers any authenticated API call that changes state:
+	// key operations, job changes, config updates and so on.
+	EventMutation EventType = "mutation"
+)
+
+// Event is a single audit record. Data holds event-specific details, e.g. the
+// request method and path for mutations.
+type Event struct {
+	Timestamp time.Time              `json:"timestamp"`
+	Type      EventType              `json:"type"`
+	User      string                 `json:"user,omitempty"`
+	Data      map[string]interface{} `json:"data,omitempty"`
+}
+
+// Config is the subset of the general config needed to build an audit logger.
+type Config interface {
+	AuditLogSink() string
+	AuditLogHTTPURL() *url.URL
+	AuditLogS3Bucket() string
+	AuditLogS3Region() string
+}
+
+// AuditLogger buffers audit events and ships them to the configured sink.
+type AuditLogger interface {
+	service.Service
+
+	// Audit records an event. It never blocks; if the buffer is full the
+	// event is dropped and a warning logged.
+	Audit(eventType EventType, user string, data map[string]interface{})
+}
+
+// NoopLogger discards all events, for nodes with no sink configured.
+type NoopLogger struct{}
+
+func (NoopLogger) Start() error                                    { return nil }
+func (NoopLogger) Close() error                                    { return nil }
+func (NoopLogger) Healthy() error                                  { return nil }
+func (NoopLogger) Ready() error                                    { return nil }
+func (NoopLogger) Audit(EventType, string, map[string]interface{}) {}
+
+// sink ships a batch of events to its destination. Implementations must be
+// safe to call from a single goroutine.
+type sink interface {
+	Ship(events []Event) error
+	Close() error
+}
+
+const (
+	// eventBufferSize is the number of events to hold before dropping new ones.
+	eventBufferSize = 1000
+	// batchSize is the max number of events shipped in one call to the sink.
+	batchSize = 100
+	// flushInterval is how long a partial batch waits before being shipped.
+	flushInterval = 5 * time.Second
+	// maxShipAttempts is how many times a batch is tried before being dropped.
+	maxShipAttempts = 5
+)
+
+type auditLogger struct {
+	utils.StartStopOnce
+	sink sink
+	lggr logger.Logger
+
+	chEvents chan Event
+	chStop   chan struct{}
+	chDone   chan struct{}
+}
+
+var _ AuditLogger = (*auditLogger)(nil)
+
+// NewLogger returns an audit logger shipping to the sink named by
+// AUDIT_LOG_SINK ("syslog", "http" or "s3").
+func NewLogger(cfg Config, lggr logger.Logger) (AuditLogger, error) {
+	var s sink
+	var err error
+	switch cfg.AuditLogSink() {
+	case "syslog":
+		s, err = newSyslogSink()
+	case "http":
+		if cfg.AuditLogHTTPURL() == nil {
+			return nil, errors.New("AUDIT_LOG_SINK is http but AUDIT_LOG_HTTP_URL is not set")
+		}
+		s = newHTTPSink(cfg.AuditLogHTTPURL())
+	case "s3":
+		if cfg.AuditLogS3Bucket() == "" {
+			return nil, errors.New("AUDIT_LOG_SINK is s3 but AUDIT_LOG_S3_BUCKET is not set")
+		}
+		s, err = newS3Sink(cfg.AuditLogS3Bucket(), cfg.AuditLogS3Region())
+	default:
+		return nil, errors.Errorf("unknown audit log sink: %q", cfg.AuditLogSink())
+	}
+	if err != nil {
+		return nil, err
+	}
+	return &auditLogger{
+		sink:     s,
+		lggr:     lggr.Named("AuditLogger"),
+		chEvents: make(chan Event, eventBufferSize),
+		chStop:   make(chan struct{}),
+		chDone:   make(chan struct{}),
+	}, nil
+}
+
+func (l *auditLogger) Start() error {
+	return l.StartOnce("AuditLogger", func() error {
+		go l.run()
+		return nil
+	})
+}
+
+func (l *auditLogger) Close() error {
+	return l.StopOnce("AuditLogger", func() error {
+		close(l.chStop)
+		<-l.chDone
+		return l.sink.Close()
+	})
+}
+
+// Audit records an event. It never blocks; if the buffer is full the event is
+// dropped and a warning logged.
+func (l *auditLogger) Audit(eventType EventType, user string, data map[string]interface{}) {
+	event := Event{
+		Timestamp: time.Now().UTC(),
+		Type:      eventType,
+		User:      user,
+		Data:      data,
+	}
+	select {
+	case l.chEvents <- event:
+	default:
+		l.lggr.Warnw("Audit event buffer full, dropping event", "eventType", eventType, "user", user)
+	}
+}
+
+func (l *auditLogger) run() {
+	defer close(l.chDone)
+
+	flushTimer := time.NewTicker(flushInterval)
+	defer flushTimer.Stop()
+
+	batch := make([]Event, 0, batchSize)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		l.ship(batch)
+		batch = batch[:0]
+	}
+
+	for {
+		select {
+		case e := <-l.chEvents:
+			batch = append(batch, e)
+			if len(batch) >= batchSize {
+				flush()
+			}
+		case <-flushTimer.C:
+			flush()
+		case <-l.chStop:
+			// Drain whatever is already buffered before shutting down
+			for {
+				select {
+				case e := <-l.chEvents:
+					batch = append(batch, e)
+					if len(batch) >= batchSize {
+						flush()
+					}
+				default:
+					flush()
+					return
+				}
+			}
+		}
+	}
+}
+
+// ship sends the batch to the sink, retrying with backoff. After
+// maxShipAttempts the batch is dropped with an error logged, so a dead sink
+// cannot wedge the node.
+func (l *auditLogger) ship(batch []Event) {
+	b := backoff.Backoff{
+		Min: 1 * time.Second,
+		Max: 30 * time.Second,
+	}
+	for {
+		err := l.sink.Ship(batch)
+		if err == nil {
+			return
+		}
+		if int(b.Attempt()) >= maxShipAttempts-1 {
+			l.lggr.Errorw("Failed to ship audit events, dropping batch", "err", err, "batchSize", len(batch))
+			return
+		}
+		wait := b.Duration()
+		l.lggr.Warnw("Failed to ship audit events, will retry", "err", err, "wait", wait)
+		select {
+		case <-time.After(wait):
+		case <-l.chStop:
+			l.lggr.Errorw("Failed to ship audit events before shutdown, dropping batch", "err", err, "batchSize", len(batch))
+			return
+		}
+	}
+}
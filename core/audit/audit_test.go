@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+type testSink struct {
+	mu      sync.Mutex
+	batches [][]Event
+	err     error
+}
+
+func (s *testSink) Ship(events []Event) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.err != nil {
+		return s.err
+	}
+	batch := make([]Event, len(events))
+	copy(batch, events)
+	s.batches = append(s.batches, batch)
+	return nil
+}
+
+func (s *testSink) Close() error { return nil }
+
+func (s *testSink) events() (evts []Event) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, b := range s.batches {
+		evts = append(evts, b...)
+	}
+	return
+}
+
+func newTestLogger(t *testing.T, s sink) *auditLogger {
+	return &auditLogger{
+		sink:     s,
+		lggr:     logger.TestLogger(t),
+		chEvents: make(chan Event, eventBufferSize),
+		chStop:   make(chan struct{}),
+		chDone:   make(chan struct{}),
+	}
+}
+
+func TestAuditLogger_ShipsBufferedEventsOnClose(t *testing.T) {
+	s := &testSink{}
+	l := newTestLogger(t, s)
+	require.NoError(t, l.Start())
+
+	l.Audit(EventLogin, "user@example.com", map[string]interface{}{"ip": "10.0.0.1"})
+	l.Audit(EventMutation, "user@example.com", nil)
+
+	require.NoError(t, l.Close())
+
+	evts := s.events()
+	require.Len(t, evts, 2)
+	assert.Equal(t, EventLogin, evts[0].Type)
+	assert.Equal(t, "user@example.com", evts[0].User)
+	assert.Equal(t, EventMutation, evts[1].Type)
+}
+
+func TestAuditLogger_FlushesFullBatches(t *testing.T) {
+	s := &testSink{}
+	l := newTestLogger(t, s)
+	require.NoError(t, l.Start())
+	defer func() { assert.NoError(t, l.Close()) }()
+
+	for i := 0; i < batchSize+1; i++ {
+		l.Audit(EventMutation, "user@example.com", nil)
+	}
+
+	// The first full batch ships without waiting for the flush interval
+	require.Eventually(t, func() bool {
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		return len(s.batches) >= 1
+	}, 2*time.Second, 10*time.Millisecond)
+
+	s.mu.Lock()
+	assert.Len(t, s.batches[0], batchSize)
+	s.mu.Unlock()
+}
+
+func TestAuditLogger_DropsBatchAfterRetriesOnShutdown(t *testing.T) {
+	s := &testSink{err: errors.New("sink unavailable")}
+	l := newTestLogger(t, s)
+	require.NoError(t, l.Start())
+
+	l.Audit(EventLogout, "user@example.com", nil)
+
+	// Close returns even though the sink never accepts the batch
+	require.NoError(t, l.Close())
+	assert.Empty(t, s.events())
+}
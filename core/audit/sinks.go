@@ -0,0 +1,126 @@
+package audit
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log/syslog"
+	"net/http"
+	"net/url"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+// syslogSink writes one JSON line per event to the local syslog daemon.
+type syslogSink struct {
+	writer *syslog.Writer
+}
+
+func newSyslogSink() (sink, error) {
+	w, err := syslog.New(syslog.LOG_NOTICE|syslog.LOG_AUTH, "chainlink-audit")
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to syslog")
+	}
+	return &syslogSink{writer: w}, nil
+}
+
+func (s *syslogSink) Ship(events []Event) error {
+	for _, e := range events {
+		b, err := json.Marshal(e)
+		if err != nil {
+			return errors.Wrap(err, "failed to encode audit event")
+		}
+		if _, err := s.writer.Write(b); err != nil {
+			return errors.Wrap(err, "failed to write audit event to syslog")
+		}
+	}
+	return nil
+}
+
+func (s *syslogSink) Close() error {
+	return s.writer.Close()
+}
+
+// httpSink POSTs each batch to the configured endpoint as a JSON array. Any
+// 2xx response counts as delivered.
+type httpSink struct {
+	url    *url.URL
+	client *http.Client
+}
+
+func newHTTPSink(u *url.URL) sink {
+	return &httpSink{
+		url:    u,
+		client: &http.Client{Timeout: 30 * time.Second},
+	}
+}
+
+func (s *httpSink) Ship(events []Event) error {
+	b, err := json.Marshal(events)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode audit events")
+	}
+	resp, err := s.client.Post(s.url.String(), "application/json", bytes.NewReader(b))
+	if err != nil {
+		return errors.Wrap(err, "failed to POST audit events")
+	}
+	defer func() {
+		_, _ = io.Copy(ioutil.Discard, resp.Body)
+		_ = resp.Body.Close()
+	}()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return errors.Errorf("audit endpoint returned status %d", resp.StatusCode)
+	}
+	return nil
+}
+
+func (s *httpSink) Close() error {
+	s.client.CloseIdleConnections()
+	return nil
+}
+
+// s3Sink writes each batch as a JSON object under audit/<date>/<time>-<uuid>.json.
+// Credentials come from the standard AWS environment/instance profile chain.
+type s3Sink struct {
+	bucket string
+	client *s3.S3
+}
+
+func newS3Sink(bucket, region string) (sink, error) {
+	cfg := aws.NewConfig()
+	if region != "" {
+		cfg = cfg.WithRegion(region)
+	}
+	sess, err := session.NewSession(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to create AWS session")
+	}
+	return &s3Sink{bucket: bucket, client: s3.New(sess)}, nil
+}
+
+func (s *s3Sink) Ship(events []Event) error {
+	b, err := json.Marshal(events)
+	if err != nil {
+		return errors.Wrap(err, "failed to encode audit events")
+	}
+	now := time.Now().UTC()
+	key := fmt.Sprintf("audit/%s/%s-%s.json", now.Format("2006-01-02"), now.Format("150405"), uuid.NewV4())
+	_, err = s.client.PutObject(&s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(key),
+		Body:        bytes.NewReader(b),
+		ContentType: aws.String("application/json"),
+	})
+	return errors.Wrap(err, "failed to put audit events to S3")
+}
+
+func (s *s3Sink) Close() error {
+	return nil
+}
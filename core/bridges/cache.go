@@ -0,0 +1,112 @@
+package bridges
+
+import (
+	"sync"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// CachedORM wraps an ORM with an in-memory write-through cache of bridge
+// types, since bridge rows rarely change but are read on every bridge task
+// run. Local mutations update the cache directly; mutations made by other
+// processes are picked up via the bridge_updated Postgres channel, whose
+// notifications are emitted by a trigger on bridge_types.
+type CachedORM struct {
+	ORM
+	bus         *postgres.EventBus
+	lggr        logger.Logger
+	bridges     sync.Map // TaskType => BridgeType
+	unsubscribe func()
+	utils.StartStopOnce
+}
+
+var _ ORM = (*CachedORM)(nil)
+var _ service.Service = (*CachedORM)(nil)
+
+func NewCachedORM(orm ORM, bus *postgres.EventBus, lggr logger.Logger) *CachedORM {
+	return &CachedORM{
+		ORM:  orm,
+		bus:  bus,
+		lggr: lggr.Named("BridgeCache"),
+	}
+}
+
+// Start subscribes for cross-process invalidations. The event bus must be
+// started first.
+func (o *CachedORM) Start() error {
+	return o.StartOnce("BridgeCache", func() (err error) {
+		o.unsubscribe, err = o.bus.SubscribeFunc(postgres.ChannelBridgeUpdated, "", func(event postgres.Event) {
+			o.invalidate(event.Payload)
+		})
+		return err
+	})
+}
+
+func (o *CachedORM) Close() error {
+	return o.StopOnce("BridgeCache", func() error {
+		o.unsubscribe()
+		return nil
+	})
+}
+
+// Flush drops the entire cache, forcing subsequent reads back to the
+// database. It is used after a database outage, during which invalidation
+// notifications may have been lost.
+func (o *CachedORM) Flush() {
+	o.invalidate("")
+}
+
+// invalidate drops the named bridge from the cache, or the entire cache if
+// the payload does not identify one
+func (o *CachedORM) invalidate(name string) {
+	if name == "" {
+		o.bridges.Range(func(key, _ interface{}) bool {
+			o.bridges.Delete(key)
+			return true
+		})
+		return
+	}
+	o.bridges.Delete(TaskType(name))
+}
+
+// FindBridge looks up a Bridge by its Name, preferring the cache.
+func (o *CachedORM) FindBridge(name TaskType) (BridgeType, error) {
+	if v, ok := o.bridges.Load(name); ok {
+		return v.(BridgeType), nil
+	}
+	bt, err := o.ORM.FindBridge(name)
+	if err == nil {
+		o.bridges.Store(name, bt)
+	}
+	return bt, err
+}
+
+// CreateBridgeType saves the bridge type.
+func (o *CachedORM) CreateBridgeType(bt *BridgeType) error {
+	err := o.ORM.CreateBridgeType(bt)
+	if err == nil {
+		o.bridges.Store(bt.Name, *bt)
+	}
+	return err
+}
+
+// UpdateBridgeType updates the bridge type.
+func (o *CachedORM) UpdateBridgeType(bt *BridgeType, btr *BridgeTypeRequest) error {
+	err := o.ORM.UpdateBridgeType(bt, btr)
+	if err == nil {
+		o.bridges.Store(bt.Name, *bt)
+	}
+	return err
+}
+
+// DeleteBridgeType removes the bridge type
+func (o *CachedORM) DeleteBridgeType(bt *BridgeType) error {
+	err := o.ORM.DeleteBridgeType(bt)
+	if err == nil {
+		o.bridges.Delete(bt.Name)
+	}
+	return err
+}
@@ -0,0 +1,111 @@
+package bridges_test
+
+import (
+	"testing"
+
+	"github.com/onsi/gomega"
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/bridges/mocks"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func setupCachedORM(t *testing.T) (*mocks.ORM, *postgres.NullEventBroadcaster, *bridges.CachedORM) {
+	t.Helper()
+
+	underlying := new(mocks.ORM)
+	eb := postgres.NewNullEventBroadcaster()
+	orm := bridges.NewCachedORM(underlying, postgres.NewEventBus(eb, logger.TestLogger(t)), logger.TestLogger(t))
+	require.NoError(t, orm.Start())
+	t.Cleanup(func() { require.NoError(t, orm.Close()) })
+
+	return underlying, eb, orm
+}
+
+func TestCachedORM_FindBridge(t *testing.T) {
+	t.Parallel()
+
+	underlying, _, orm := setupCachedORM(t)
+
+	bt := bridges.BridgeType{}
+	bt.Name = bridges.MustNewTaskType("solargridreporting")
+	bt.URL = cltest.WebURL(t, "https://denergy.eth")
+
+	// only the first lookup hits the underlying ORM
+	underlying.On("FindBridge", bt.Name).Return(bt, nil).Once()
+
+	for i := 0; i < 3; i++ {
+		found, err := orm.FindBridge(bt.Name)
+		require.NoError(t, err)
+		assert.Equal(t, bt, found)
+	}
+	underlying.AssertExpectations(t)
+
+	// errors are not cached
+	missing := bridges.MustNewTaskType("missing")
+	underlying.On("FindBridge", missing).Return(bridges.BridgeType{}, errors.New("no rows")).Twice()
+	_, err := orm.FindBridge(missing)
+	require.Error(t, err)
+	_, err = orm.FindBridge(missing)
+	require.Error(t, err)
+	underlying.AssertExpectations(t)
+}
+
+func TestCachedORM_Mutations(t *testing.T) {
+	t.Parallel()
+
+	underlying, _, orm := setupCachedORM(t)
+
+	bt := bridges.BridgeType{}
+	bt.Name = bridges.MustNewTaskType("votingbooth")
+	bt.URL = cltest.WebURL(t, "https://vote.eth")
+
+	// writes populate the cache, so the subsequent read does not hit the DB
+	underlying.On("CreateBridgeType", &bt).Return(nil).Once()
+	require.NoError(t, orm.CreateBridgeType(&bt))
+
+	found, err := orm.FindBridge(bt.Name)
+	require.NoError(t, err)
+	assert.Equal(t, bt, found)
+
+	// deletes drop the entry
+	underlying.On("DeleteBridgeType", &bt).Return(nil).Once()
+	require.NoError(t, orm.DeleteBridgeType(&bt))
+
+	underlying.On("FindBridge", bt.Name).Return(bridges.BridgeType{}, errors.New("no rows")).Once()
+	_, err = orm.FindBridge(bt.Name)
+	require.Error(t, err)
+	underlying.AssertExpectations(t)
+}
+
+func TestCachedORM_Invalidation(t *testing.T) {
+	t.Parallel()
+
+	underlying, eb, orm := setupCachedORM(t)
+
+	bt := bridges.BridgeType{}
+	bt.Name = bridges.MustNewTaskType("externallyupdated")
+	bt.URL = cltest.WebURL(t, "https://update.eth")
+
+	underlying.On("CreateBridgeType", &bt).Return(nil).Once()
+	require.NoError(t, orm.CreateBridgeType(&bt))
+
+	// simulate another process updating the bridge
+	eb.Sub.Ch <- postgres.Event{Channel: postgres.ChannelBridgeUpdated, Payload: bt.Name.String()}
+
+	updated := bt
+	updated.URL = cltest.WebURL(t, "https://update2.eth")
+	underlying.On("FindBridge", bt.Name).Return(updated, nil).Once()
+
+	// the invalidation is processed asynchronously
+	gomega.NewGomegaWithT(t).Eventually(func() bridges.BridgeType {
+		found, _ := orm.FindBridge(bt.Name)
+		return found
+	}).Should(gomega.Equal(updated))
+	underlying.AssertExpectations(t)
+}
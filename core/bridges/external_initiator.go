@@ -1,11 +1,16 @@
 package bridges
 
 import (
+	"crypto/hmac"
+	"crypto/sha256"
 	"crypto/subtle"
+	"encoding/hex"
+	"strconv"
 	"strings"
 	"time"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 
@@ -26,6 +31,7 @@ type ExternalInitiator struct {
 	AccessKey      string         `gorm:"not null"`
 	Salt           string         `gorm:"not null"`
 	HashedSecret   string         `gorm:"not null"`
+	SigningKey     string         `gorm:"not null"`
 	OutgoingSecret string         `gorm:"not null"`
 	OutgoingToken  string         `gorm:"not null"`
 
@@ -44,6 +50,12 @@ func NewExternalInitiator(
 	if err != nil {
 		return nil, errors.Wrap(err, "error hashing secret for external initiator")
 	}
+	signingKey := utils.NewSecret(utils.DefaultSecretSize)
+	outgoingToken := utils.NewSecret(utils.DefaultSecretSize)
+	outgoingSecret := utils.NewSecret(utils.DefaultSecretSize)
+	// These credentials are only ever held in plain text here and in the
+	// creation response, so make sure they cannot leak via log output.
+	logger.RegisterSecrets(eia.Secret, signingKey, outgoingToken, outgoingSecret)
 
 	return &ExternalInitiator{
 		Name:           strings.ToLower(eir.Name),
@@ -51,8 +63,9 @@ func NewExternalInitiator(
 		AccessKey:      eia.AccessKey,
 		HashedSecret:   hashedSecret,
 		Salt:           salt,
-		OutgoingToken:  utils.NewSecret(utils.DefaultSecretSize),
-		OutgoingSecret: utils.NewSecret(utils.DefaultSecretSize),
+		SigningKey:     signingKey,
+		OutgoingToken:  outgoingToken,
+		OutgoingSecret: outgoingSecret,
 	}, nil
 }
 
@@ -65,3 +78,42 @@ func AuthenticateExternalInitiator(eia *auth.Token, ea *ExternalInitiator) (bool
 	}
 	return subtle.ConstantTimeCompare([]byte(hashedSecret), []byte(ea.HashedSecret)) == 1, nil
 }
+
+// ExternalInitiatorSignatureMaxAge bounds how far a signed request's
+// timestamp may drift from the node's clock. Nonces only need to be retained
+// for this window to prevent replays.
+const ExternalInitiatorSignatureMaxAge = 5 * time.Minute
+
+// SignRequest computes the hex-encoded HMAC-SHA256 signature an external
+// initiator attaches to a request with the given timestamp, nonce and body.
+func SignRequest(signingKey, timestamp, nonce string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(signingKey))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("\n"))
+	mac.Write([]byte(nonce))
+	mac.Write([]byte("\n"))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// ValidateSignature verifies a signed external initiator request against the
+// initiator's signing key, rejecting stale timestamps. Replay protection for
+// the nonce is handled separately against the database.
+func (ea *ExternalInitiator) ValidateSignature(timestamp, nonce, signature string, body []byte) error {
+	if timestamp == "" || nonce == "" || signature == "" {
+		return errors.New("external initiator request is missing signature headers")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrap(err, "invalid external initiator request timestamp")
+	}
+	drift := time.Since(time.Unix(ts, 0))
+	if drift > ExternalInitiatorSignatureMaxAge || drift < -ExternalInitiatorSignatureMaxAge {
+		return errors.New("external initiator request timestamp is outside the allowed window")
+	}
+	expected := SignRequest(ea.SigningKey, timestamp, nonce, body)
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return errors.New("external initiator request signature does not match")
+	}
+	return nil
+}
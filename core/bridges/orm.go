@@ -108,8 +108,8 @@ func (o *orm) ExternalInitiators(offset int, limit int) (exis []ExternalInitiato
 
 // CreateExternalInitiator inserts a new external initiator
 func (o *orm) CreateExternalInitiator(externalInitiator *ExternalInitiator) error {
-	sql := `INSERT INTO external_initiators (name, url, access_key, salt, hashed_secret, outgoing_secret, outgoing_token, created_at, updated_at)
-	VALUES (:name, :url, :access_key, :salt, :hashed_secret, :outgoing_secret, :outgoing_token, now(), now())
+	sql := `INSERT INTO external_initiators (name, url, access_key, salt, hashed_secret, signing_key, outgoing_secret, outgoing_token, created_at, updated_at)
+	VALUES (:name, :url, :access_key, :salt, :hashed_secret, :signing_key, :outgoing_secret, :outgoing_token, now(), now())
 	RETURNING *
 	`
 	stmt, err := o.db.PrepareNamed(sql)
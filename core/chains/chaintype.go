@@ -4,15 +4,17 @@ type ChainType string
 
 const (
 	Arbitrum ChainType = "arbitrum"
+	Celo     ChainType = "celo"
 	ExChain  ChainType = "exchain"
 	Optimism ChainType = "optimism"
 	XDai     ChainType = "xdai"
+	ZkSync   ChainType = "zksync"
 )
 
 // IsValid returns true if the ChainType value is known or empty.
 func (c ChainType) IsValid() bool {
 	switch c {
-	case "", Arbitrum, ExChain, Optimism, XDai:
+	case "", Arbitrum, Celo, ExChain, Optimism, XDai, ZkSync:
 		return true
 	}
 	return false
@@ -25,7 +27,7 @@ func (c ChainType) IsL2() bool {
 	case Arbitrum, Optimism:
 		return true
 
-	case XDai, ExChain:
+	case Celo, XDai, ExChain, ZkSync:
 		fallthrough
 	default:
 		return false
@@ -117,7 +117,7 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 		txm = opts.GenTxManager(dbchain)
 	}
 
-	headBroadcaster.Subscribe(txm)
+	headBroadcaster.SubscribeWithOpts(txm, httypes.SubscriptionOpts{Priority: httypes.PriorityCritical})
 
 	// Highest seen head height is used as part of the start of LogBroadcaster backfill range
 	highestSeenHead, err := headTracker.HighestSeenHeadFromDB(context.Background())
@@ -127,8 +127,8 @@ func newChain(dbchain types.Chain, opts ChainSetOpts) (*chain, error) {
 
 	var balanceMonitor services.BalanceMonitor
 	if !cfg.EthereumDisabled() && cfg.BalanceMonitorEnabled() {
-		balanceMonitor = services.NewBalanceMonitor(db, client, opts.KeyStore, l)
-		headBroadcaster.Subscribe(balanceMonitor)
+		balanceMonitor = services.NewBalanceMonitor(db, client, cfg, opts.KeyStore, l)
+		headBroadcaster.SubscribeWithOpts(balanceMonitor, httypes.SubscriptionOpts{Priority: httypes.PriorityBestEffort})
 	}
 
 	var logBroadcaster log.Broadcaster
@@ -28,15 +28,24 @@ type (
 		blockHistoryEstimatorTransactionPercentile uint16
 		chainType                                  chains.ChainType
 		eip1559DynamicFees                         bool
+		ethTxAttemptGCKeepCount                    uint16
+		ethTxReaperArchiveEnabled                  bool
 		ethTxReaperInterval                        time.Duration
 		ethTxReaperThreshold                       time.Duration
 		ethTxResendAfterThreshold                  time.Duration
+		feeHistoryEstimatorBaseFeeHeadroom         float32
+		feeHistoryEstimatorBlockHistorySize        uint16
+		feeHistoryEstimatorCapBehavior             string
+		feeHistoryEstimatorRewardPercentile        uint16
 		finalityDepth                              uint32
+		finalityTagEnabled                         bool
 		flagsContractAddress                       string
+		fundingTopUpMaxDailyWei                    big.Int
 		gasBumpPercent                             uint16
 		gasBumpThreshold                           uint64
 		gasBumpTxDepth                             uint16
 		gasBumpWei                                 big.Int
+		gasEstimatorExternalURL                    string
 		gasEstimatorMode                           string
 		gasLimitDefault                            uint64
 		gasLimitMultiplier                         float32
@@ -44,9 +53,14 @@ type (
 		gasPriceDefault                            big.Int
 		gasTipCapDefault                           big.Int
 		gasTipCapMinimum                           big.Int
+		headTrackerCrossValidationEnabled          bool
 		headTrackerHistoryDepth                    uint32
+		headTrackerMaxBackfillDepth                uint32
+		headTrackerMaxBatchSize                    uint32
 		headTrackerMaxBufferSize                   uint32
+		headTrackerMaxHeadLag                      time.Duration
 		headTrackerSamplingInterval                time.Duration
+		keyMinimumBalanceWei                       big.Int
 		linkContractAddress                        string
 		logBackfillBatchSize                       uint32
 		maxGasPriceWei                             big.Int
@@ -57,6 +71,10 @@ type (
 		minRequiredOutgoingConfirmations           uint64
 		minimumContractPayment                     *assets.Link
 		nonceAutoSync                              bool
+		autoCreateAccessList                       bool
+		nonceGapRepair                             bool
+		privateRelayFallbackBlocks                 uint32
+		privateRelayURL                            string
 		ocrContractConfirmations                   uint16
 		rpcDefaultBatchSize                        uint32
 		// set true if fully configured
@@ -92,39 +110,57 @@ func setChainSpecificConfigDefaultSets() {
 		blockHistoryEstimatorBlockDelay:            1,
 		blockHistoryEstimatorBlockHistorySize:      16,
 		blockHistoryEstimatorTransactionPercentile: 60,
-		chainType:                        "",
-		eip1559DynamicFees:               false,
-		ethTxReaperInterval:              1 * time.Hour,
-		ethTxReaperThreshold:             168 * time.Hour,
-		ethTxResendAfterThreshold:        1 * time.Minute,
-		finalityDepth:                    50,
-		gasBumpPercent:                   20,
-		gasBumpThreshold:                 3,
-		gasBumpTxDepth:                   10,
-		gasBumpWei:                       *assets.GWei(5),
-		gasEstimatorMode:                 "BlockHistory",
-		gasLimitDefault:                  DefaultGasLimit,
-		gasLimitMultiplier:               1.0,
-		gasLimitTransfer:                 21000,
-		gasPriceDefault:                  *DefaultGasPrice,
-		gasTipCapDefault:                 *DefaultGasTip,
-		gasTipCapMinimum:                 *big.NewInt(0),
-		headTrackerHistoryDepth:          100,
-		headTrackerMaxBufferSize:         3,
-		headTrackerSamplingInterval:      1 * time.Second,
-		linkContractAddress:              "",
-		logBackfillBatchSize:             100,
-		maxGasPriceWei:                   *assets.GWei(5000),
-		maxInFlightTransactions:          16,
-		maxQueuedTransactions:            250,
-		minGasPriceWei:                   *assets.GWei(1),
-		minIncomingConfirmations:         3,
-		minRequiredOutgoingConfirmations: 12,
-		minimumContractPayment:           DefaultMinimumContractPayment,
-		nonceAutoSync:                    true,
-		ocrContractConfirmations:         4,
-		rpcDefaultBatchSize:              100,
-		complete:                         true,
+		chainType:                           "",
+		eip1559DynamicFees:                  false,
+		ethTxAttemptGCKeepCount:             0, // disabled by default; superseded attempts are kept forever
+		ethTxReaperArchiveEnabled:           false,
+		ethTxReaperInterval:                 1 * time.Hour,
+		ethTxReaperThreshold:                168 * time.Hour,
+		ethTxResendAfterThreshold:           1 * time.Minute,
+		feeHistoryEstimatorBaseFeeHeadroom:  2.0, // the base fee can rise at most 12.5% per block, so this holds for ~6 blocks of full blocks
+		feeHistoryEstimatorBlockHistorySize: 8,
+		feeHistoryEstimatorCapBehavior:      "clamp",
+		feeHistoryEstimatorRewardPercentile: 60,
+		finalityDepth:                       50,
+		finalityTagEnabled:                  false, // only post-merge clients support the "finalized" block tag
+		fundingTopUpMaxDailyWei:             *big.NewInt(0),
+		gasBumpPercent:                      20,
+		gasBumpThreshold:                    3,
+		gasBumpTxDepth:                      10,
+		gasBumpWei:                          *assets.GWei(5),
+		gasEstimatorExternalURL:             "",
+		gasEstimatorMode:                    "BlockHistory",
+		gasLimitDefault:                     DefaultGasLimit,
+		gasLimitMultiplier:                  1.0,
+		gasLimitTransfer:                    21000,
+		gasPriceDefault:                     *DefaultGasPrice,
+		gasTipCapDefault:                    *DefaultGasTip,
+		gasTipCapMinimum:                    *big.NewInt(0),
+		headTrackerCrossValidationEnabled:   false,
+		headTrackerHistoryDepth:             100,
+		headTrackerMaxBackfillDepth:         300,
+		headTrackerMaxBatchSize:             0, // disabled by default; every head is delivered individually
+		headTrackerMaxBufferSize:            3,
+		headTrackerMaxHeadLag:               0, // disabled by default; head lag never flips the health check
+		headTrackerSamplingInterval:         1 * time.Second,
+		keyMinimumBalanceWei:                *big.NewInt(0),
+		linkContractAddress:                 "",
+		logBackfillBatchSize:                100,
+		maxGasPriceWei:                      *assets.GWei(5000),
+		maxInFlightTransactions:             16,
+		maxQueuedTransactions:               250,
+		minGasPriceWei:                      *assets.GWei(1),
+		minIncomingConfirmations:            3,
+		minRequiredOutgoingConfirmations:    12,
+		minimumContractPayment:              DefaultMinimumContractPayment,
+		nonceAutoSync:                       true,
+		autoCreateAccessList:                false,
+		nonceGapRepair:                      false,
+		privateRelayFallbackBlocks:          6,
+		privateRelayURL:                     "",
+		ocrContractConfirmations:            4,
+		rpcDefaultBatchSize:                 100,
+		complete:                            true,
 	}
 
 	mainnet := fallbackDefaultSet
@@ -31,11 +31,15 @@ type ChainScopedOnlyConfig interface {
 	BlockHistoryEstimatorTransactionPercentile() uint16
 	ChainID() *big.Int
 	EvmEIP1559DynamicFees() bool
+	EthTxAttemptGCKeepCount() uint16
+	EthTxReaperArchiveEnabled() bool
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
 	EvmDefaultBatchSize() uint32
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
+	EvmFundingTopUpMaxDailyWei() *big.Int
 	EvmGasBumpPercent() uint16
 	EvmGasBumpThreshold() uint64
 	EvmGasBumpTxDepth() uint16
@@ -47,17 +51,31 @@ type ChainScopedOnlyConfig interface {
 	EvmGasPriceDefault() *big.Int
 	EvmGasTipCapDefault() *big.Int
 	EvmGasTipCapMinimum() *big.Int
+	EvmHeadTrackerCrossValidationEnabled() bool
 	EvmHeadTrackerHistoryDepth() uint32
+	EvmHeadTrackerMaxBackfillDepth() uint32
+	EvmHeadTrackerMaxBatchSize() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
+	EvmHeadTrackerMaxHeadLag() time.Duration
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmKeyMinimumBalanceWei() *big.Int
 	EvmLogBackfillBatchSize() uint32
 	EvmMaxGasPriceWei() *big.Int
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmMinGasPriceWei() *big.Int
 	EvmNonceAutoSync() bool
+	EvmAutoCreateAccessList() bool
+	EvmNonceGapRepair() bool
+	EvmPrivateRelayFallbackBlocks() uint32
+	EvmPrivateRelayURL() string
 	EvmRPCDefaultBatchSize() uint32
+	FeeHistoryEstimatorBaseFeeHeadroom() float32
+	FeeHistoryEstimatorBlockHistorySize() uint16
+	FeeHistoryEstimatorCapBehavior() string
+	FeeHistoryEstimatorRewardPercentile() uint16
 	FlagsContractAddress() string
+	GasEstimatorExternalURL() string
 	GasEstimatorMode() string
 	ChainType() chains.ChainType
 	KeySpecificMaxGasPriceWei(addr gethcommon.Address) *big.Int
@@ -161,6 +179,17 @@ func (c *chainScopedConfig) validate() (err error) {
 	if c.GasEstimatorMode() == "BlockHistory" && c.BlockHistoryEstimatorBlockHistorySize() <= 0 {
 		err = multierr.Combine(err, errors.New("BLOCK_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if block history estimator is enabled"))
 	}
+	if c.GasEstimatorMode() == "FeeHistory" {
+		if c.FeeHistoryEstimatorBlockHistorySize() <= 0 {
+			err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE must be greater than or equal to 1 if fee history estimator is enabled"))
+		}
+		if p := c.FeeHistoryEstimatorRewardPercentile(); p > 100 {
+			err = multierr.Combine(err, errors.New("FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE must be between 0 and 100"))
+		}
+		if b := c.FeeHistoryEstimatorCapBehavior(); b != "clamp" && b != "hold" {
+			err = multierr.Combine(err, errors.Errorf("FEE_HISTORY_ESTIMATOR_CAP_BEHAVIOR must be either 'clamp' or 'hold', got '%s'", b))
+		}
+	}
 	if c.EvmFinalityDepth() < 1 {
 		err = multierr.Combine(err, errors.New("ETH_FINALITY_DEPTH must be greater than or equal to 1"))
 	}
@@ -315,6 +344,46 @@ func (c *chainScopedConfig) EvmMaxInFlightTransactions() uint32 {
 	return c.defaultSet.maxInFlightTransactions
 }
 
+// EvmKeyMinimumBalanceWei is the minimum balance each sending key must
+// maintain. When a key dips below this the balance monitor will top it up
+// from a funding key if one is available. Set to 0 to disable auto-top-up
+func (c *chainScopedConfig) EvmKeyMinimumBalanceWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmKeyMinimumBalanceWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmKeyMinimumBalanceWei", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmKeyMinimumBalanceWei
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmKeyMinimumBalanceWei", p)
+		return p.ToInt()
+	}
+	n := c.defaultSet.keyMinimumBalanceWei
+	return &n
+}
+
+// EvmFundingTopUpMaxDailyWei caps the total value of top-up transactions the
+// balance monitor may send from the funding key in any 24 hour window. Set to
+// 0 for no cap
+func (c *chainScopedConfig) EvmFundingTopUpMaxDailyWei() *big.Int {
+	val, ok := c.GeneralConfig.GlobalEvmFundingTopUpMaxDailyWei()
+	if ok {
+		c.logEnvOverrideOnce("EvmFundingTopUpMaxDailyWei", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmFundingTopUpMaxDailyWei
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmFundingTopUpMaxDailyWei", p)
+		return p.ToInt()
+	}
+	n := c.defaultSet.fundingTopUpMaxDailyWei
+	return &n
+}
+
 // EvmMaxGasPriceWei is the maximum amount in Wei that a transaction will be
 // bumped to before abandoning it and marking it as errored.
 func (c *chainScopedConfig) EvmMaxGasPriceWei() *big.Int {
@@ -463,6 +532,45 @@ func (c *chainScopedConfig) EvmFinalityDepth() uint32 {
 	return c.defaultSet.finalityDepth
 }
 
+// EvmFinalityTagEnabled enables use of the "finalized" block tag when querying for finality.
+// Only post-merge execution clients support eth_getBlockByNumber with a named tag; on chains
+// where it is supported this replaces counting back a fixed EvmFinalityDepth number of blocks.
+func (c *chainScopedConfig) EvmFinalityTagEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmFinalityTagEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmFinalityTagEnabled", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmFinalityTagEnabled
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmFinalityTagEnabled", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.finalityTagEnabled
+}
+
+// EvmHeadTrackerCrossValidationEnabled enables comparing block hashes at a
+// finalized height across every node in the pool on each new head, to detect
+// badly-forked or malicious RPC providers. It requires at least two primary
+// nodes to be useful and costs one extra RPC call per node per head
+func (c *chainScopedConfig) EvmHeadTrackerCrossValidationEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEvmHeadTrackerCrossValidationEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EvmHeadTrackerCrossValidationEnabled", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmHeadTrackerCrossValidationEnabled
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmHeadTrackerCrossValidationEnabled", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.headTrackerCrossValidationEnabled
+}
+
 // EvmHeadTrackerHistoryDepth tracks the top N block numbers to keep in the `heads` database table.
 // Note that this can easily result in MORE than N records since in the case of re-orgs we keep multiple heads for a particular block height.
 // This number should be at least as large as `EvmFinalityDepth`.
@@ -630,6 +738,24 @@ func (c *chainScopedConfig) BlockHistoryEstimatorTransactionPercentile() uint16
 	return c.defaultSet.blockHistoryEstimatorTransactionPercentile
 }
 
+// GasEstimatorExternalURL is the endpoint of the external gas estimation
+// service used when GAS_ESTIMATOR_MODE=External
+func (c *chainScopedConfig) GasEstimatorExternalURL() string {
+	val, ok := c.GeneralConfig.GlobalGasEstimatorExternalURL()
+	if ok {
+		c.logEnvOverrideOnce("GasEstimatorExternalURL", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.GasEstimatorExternalURL
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("GasEstimatorExternalURL", p.String)
+		return p.String
+	}
+	return c.defaultSet.gasEstimatorExternalURL
+}
+
 // GasEstimatorMode controls what type of gas estimator is used
 func (c *chainScopedConfig) GasEstimatorMode() string {
 	val, ok := c.GeneralConfig.GlobalGasEstimatorMode()
@@ -833,6 +959,81 @@ func (c *chainScopedConfig) EvmNonceAutoSync() bool {
 	return c.defaultSet.nonceAutoSync
 }
 
+// EvmAutoCreateAccessList enables generating an EIP-2930 access list for
+// outgoing transactions via eth_createAccessList, attaching it when it
+// reduces the estimated gas
+func (c *chainScopedConfig) EvmAutoCreateAccessList() bool {
+	val, ok := c.GeneralConfig.GlobalEvmAutoCreateAccessList()
+	if ok {
+		c.logEnvOverrideOnce("EvmAutoCreateAccessList", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmAutoCreateAccessList
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmAutoCreateAccessList", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.autoCreateAccessList
+}
+
+// EvmNonceGapRepair enables/disables automatic repair when the
+// NonceGapMonitor detects a nonce gap at runtime
+func (c *chainScopedConfig) EvmNonceGapRepair() bool {
+	val, ok := c.GeneralConfig.GlobalEvmNonceGapRepair()
+	if ok {
+		c.logEnvOverrideOnce("EvmNonceGapRepair", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmNonceGapRepair
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmNonceGapRepair", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.nonceGapRepair
+}
+
+// EvmPrivateRelayURL is the URL of a private transaction relay (e.g. Flashbots
+// Protect). If set, new transactions are submitted to the relay using
+// eth_sendPrivateTransaction instead of the public mempool
+func (c *chainScopedConfig) EvmPrivateRelayURL() string {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayURL()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayURL", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmPrivateRelayURL
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmPrivateRelayURL", p.String)
+		return p.String
+	}
+	return c.defaultSet.privateRelayURL
+}
+
+// EvmPrivateRelayFallbackBlocks is the number of blocks to wait for a
+// privately relayed transaction to be mined before rebroadcasts fall back to
+// the public mempool
+func (c *chainScopedConfig) EvmPrivateRelayFallbackBlocks() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmPrivateRelayFallbackBlocks()
+	if ok {
+		c.logEnvOverrideOnce("EvmPrivateRelayFallbackBlocks", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmPrivateRelayFallbackBlocks
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmPrivateRelayFallbackBlocks", p.Int64)
+		return uint32(p.Int64)
+	}
+	return c.defaultSet.privateRelayFallbackBlocks
+}
+
 // EvmGasLimitMultiplier is a factor by which a transaction's GasLimit is
 // multiplied before transmission. So if the value is 1.1, and the GasLimit for
 // a transaction is 10, 10% will be added before transmission.
@@ -855,6 +1056,48 @@ func (c *chainScopedConfig) EvmGasLimitMultiplier() float32 {
 	return c.defaultSet.gasLimitMultiplier
 }
 
+// EvmHeadTrackerMaxBackfillDepth is the largest gap of missed heads that will
+// be backfilled over HTTP after the websocket subscription skips ahead, e.g.
+// on reconnect after an outage. Larger gaps are treated like a node restart:
+// only the new head is processed and older blocks are left to the regular
+// finality-depth backfill. Setting it to 0 disables gap backfill entirely
+func (c *chainScopedConfig) EvmHeadTrackerMaxBackfillDepth() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmHeadTrackerMaxBackfillDepth()
+	if ok {
+		c.logEnvOverrideOnce("EvmHeadTrackerMaxBackfillDepth", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmHeadTrackerMaxBackfillDepth
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmHeadTrackerMaxBackfillDepth", p.Int64)
+		return uint32(p.Int64)
+	}
+	return c.defaultSet.headTrackerMaxBackfillDepth
+}
+
+// EvmHeadTrackerMaxBatchSize is the maximum number of queued heads that are
+// collapsed into a single callback, delivering only the newest of the batch.
+// On chains that produce blocks faster than the callbacks can process them
+// this stops the head tracker from falling ever further behind. 0 or 1
+// disables batching and every head is delivered individually
+func (c *chainScopedConfig) EvmHeadTrackerMaxBatchSize() uint32 {
+	val, ok := c.GeneralConfig.GlobalEvmHeadTrackerMaxBatchSize()
+	if ok {
+		c.logEnvOverrideOnce("EvmHeadTrackerMaxBatchSize", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmHeadTrackerMaxBatchSize
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EvmHeadTrackerMaxBatchSize", p.Int64)
+		return uint32(p.Int64)
+	}
+	return c.defaultSet.headTrackerMaxBatchSize
+}
+
 // EvmHeadTrackerMaxBufferSize is the maximum number of heads that may be
 // buffered in front of the head tracker before older heads start to be
 // dropped. You may think of it as something like the maximum permittable "lag"
@@ -875,6 +1118,64 @@ func (c *chainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
 	return c.defaultSet.headTrackerMaxBufferSize
 }
 
+// EvmHeadTrackerMaxHeadLag is the maximum amount of time the latest received
+// head's timestamp may trail wall-clock time before the head tracker reports
+// itself unhealthy. This is the primary signal that an RPC provider is serving
+// stale heads. Setting it to a zero duration disables the check
+func (c *chainScopedConfig) EvmHeadTrackerMaxHeadLag() time.Duration {
+	val, ok := c.GeneralConfig.GlobalEvmHeadTrackerMaxHeadLag()
+	if ok {
+		c.logEnvOverrideOnce("EvmHeadTrackerMaxHeadLag", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EvmHeadTrackerMaxHeadLag
+	c.persistMu.RUnlock()
+	if p != nil {
+		c.logPersistedOverrideOnce("EvmHeadTrackerMaxHeadLag", p.Duration())
+		return p.Duration()
+	}
+	return c.defaultSet.headTrackerMaxHeadLag
+}
+
+// EthTxAttemptGCKeepCount is the number of most recent attempts to keep per
+// confirmed eth_tx when the reaper garbage collects superseded attempts. The
+// attempt that got the receipt is always kept. 0 disables attempt GC
+func (c *chainScopedConfig) EthTxAttemptGCKeepCount() uint16 {
+	val, ok := c.GeneralConfig.GlobalEthTxAttemptGCKeepCount()
+	if ok {
+		c.logEnvOverrideOnce("EthTxAttemptGCKeepCount", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EthTxAttemptGCKeepCount
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EthTxAttemptGCKeepCount", p.Int64)
+		return uint16(p.Int64)
+	}
+	return c.defaultSet.ethTxAttemptGCKeepCount
+}
+
+// EthTxReaperArchiveEnabled enables copying reaped confirmed eth_txes (along
+// with their attempts and receipts) into archive tables instead of discarding
+// them outright
+func (c *chainScopedConfig) EthTxReaperArchiveEnabled() bool {
+	val, ok := c.GeneralConfig.GlobalEthTxReaperArchiveEnabled()
+	if ok {
+		c.logEnvOverrideOnce("EthTxReaperArchiveEnabled", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.EthTxReaperArchiveEnabled
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("EthTxReaperArchiveEnabled", p.Bool)
+		return p.Bool
+	}
+	return c.defaultSet.ethTxReaperArchiveEnabled
+}
+
 // EthTxReaperInterval controls how often the eth tx reaper should run
 func (c *chainScopedConfig) EthTxReaperInterval() time.Duration {
 	val, ok := c.GeneralConfig.GlobalEthTxReaperInterval()
@@ -945,6 +1246,80 @@ func (c *chainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	return c.defaultSet.rpcDefaultBatchSize
 }
 
+// FeeHistoryEstimatorBaseFeeHeadroom is the factor by which the FeeHistory
+// estimator multiplies the next block's base fee when computing the fee cap,
+// to leave room for the base fee to rise before the transaction is included
+func (c *chainScopedConfig) FeeHistoryEstimatorBaseFeeHeadroom() float32 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBaseFeeHeadroom()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBaseFeeHeadroom", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.FeeHistoryEstimatorBaseFeeHeadroom
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("FeeHistoryEstimatorBaseFeeHeadroom", p.Float64)
+		return float32(p.Float64)
+	}
+	return c.defaultSet.feeHistoryEstimatorBaseFeeHeadroom
+}
+
+// FeeHistoryEstimatorBlockHistorySize is the number of past blocks the
+// FeeHistory estimator asks eth_feeHistory to sample
+func (c *chainScopedConfig) FeeHistoryEstimatorBlockHistorySize() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorBlockHistorySize()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorBlockHistorySize", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.FeeHistoryEstimatorBlockHistorySize
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("FeeHistoryEstimatorBlockHistorySize", p.Int64)
+		return uint16(p.Int64)
+	}
+	return c.defaultSet.feeHistoryEstimatorBlockHistorySize
+}
+
+// FeeHistoryEstimatorCapBehavior controls what the FeeHistory estimator does
+// when the computed fee exceeds ETH_MAX_GAS_PRICE_WEI: "clamp" caps the fee at
+// the maximum, "hold" refuses to estimate until fees fall below it
+func (c *chainScopedConfig) FeeHistoryEstimatorCapBehavior() string {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorCapBehavior()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorCapBehavior", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.FeeHistoryEstimatorCapBehavior
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("FeeHistoryEstimatorCapBehavior", p.String)
+		return p.String
+	}
+	return c.defaultSet.feeHistoryEstimatorCapBehavior
+}
+
+// FeeHistoryEstimatorRewardPercentile is the within-block priority fee
+// percentile the FeeHistory estimator requests from eth_feeHistory
+func (c *chainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	val, ok := c.GeneralConfig.GlobalFeeHistoryEstimatorRewardPercentile()
+	if ok {
+		c.logEnvOverrideOnce("FeeHistoryEstimatorRewardPercentile", val)
+		return val
+	}
+	c.persistMu.RLock()
+	p := c.persistedCfg.FeeHistoryEstimatorRewardPercentile
+	c.persistMu.RUnlock()
+	if p.Valid {
+		c.logPersistedOverrideOnce("FeeHistoryEstimatorRewardPercentile", p.Int64)
+		return uint16(p.Int64)
+	}
+	return c.defaultSet.feeHistoryEstimatorRewardPercentile
+}
+
 // FlagsContractAddress represents the Flags contract address
 func (c *chainScopedConfig) FlagsContractAddress() string {
 	val, ok := c.GeneralConfig.GlobalFlagsContractAddress()
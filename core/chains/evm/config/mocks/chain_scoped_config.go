@@ -557,6 +557,34 @@ func (_m *ChainScopedConfig) EVMDisabled() bool {
 	return r0
 }
 
+// EthTxAttemptGCKeepCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxAttemptGCKeepCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EthTxReaperArchiveEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EthTxReaperArchiveEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -701,6 +729,36 @@ func (_m *ChainScopedConfig) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmFundingTopUpMaxDailyWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmFundingTopUpMaxDailyWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EvmGasBumpPercent provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmGasBumpPercent() uint16 {
 	ret := _m.Called()
@@ -865,6 +923,20 @@ func (_m *ChainScopedConfig) EvmGasTipCapMinimum() *big.Int {
 	return r0
 }
 
+// EvmHeadTrackerCrossValidationEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerCrossValidationEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
 	ret := _m.Called()
@@ -879,6 +951,34 @@ func (_m *ChainScopedConfig) EvmHeadTrackerHistoryDepth() uint32 {
 	return r0
 }
 
+// EvmHeadTrackerMaxBackfillDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerMaxBackfillDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerMaxBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerMaxBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerMaxBufferSize provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
 	ret := _m.Called()
@@ -893,6 +993,20 @@ func (_m *ChainScopedConfig) EvmHeadTrackerMaxBufferSize() uint32 {
 	return r0
 }
 
+// EvmHeadTrackerMaxHeadLag provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmHeadTrackerMaxHeadLag() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerSamplingInterval provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
 	ret := _m.Called()
@@ -907,6 +1021,22 @@ func (_m *ChainScopedConfig) EvmHeadTrackerSamplingInterval() time.Duration {
 	return r0
 }
 
+// EvmKeyMinimumBalanceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmKeyMinimumBalanceWei() *big.Int {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	return r0
+}
+
 // EvmLogBackfillBatchSize provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmLogBackfillBatchSize() uint32 {
 	ret := _m.Called()
@@ -995,6 +1125,62 @@ func (_m *ChainScopedConfig) EvmNonceAutoSync() bool {
 	return r0
 }
 
+// EvmAutoCreateAccessList provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmAutoCreateAccessList() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmNonceGapRepair provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmNonceGapRepair() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmPrivateRelayFallbackBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmPrivateRelayFallbackBlocks() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmPrivateRelayURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) EvmPrivateRelayURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmRPCDefaultBatchSize provides a mock function with given fields:
 func (_m *ChainScopedConfig) EvmRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
@@ -1053,6 +1239,34 @@ func (_m *ChainScopedConfig) ExplorerURL() *url.URL {
 	return r0
 }
 
+// FMBatchFlushInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) FMBatchFlushInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// FMBatchSubmissions provides a mock function with given fields:
+func (_m *ChainScopedConfig) FMBatchSubmissions() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FMDefaultTransactionQueueDepth provides a mock function with given fields:
 func (_m *ChainScopedConfig) FMDefaultTransactionQueueDepth() uint32 {
 	ret := _m.Called()
@@ -1137,6 +1351,62 @@ func (_m *ChainScopedConfig) FeatureUIFeedsManager() bool {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeHeadroom provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBaseFeeHeadroom() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockHistorySize provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorBlockHistorySize() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorCapBehavior provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorCapBehavior() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
 // FlagsContractAddress provides a mock function with given fields:
 func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	ret := _m.Called()
@@ -1151,6 +1421,20 @@ func (_m *ChainScopedConfig) FlagsContractAddress() string {
 	return r0
 }
 
+// GasEstimatorExternalURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) GasEstimatorExternalURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *ChainScopedConfig) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -1302,11 +1586,393 @@ func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorBlockHistorySize() (uint
 func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalChainType provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalChainType() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxAttemptGCKeepCount provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxAttemptGCKeepCount() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxReaperArchiveEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxReaperArchiveEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxReaperInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxReaperThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxResendAfterThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmDefaultBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmFinalityDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmFundingTopUpMaxDailyWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmFundingTopUpMaxDailyWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpPercent provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpThreshold provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasBumpWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmGasLimitTransfer provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1319,15 +1985,17 @@ func (_m *ChainScopedConfig) GlobalBlockHistoryEstimatorTransactionPercentile()
 	return r0, r1
 }
 
-// GlobalChainType provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalChainType() (string, bool) {
+// GlobalEvmGasPriceDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 string
-	if rf, ok := ret.Get(0).(func() string); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(string)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1340,15 +2008,17 @@ func (_m *ChainScopedConfig) GlobalChainType() (string, bool) {
 	return r0, r1
 }
 
-// GlobalEthTxReaperInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
+// GlobalEvmGasTipCapDefault provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1361,15 +2031,17 @@ func (_m *ChainScopedConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	return r0, r1
 }
 
-// GlobalEthTxReaperThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEthTxReaperThreshold() (time.Duration, bool) {
+// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1382,15 +2054,15 @@ func (_m *ChainScopedConfig) GlobalEthTxReaperThreshold() (time.Duration, bool)
 	return r0, r1
 }
 
-// GlobalEthTxResendAfterThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, bool) {
+// GlobalEvmHeadTrackerCrossValidationEnabled provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerCrossValidationEnabled() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1403,8 +2075,8 @@ func (_m *ChainScopedConfig) GlobalEthTxResendAfterThreshold() (time.Duration, b
 	return r0, r1
 }
 
-// GlobalEvmDefaultBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
+// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1424,15 +2096,15 @@ func (_m *ChainScopedConfig) GlobalEvmDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmEIP1559DynamicFees provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
+// GlobalEvmHeadTrackerMaxBackfillDepth provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBackfillDepth() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1445,8 +2117,8 @@ func (_m *ChainScopedConfig) GlobalEvmEIP1559DynamicFees() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmFinalityDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
+// GlobalEvmHeadTrackerMaxBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
 	var r0 uint32
@@ -1466,15 +2138,15 @@ func (_m *ChainScopedConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpPercent provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
+// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1487,15 +2159,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpThreshold provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
+// GlobalEvmHeadTrackerMaxHeadLag provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxHeadLag() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1508,15 +2180,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpThreshold() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpTxDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
+// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	ret := _m.Called()
 
-	var r0 uint16
-	if rf, ok := ret.Get(0).(func() uint16); ok {
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint16)
+		r0 = ret.Get(0).(time.Duration)
 	}
 
 	var r1 bool
@@ -1529,8 +2201,8 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpTxDepth() (uint16, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasBumpWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
+// GlobalEvmKeyMinimumBalanceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmKeyMinimumBalanceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
 	var r0 *big.Int
@@ -1552,15 +2224,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasBumpWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
+// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1573,15 +2245,17 @@ func (_m *ChainScopedConfig) GlobalEvmGasLimitDefault() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitMultiplier provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
+// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
-	var r0 float32
-	if rf, ok := ret.Get(0).(func() float32); ok {
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(float32)
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
 	}
 
 	var r1 bool
@@ -1594,15 +2268,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasLimitMultiplier() (float32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasLimitTransfer provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
+// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1615,17 +2289,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasLimitTransfer() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasPriceDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
+// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint64)
 	}
 
 	var r1 bool
@@ -1638,8 +2310,8 @@ func (_m *ChainScopedConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapDefault provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
+// GlobalEvmMinGasPriceWei provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	ret := _m.Called()
 
 	var r0 *big.Int
@@ -1661,17 +2333,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasTipCapDefault() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmGasTipCapMinimum provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
+// GlobalEvmNonceAutoSync provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1684,15 +2354,15 @@ func (_m *ChainScopedConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
+// GlobalEvmAutoCreateAccessList provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmAutoCreateAccessList() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1705,15 +2375,15 @@ func (_m *ChainScopedConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
+// GlobalEvmNonceGapRepair provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmNonceGapRepair() (bool, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(bool)
 	}
 
 	var r1 bool
@@ -1726,15 +2396,15 @@ func (_m *ChainScopedConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
 	return r0, r1
 }
 
-// GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
+// GlobalEvmPrivateRelayFallbackBlocks provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 time.Duration
-	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(time.Duration)
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1747,15 +2417,15 @@ func (_m *ChainScopedConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Durati
 	return r0, r1
 }
 
-// GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
+// GlobalEvmPrivateRelayURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmPrivateRelayURL() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1768,17 +2438,15 @@ func (_m *ChainScopedConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
+// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1791,15 +2459,15 @@ func (_m *ChainScopedConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+// GlobalFeeHistoryEstimatorBaseFeeHeadroom provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorBaseFeeHeadroom() (float32, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(float32)
 	}
 
 	var r1 bool
@@ -1812,15 +2480,15 @@ func (_m *ChainScopedConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+// GlobalFeeHistoryEstimatorBlockHistorySize provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorBlockHistorySize() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1833,17 +2501,15 @@ func (_m *ChainScopedConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMinGasPriceWei provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
+// GlobalFeeHistoryEstimatorCapBehavior provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorCapBehavior() (string, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1856,15 +2522,15 @@ func (_m *ChainScopedConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmNonceAutoSync provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+// GlobalFeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1877,15 +2543,15 @@ func (_m *ChainScopedConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
+// GlobalFlagsContractAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1898,8 +2564,8 @@ func (_m *ChainScopedConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalFlagsContractAddress provides a mock function with given fields:
-func (_m *ChainScopedConfig) GlobalFlagsContractAddress() (string, bool) {
+// GlobalGasEstimatorExternalURL provides a mock function with given fields:
+func (_m *ChainScopedConfig) GlobalGasEstimatorExternalURL() (string, bool) {
 	ret := _m.Called()
 
 	var r0 string
@@ -2471,6 +3137,20 @@ func (_m *ChainScopedConfig) MinimumContractPayment() *assets.Link {
 	return r0
 }
 
+// MulticallContractAddress provides a mock function with given fields:
+func (_m *ChainScopedConfig) MulticallContractAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCRBlockchainTimeout provides a mock function with given fields:
 func (_m *ChainScopedConfig) OCRBlockchainTimeout() time.Duration {
 	ret := _m.Called()
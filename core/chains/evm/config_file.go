@@ -0,0 +1,143 @@
+package evm
+
+import (
+	"database/sql"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	null "gopkg.in/guregu/null.v4"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/config"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// SeedChainsFromConfigFile upserts the chains and nodes declared in the
+// EVM_CHAINS section of the TOML config file at path into the database, so
+// that a node can be stood up from a single file without `chainlink chains`
+// and `chainlink nodes` commands. Chains are created or updated by ID; nodes
+// are matched by name and only created, never modified, since renaming or
+// repointing a live node is an operator decision.
+func SeedChainsFromConfigFile(lggr logger.Logger, orm types.ORM, path string) error {
+	tree, err := toml.LoadFile(path)
+	if err != nil {
+		return errors.Wrapf(err, "failed to load config file %q", path)
+	}
+	raw := tomlValue(tree, config.ConfigFileChainsKey)
+	if raw == nil {
+		return nil
+	}
+	chains, ok := raw.([]*toml.Tree)
+	if !ok {
+		return errors.Errorf("%s must be an array of tables", config.ConfigFileChainsKey)
+	}
+	for i, chainTree := range chains {
+		if err := seedChain(lggr, orm, chainTree); err != nil {
+			return errors.Wrapf(err, "%s[%d]", config.ConfigFileChainsKey, i)
+		}
+	}
+	return nil
+}
+
+func seedChain(lggr logger.Logger, orm types.ORM, chainTree *toml.Tree) error {
+	rawID := tomlValue(chainTree, "id")
+	if rawID == nil {
+		return errors.New("missing chain id")
+	}
+	var id utils.Big
+	if err := id.UnmarshalText([]byte(fmt.Sprint(rawID))); err != nil {
+		return errors.Wrapf(err, "invalid chain id %v", rawID)
+	}
+	enabled := true
+	if e, ok := tomlValue(chainTree, "enabled").(bool); ok {
+		enabled = e
+	}
+	var cfg types.ChainCfg
+	if confTree, ok := tomlValue(chainTree, "config").(*toml.Tree); ok {
+		b, err := json.Marshal(confTree.ToMap())
+		if err != nil {
+			return errors.Wrap(err, "failed to marshal chain config")
+		}
+		if err := json.Unmarshal(b, &cfg); err != nil {
+			return errors.Wrap(err, "invalid chain config")
+		}
+	}
+
+	_, err := orm.Chain(id)
+	switch {
+	case errors.Is(err, sql.ErrNoRows):
+		if _, err = orm.CreateChain(id, cfg); err != nil {
+			return errors.Wrapf(err, "failed to create chain %s", id.String())
+		}
+		lggr.Infow("Created chain from config file", "evmChainID", id.String())
+		if !enabled {
+			if _, err = orm.UpdateChain(id, enabled, cfg); err != nil {
+				return errors.Wrapf(err, "failed to disable chain %s", id.String())
+			}
+		}
+	case err != nil:
+		return errors.Wrapf(err, "failed to load chain %s", id.String())
+	default:
+		if _, err = orm.UpdateChain(id, enabled, cfg); err != nil {
+			return errors.Wrapf(err, "failed to update chain %s", id.String())
+		}
+		lggr.Debugw("Updated chain from config file", "evmChainID", id.String())
+	}
+
+	return seedNodes(lggr, orm, id, chainTree)
+}
+
+func seedNodes(lggr logger.Logger, orm types.ORM, chainID utils.Big, chainTree *toml.Tree) error {
+	nodeTrees, ok := tomlValue(chainTree, "nodes").([]*toml.Tree)
+	if !ok {
+		return nil
+	}
+	existing, err := orm.GetNodesByChainIDs([]utils.Big{chainID})
+	if err != nil {
+		return errors.Wrapf(err, "failed to load nodes for chain %s", chainID.String())
+	}
+	existingNames := make(map[string]bool, len(existing))
+	for _, node := range existing {
+		existingNames[node.Name] = true
+	}
+	for j, nodeTree := range nodeTrees {
+		name, _ := tomlValue(nodeTree, "name").(string)
+		if name == "" {
+			return errors.Errorf("nodes[%d] is missing a name", j)
+		}
+		if existingNames[name] {
+			continue
+		}
+		newNode := types.NewNode{
+			Name:       name,
+			EVMChainID: chainID,
+		}
+		if wsURL, ok := tomlValue(nodeTree, "wsURL").(string); ok {
+			newNode.WSURL = null.StringFrom(wsURL)
+		}
+		if httpURL, ok := tomlValue(nodeTree, "httpURL").(string); ok {
+			newNode.HTTPURL = null.StringFrom(httpURL)
+		}
+		newNode.SendOnly, _ = tomlValue(nodeTree, "sendOnly").(bool)
+		if _, err := orm.CreateNode(newNode); err != nil {
+			return errors.Wrapf(err, "failed to create node %q", name)
+		}
+		lggr.Infow("Created node from config file", "evmChainID", chainID.String(), "name", name)
+	}
+	return nil
+}
+
+// tomlValue looks up key in tree case-insensitively, matching viper's
+// handling of the rest of the config file
+func tomlValue(tree *toml.Tree, key string) interface{} {
+	for _, treeKey := range tree.Keys() {
+		if strings.EqualFold(treeKey, key) {
+			return tree.Get(treeKey)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,69 @@
+package evm_test
+
+import (
+	"database/sql"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/mocks"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/types"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func TestSeedChainsFromConfigFile(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "chainlink.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`
+LOG_LEVEL = "debug"
+
+[[EVM_CHAINS]]
+id = "42"
+enabled = true
+
+[EVM_CHAINS.config]
+EvmGasPriceDefault = "20000000000"
+
+[[EVM_CHAINS.nodes]]
+name = "existing"
+wsURL = "wss://existing.example.com/ws"
+
+[[EVM_CHAINS.nodes]]
+name = "new"
+httpURL = "https://new.example.com"
+sendOnly = true
+`), 0600))
+
+	id := *utils.NewBigI(42)
+	orm := new(mocks.ORM)
+	orm.Test(t)
+	orm.On("Chain", id).Return(types.Chain{}, sql.ErrNoRows)
+	orm.On("CreateChain", id, mock.MatchedBy(func(cfg types.ChainCfg) bool {
+		return cfg.EvmGasPriceDefault.String() == "20000000000"
+	})).Return(types.Chain{ID: id}, nil)
+	orm.On("GetNodesByChainIDs", []utils.Big{id}).Return([]types.Node{{Name: "existing", EVMChainID: id}}, nil)
+	orm.On("CreateNode", mock.MatchedBy(func(newNode types.NewNode) bool {
+		return newNode.Name == "new" && newNode.HTTPURL.String == "https://new.example.com" && newNode.SendOnly
+	})).Return(types.Node{}, nil)
+
+	require.NoError(t, evm.SeedChainsFromConfigFile(logger.TestLogger(t), orm, path))
+	orm.AssertExpectations(t)
+}
+
+func TestSeedChainsFromConfigFile_NoChains(t *testing.T) {
+	t.Parallel()
+
+	path := filepath.Join(t.TempDir(), "chainlink.toml")
+	require.NoError(t, os.WriteFile(path, []byte(`LOG_LEVEL = "debug"`), 0600))
+
+	orm := new(mocks.ORM)
+	orm.Test(t)
+	require.NoError(t, evm.SeedChainsFromConfigFile(logger.TestLogger(t), orm, path))
+	orm.AssertExpectations(t)
+}
@@ -0,0 +1,52 @@
+package forwarders
+
+import (
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Forwarder is an operator-owned forwarder contract through which outgoing
+// transactions can be routed, so sending keys can be rotated without updating
+// every consuming contract
+type Forwarder struct {
+	ID         int64
+	Address    ethkey.EIP55Address
+	EVMChainID utils.Big `db:"evm_chain_id"`
+	CreatedAt  time.Time `db:"created_at"`
+	UpdatedAt  time.Time `db:"updated_at"`
+}
+
+// authorizedForwarderABI is the subset of the AuthorizedForwarder contract
+// interface needed to wrap calldata and rotate authorized senders
+const authorizedForwarderABI = `[
+	{"inputs":[{"internalType":"address","name":"to","type":"address"},{"internalType":"bytes","name":"data","type":"bytes"}],"name":"forward","outputs":[],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"internalType":"address[]","name":"senders","type":"address[]"}],"name":"setAuthorizedSenders","outputs":[],"stateMutability":"nonpayable","type":"function"}
+]`
+
+var forwarderABI abi.ABI
+
+func init() {
+	var err error
+	forwarderABI, err = abi.JSON(strings.NewReader(authorizedForwarderABI))
+	if err != nil {
+		panic(err)
+	}
+}
+
+// WrapCalldata wraps the given payload in a forward(to, data) call suitable
+// for sending to a forwarder contract instead of the original target
+func WrapCalldata(to common.Address, data []byte) ([]byte, error) {
+	return forwarderABI.Pack("forward", to, data)
+}
+
+// SetAuthorizedSendersCalldata returns calldata that rotates the set of
+// sending keys authorized to call forward() on a forwarder contract
+func SetAuthorizedSendersCalldata(senders []common.Address) ([]byte, error) {
+	return forwarderABI.Pack("setAuthorizedSenders", senders)
+}
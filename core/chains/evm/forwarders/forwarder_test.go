@@ -0,0 +1,46 @@
+package forwarders_test
+
+import (
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
+)
+
+func TestWrapCalldata(t *testing.T) {
+	t.Parallel()
+
+	to := common.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	payload := []byte{0xde, 0xad, 0xbe, 0xef}
+
+	wrapped, err := forwarders.WrapCalldata(to, payload)
+	require.NoError(t, err)
+
+	selector := crypto.Keccak256([]byte("forward(address,bytes)"))[:4]
+	assert.Equal(t, selector, wrapped[:4])
+	// The target address is the first (left-padded) argument
+	assert.Equal(t, to.Bytes(), wrapped[4+12:4+32])
+	// The original payload appears intact in the encoded bytes argument
+	assert.Contains(t, string(wrapped), string(payload))
+}
+
+func TestSetAuthorizedSendersCalldata(t *testing.T) {
+	t.Parallel()
+
+	senders := []common.Address{
+		common.HexToAddress("0x3cCad4715152693fE3BC4460591e3D3Fbd071b42"),
+		common.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411"),
+	}
+
+	data, err := forwarders.SetAuthorizedSendersCalldata(senders)
+	require.NoError(t, err)
+
+	selector := crypto.Keccak256([]byte("setAuthorizedSenders(address[])"))[:4]
+	assert.Equal(t, selector, data[:4])
+	assert.Equal(t, senders[0].Bytes(), data[4+64+12:4+64+32])
+	assert.Equal(t, senders[1].Bytes(), data[4+96+12:4+96+32])
+}
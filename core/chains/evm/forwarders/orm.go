@@ -0,0 +1,74 @@
+package forwarders
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+//go:generate mockery --name ORM --output ./mocks/ --case=underscore
+
+type ORM interface {
+	CreateForwarder(addr common.Address, evmChainId utils.Big) (fwd Forwarder, err error)
+	FindForwarders(offset, limit int) ([]Forwarder, int, error)
+	FindForwardersByChain(evmChainId utils.Big) ([]Forwarder, error)
+	DeleteForwarder(id int64) error
+}
+
+type orm struct {
+	db *sqlx.DB
+}
+
+var _ ORM = (*orm)(nil)
+
+func NewORM(db *sqlx.DB) ORM {
+	return &orm{db}
+}
+
+var ErrNoRowsAffected = errors.New("no rows affected")
+
+// CreateForwarder registers a forwarder contract address for the given chain
+func (o *orm) CreateForwarder(addr common.Address, evmChainId utils.Big) (fwd Forwarder, err error) {
+	sql := `INSERT INTO evm_forwarders (address, evm_chain_id, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *`
+	err = o.db.Get(&fwd, sql, addr, evmChainId)
+	return fwd, err
+}
+
+func (o *orm) FindForwarders(offset, limit int) (fwds []Forwarder, count int, err error) {
+	sql := `SELECT count(*) FROM evm_forwarders`
+	if err = o.db.Get(&count, sql); err != nil {
+		return
+	}
+
+	sql = `SELECT * FROM evm_forwarders ORDER BY created_at DESC, id DESC LIMIT $1 OFFSET $2`
+	if err = o.db.Select(&fwds, sql, limit, offset); err != nil {
+		return
+	}
+	return
+}
+
+// FindForwardersByChain returns all forwarders registered for the given
+// chain, newest first
+func (o *orm) FindForwardersByChain(evmChainId utils.Big) (fwds []Forwarder, err error) {
+	sql := `SELECT * FROM evm_forwarders WHERE evm_chain_id = $1 ORDER BY created_at DESC, id DESC`
+	err = o.db.Select(&fwds, sql, evmChainId)
+	return
+}
+
+func (o *orm) DeleteForwarder(id int64) error {
+	sql := `DELETE FROM evm_forwarders WHERE id = $1`
+	result, err := o.db.Exec(sql, id)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return ErrNoRowsAffected
+	}
+	return nil
+}
@@ -46,10 +46,14 @@ type ORM interface {
 type ChainCfg struct {
 	BlockHistoryEstimatorBlockDelay       null.Int
 	BlockHistoryEstimatorBlockHistorySize null.Int
+	EthTxAttemptGCKeepCount               null.Int
+	EthTxReaperArchiveEnabled             null.Bool
 	EthTxReaperThreshold                  *models.Duration
 	EthTxResendAfterThreshold             *models.Duration
 	EvmEIP1559DynamicFees                 null.Bool
 	EvmFinalityDepth                      null.Int
+	EvmFinalityTagEnabled                 null.Bool
+	EvmFundingTopUpMaxDailyWei            *utils.Big
 	EvmGasBumpPercent                     null.Int
 	EvmGasBumpTxDepth                     null.Int
 	EvmGasBumpWei                         *utils.Big
@@ -58,14 +62,28 @@ type ChainCfg struct {
 	EvmGasPriceDefault                    *utils.Big
 	EvmGasTipCapDefault                   *utils.Big
 	EvmGasTipCapMinimum                   *utils.Big
+	EvmHeadTrackerCrossValidationEnabled  null.Bool
 	EvmHeadTrackerHistoryDepth            null.Int
+	EvmHeadTrackerMaxBackfillDepth        null.Int
+	EvmHeadTrackerMaxBatchSize            null.Int
 	EvmHeadTrackerMaxBufferSize           null.Int
+	EvmHeadTrackerMaxHeadLag              *models.Duration
 	EvmHeadTrackerSamplingInterval        *models.Duration
+	EvmKeyMinimumBalanceWei               *utils.Big
 	EvmLogBackfillBatchSize               null.Int
 	EvmMaxGasPriceWei                     *utils.Big
 	EvmNonceAutoSync                      null.Bool
+	EvmAutoCreateAccessList               null.Bool
+	EvmNonceGapRepair                     null.Bool
+	EvmPrivateRelayFallbackBlocks         null.Int
+	EvmPrivateRelayURL                    null.String
 	EvmRPCDefaultBatchSize                null.Int
+	FeeHistoryEstimatorBaseFeeHeadroom    null.Float
+	FeeHistoryEstimatorBlockHistorySize   null.Int
+	FeeHistoryEstimatorCapBehavior        null.String
+	FeeHistoryEstimatorRewardPercentile   null.Int
 	FlagsContractAddress                  null.String
+	GasEstimatorExternalURL               null.String
 	GasEstimatorMode                      null.String
 	ChainType                             null.String
 	MinIncomingConfirmations              null.Int
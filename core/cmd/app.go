@@ -143,6 +143,12 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Show the node's environment variables",
 					Action: client.GetConfiguration,
 				},
+				{
+					Name:      "validate",
+					Usage:     "Validate a TOML config file against the config schema",
+					ArgsUsage: "<path to config file>",
+					Action:    client.ValidateConfigFile,
+				},
 				{
 					Name:   "setgasprice",
 					Usage:  "Set the default gas price to use for outgoing transactions",
@@ -237,6 +243,65 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "Trigger a job run",
 					Action: client.TriggerPipelineRun,
 				},
+				{
+					Name:   "export",
+					Usage:  "Export job specs as TOML files, one per job",
+					Action: client.ExportJobs,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "all",
+							Usage: "export every job on the node",
+						},
+						cli.StringFlag{
+							Name:  "dir",
+							Usage: "directory to write the TOML files to (default: current directory)",
+						},
+					},
+				},
+				{
+					Name:   "import",
+					Usage:  "Import job specs from a directory of TOML files, creating new jobs and replacing changed ones",
+					Action: client.ImportJobs,
+					Flags: []cli.Flag{
+						cli.BoolFlag{
+							Name:  "dry-run",
+							Usage: "print what would change without applying anything",
+						},
+					},
+				},
+			},
+		},
+		{
+			Name:        "doctor",
+			Usage:       "Run local diagnostics and print a prioritized report",
+			Description: "Checks config validity, database connectivity and migrations, the lease lock, clock skew, keystore state, RPC node reachability per chain, and table bloat. Must be run on the same machine as the Chainlink node.",
+			Action:      client.Doctor,
+			Flags: []cli.Flag{
+				cli.StringFlag{
+					Name:  "password, p",
+					Usage: "text file holding the keystore password; if set the keystore is verified to unlock with it",
+				},
+			},
+		},
+		{
+			Name:  "pipeline",
+			Usage: "Commands for developing pipeline specs locally",
+			Subcommands: []cli.Command{
+				{
+					Name:   "run",
+					Usage:  "Execute a job spec's pipeline locally, printing each task's result",
+					Action: client.RunPipelineLocal,
+					Flags: []cli.Flag{
+						cli.StringFlag{
+							Name:  "input",
+							Usage: "JSON made available to the pipeline as $(input) and $(jobRun.requestBody)",
+						},
+						cli.StringFlag{
+							Name:  "mock-http",
+							Usage: "JSON object (or path to a file containing one) mapping URL prefixes to canned response bodies; when set no real HTTP calls are made",
+						},
+					},
+				},
 			},
 		},
 		{
@@ -393,6 +458,17 @@ func NewApp(client *Client) *cli.App {
 							Usage:  format(`Create a CSA key, encrypted with password from the password file, and store it in the database.`),
 							Action: client.CreateCSAKey,
 						},
+						{
+							Name:  "delete",
+							Usage: format(`Delete the CSA key by id`),
+							Flags: []cli.Flag{
+								cli.BoolFlag{
+									Name:  "yes, y",
+									Usage: "skip the confirmation prompt",
+								},
+							},
+							Action: client.DeleteCSAKey,
+						},
 						{
 							Name:   "list",
 							Usage:  format(`List available CSA keys`),
@@ -678,12 +754,30 @@ func NewApp(client *Client) *cli.App {
 							Action: client.StatusDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:   "lock",
+							Usage:  "Display the current holder of the database lease lock and the lease age.",
+							Action: client.ShowDatabaseLock,
+							Flags:  []cli.Flag{},
+						},
+						{
+							Name:   "unlock",
+							Usage:  "Remove an expired database lease lock left behind by a crashed node. Refuses to remove a lease that is still being renewed.",
+							Action: client.UnlockDatabase,
+							Flags:  []cli.Flag{},
+						},
 						{
 							Name:   "migrate",
 							Usage:  "Migrate the database to the latest version.",
 							Action: client.MigrateDatabase,
 							Flags:  []cli.Flag{},
 						},
+						{
+							Name:   "plan",
+							Usage:  "Display pending migrations and estimate their lock impact without applying them.",
+							Action: client.PlanDatabaseMigrations,
+							Flags:  []cli.Flag{},
+						},
 						{
 							Name:   "rollback",
 							Usage:  "Roll back the database to a previous <version>. Rolls back a single migration if no version specified.",
@@ -797,6 +891,25 @@ func NewApp(client *Client) *cli.App {
 								},
 							},
 						},
+						{
+							Name:   "update",
+							Usage:  "Update an EVM chain's full configuration from a JSON or TOML blob or file",
+							Action: client.UpdateChain,
+							Flags: []cli.Flag{
+								cli.Int64Flag{
+									Name:  "id",
+									Usage: "chain ID",
+								},
+								cli.BoolFlag{
+									Name:  "enable",
+									Usage: "enable the chain",
+								},
+								cli.BoolFlag{
+									Name:  "disable",
+									Usage: "disable the chain",
+								},
+							},
+						},
 					},
 				},
 			},
@@ -842,6 +955,55 @@ func NewApp(client *Client) *cli.App {
 					Usage:  "List all nodes",
 					Action: client.IndexNodes,
 				},
+				{
+					Name:  "evm",
+					Usage: "Commands for handling EVM nodes",
+					Subcommands: cli.Commands{
+						{
+							Name:   "create",
+							Usage:  "Create a new EVM node",
+							Action: client.CreateNode,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "name",
+									Usage: "node name",
+								},
+								cli.StringFlag{
+									Name:  "ws-url",
+									Usage: "Websocket URL",
+								},
+								cli.StringFlag{
+									Name:  "http-url",
+									Usage: "HTTP URL, optional",
+								},
+								cli.Int64Flag{
+									Name:  "chain-id",
+									Usage: "chain ID",
+								},
+								cli.StringFlag{
+									Name:  "type",
+									Usage: "primary|sendonly",
+								},
+							},
+						},
+						{
+							Name:   "delete",
+							Usage:  "Delete an EVM node",
+							Action: client.RemoveNode,
+						},
+						{
+							Name:   "list",
+							Usage:  "List EVM nodes, optionally scoped to a single chain",
+							Action: client.IndexEVMNodes,
+							Flags: []cli.Flag{
+								cli.StringFlag{
+									Name:  "chain-id",
+									Usage: "only list nodes for this chain ID",
+								},
+							},
+						},
+					},
+				},
 			},
 		},
 	}...)
@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
@@ -72,21 +73,21 @@ func (cli *Client) IndexChains(c *cli.Context) (err error) {
 // CreateChain adds a new chain to the chainlink node
 func (cli *Client) CreateChain(c *cli.Context) (err error) {
 	if !c.Args().Present() {
-		return cli.errorOut(errors.New("must pass in the chain's parameters [-id integer] [JSON blob | JSON filepath]"))
+		return cli.errorOut(errors.New("must pass in the chain's parameters [-id integer] [JSON/TOML blob | filepath]"))
 	}
 	chainID := c.Int64("id")
 	if chainID == 0 {
 		return cli.errorOut(errors.New("missing chain ID [-id integer]"))
 	}
 
-	buf, err := getBufferFromJSON(c.Args().First())
+	config, err := getChainConfigJSON(c.Args().First())
 	if err != nil {
 		return cli.errorOut(err)
 	}
 
 	params := map[string]interface{}{
 		"chainID": chainID,
-		"config":  buf,
+		"config":  config,
 	}
 
 	body, err := json.Marshal(params)
@@ -201,3 +202,85 @@ func (cli *Client) ConfigureChain(c *cli.Context) (err error) {
 	}()
 	return cli.renderAPIResponse(resp, &ChainPresenter{})
 }
+
+// UpdateChain replaces an EVM chain's configuration wholesale from a JSON or
+// TOML blob or file, unlike ConfigureChain which patches individual keys. The
+// chain's enabled state is preserved unless -enable or -disable is passed.
+func (cli *Client) UpdateChain(c *cli.Context) (err error) {
+	chainID := c.Int64("id")
+	if chainID == 0 {
+		return cli.errorOut(errors.New("missing chain ID [-id integer]"))
+	}
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass in the chain's parameters [-id integer] [JSON/TOML blob | filepath]"))
+	}
+	if c.Bool("enable") && c.Bool("disable") {
+		return cli.errorOut(errors.New("cannot pass both -enable and -disable"))
+	}
+
+	config, err := getChainConfigJSON(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	// Fetch the existing chain to preserve its enabled state
+	resp, err := cli.HTTP.Get(fmt.Sprintf("/v2/chains/evm/%v", chainID))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	var chain presenters.ChainResource
+	if err = cli.deserializeAPIResponse(resp, &chain, &jsonapi.Links{}); err != nil {
+		return cli.errorOut(err)
+	}
+
+	enabled := chain.Enabled
+	if c.Bool("enable") {
+		enabled = true
+	} else if c.Bool("disable") {
+		enabled = false
+	}
+
+	params := map[string]interface{}{
+		"enabled": enabled,
+		"config":  config,
+	}
+	body, err := json.Marshal(params)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	resp, err = cli.HTTP.Patch(fmt.Sprintf("/v2/chains/evm/%v", chainID), bytes.NewBuffer(body))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	return cli.renderAPIResponse(resp, &ChainPresenter{})
+}
+
+// getChainConfigJSON accepts a chain config as a JSON or TOML blob, or a path
+// to a file containing either, and returns it as JSON for the chains API.
+func getChainConfigJSON(arg string) (json.RawMessage, error) {
+	buf, err := getBufferFromJSON(arg)
+	if err == nil {
+		s := strings.TrimSpace(buf.String())
+		if len(s) > 0 && s[0] == '{' {
+			return json.RawMessage(s), nil
+		}
+		// The file exists but is not JSON; fall through to TOML
+		arg = s
+	}
+
+	tree, terr := toml.Load(arg)
+	if terr != nil {
+		return nil, errors.Errorf("config is neither valid JSON nor valid TOML, nor a path to a file containing either: %v", terr)
+	}
+	return json.Marshal(tree.ToMap())
+}
@@ -41,6 +41,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/store/migrate"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/sqlx"
 )
 
 var prometheus *ginprom.Prometheus
@@ -163,6 +164,24 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig) (chainlink
 		return nil, err
 	}
 
+	// Open a separate read-only connection pool when a read replica is
+	// configured; heavy read queries are served from it with fallback to the
+	// primary
+	var readReplicaDB *sqlx.DB
+	if replicaURL := cfg.DatabaseReadReplicaURL(); replicaURL != nil {
+		replicaURI := *replicaURL
+		static.SetConsumerName(&replicaURI, "ReadReplica", &appID)
+		readReplicaDB, _, err = postgres.NewConnection(replicaURI.String(), string(dialect), postgres.Config{
+			Logger:           appLggr,
+			LogSQLStatements: cfg.LogSQLStatements(),
+			MaxOpenConns:     cfg.ORMMaxOpenConns(),
+			MaxIdleConns:     cfg.ORMMaxIdleConns(),
+		})
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to connect to read replica database")
+		}
+	}
+
 	appLggr.Debugf("Using database locking mode: %s", cfg.DatabaseLockingMode())
 
 	// Lease will be explicitly released on application stop
@@ -244,6 +263,12 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig) (chainlink
 		}
 	}
 
+	if configFile := cfg.ConfigFile(); configFile != "" {
+		if err = evm.SeedChainsFromConfigFile(appLggr, evm.NewORM(db), configFile); err != nil {
+			return nil, err
+		}
+	}
+
 	eventBroadcaster := postgres.NewEventBroadcaster(cfg.DatabaseURL(), cfg.DatabaseListenerMinReconnectInterval(), cfg.DatabaseListenerMaxReconnectDuration(), appLggr, appID)
 	ccOpts := evm.ChainSetOpts{
 		Config:           cfg,
@@ -258,12 +283,13 @@ func (n ChainlinkAppFactory) NewApplication(cfg config.GeneralConfig) (chainlink
 	if err != nil {
 		appLggr.Fatal(err)
 	}
-	externalInitiatorManager := webhook.NewExternalInitiatorManager(gormDB, utils.UnrestrictedClient)
+	externalInitiatorManager := webhook.NewExternalInitiatorManager(gormDB, utils.UnrestrictedClient, appLggr)
 	return chainlink.NewApplication(chainlink.ApplicationOpts{
 		Config:                   cfg,
 		ShutdownSignal:           shutdownSignal,
 		GormDB:                   gormDB,
 		SqlxDB:                   db,
+		ReadReplicaDB:            readReplicaDB,
 		KeyStore:                 keyStore,
 		ChainSet:                 chainSet,
 		EventBroadcaster:         eventBroadcaster,
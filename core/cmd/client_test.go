@@ -139,13 +139,13 @@ func TestTerminalAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
-			orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
+			orm := sessions.NewORM(db, time.Minute, 0, "", logger.TestLogger(t))
 
 			mock := &cltest.MockCountingPrompter{EnteredStrings: test.enteredStrings, NotTerminal: !test.isTerminal}
 			tai := cmd.NewPromptingAPIInitializer(mock)
 
 			// Remove fixture user
-			err := orm.DeleteUser()
+			err := orm.DeleteUser(cltest.APIEmail)
 			require.NoError(t, err)
 
 			user, err := tai.Initialize(orm)
@@ -169,7 +169,7 @@ func TestTerminalAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	t.Parallel()
 
 	db := pgtest.NewSqlxDB(t)
-	orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
+	orm := sessions.NewORM(db, time.Minute, 0, "", logger.TestLogger(t))
 
 	initialUser := cltest.MustRandomUser(t)
 	require.NoError(t, orm.CreateUser(&initialUser))
@@ -198,9 +198,9 @@ func TestFileAPIInitializer_InitializeWithoutAPIUser(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
-			orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
+			orm := sessions.NewORM(db, time.Minute, 0, "", logger.TestLogger(t))
 			// Clear out fixture user
-			orm.DeleteUser()
+			orm.DeleteUser(cltest.APIEmail)
 
 			tfi := cmd.NewFileAPIInitializer(test.file, logger.TestLogger(t))
 			user, err := tfi.Initialize(orm)
@@ -221,7 +221,7 @@ func TestFileAPIInitializer_InitializeWithExistingAPIUser(t *testing.T) {
 	t.Parallel()
 
 	db := pgtest.NewSqlxDB(t)
-	orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
+	orm := sessions.NewORM(db, time.Minute, 0, "", logger.TestLogger(t))
 
 	tests := []struct {
 		name      string
@@ -75,6 +75,31 @@ func (cli *Client) ListCSAKeys(c *cli.Context) (err error) {
 	return cli.renderAPIResponse(resp, &CSAKeyPresenters{})
 }
 
+// DeleteCSAKey deletes a CSA key,
+// key ID must be passed
+func (cli *Client) DeleteCSAKey(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("Must pass the key ID to be deleted"))
+	}
+	id := c.Args().Get(0)
+
+	if !confirmAction(c) {
+		return nil
+	}
+
+	resp, err := cli.HTTP.Delete(fmt.Sprintf("/v2/keys/csa/%s", id))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+
+	return cli.renderAPIResponse(resp, &CSAKeyPresenter{}, "CSA key deleted")
+}
+
 // CreateCSAKey creates a new CSA key
 func (cli *Client) CreateCSAKey(c *cli.Context) (err error) {
 	resp, err := cli.HTTP.Post("/v2/keys/csa", nil)
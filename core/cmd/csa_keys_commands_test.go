@@ -83,6 +83,26 @@ func TestClient_CreateCSAKey(t *testing.T) {
 	requireCSAKeyCount(t, app, 1)
 }
 
+func TestClient_DeleteCSAKey(t *testing.T) {
+	t.Parallel()
+
+	app := startNewApplication(t)
+	client, _ := app.NewClientAndRenderer()
+
+	key, err := app.GetKeyStore().CSA().Create()
+	require.NoError(t, err)
+
+	requireCSAKeyCount(t, app, 1)
+
+	set := flag.NewFlagSet("test", 0)
+	set.Bool("yes", true, "")
+	set.Parse([]string{key.ID()})
+	c := cli.NewContext(nil, set, nil)
+	require.NoError(t, client.DeleteCSAKey(c))
+
+	requireCSAKeyCount(t, app, 0)
+}
+
 func TestClient_ImportExportCsaKey(t *testing.T) {
 	t.Parallel()
 
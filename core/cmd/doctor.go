@@ -0,0 +1,287 @@
+package cmd
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/ethclient"
+	"github.com/pkg/errors"
+	clipkg "github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/migrate"
+	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/sqlx"
+)
+
+// doctorSeverity orders findings from most to least urgent.
+type doctorSeverity int
+
+const (
+	sevCritical doctorSeverity = iota
+	sevWarning
+	sevInfo
+	sevOK
+)
+
+func (s doctorSeverity) String() string {
+	switch s {
+	case sevCritical:
+		return "CRITICAL"
+	case sevWarning:
+		return "WARNING"
+	case sevInfo:
+		return "INFO"
+	default:
+		return "OK"
+	}
+}
+
+// MarshalText makes severities render as their names in --json output
+func (s doctorSeverity) MarshalText() ([]byte, error) {
+	return []byte(s.String()), nil
+}
+
+// DoctorFinding is a single diagnostic result
+type DoctorFinding struct {
+	Severity doctorSeverity `json:"severity"`
+	Check    string         `json:"check"`
+	Message  string         `json:"message"`
+}
+
+// DoctorFindingPresenters renders the doctor report, most urgent first
+type DoctorFindingPresenters []DoctorFinding
+
+// RenderTable implements TableRenderer
+func (ps DoctorFindingPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"Severity", "Check", "Message"}
+	rows := [][]string{}
+	for _, f := range ps {
+		rows = append(rows, []string{f.Severity.String(), f.Check, f.Message})
+	}
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// how far the database clock may drift from the local clock before we warn;
+// OCR in particular is sensitive to clock skew
+const maxClockSkew = 5 * time.Second
+
+// Doctor runs local diagnostics against the node's database and configured
+// chains and prints a prioritized report: config validity, DB connectivity
+// and pending migrations, the lease lock, clock skew against the DB server,
+// keystore state, RPC node reachability and chain ID agreement, and table
+// bloat. It exits non-zero if any critical problem is found.
+func (cli *Client) Doctor(c *clipkg.Context) error {
+	var findings []DoctorFinding
+	add := func(s doctorSeverity, check, format string, args ...interface{}) {
+		findings = append(findings, DoctorFinding{Severity: s, Check: check, Message: fmt.Sprintf(format, args...)})
+	}
+
+	if err := cli.Config.Validate(); err != nil {
+		add(sevCritical, "config", "configuration is invalid: %v", err)
+	} else {
+		add(sevOK, "config", "configuration is valid")
+	}
+
+	db, err := newConnection(cli.Config, cli.Logger)
+	if err != nil {
+		add(sevCritical, "database", "cannot connect to the database: %v", err)
+	} else {
+		defer cli.Logger.ErrorIfClosing(db, "db")
+		dbURL := cli.Config.DatabaseURL()
+		add(sevOK, "database", "connected to %s", dbURL.Redacted())
+
+		cli.checkMigrations(db, add)
+		checkLeaseLock(db, add)
+		checkClockSkew(db, add)
+		cli.checkKeystore(db, c.String("password"), add)
+		checkChains(db, cli.Config.EthereumDisabled(), add)
+		checkTableBloat(db, add)
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool { return findings[i].Severity < findings[j].Severity })
+
+	if err := cli.errorOut(cli.Render(DoctorFindingPresenters(findings))); err != nil {
+		return err
+	}
+	var criticals int
+	for _, f := range findings {
+		if f.Severity == sevCritical {
+			criticals++
+		}
+	}
+	if criticals > 0 {
+		return cli.errorOut(errors.Errorf("%d critical problem(s) found", criticals))
+	}
+	return nil
+}
+
+func (cli *Client) checkMigrations(db *sqlx.DB, add func(doctorSeverity, string, string, ...interface{})) {
+	version, err := migrate.Current(db.DB, cli.Logger)
+	if err != nil {
+		add(sevCritical, "migrations", "cannot determine database version: %v", err)
+		return
+	}
+	plans, err := migrate.Plan(db.DB, cli.Logger)
+	if err != nil {
+		add(sevCritical, "migrations", "cannot determine pending migrations: %v", err)
+		return
+	}
+	if len(plans) > 0 {
+		add(sevCritical, "migrations", "database is at version %d with %d pending migration(s); run `chainlink node db migrate`", version, len(plans))
+		return
+	}
+	add(sevOK, "migrations", "database is at version %d with no pending migrations", version)
+}
+
+func checkLeaseLock(db *sqlx.DB, add func(doctorSeverity, string, string, ...interface{})) {
+	info, err := postgres.GetLeaseLockInfo(db)
+	if errors.Is(err, sql.ErrNoRows) {
+		add(sevInfo, "lease", "no lease lock is held (no node is running against this database)")
+		return
+	} else if err != nil {
+		// lease locking may be disabled in favour of advisory locks
+		add(sevInfo, "lease", "cannot read lease lock: %v", err)
+		return
+	}
+	if info.Expired() {
+		add(sevWarning, "lease", "expired lease lock left behind by client %s; remove it with `chainlink node db unlock`", info.ClientID)
+		return
+	}
+	add(sevOK, "lease", "lease lock held by client %s (expires %s)", info.ClientID, info.ExpiresAt)
+}
+
+func checkClockSkew(db *sqlx.DB, add func(doctorSeverity, string, string, ...interface{})) {
+	var dbNow time.Time
+	if err := db.Get(&dbNow, "SELECT now()"); err != nil {
+		add(sevWarning, "clock", "cannot read database clock: %v", err)
+		return
+	}
+	skew := time.Since(dbNow)
+	if skew < 0 {
+		skew = -skew
+	}
+	if skew > maxClockSkew {
+		add(sevWarning, "clock", "local clock and database clock differ by %s; check NTP on both hosts (OCR requires synchronized clocks)", skew.Round(time.Millisecond))
+		return
+	}
+	add(sevOK, "clock", "local clock and database clock agree (skew %s)", skew.Round(time.Millisecond))
+}
+
+func (cli *Client) checkKeystore(db *sqlx.DB, passwordFile string, add func(doctorSeverity, string, string, ...interface{})) {
+	var count int
+	if err := db.Get(&count, "SELECT count(*) FROM encrypted_key_rings"); err != nil {
+		add(sevWarning, "keystore", "cannot read keystore: %v", err)
+		return
+	}
+	if count == 0 {
+		add(sevInfo, "keystore", "keystore is empty; keys will be generated on first run")
+		return
+	}
+	if passwordFile == "" {
+		add(sevInfo, "keystore", "keystore is present; pass --password to verify it unlocks")
+		return
+	}
+	password, err := passwordFromFile(passwordFile)
+	if err != nil {
+		add(sevCritical, "keystore", "cannot read password file: %v", err)
+		return
+	}
+	if err := keystore.New(db, utils.GetScryptParams(cli.Config), cli.Logger).Unlock(password); err != nil {
+		add(sevCritical, "keystore", "keystore does not unlock with the supplied password: %v", err)
+		return
+	}
+	add(sevOK, "keystore", "keystore unlocks with the supplied password")
+}
+
+func checkChains(db *sqlx.DB, ethDisabled bool, add func(doctorSeverity, string, string, ...interface{})) {
+	if ethDisabled {
+		add(sevInfo, "chains", "Ethereum is disabled; skipping RPC checks")
+		return
+	}
+	var chainIDs []string
+	if err := db.Select(&chainIDs, "SELECT id FROM evm_chains WHERE enabled ORDER BY id"); err != nil {
+		add(sevWarning, "chains", "cannot read chains: %v", err)
+		return
+	}
+	if len(chainIDs) == 0 {
+		add(sevWarning, "chains", "no enabled chains are configured")
+		return
+	}
+	for _, chainID := range chainIDs {
+		var nodes []struct {
+			Name    string
+			WSURL   sql.NullString `db:"ws_url"`
+			HTTPURL sql.NullString `db:"http_url"`
+		}
+		if err := db.Select(&nodes, "SELECT name, ws_url, http_url FROM nodes WHERE evm_chain_id = $1", chainID); err != nil {
+			add(sevWarning, "chains", "cannot read nodes for chain %s: %v", chainID, err)
+			continue
+		}
+		if len(nodes) == 0 {
+			add(sevWarning, "chains", "chain %s has no nodes configured", chainID)
+			continue
+		}
+		for _, node := range nodes {
+			url := node.WSURL.String
+			if url == "" {
+				url = node.HTTPURL.String
+			}
+			checkRPCNode(node.Name, url, chainID, add)
+		}
+	}
+}
+
+func checkRPCNode(name, url, chainID string, add func(doctorSeverity, string, string, ...interface{})) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	client, err := ethclient.DialContext(ctx, url)
+	if err != nil {
+		add(sevCritical, "rpc", "node %s (chain %s) is unreachable: %v", name, chainID, err)
+		return
+	}
+	defer client.Close()
+	remoteChainID, err := client.ChainID(ctx)
+	if err != nil {
+		add(sevCritical, "rpc", "node %s (chain %s) did not answer eth_chainId: %v", name, chainID, err)
+		return
+	}
+	expected, ok := new(big.Int).SetString(chainID, 10)
+	if ok && remoteChainID.Cmp(expected) != 0 {
+		add(sevCritical, "rpc", "node %s reports chain ID %s but is configured for chain %s", name, remoteChainID, chainID)
+		return
+	}
+	add(sevOK, "rpc", "node %s (chain %s) is reachable and reports the expected chain ID", name, chainID)
+}
+
+func checkTableBloat(db *sqlx.DB, add func(doctorSeverity, string, string, ...interface{})) {
+	var dbSize int64
+	if err := db.Get(&dbSize, "SELECT pg_database_size(current_database())"); err != nil {
+		add(sevInfo, "disk", "cannot read database size: %v", err)
+		return
+	}
+	add(sevInfo, "disk", "database size is %.1f MB", float64(dbSize)/1024/1024)
+
+	var bloated []struct {
+		Relname  string
+		NLiveTup int64 `db:"n_live_tup"`
+		NDeadTup int64 `db:"n_dead_tup"`
+	}
+	err := db.Select(&bloated, `
+SELECT relname, n_live_tup, n_dead_tup FROM pg_stat_user_tables
+WHERE n_dead_tup > 10000 AND n_dead_tup > n_live_tup
+ORDER BY n_dead_tup DESC LIMIT 5`)
+	if err != nil {
+		add(sevInfo, "disk", "cannot read table statistics: %v", err)
+		return
+	}
+	for _, t := range bloated {
+		add(sevWarning, "disk", "table %s has %d dead tuples against %d live; consider VACUUM (FULL) during a maintenance window", t.Relname, t.NDeadTup, t.NLiveTup)
+	}
+}
@@ -5,8 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"io/ioutil"
+	"path/filepath"
+	"sort"
+	"strings"
 	"time"
 
+	"github.com/manyminds/api2go/jsonapi"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/web"
@@ -250,3 +255,227 @@ func (cli *Client) TriggerPipelineRun(c *cli.Context) error {
 	err = cli.renderAPIResponse(resp, &run, "Pipeline run successfully triggered")
 	return err
 }
+
+// ExportJobs writes job specs as TOML files into a directory, one file per
+// job named by its external job ID, each with a metadata comment header.
+func (cli *Client) ExportJobs(c *cli.Context) (err error) {
+	if !c.Bool("all") && !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass a job id or --all"))
+	}
+
+	dir := c.String("dir")
+	if dir == "" {
+		dir = "."
+	}
+
+	all, err := cli.fetchAllJobs()
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	jobs := all
+	if !c.Bool("all") {
+		id := c.Args().First()
+		jobs = nil
+		for _, j := range all {
+			if j.GetID() == id || j.ExternalJobID.String() == id {
+				jobs = []JobPresenter{j}
+				break
+			}
+		}
+		if len(jobs) == 0 {
+			return cli.errorOut(errors.Errorf("no job found with id %s", id))
+		}
+	}
+
+	exported := 0
+	for _, j := range jobs {
+		tomlSpec, exportErr := cli.fetchJobTOML(j.GetID())
+		if exportErr != nil {
+			err = multierr.Append(err, errors.Wrapf(exportErr, "job %s", j.GetID()))
+			continue
+		}
+		name := j.ExternalJobID.String()
+		header := fmt.Sprintf("# Job ID: %s\n# Name: %s\n# Type: %s\n", j.GetID(), j.Name, j.Type)
+		path := filepath.Join(dir, name+".toml")
+		if werr := ioutil.WriteFile(path, []byte(header+tomlSpec), 0644); werr != nil {
+			err = multierr.Append(err, errors.Wrapf(werr, "job %s", j.GetID()))
+			continue
+		}
+		fmt.Printf("Exported job %s to %s\n", j.GetID(), path)
+		exported++
+	}
+	fmt.Printf("Exported %d job(s)\n", exported)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	return nil
+}
+
+// ImportJobs creates or updates jobs from a directory of TOML files, keyed by
+// externalJobID. Unchanged specs are skipped; changed ones replace the
+// existing job (the old job and its history are purged). With --dry-run the
+// plan is printed without applying anything.
+func (cli *Client) ImportJobs(c *cli.Context) (err error) {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the directory containing job TOML files"))
+	}
+	dryRun := c.Bool("dry-run")
+
+	files, err := filepath.Glob(filepath.Join(c.Args().First(), "*.toml"))
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if len(files) == 0 {
+		return cli.errorOut(errors.Errorf("no TOML files found in %s", c.Args().First()))
+	}
+	sort.Strings(files)
+
+	jobs, err := cli.fetchAllJobs()
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	existing := make(map[string]JobPresenter)
+	for _, j := range jobs {
+		existing[j.ExternalJobID.String()] = j
+	}
+
+	var created, updated, skipped int
+	for _, file := range files {
+		contents, rerr := ioutil.ReadFile(file)
+		if rerr != nil {
+			return cli.errorOut(rerr)
+		}
+		tomlSpec := string(contents)
+
+		tree, terr := toml.Load(tomlSpec)
+		if terr != nil {
+			return cli.errorOut(errors.Wrapf(terr, "invalid TOML in %s", file))
+		}
+		externalJobID, _ := tree.Get("externalJobID").(string)
+
+		current, exists := existing[externalJobID]
+		if externalJobID == "" || !exists {
+			if dryRun {
+				fmt.Printf("Would create job from %s\n", file)
+			} else {
+				if cerr := cli.createJobFromTOML(tomlSpec); cerr != nil {
+					return cli.errorOut(errors.Wrapf(cerr, "failed to create job from %s", file))
+				}
+				fmt.Printf("Created job from %s\n", file)
+			}
+			created++
+			continue
+		}
+
+		currentTOML, eerr := cli.fetchJobTOML(current.GetID())
+		if eerr == nil && normalizeTOMLSpec(currentTOML) == normalizeTOMLSpec(tomlSpec) {
+			fmt.Printf("Skipped %s: job %s is unchanged\n", file, current.GetID())
+			skipped++
+			continue
+		}
+
+		if dryRun {
+			fmt.Printf("Would replace job %s from %s\n", current.GetID(), file)
+		} else {
+			if uerr := cli.replaceJobFromTOML(current.GetID(), tomlSpec); uerr != nil {
+				return cli.errorOut(errors.Wrapf(uerr, "failed to replace job %s from %s", current.GetID(), file))
+			}
+			fmt.Printf("Replaced job %s from %s\n", current.GetID(), file)
+		}
+		updated++
+	}
+
+	verb := ""
+	if dryRun {
+		verb = "would be "
+	}
+	fmt.Printf("%d job(s) %screated, %d %supdated, %d unchanged\n", created, verb, updated, verb, skipped)
+	return nil
+}
+
+// fetchAllJobs lists every job on the node without rendering them.
+func (cli *Client) fetchAllJobs() (jobs []JobPresenter, err error) {
+	resp, err := cli.HTTP.Get("/v2/jobs?size=1000")
+	if err != nil {
+		return nil, err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	b, err := cli.parseResponse(resp)
+	if err != nil {
+		return nil, err
+	}
+	var ps JobPresenters
+	if err = web.ParsePaginatedResponse(b, &ps, &jsonapi.Links{}); err != nil {
+		return nil, err
+	}
+	return ps, nil
+}
+
+// fetchJobTOML gets the job's exported TOML spec.
+func (cli *Client) fetchJobTOML(id string) (spec string, err error) {
+	resp, err := cli.HTTP.Get("/v2/jobs/" + id + "/export")
+	if err != nil {
+		return "", err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	b, err := cli.parseResponse(resp)
+	if err != nil {
+		return "", err
+	}
+	return string(b), nil
+}
+
+func (cli *Client) createJobFromTOML(tomlSpec string) (err error) {
+	request, err := json.Marshal(web.CreateJobRequest{TOML: tomlSpec})
+	if err != nil {
+		return err
+	}
+	resp, err := cli.HTTP.Post("/v2/jobs", bytes.NewReader(request))
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			err = multierr.Append(err, cerr)
+		}
+	}()
+	_, err = cli.parseResponse(resp)
+	return err
+}
+
+// replaceJobFromTOML purges the existing job and recreates it from the given
+// spec. The purge is required because external job IDs are unique and
+// archived jobs still hold theirs.
+func (cli *Client) replaceJobFromTOML(id, tomlSpec string) error {
+	for _, uri := range []string{"/v2/jobs/" + id, "/v2/jobs/" + id + "/purge"} {
+		resp, err := cli.HTTP.Delete(uri)
+		if err != nil {
+			return err
+		}
+		if _, err = cli.parseResponse(resp); err != nil {
+			return err
+		}
+	}
+	return cli.createJobFromTOML(tomlSpec)
+}
+
+// normalizeTOMLSpec strips comment-only lines and surrounding whitespace so
+// exported metadata headers do not show up as spec changes.
+func normalizeTOMLSpec(spec string) string {
+	var lines []string
+	for _, line := range strings.Split(spec, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		lines = append(lines, strings.TrimRight(line, " \t"))
+	}
+	return strings.TrimSpace(strings.Join(lines, "\n"))
+}
@@ -336,6 +336,31 @@ func (ps HealthCheckPresenters) RenderTable(rt RendererTable) error {
 	return nil
 }
 
+// ValidateConfigFile validates a TOML config file against the config schema
+// without starting the node, so operators can check a file before deploying
+// it. The path is taken from the first argument, falling back to CONFIG_FILE.
+func (cli *Client) ValidateConfigFile(c *clipkg.Context) error {
+	path := c.Args().First()
+	if path == "" {
+		path = cli.Config.ConfigFile()
+	}
+	if path == "" {
+		return cli.errorOut(errors.New("must pass the path of the config file, or set CONFIG_FILE"))
+	}
+	problems, err := config.ValidateConfigFile(path)
+	if err != nil {
+		return cli.errorOut(errors.Wrapf(err, "failed to read config file %q", path))
+	}
+	if len(problems) > 0 {
+		for _, problem := range problems {
+			fmt.Println(problem)
+		}
+		return cli.errorOut(errors.Errorf("config file %q has %d problem(s)", path, len(problems)))
+	}
+	fmt.Printf("Config file %q is valid\n", path)
+	return nil
+}
+
 // Status will display the health of various services
 func (cli *Client) Status(c *clipkg.Context) error {
 	resp, err := cli.HTTP.Get("/health?full=1", nil)
@@ -489,6 +514,78 @@ func (cli *Client) StatusDatabase(c *clipkg.Context) error {
 	return nil
 }
 
+// PlanDatabaseMigrations displays the expected lock impact of all pending
+// migrations without applying them
+func (cli *Client) PlanDatabaseMigrations(c *clipkg.Context) error {
+	db, err := newConnection(cli.Config, cli.Logger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize orm: %v", err)
+	}
+
+	plans, err := migrate.Plan(db.DB, cli.Logger)
+	if err != nil {
+		return cli.errorOut(err)
+	}
+	if len(plans) == 0 {
+		cli.Logger.Info("No pending migrations")
+		return nil
+	}
+	for _, plan := range plans {
+		cli.Logger.Infof("Pending migration %v (%s):", plan.Version, plan.Source)
+		if plan.Go {
+			cli.Logger.Info("  Go migration; lock impact cannot be estimated, review the migration source")
+			continue
+		}
+		if len(plan.Locks) == 0 {
+			cli.Logger.Info("  No locks of interest; expected to be non-disruptive")
+			continue
+		}
+		for _, lock := range plan.Locks {
+			size := "new table"
+			if lock.SizeBytes >= 0 {
+				size = fmt.Sprintf("%.1f MB", float64(lock.SizeBytes)/1024/1024)
+			}
+			cli.Logger.Infof("  %s lock on %s (%s): %s", lock.Mode, lock.Table, size, lock.Statement)
+		}
+	}
+	return nil
+}
+
+// ShowDatabaseLock displays the current database lease lock holder
+func (cli *Client) ShowDatabaseLock(c *clipkg.Context) error {
+	db, err := newConnection(cli.Config, cli.Logger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize orm: %v", err)
+	}
+
+	info, err := postgres.GetLeaseLockInfo(db)
+	if errors.Is(err, sql.ErrNoRows) {
+		cli.Logger.Info("No lease lock has been taken")
+		return nil
+	} else if err != nil {
+		return cli.errorOut(err)
+	}
+	cli.Logger.Infof("Lease lock is held by client %s (last renewed: %s, expires: %s)", info.ClientID, info.UpdatedAt, info.ExpiresAt)
+	if info.Expired() {
+		cli.Logger.Info("The lease has expired; it can be removed with `chainlink node db unlock`")
+	}
+	return nil
+}
+
+// UnlockDatabase removes an expired lease lock left behind by a crashed node
+func (cli *Client) UnlockDatabase(c *clipkg.Context) error {
+	db, err := newConnection(cli.Config, cli.Logger)
+	if err != nil {
+		return fmt.Errorf("failed to initialize orm: %v", err)
+	}
+
+	if err = postgres.StealLeaseLock(db); err != nil {
+		return cli.errorOut(err)
+	}
+	cli.Logger.Info("Expired lease lock removed")
+	return nil
+}
+
 // CreateMigration displays the database migration status
 func (cli *Client) CreateMigration(c *clipkg.Context) error {
 	if !c.Args().Present() {
@@ -644,11 +741,11 @@ func (cli *Client) DeleteUser(c *clipkg.Context) (err error) {
 	}()
 	orm := app.SessionORM()
 	user, err := orm.FindUser()
-	if err == nil {
+	if err != nil {
 		app.GetLogger().Info("No such API user ", user.Email)
 		return err
 	}
-	err = orm.DeleteUser()
+	err = orm.DeleteUser(user.Email)
 	if err == nil {
 		app.GetLogger().Info("Deleted API user ", user.Email)
 	}
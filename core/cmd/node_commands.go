@@ -136,3 +136,12 @@ func (cli *Client) RemoveNode(c *cli.Context) (err error) {
 	fmt.Printf("Node %v deleted\n", c.Args().First())
 	return nil
 }
+
+// IndexEVMNodes returns EVM nodes, optionally scoped to a single chain.
+func (cli *Client) IndexEVMNodes(c *cli.Context) (err error) {
+	uri := "/v2/nodes"
+	if id := c.String("chain-id"); id != "" {
+		uri = "/v2/chains/evm/" + id + "/nodes"
+	}
+	return cli.getPage(uri, c.Int("page"), &NodePresenters{})
+}
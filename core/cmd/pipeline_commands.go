@@ -0,0 +1,193 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	"github.com/urfave/cli"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// PipelineTaskRunResultPresenters renders the per-task results of a local
+// pipeline run.
+type PipelineTaskRunResultPresenters pipeline.TaskRunResults
+
+// RenderTable implements TableRenderer
+func (ps PipelineTaskRunResultPresenters) RenderTable(rt RendererTable) error {
+	headers := []string{"Task", "Type", "Output", "Error", "Duration"}
+	rows := [][]string{}
+	for _, trr := range ps {
+		output := ""
+		if trr.Result.Value != nil {
+			b, err := json.Marshal(trr.Result.Value)
+			if err != nil {
+				output = fmt.Sprintf("%v", trr.Result.Value)
+			} else {
+				output = string(b)
+			}
+		}
+		errStr := ""
+		if trr.Result.Error != nil {
+			errStr = trr.Result.Error.Error()
+		}
+		duration := ""
+		if trr.FinishedAt.Valid {
+			duration = trr.FinishedAt.ValueOrZero().Sub(trr.CreatedAt).String()
+		}
+		rows = append(rows, []string{trr.Task.DotID(), string(trr.Task.Type()), output, errStr, duration})
+	}
+	renderList(headers, rows, rt.Writer)
+	return nil
+}
+
+// RunPipelineLocal parses a job spec TOML file (or bare DOT source) and
+// executes its pipeline in-process: no database and no running node. Task
+// types that need a live node (bridges, eth calls and transactions, VRF) are
+// rejected up front; HTTP tasks hit real endpoints unless --mock-http
+// provides canned responses.
+func (cli *Client) RunPipelineLocal(c *cli.Context) error {
+	if !c.Args().Present() {
+		return cli.errorOut(errors.New("must pass the path to a job spec TOML file"))
+	}
+	contents, err := ioutil.ReadFile(c.Args().First())
+	if err != nil {
+		return cli.errorOut(err)
+	}
+
+	// Accept either a full job spec with an observationSource, or a bare
+	// DOT pipeline source
+	dotSource := string(contents)
+	name := "local"
+	if tree, terr := toml.Load(dotSource); terr == nil {
+		if src, ok := tree.Get("observationSource").(string); ok {
+			dotSource = src
+		}
+		if n, ok := tree.Get("name").(string); ok {
+			name = n
+		}
+	}
+
+	p, err := pipeline.Parse(dotSource)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "failed to parse pipeline"))
+	}
+	for _, task := range p.Tasks {
+		switch task.Type() {
+		case pipeline.TaskTypeBridge, pipeline.TaskTypeETHCall, pipeline.TaskTypeETHTx,
+			pipeline.TaskTypeEstimateGasLimit, pipeline.TaskTypeVRF, pipeline.TaskTypeVRFV2:
+			return cli.errorOut(errors.Errorf("task %s (%s) requires a live node and cannot run locally", task.DotID(), task.Type()))
+		}
+	}
+
+	var input interface{}
+	if in := c.String("input"); in != "" {
+		if err = json.Unmarshal([]byte(in), &input); err != nil {
+			return cli.errorOut(errors.Wrap(err, "--input must be valid JSON"))
+		}
+	}
+
+	if mocks := c.String("mock-http"); mocks != "" {
+		restore, merr := installHTTPMocks(mocks)
+		if merr != nil {
+			return cli.errorOut(merr)
+		}
+		defer restore()
+	}
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"input": input,
+		"jobSpec": map[string]interface{}{
+			"databaseID": int32(0),
+			"name":       name,
+			"params":     map[string]interface{}{},
+		},
+		"jobRun": map[string]interface{}{
+			"requestBody": c.String("input"),
+			"meta":        map[string]interface{}{},
+		},
+	})
+
+	spec := pipeline.Spec{
+		DotDagSource: dotSource,
+		JobName:      name,
+	}
+
+	runner := pipeline.NewRunner(nil, cli.Config, nil, nil, nil, cli.Logger)
+	ctx, cancel := context.WithTimeout(context.Background(), cli.Config.JobPipelineMaxRunDuration())
+	defer cancel()
+
+	run, trrs, err := runner.ExecuteRun(ctx, spec, vars, cli.Logger)
+	if err != nil {
+		return cli.errorOut(errors.Wrap(err, "pipeline run failed"))
+	}
+
+	if err = cli.errorOut(cli.Render(PipelineTaskRunResultPresenters(trrs))); err != nil {
+		return err
+	}
+	if run.HasErrors() {
+		return cli.errorOut(errors.New("pipeline run finished with errors"))
+	}
+	return nil
+}
+
+// installHTTPMocks replaces the shared HTTP clients' transports with one
+// serving canned responses, so specs can be exercised without real network
+// calls. The mapping is a JSON object (or a path to a file containing one)
+// from URL prefix to response body; requests matching no prefix fail.
+func installHTTPMocks(arg string) (restore func(), err error) {
+	buf, err := getBufferFromJSON(arg)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]json.RawMessage
+	if err = json.Unmarshal(buf.Bytes(), &raw); err != nil {
+		return nil, errors.Wrap(err, "--mock-http must be a JSON object mapping URL prefixes to response bodies")
+	}
+	mocks := make(map[string]string, len(raw))
+	for prefix, body := range raw {
+		var s string
+		// A string value is used verbatim; anything else is served as JSON
+		if jerr := json.Unmarshal(body, &s); jerr == nil {
+			mocks[prefix] = s
+		} else {
+			mocks[prefix] = string(body)
+		}
+	}
+
+	rt := mockRoundTripper{mocks: mocks}
+	origRestricted := utils.Client.Transport
+	origUnrestricted := utils.UnrestrictedClient.Transport
+	utils.Client.Transport = rt
+	utils.UnrestrictedClient.Transport = rt
+	return func() {
+		utils.Client.Transport = origRestricted
+		utils.UnrestrictedClient.Transport = origUnrestricted
+	}, nil
+}
+
+type mockRoundTripper struct {
+	mocks map[string]string
+}
+
+func (m mockRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	for prefix, body := range m.mocks {
+		if strings.HasPrefix(req.URL.String(), prefix) {
+			return &http.Response{
+				StatusCode: http.StatusOK,
+				Status:     "200 OK",
+				Body:       ioutil.NopCloser(strings.NewReader(body)),
+				Header:     http.Header{"Content-Type": []string{"application/json"}},
+				Request:    req,
+			}, nil
+		}
+	}
+	return nil, errors.Errorf("no mock response configured for %s", req.URL)
+}
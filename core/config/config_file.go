@@ -0,0 +1,185 @@
+package config
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/spf13/viper"
+
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+// ConfigFileChainsKey is the TOML array-of-tables section of the config file
+// that describes EVM chains and their nodes; every other top-level key must
+// be a known environment variable name.
+const ConfigFileChainsKey = "EVM_CHAINS"
+
+// ValidateConfigFile checks the TOML config file at path against the config
+// schema and returns a list of problems. An error is only returned if the
+// file cannot be read or parsed at all. Env vars are not consulted: this
+// validates the file on its own, so it can run before the node starts and
+// from `chainlink config validate`.
+func ValidateConfigFile(path string) (problems []string, err error) {
+	v := viper.New()
+	v.SetConfigFile(path)
+	if err := v.ReadInConfig(); err != nil {
+		return nil, err
+	}
+	settings := v.AllSettings()
+
+	keys := make([]string, 0, len(settings))
+	for key := range settings {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	schema := configFileSchema()
+	for _, key := range keys {
+		if strings.EqualFold(key, ConfigFileChainsKey) {
+			problems = append(problems, validateConfigFileChains(settings[key])...)
+			continue
+		}
+		typ, known := schema[strings.ToUpper(key)]
+		if !known {
+			problems = append(problems, fmt.Sprintf("unknown key %s", strings.ToUpper(key)))
+			continue
+		}
+		if problem := validateConfigFileValue(strings.ToUpper(key), typ, settings[key]); problem != "" {
+			problems = append(problems, problem)
+		}
+	}
+	return problems, nil
+}
+
+// configFileSchema maps env var names to their schema types
+func configFileSchema() map[string]reflect.Type {
+	schemaT := reflect.TypeOf(ConfigSchema{})
+	schema := make(map[string]reflect.Type, schemaT.NumField())
+	for index := 0; index < schemaT.NumField(); index++ {
+		item := schemaT.FieldByIndex([]int{index})
+		schema[item.Tag.Get("env")] = item.Type
+	}
+	return schema
+}
+
+// validateConfigFileValue best-effort checks that value can be interpreted as
+// the schema type; string values for exotic types are accepted, matching how
+// env vars are parsed lazily at lookup time
+func validateConfigFileValue(key string, typ reflect.Type, value interface{}) string {
+	str := fmt.Sprint(value)
+	var err error
+	switch typ {
+	case reflect.TypeOf(time.Duration(0)):
+		_, err = time.ParseDuration(str)
+	case reflect.TypeOf(models.Duration{}):
+		var d time.Duration
+		if d, err = time.ParseDuration(str); err == nil {
+			_, err = models.MakeDuration(d)
+		}
+	case reflect.TypeOf(&url.URL{}):
+		_, err = url.Parse(str)
+	case reflect.TypeOf(&big.Int{}):
+		if _, ok := new(big.Int).SetString(str, 10); !ok {
+			err = fmt.Errorf("not an integer")
+		}
+	default:
+		switch typ.Kind() {
+		case reflect.Bool:
+			_, err = strconv.ParseBool(str)
+		case reflect.Int, reflect.Int16, reflect.Int32, reflect.Int64:
+			_, err = strconv.ParseInt(str, 10, 64)
+		case reflect.Uint, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+			_, err = strconv.ParseUint(str, 10, 64)
+		case reflect.Float32, reflect.Float64:
+			_, err = strconv.ParseFloat(str, 64)
+		}
+	}
+	if err != nil {
+		return fmt.Sprintf("%s: %q is not a valid %s", key, str, typ)
+	}
+	return ""
+}
+
+// validateConfigFileChains structurally validates the EVM_CHAINS section:
+//
+//	[[EVM_CHAINS]]
+//	id = "1"
+//	enabled = true
+//	[EVM_CHAINS.config]
+//	EvmGasPriceDefault = "20000000000"
+//	[[EVM_CHAINS.nodes]]
+//	name = "primary"
+//	wsURL = "wss://..."
+func validateConfigFileChains(value interface{}) (problems []string) {
+	chains, ok := value.([]interface{})
+	if !ok {
+		return []string{fmt.Sprintf("%s must be an array of tables", ConfigFileChainsKey)}
+	}
+	for i, entry := range chains {
+		prefix := fmt.Sprintf("%s[%d]", ConfigFileChainsKey, i)
+		chain, ok := entry.(map[string]interface{})
+		if !ok {
+			problems = append(problems, fmt.Sprintf("%s must be a table", prefix))
+			continue
+		}
+		fields := lowercaseKeys(chain)
+		id := fmt.Sprint(fields["id"])
+		if fields["id"] == nil {
+			problems = append(problems, fmt.Sprintf("%s is missing an id", prefix))
+		} else if _, ok := new(big.Int).SetString(id, 10); !ok {
+			problems = append(problems, fmt.Sprintf("%s: id %q is not a valid chain ID", prefix, id))
+		}
+		for key := range chain {
+			switch strings.ToLower(key) {
+			case "id", "enabled", "config", "nodes":
+			default:
+				problems = append(problems, fmt.Sprintf("%s: unknown key %s", prefix, key))
+			}
+		}
+		nodes, _ := fields["nodes"].([]interface{})
+		for j, nodeEntry := range nodes {
+			nodePrefix := fmt.Sprintf("%s.nodes[%d]", prefix, j)
+			node, ok := nodeEntry.(map[string]interface{})
+			if !ok {
+				problems = append(problems, fmt.Sprintf("%s must be a table", nodePrefix))
+				continue
+			}
+			nodeFields := lowercaseKeys(node)
+			if nodeFields["name"] == nil || fmt.Sprint(nodeFields["name"]) == "" {
+				problems = append(problems, fmt.Sprintf("%s is missing a name", nodePrefix))
+			}
+			sendOnly, _ := nodeFields["sendonly"].(bool)
+			if sendOnly {
+				if nodeFields["httpurl"] == nil {
+					problems = append(problems, fmt.Sprintf("%s: send-only nodes require an httpURL", nodePrefix))
+				}
+			} else if nodeFields["wsurl"] == nil {
+				problems = append(problems, fmt.Sprintf("%s: nodes require a wsURL unless sendOnly is true", nodePrefix))
+			}
+			for key := range node {
+				switch strings.ToLower(key) {
+				case "name", "wsurl", "httpurl", "sendonly":
+				default:
+					problems = append(problems, fmt.Sprintf("%s: unknown key %s", nodePrefix, key))
+				}
+			}
+		}
+	}
+	return problems
+}
+
+// lowercaseKeys normalizes a TOML table for lookups; viper lowercases
+// top-level keys but preserves case inside arrays of tables
+func lowercaseKeys(m map[string]interface{}) map[string]interface{} {
+	normalized := make(map[string]interface{}, len(m))
+	for key, value := range m {
+		normalized[strings.ToLower(key)] = value
+	}
+	return normalized
+}
@@ -0,0 +1,84 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func writeConfigFile(t *testing.T, contents string) string {
+	t.Helper()
+	path := filepath.Join(t.TempDir(), "chainlink.toml")
+	require.NoError(t, os.WriteFile(path, []byte(contents), 0600))
+	return path
+}
+
+func TestValidateConfigFile_Valid(t *testing.T) {
+	path := writeConfigFile(t, `
+LOG_LEVEL = "debug"
+ETH_GAS_BUMP_PERCENT = 30
+FEATURE_EXTERNAL_INITIATORS = true
+BRIDGE_RESPONSE_URL = "http://localhost:6688"
+
+[[EVM_CHAINS]]
+id = "1"
+enabled = true
+
+[EVM_CHAINS.config]
+EvmGasPriceDefault = "20000000000"
+
+[[EVM_CHAINS.nodes]]
+name = "primary"
+wsURL = "wss://mainnet.example.com/ws"
+
+[[EVM_CHAINS.nodes]]
+name = "sendonly"
+httpURL = "https://mainnet.example.com"
+sendOnly = true
+`)
+	problems, err := ValidateConfigFile(path)
+	require.NoError(t, err)
+	assert.Empty(t, problems)
+}
+
+func TestValidateConfigFile_Problems(t *testing.T) {
+	path := writeConfigFile(t, `
+NOT_A_REAL_SETTING = "whoops"
+ETH_GAS_BUMP_PERCENT = "not a number"
+
+[[EVM_CHAINS]]
+enabled = "also not a bool, but structural only"
+extraKey = 1
+
+[[EVM_CHAINS]]
+id = "not an integer"
+
+[[EVM_CHAINS.nodes]]
+wsURL = "wss://mainnet.example.com/ws"
+
+[[EVM_CHAINS.nodes]]
+name = "sendonly"
+sendOnly = true
+`)
+	problems, err := ValidateConfigFile(path)
+	require.NoError(t, err)
+	assert.Contains(t, problems, "unknown key NOT_A_REAL_SETTING")
+	assert.Contains(t, problems, `ETH_GAS_BUMP_PERCENT: "not a number" is not a valid uint16`)
+	assert.Contains(t, problems, "EVM_CHAINS[0] is missing an id")
+	assert.Contains(t, problems, "EVM_CHAINS[0]: unknown key extraKey")
+	assert.Contains(t, problems, `EVM_CHAINS[1]: id "not an integer" is not a valid chain ID`)
+	assert.Contains(t, problems, "EVM_CHAINS[1].nodes[0] is missing a name")
+	assert.Contains(t, problems, "EVM_CHAINS[1].nodes[1]: send-only nodes require an httpURL")
+}
+
+func TestValidateConfigFile_Unreadable(t *testing.T) {
+	_, err := ValidateConfigFile(filepath.Join(t.TempDir(), "missing.toml"))
+	require.Error(t, err)
+
+	path := writeConfigFile(t, `this is not toml [[`)
+	_, err = ValidateConfigFile(path)
+	require.Error(t, err)
+}
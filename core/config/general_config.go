@@ -11,6 +11,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"strings"
 	"sync"
 	"time"
 
@@ -49,6 +50,10 @@ var (
 type GeneralOnlyConfig interface {
 	AdminCredentialsFile() string
 	AllowOrigins() string
+	AuditLogHTTPURL() *url.URL
+	AuditLogS3Bucket() string
+	AuditLogS3Region() string
+	AuditLogSink() string
 	AuthenticatedRateLimit() int64
 	AuthenticatedRateLimitPeriod() models.Duration
 	BlockBackfillDepth() uint64
@@ -56,14 +61,20 @@ type GeneralOnlyConfig interface {
 	BridgeResponseURL() *url.URL
 	CertFile() string
 	ClientNodeURL() string
+	ConfigFile() string
 	DatabaseBackupDir() string
 	DatabaseBackupFrequency() time.Duration
 	DatabaseBackupMode() DatabaseBackupMode
 	DatabaseBackupURL() *url.URL
+	DatabaseDefaultBatchSize() uint
 	DatabaseListenerMaxReconnectDuration() time.Duration
 	DatabaseListenerMinReconnectInterval() time.Duration
 	DatabaseLockingMode() string
+	DatabaseMaintenanceWindow() string
 	DatabaseMaximumTxDuration() time.Duration
+	DatabaseReadReplicaMaxLag() time.Duration
+	DatabaseReadReplicaURL() *url.URL
+	DatabaseSlowQueryThreshold() time.Duration
 	DatabaseTimeout() models.Duration
 	DatabaseURL() url.URL
 	DefaultChainID() *big.Int
@@ -80,6 +91,8 @@ type GeneralOnlyConfig interface {
 	ExplorerAccessKey() string
 	ExplorerSecret() string
 	ExplorerURL() *url.URL
+	FMBatchFlushInterval() models.Duration
+	FMBatchSubmissions() bool
 	FMDefaultTransactionQueueDepth() uint32
 	FMSimulateTransactions() bool
 	FeatureExternalInitiators() bool
@@ -97,6 +110,8 @@ type GeneralOnlyConfig interface {
 	JobPipelineReaperInterval() time.Duration
 	JobPipelineReaperThreshold() time.Duration
 	JobPipelineResultWriteQueueDepth() uint64
+	JobPipelineRunsArchiveURL() string
+	JobQuarantineFailureThreshold() uint32
 	KeeperDefaultTransactionQueueDepth() uint32
 	KeeperGasPriceBufferPercent() uint32
 	KeeperGasTipCapBufferPercent() uint32
@@ -114,6 +129,7 @@ type GeneralOnlyConfig interface {
 	LogToDisk() bool
 	LogUnixTimestamps() bool
 	MigrateDatabase() bool
+	MulticallContractAddress() string
 	OCRBlockchainTimeout() time.Duration
 	OCRBootstrapCheckInterval() time.Duration
 	OCRContractPollInterval() time.Duration
@@ -132,6 +148,13 @@ type GeneralOnlyConfig interface {
 	OCRSimulateTransactions() bool
 	OCRTraceLogging() bool
 	OCRTransmitterAddress() (ethkey.EIP55Address, error)
+	OIDCAllowedDomains() []string
+	OIDCClientID() string
+	OIDCClientSecret() string
+	OIDCDefaultRole() string
+	OIDCIssuer() string
+	OIDCRedirectURL() string
+	OIDCRoleMapping() string
 	ORMMaxIdleConns() int
 	ORMMaxOpenConns() int
 	P2PAnnounceIP() net.IP
@@ -160,6 +183,7 @@ type GeneralOnlyConfig interface {
 	ReplayFromBlock() int64
 	RootDir() string
 	SecureCookies() bool
+	SessionAbsoluteTimeout() models.Duration
 	SessionOptions() sessions.Options
 	SessionSecret() ([]byte, error)
 	SessionTimeout() models.Duration
@@ -173,9 +197,14 @@ type GeneralOnlyConfig interface {
 	TLSKeyPath() string
 	TLSPort() uint16
 	TLSRedirect() bool
+	TelemetryIngressBufferDir() string
+	TelemetryIngressEndpoints() string
 	TelemetryIngressLogging() bool
 	TelemetryIngressServerPubKey() string
 	TelemetryIngressURL() *url.URL
+	TracingCollectorTarget() string
+	TracingEnabled() bool
+	TracingSamplingRatio() float32
 	TriggerFallbackDBPollInterval() time.Duration
 	UnAuthenticatedRateLimit() int64
 	UnAuthenticatedRateLimitPeriod() models.Duration
@@ -193,12 +222,16 @@ type GlobalConfig interface {
 	GlobalBlockHistoryEstimatorBlockDelay() (uint16, bool)
 	GlobalBlockHistoryEstimatorBlockHistorySize() (uint16, bool)
 	GlobalBlockHistoryEstimatorTransactionPercentile() (uint16, bool)
+	GlobalEthTxAttemptGCKeepCount() (uint16, bool)
+	GlobalEthTxReaperArchiveEnabled() (bool, bool)
 	GlobalEthTxReaperInterval() (time.Duration, bool)
 	GlobalEthTxReaperThreshold() (time.Duration, bool)
 	GlobalEthTxResendAfterThreshold() (time.Duration, bool)
 	GlobalEvmDefaultBatchSize() (uint32, bool)
 	GlobalEvmEIP1559DynamicFees() (bool, bool)
 	GlobalEvmFinalityDepth() (uint32, bool)
+	GlobalEvmFinalityTagEnabled() (bool, bool)
+	GlobalEvmFundingTopUpMaxDailyWei() (*big.Int, bool)
 	GlobalEvmGasBumpPercent() (uint16, bool)
 	GlobalEvmGasBumpThreshold() (uint64, bool)
 	GlobalEvmGasBumpTxDepth() (uint16, bool)
@@ -209,17 +242,31 @@ type GlobalConfig interface {
 	GlobalEvmGasPriceDefault() (*big.Int, bool)
 	GlobalEvmGasTipCapDefault() (*big.Int, bool)
 	GlobalEvmGasTipCapMinimum() (*big.Int, bool)
+	GlobalEvmHeadTrackerCrossValidationEnabled() (bool, bool)
 	GlobalEvmHeadTrackerHistoryDepth() (uint32, bool)
+	GlobalEvmHeadTrackerMaxBackfillDepth() (uint32, bool)
+	GlobalEvmHeadTrackerMaxBatchSize() (uint32, bool)
 	GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool)
+	GlobalEvmHeadTrackerMaxHeadLag() (time.Duration, bool)
 	GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool)
+	GlobalEvmKeyMinimumBalanceWei() (*big.Int, bool)
 	GlobalEvmLogBackfillBatchSize() (uint32, bool)
 	GlobalEvmMaxGasPriceWei() (*big.Int, bool)
 	GlobalEvmMaxInFlightTransactions() (uint32, bool)
 	GlobalEvmMaxQueuedTransactions() (uint64, bool)
 	GlobalEvmMinGasPriceWei() (*big.Int, bool)
 	GlobalEvmNonceAutoSync() (bool, bool)
+	GlobalEvmAutoCreateAccessList() (bool, bool)
+	GlobalEvmNonceGapRepair() (bool, bool)
+	GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool)
+	GlobalEvmPrivateRelayURL() (string, bool)
 	GlobalEvmRPCDefaultBatchSize() (uint32, bool)
+	GlobalFeeHistoryEstimatorBaseFeeHeadroom() (float32, bool)
+	GlobalFeeHistoryEstimatorBlockHistorySize() (uint16, bool)
+	GlobalFeeHistoryEstimatorCapBehavior() (string, bool)
+	GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool)
 	GlobalFlagsContractAddress() (string, bool)
+	GlobalGasEstimatorExternalURL() (string, bool)
 	GlobalGasEstimatorMode() (string, bool)
 	GlobalChainType() (string, bool)
 	GlobalLinkContractAddress() (string, bool)
@@ -284,11 +331,25 @@ func newGeneralConfigWithViper(v *viper.Viper) *generalConfig {
 		logger.Fatalf(`Error creating root directory "%s": %+v`, config.RootDir(), err)
 	}
 
-	v.SetConfigName("chainlink")
-	v.AddConfigPath(config.RootDir())
-	err := v.ReadInConfig()
-	if err != nil && reflect.TypeOf(err) != configFileNotFoundError {
-		logger.Warnf("Unable to load config file: %v\n", err)
+	if configFile := v.GetString(EnvVarName("ConfigFile")); configFile != "" {
+		// An explicitly requested config file must load; failing quietly here
+		// would silently run the node with the wrong configuration
+		v.SetConfigFile(configFile)
+		if err := v.ReadInConfig(); err != nil {
+			logger.Fatalf(`Error loading config file "%s": %+v`, configFile, err)
+		}
+		if problems, err := ValidateConfigFile(configFile); err != nil {
+			logger.Fatalf(`Error validating config file "%s": %+v`, configFile, err)
+		} else if len(problems) > 0 {
+			logger.Fatalf(`Config file "%s" is invalid:%s`, configFile, "\n  "+strings.Join(problems, "\n  "))
+		}
+	} else {
+		v.SetConfigName("chainlink")
+		v.AddConfigPath(config.RootDir())
+		err := v.ReadInConfig()
+		if err != nil && reflect.TypeOf(err) != configFileNotFoundError {
+			logger.Warnf("Unable to load config file: %v\n", err)
+		}
 	}
 
 	if v.IsSet(EnvVarName("LogLevel")) {
@@ -377,6 +438,35 @@ func (c *generalConfig) AllowOrigins() string {
 	return c.viper.GetString(EnvVarName("AllowOrigins"))
 }
 
+// AuditLogSink names the sink audit events are shipped to: "syslog", "http"
+// or "s3". Empty disables audit logging.
+func (c *generalConfig) AuditLogSink() string {
+	return c.viper.GetString(EnvVarName("AuditLogSink"))
+}
+
+// AuditLogHTTPURL is the endpoint audit events are POSTed to when the sink is "http".
+func (c *generalConfig) AuditLogHTTPURL() *url.URL {
+	rval := c.getWithFallback("AuditLogHTTPURL", ParseURL)
+	switch t := rval.(type) {
+	case nil:
+		return nil
+	case *url.URL:
+		return t
+	default:
+		panic(fmt.Sprintf("invariant: AuditLogHTTPURL returned as type %T", rval))
+	}
+}
+
+// AuditLogS3Bucket is the bucket audit events are written to when the sink is "s3".
+func (c *generalConfig) AuditLogS3Bucket() string {
+	return c.viper.GetString(EnvVarName("AuditLogS3Bucket"))
+}
+
+// AuditLogS3Region is the AWS region of the audit log bucket.
+func (c *generalConfig) AuditLogS3Region() string {
+	return c.viper.GetString(EnvVarName("AuditLogS3Region"))
+}
+
 // AdminCredentialsFile points to text file containing admnn credentials for logging in
 func (c *generalConfig) AdminCredentialsFile() string {
 	fieldName := "AdminCredentialsFile"
@@ -419,6 +509,12 @@ func (c *generalConfig) ClientNodeURL() string {
 	return c.viper.GetString(EnvVarName("ClientNodeURL"))
 }
 
+// ConfigFile is the path of the TOML config file the node was configured
+// from, or empty if none was loaded.
+func (c *generalConfig) ConfigFile() string {
+	return c.viper.ConfigFileUsed()
+}
+
 // FeatureUICSAKeys enables the CSA Keys UI Feature.
 func (c *generalConfig) FeatureUICSAKeys() bool {
 	return c.getWithFallback("FeatureUICSAKeys", ParseBool).(bool)
@@ -441,6 +537,36 @@ func (c *generalConfig) DatabaseMaximumTxDuration() time.Duration {
 	return c.getWithFallback("DatabaseMaximumTxDuration", ParseDuration).(time.Duration)
 }
 
+// DatabaseReadReplicaMaxLag is the maximum tolerated replication lag of the
+// read replica; heavy read queries fall back to the primary while the replica
+// lags beyond this
+func (c *generalConfig) DatabaseReadReplicaMaxLag() time.Duration {
+	return c.getWithFallback("DatabaseReadReplicaMaxLag", ParseDuration).(time.Duration)
+}
+
+// DatabaseReadReplicaURL configures an optional read-only replica DSN used
+// for heavy read queries, or nil if no replica is configured. Writes always
+// go to DatabaseURL.
+func (c *generalConfig) DatabaseReadReplicaURL() *url.URL {
+	s := c.viper.GetString(EnvVarName("DatabaseReadReplicaURL"))
+	if s == "" {
+		return nil
+	}
+	uri, err := url.Parse(s)
+	if err != nil {
+		logger.Error("invalid database read replica url %s", s)
+		return nil
+	}
+	static.SetConsumerName(uri, "ReadReplica", nil)
+	return uri
+}
+
+// DatabaseSlowQueryThreshold is the duration above which individual SQL
+// queries are logged as slow; zero disables slow query logging
+func (c *generalConfig) DatabaseSlowQueryThreshold() time.Duration {
+	return c.getWithFallback("DatabaseSlowQueryThreshold", ParseDuration).(time.Duration)
+}
+
 // DatabaseBackupMode sets the database backup mode
 func (c *generalConfig) DatabaseBackupMode() DatabaseBackupMode {
 	return c.getWithFallback("DatabaseBackupMode", parseDatabaseBackupMode).(DatabaseBackupMode)
@@ -471,6 +597,12 @@ func (c *generalConfig) DatabaseBackupDir() string {
 	return c.viper.GetString(EnvVarName("DatabaseBackupDir"))
 }
 
+// DatabaseDefaultBatchSize is the default number of records to page over in
+// batched queries such as run reaping
+func (c *generalConfig) DatabaseDefaultBatchSize() uint {
+	return uint(c.getWithFallback("DatabaseDefaultBatchSize", ParseUint64).(uint64))
+}
+
 // DatabaseTimeout represents how long to tolerate non response from the DB.
 func (c *generalConfig) DatabaseTimeout() models.Duration {
 	return models.MustMakeDuration(c.getWithFallback("DatabaseTimeout", ParseDuration).(time.Duration))
@@ -503,6 +635,13 @@ func (c *generalConfig) MigrateDatabase() bool {
 	return c.viper.GetBool(EnvVarName("MigrateDatabase"))
 }
 
+// MulticallContractAddress is the address of the Multicall contract used to
+// aggregate batched calls (e.g. Flux Monitor submissions) into a single
+// transaction
+func (c *generalConfig) MulticallContractAddress() string {
+	return c.viper.GetString(EnvVarName("MulticallContractAddress"))
+}
+
 // DefaultMaxHTTPAttempts defines the limit for HTTP requests.
 func (c *generalConfig) DefaultMaxHTTPAttempts() uint {
 	return uint(c.getWithFallback("DefaultMaxHTTPAttempts", ParseUint64).(uint64))
@@ -539,6 +678,19 @@ func (c *generalConfig) FeatureOffchainReporting() bool {
 	return c.getWithFallback("FeatureOffchainReporting", ParseBool).(bool)
 }
 
+// FMBatchFlushInterval is how often queued Flux Monitor submissions are
+// flushed as a single Multicall transaction when FM_BATCH_SUBMISSIONS is
+// enabled
+func (c *generalConfig) FMBatchFlushInterval() models.Duration {
+	return models.MustMakeDuration(c.getWithFallback("FMBatchFlushInterval", ParseDuration).(time.Duration))
+}
+
+// FMBatchSubmissions enables batching Flux Monitor submissions through the
+// Multicall contract configured by MULTICALL_CONTRACT_ADDRESS
+func (c *generalConfig) FMBatchSubmissions() bool {
+	return c.viper.GetBool(EnvVarName("FMBatchSubmissions"))
+}
+
 // FMDefaultTransactionQueueDepth controls the queue size for DropOldestStrategy in Flux Monitor
 // Set to 0 to use SendEvery strategy instead
 func (c *generalConfig) FMDefaultTransactionQueueDepth() uint32 {
@@ -625,6 +777,23 @@ func (c *generalConfig) InsecureSkipVerify() bool {
 	return c.viper.GetBool(EnvVarName("InsecureSkipVerify"))
 }
 
+// TracingEnabled toggles OpenTelemetry tracing of incoming HTTP requests,
+// pipeline runs, tasks and DB transactions.
+func (c *generalConfig) TracingEnabled() bool {
+	return c.viper.GetBool(EnvVarName("TracingEnabled"))
+}
+
+// TracingCollectorTarget is the OTLP gRPC endpoint traces are exported to,
+// e.g. "localhost:4317".
+func (c *generalConfig) TracingCollectorTarget() string {
+	return c.viper.GetString(EnvVarName("TracingCollectorTarget"))
+}
+
+// TracingSamplingRatio is the fraction of traces to sample, between 0 and 1.
+func (c *generalConfig) TracingSamplingRatio() float32 {
+	return c.getWithFallback("TracingSamplingRatio", ParseF32).(float32)
+}
+
 func (c *generalConfig) TriggerFallbackDBPollInterval() time.Duration {
 	return c.getWithFallback("TriggerFallbackDBPollInterval", ParseDuration).(time.Duration)
 }
@@ -638,6 +807,19 @@ func (c *generalConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return c.getWithFallback("JobPipelineResultWriteQueueDepth", ParseUint64).(uint64)
 }
 
+// JobPipelineRunsArchiveURL is the cold storage destination reaped pipeline
+// runs are archived to before deletion (s3://bucket/prefix or file:///path).
+// If empty, reaped runs are simply deleted.
+func (c *generalConfig) JobPipelineRunsArchiveURL() string {
+	return c.viper.GetString(EnvVarName("JobPipelineRunsArchiveURL"))
+}
+
+// JobQuarantineFailureThreshold is the number of consecutive failed runs
+// after which a job is automatically quarantined. 0 disables quarantining.
+func (c *generalConfig) JobQuarantineFailureThreshold() uint32 {
+	return c.getWithFallback("JobQuarantineFailureThreshold", ParseUint32).(uint32)
+}
+
 func (c *generalConfig) JobPipelineReaperInterval() time.Duration {
 	return c.getWithFallback("JobPipelineReaperInterval", ParseDuration).(time.Duration)
 }
@@ -751,6 +933,20 @@ func (c *generalConfig) TelemetryIngressLogging() bool {
 	return c.getWithFallback("TelemetryIngressLogging", ParseBool).(bool)
 }
 
+// TelemetryIngressEndpoints returns the JSON array describing multiple
+// telemetry ingress servers with optional per-contract routing. Takes
+// precedence over TELEMETRY_INGRESS_URL when set.
+func (c *generalConfig) TelemetryIngressEndpoints() string {
+	return c.viper.GetString(EnvVarName("TelemetryIngressEndpoints"))
+}
+
+// TelemetryIngressBufferDir is the directory where undeliverable telemetry is
+// buffered on disk for resending; if empty, telemetry is only buffered in
+// memory and may be dropped during ingress outages
+func (c *generalConfig) TelemetryIngressBufferDir() string {
+	return c.viper.GetString(EnvVarName("TelemetryIngressBufferDir"))
+}
+
 // FIXME: Add comments to all of these
 func (c *generalConfig) OCRBootstrapCheckInterval() time.Duration {
 	return c.getWithFallback("OCRBootstrapCheckInterval", ParseDuration).(time.Duration)
@@ -849,6 +1045,47 @@ func (c *generalConfig) OCRKeyBundleID() (string, error) {
 	return kbStr, nil
 }
 
+// OIDCIssuer is the OpenID Connect issuer URL. Setting this enables OIDC
+// login alongside password auth.
+func (c *generalConfig) OIDCIssuer() string {
+	return c.viper.GetString(EnvVarName("OIDCIssuer"))
+}
+
+// OIDCClientID is the OAuth client ID registered with the identity provider.
+func (c *generalConfig) OIDCClientID() string {
+	return c.viper.GetString(EnvVarName("OIDCClientID"))
+}
+
+// OIDCClientSecret is the OAuth client secret registered with the identity
+// provider.
+func (c *generalConfig) OIDCClientSecret() string {
+	return c.viper.GetString(EnvVarName("OIDCClientSecret"))
+}
+
+// OIDCRedirectURL overrides the callback URL sent to the identity provider.
+// If unset, it is derived from the incoming request.
+func (c *generalConfig) OIDCRedirectURL() string {
+	return c.viper.GetString(EnvVarName("OIDCRedirectURL"))
+}
+
+// OIDCAllowedDomains restricts OIDC logins to the given email domains. An
+// empty list allows any domain.
+func (c *generalConfig) OIDCAllowedDomains() []string {
+	return c.viper.GetStringSlice(EnvVarName("OIDCAllowedDomains"))
+}
+
+// OIDCRoleMapping maps identity provider groups onto node roles using
+// comma-separated "group:role" pairs, e.g. "node-admins:admin,node-ops:edit".
+func (c *generalConfig) OIDCRoleMapping() string {
+	return c.viper.GetString(EnvVarName("OIDCRoleMapping"))
+}
+
+// OIDCDefaultRole is the role assigned to OIDC users whose groups match no
+// OIDCRoleMapping entry.
+func (c *generalConfig) OIDCDefaultRole() string {
+	return c.viper.GetString(EnvVarName("OIDCDefaultRole"))
+}
+
 func (c *generalConfig) ORMMaxOpenConns() int {
 	return int(c.getWithFallback("ORMMaxOpenConns", ParseUint16).(uint16))
 }
@@ -1127,6 +1364,13 @@ func (c *generalConfig) SecureCookies() bool {
 	return c.viper.GetBool(EnvVarName("SecureCookies"))
 }
 
+// SessionAbsoluteTimeout is the maximum duration that a user session can
+// persist regardless of activity. 0 disables the absolute limit, leaving only
+// the inactivity limit (SessionTimeout).
+func (c *generalConfig) SessionAbsoluteTimeout() models.Duration {
+	return models.MustMakeDuration(c.getWithFallback("SessionAbsoluteTimeout", ParseDuration).(time.Duration))
+}
+
 // SessionTimeout is the maximum duration that a user session can persist without any activity.
 func (c *generalConfig) SessionTimeout() models.Duration {
 	return models.MustMakeDuration(c.getWithFallback("SessionTimeout", ParseDuration).(time.Duration))
@@ -1320,6 +1564,20 @@ func (*generalConfig) GlobalBlockHistoryEstimatorTransactionPercentile() (uint16
 	}
 	return val.(uint16), ok
 }
+func (*generalConfig) GlobalEthTxAttemptGCKeepCount() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("EthTxAttemptGCKeepCount"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (*generalConfig) GlobalEthTxReaperArchiveEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EthTxReaperArchiveEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (*generalConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	val, ok := lookupEnv(EnvVarName("EthTxReaperInterval"), ParseDuration)
 	if val == nil {
@@ -1355,6 +1613,20 @@ func (*generalConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmFinalityTagEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
+func (*generalConfig) GlobalEvmFundingTopUpMaxDailyWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmFundingTopUpMaxDailyWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
 func (*generalConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmGasBumpPercent"), ParseUint16)
 	if val == nil {
@@ -1411,6 +1683,13 @@ func (*generalConfig) GlobalEvmGasPriceDefault() (*big.Int, bool) {
 	}
 	return val.(*big.Int), ok
 }
+func (*generalConfig) GlobalEvmHeadTrackerCrossValidationEnabled() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerCrossValidationEnabled"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
 func (*generalConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerHistoryDepth"), ParseUint32)
 	if val == nil {
@@ -1418,6 +1697,20 @@ func (*generalConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalEvmHeadTrackerMaxBackfillDepth() (uint32, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerMaxBackfillDepth"), ParseUint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
+func (*generalConfig) GlobalEvmHeadTrackerMaxBatchSize() (uint32, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerMaxBatchSize"), ParseUint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
 func (*generalConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerMaxBufferSize"), ParseUint32)
 	if val == nil {
@@ -1425,6 +1718,13 @@ func (*generalConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalEvmHeadTrackerMaxHeadLag() (time.Duration, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerMaxHeadLag"), ParseDuration)
+	if val == nil {
+		return 0, false
+	}
+	return val.(time.Duration), ok
+}
 func (*generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmHeadTrackerSamplingInterval"), ParseDuration)
 	if val == nil {
@@ -1432,6 +1732,13 @@ func (*generalConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, boo
 	}
 	return val.(time.Duration), ok
 }
+func (*generalConfig) GlobalEvmKeyMinimumBalanceWei() (*big.Int, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmKeyMinimumBalanceWei"), ParseBigInt)
+	if val == nil {
+		return nil, false
+	}
+	return val.(*big.Int), ok
+}
 func (*generalConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmLogBackfillBatchSize"), ParseUint32)
 	if val == nil {
@@ -1474,6 +1781,34 @@ func (*generalConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return val.(bool), ok
 }
+func (*generalConfig) GlobalEvmAutoCreateAccessList() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmAutoCreateAccessList"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
+func (*generalConfig) GlobalEvmNonceGapRepair() (bool, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmNonceGapRepair"), ParseBool)
+	if val == nil {
+		return false, false
+	}
+	return val.(bool), ok
+}
+func (*generalConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmPrivateRelayFallbackBlocks"), ParseUint32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint32), ok
+}
+func (*generalConfig) GlobalEvmPrivateRelayURL() (string, bool) {
+	val, ok := lookupEnv(EnvVarName("EvmPrivateRelayURL"), ParseString)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	val, ok := lookupEnv(EnvVarName("EvmRPCDefaultBatchSize"), ParseUint32)
 	if val == nil {
@@ -1481,6 +1816,34 @@ func (*generalConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	}
 	return val.(uint32), ok
 }
+func (*generalConfig) GlobalFeeHistoryEstimatorBaseFeeHeadroom() (float32, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorBaseFeeHeadroom"), ParseF32)
+	if val == nil {
+		return 0, false
+	}
+	return val.(float32), ok
+}
+func (*generalConfig) GlobalFeeHistoryEstimatorBlockHistorySize() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorBlockHistorySize"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
+func (*generalConfig) GlobalFeeHistoryEstimatorCapBehavior() (string, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorCapBehavior"), ParseString)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
+func (*generalConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
+	val, ok := lookupEnv(EnvVarName("FeeHistoryEstimatorRewardPercentile"), ParseUint16)
+	if val == nil {
+		return 0, false
+	}
+	return val.(uint16), ok
+}
 func (*generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	val, ok := lookupEnv(EnvVarName("FlagsContractAddress"), ParseString)
 	if val == nil {
@@ -1488,6 +1851,13 @@ func (*generalConfig) GlobalFlagsContractAddress() (string, bool) {
 	}
 	return val.(string), ok
 }
+func (*generalConfig) GlobalGasEstimatorExternalURL() (string, bool) {
+	val, ok := lookupEnv(EnvVarName("GasEstimatorExternalURL"), ParseString)
+	if val == nil {
+		return "", false
+	}
+	return val.(string), ok
+}
 func (*generalConfig) GlobalGasEstimatorMode() (string, bool) {
 	val, ok := lookupEnv(EnvVarName("GasEstimatorMode"), ParseString)
 	if val == nil {
@@ -1570,3 +1940,9 @@ func (c *generalConfig) UseLegacyEthEnvVars() bool {
 func (c *generalConfig) DatabaseLockingMode() string {
 	return c.getWithFallback("DatabaseLockingMode", ParseString).(string)
 }
+
+// DatabaseMaintenanceWindow is a daily low-traffic window ("HH:MM-HH:MM",
+// UTC) during which hot tables are vacuumed; empty disables maintenance
+func (c *generalConfig) DatabaseMaintenanceWindow() string {
+	return c.viper.GetString(EnvVarName("DatabaseMaintenanceWindow"))
+}
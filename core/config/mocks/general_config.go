@@ -67,6 +67,64 @@ func (_m *GeneralConfig) AllowOrigins() string {
 	return r0
 }
 
+// AuditLogHTTPURL provides a mock function with given fields:
+func (_m *GeneralConfig) AuditLogHTTPURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// AuditLogS3Bucket provides a mock function with given fields:
+func (_m *GeneralConfig) AuditLogS3Bucket() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AuditLogS3Region provides a mock function with given fields:
+func (_m *GeneralConfig) AuditLogS3Region() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// AuditLogSink provides a mock function with given fields:
+func (_m *GeneralConfig) AuditLogSink() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // AuthenticatedRateLimit provides a mock function with given fields:
 func (_m *GeneralConfig) AuthenticatedRateLimit() int64 {
 	ret := _m.Called()
@@ -167,6 +225,20 @@ func (_m *GeneralConfig) ClientNodeURL() string {
 	return r0
 }
 
+// ConfigFile provides a mock function with given fields:
+func (_m *GeneralConfig) ConfigFile() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseBackupDir provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseBackupDir() string {
 	ret := _m.Called()
@@ -225,6 +297,20 @@ func (_m *GeneralConfig) DatabaseBackupURL() *url.URL {
 	return r0
 }
 
+// DatabaseDefaultBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseDefaultBatchSize() uint {
+	ret := _m.Called()
+
+	var r0 uint
+	if rf, ok := ret.Get(0).(func() uint); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint)
+	}
+
+	return r0
+}
+
 // DatabaseListenerMaxReconnectDuration provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseListenerMaxReconnectDuration() time.Duration {
 	ret := _m.Called()
@@ -267,6 +353,20 @@ func (_m *GeneralConfig) DatabaseLockingMode() string {
 	return r0
 }
 
+// DatabaseMaintenanceWindow provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseMaintenanceWindow() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // DatabaseMaximumTxDuration provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseMaximumTxDuration() time.Duration {
 	ret := _m.Called()
@@ -281,6 +381,50 @@ func (_m *GeneralConfig) DatabaseMaximumTxDuration() time.Duration {
 	return r0
 }
 
+// DatabaseReadReplicaMaxLag provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseReadReplicaMaxLag() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
+// DatabaseReadReplicaURL provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseReadReplicaURL() *url.URL {
+	ret := _m.Called()
+
+	var r0 *url.URL
+	if rf, ok := ret.Get(0).(func() *url.URL); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*url.URL)
+		}
+	}
+
+	return r0
+}
+
+// DatabaseSlowQueryThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) DatabaseSlowQueryThreshold() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // DatabaseTimeout provides a mock function with given fields:
 func (_m *GeneralConfig) DatabaseTimeout() models.Duration {
 	ret := _m.Called()
@@ -527,6 +671,34 @@ func (_m *GeneralConfig) ExplorerURL() *url.URL {
 	return r0
 }
 
+// FMBatchFlushInterval provides a mock function with given fields:
+func (_m *GeneralConfig) FMBatchFlushInterval() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
+// FMBatchSubmissions provides a mock function with given fields:
+func (_m *GeneralConfig) FMBatchSubmissions() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // FMDefaultTransactionQueueDepth provides a mock function with given fields:
 func (_m *GeneralConfig) FMDefaultTransactionQueueDepth() uint32 {
 	ret := _m.Called()
@@ -786,6 +958,48 @@ func (_m *GeneralConfig) GlobalChainType() (string, bool) {
 	return r0, r1
 }
 
+// GlobalEthTxAttemptGCKeepCount provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEthTxAttemptGCKeepCount() (uint16, bool) {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEthTxReaperArchiveEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEthTxReaperArchiveEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEthTxReaperInterval provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEthTxReaperInterval() (time.Duration, bool) {
 	ret := _m.Called()
@@ -912,6 +1126,50 @@ func (_m *GeneralConfig) GlobalEvmFinalityDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmFinalityTagEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmFundingTopUpMaxDailyWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmFundingTopUpMaxDailyWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmGasBumpPercent provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmGasBumpPercent() (uint16, bool) {
 	ret := _m.Called()
@@ -1130,6 +1388,27 @@ func (_m *GeneralConfig) GlobalEvmGasTipCapMinimum() (*big.Int, bool) {
 	return r0, r1
 }
 
+// GlobalEvmHeadTrackerCrossValidationEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerCrossValidationEnabled() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	ret := _m.Called()
@@ -1151,6 +1430,48 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerHistoryDepth() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmHeadTrackerMaxBackfillDepth provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBackfillDepth() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmHeadTrackerMaxBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBatchSize() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmHeadTrackerMaxBufferSize provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	ret := _m.Called()
@@ -1172,6 +1493,27 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxBufferSize() (uint32, bool) {
 	return r0, r1
 }
 
+// GlobalEvmHeadTrackerMaxHeadLag provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmHeadTrackerMaxHeadLag() (time.Duration, bool) {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmHeadTrackerSamplingInterval provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration, bool) {
 	ret := _m.Called()
@@ -1193,6 +1535,29 @@ func (_m *GeneralConfig) GlobalEvmHeadTrackerSamplingInterval() (time.Duration,
 	return r0, r1
 }
 
+// GlobalEvmKeyMinimumBalanceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmKeyMinimumBalanceWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
 // GlobalEvmLogBackfillBatchSize provides a mock function with given fields:
 func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	ret := _m.Called()
@@ -1201,7 +1566,200 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+	ret := _m.Called()
+
+	var r0 uint64
+	if rf, ok := ret.Get(0).(func() uint64); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint64)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmMinGasPriceWei provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
+	ret := _m.Called()
+
+	var r0 *big.Int
+	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*big.Int)
+		}
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmNonceAutoSync provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmAutoCreateAccessList provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmAutoCreateAccessList() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmNonceGapRepair provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmNonceGapRepair() (bool, bool) {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmPrivateRelayFallbackBlocks provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	var r1 bool
+	if rf, ok := ret.Get(1).(func() bool); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Get(1).(bool)
+	}
+
+	return r0, r1
+}
+
+// GlobalEvmPrivateRelayURL provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmPrivateRelayURL() (string, bool) {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1214,17 +1772,15 @@ func (_m *GeneralConfig) GlobalEvmLogBackfillBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxGasPriceWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
+// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(uint32)
 	}
 
 	var r1 bool
@@ -1237,15 +1793,15 @@ func (_m *GeneralConfig) GlobalEvmMaxGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxInFlightTransactions provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
+// GlobalFeeHistoryEstimatorBaseFeeHeadroom provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorBaseFeeHeadroom() (float32, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(float32)
 	}
 
 	var r1 bool
@@ -1258,15 +1814,15 @@ func (_m *GeneralConfig) GlobalEvmMaxInFlightTransactions() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMaxQueuedTransactions provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
+// GlobalFeeHistoryEstimatorBlockHistorySize provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorBlockHistorySize() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 uint64
-	if rf, ok := ret.Get(0).(func() uint64); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint64)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1279,17 +1835,15 @@ func (_m *GeneralConfig) GlobalEvmMaxQueuedTransactions() (uint64, bool) {
 	return r0, r1
 }
 
-// GlobalEvmMinGasPriceWei provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
+// GlobalFeeHistoryEstimatorCapBehavior provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorCapBehavior() (string, bool) {
 	ret := _m.Called()
 
-	var r0 *big.Int
-	if rf, ok := ret.Get(0).(func() *big.Int); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		if ret.Get(0) != nil {
-			r0 = ret.Get(0).(*big.Int)
-		}
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1302,15 +1856,15 @@ func (_m *GeneralConfig) GlobalEvmMinGasPriceWei() (*big.Int, bool) {
 	return r0, r1
 }
 
-// GlobalEvmNonceAutoSync provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
+// GlobalFeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFeeHistoryEstimatorRewardPercentile() (uint16, bool) {
 	ret := _m.Called()
 
-	var r0 bool
-	if rf, ok := ret.Get(0).(func() bool); ok {
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(bool)
+		r0 = ret.Get(0).(uint16)
 	}
 
 	var r1 bool
@@ -1323,15 +1877,15 @@ func (_m *GeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	return r0, r1
 }
 
-// GlobalEvmRPCDefaultBatchSize provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
+// GlobalFlagsContractAddress provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalFlagsContractAddress() (string, bool) {
 	ret := _m.Called()
 
-	var r0 uint32
-	if rf, ok := ret.Get(0).(func() uint32); ok {
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
 		r0 = rf()
 	} else {
-		r0 = ret.Get(0).(uint32)
+		r0 = ret.Get(0).(string)
 	}
 
 	var r1 bool
@@ -1344,8 +1898,8 @@ func (_m *GeneralConfig) GlobalEvmRPCDefaultBatchSize() (uint32, bool) {
 	return r0, r1
 }
 
-// GlobalFlagsContractAddress provides a mock function with given fields:
-func (_m *GeneralConfig) GlobalFlagsContractAddress() (string, bool) {
+// GlobalGasEstimatorExternalURL provides a mock function with given fields:
+func (_m *GeneralConfig) GlobalGasEstimatorExternalURL() (string, bool) {
 	ret := _m.Called()
 
 	var r0 string
@@ -1619,6 +2173,34 @@ func (_m *GeneralConfig) JobPipelineResultWriteQueueDepth() uint64 {
 	return r0
 }
 
+// JobQuarantineFailureThreshold provides a mock function with given fields:
+func (_m *GeneralConfig) JobQuarantineFailureThreshold() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// JobPipelineRunsArchiveURL provides a mock function with given fields:
+func (_m *GeneralConfig) JobPipelineRunsArchiveURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // KeeperDefaultTransactionQueueDepth provides a mock function with given fields:
 func (_m *GeneralConfig) KeeperDefaultTransactionQueueDepth() uint32 {
 	ret := _m.Called()
@@ -1843,6 +2425,20 @@ func (_m *GeneralConfig) MigrateDatabase() bool {
 	return r0
 }
 
+// MulticallContractAddress provides a mock function with given fields:
+func (_m *GeneralConfig) MulticallContractAddress() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // OCRBlockchainTimeout provides a mock function with given fields:
 func (_m *GeneralConfig) OCRBlockchainTimeout() time.Duration {
 	ret := _m.Called()
@@ -2109,6 +2705,106 @@ func (_m *GeneralConfig) OCRTransmitterAddress() (ethkey.EIP55Address, error) {
 	return r0, r1
 }
 
+// OIDCAllowedDomains provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCAllowedDomains() []string {
+	ret := _m.Called()
+
+	var r0 []string
+	if rf, ok := ret.Get(0).(func() []string); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]string)
+		}
+	}
+
+	return r0
+}
+
+// OIDCClientID provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCClientID() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCClientSecret provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCClientSecret() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCDefaultRole provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCDefaultRole() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCIssuer provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCIssuer() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCRedirectURL provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRedirectURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// OIDCRoleMapping provides a mock function with given fields:
+func (_m *GeneralConfig) OIDCRoleMapping() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // ORMMaxIdleConns provides a mock function with given fields:
 func (_m *GeneralConfig) ORMMaxIdleConns() int {
 	ret := _m.Called()
@@ -2524,6 +3220,20 @@ func (_m *GeneralConfig) SecureCookies() bool {
 	return r0
 }
 
+// SessionAbsoluteTimeout provides a mock function with given fields:
+func (_m *GeneralConfig) SessionAbsoluteTimeout() models.Duration {
+	ret := _m.Called()
+
+	var r0 models.Duration
+	if rf, ok := ret.Get(0).(func() models.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(models.Duration)
+	}
+
+	return r0
+}
+
 // SessionOptions provides a mock function with given fields:
 func (_m *GeneralConfig) SessionOptions() sessions.Options {
 	ret := _m.Called()
@@ -2706,6 +3416,34 @@ func (_m *GeneralConfig) TLSRedirect() bool {
 	return r0
 }
 
+// TelemetryIngressBufferDir provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryIngressBufferDir() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TelemetryIngressEndpoints provides a mock function with given fields:
+func (_m *GeneralConfig) TelemetryIngressEndpoints() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // TelemetryIngressLogging provides a mock function with given fields:
 func (_m *GeneralConfig) TelemetryIngressLogging() bool {
 	ret := _m.Called()
@@ -2750,6 +3488,48 @@ func (_m *GeneralConfig) TelemetryIngressURL() *url.URL {
 	return r0
 }
 
+// TracingCollectorTarget provides a mock function with given fields:
+func (_m *GeneralConfig) TracingCollectorTarget() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// TracingEnabled provides a mock function with given fields:
+func (_m *GeneralConfig) TracingEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// TracingSamplingRatio provides a mock function with given fields:
+func (_m *GeneralConfig) TracingSamplingRatio() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
 // TriggerFallbackDBPollInterval provides a mock function with given fields:
 func (_m *GeneralConfig) TriggerFallbackDBPollInterval() time.Duration {
 	ret := _m.Called()
@@ -26,6 +26,10 @@ type ConfigPrinter struct {
 // EnvPrinter contains the supported environment variables
 type EnvPrinter struct {
 	AllowOrigins                               string          `json:"ALLOW_ORIGINS"`
+	AuditLogHTTPURL                            string          `json:"AUDIT_LOG_HTTP_URL"`
+	AuditLogS3Bucket                           string          `json:"AUDIT_LOG_S3_BUCKET"`
+	AuditLogS3Region                           string          `json:"AUDIT_LOG_S3_REGION"`
+	AuditLogSink                               string          `json:"AUDIT_LOG_SINK"`
 	BlockBackfillDepth                         uint64          `json:"BLOCK_BACKFILL_DEPTH"`
 	BlockHistoryEstimatorBlockDelay            uint16          `json:"GAS_UPDATER_BLOCK_DELAY"`
 	BlockHistoryEstimatorBlockHistorySize      uint16          `json:"GAS_UPDATER_BLOCK_HISTORY_SIZE"`
@@ -33,6 +37,7 @@ type EnvPrinter struct {
 	BridgeResponseURL                          string          `json:"BRIDGE_RESPONSE_URL,omitempty"`
 	ChainType                                  string          `json:"CHAIN_TYPE"`
 	ClientNodeURL                              string          `json:"CLIENT_NODE_URL"`
+	ConfigFile                                 string          `json:"CONFIG_FILE,omitempty"`
 	DatabaseBackupFrequency                    time.Duration   `json:"DATABASE_BACKUP_FREQUENCY"`
 	DatabaseBackupMode                         string          `json:"DATABASE_BACKUP_MODE"`
 	DatabaseMaximumTxDuration                  time.Duration   `json:"DATABASE_MAXIMUM_TX_DURATION"`
@@ -47,6 +52,8 @@ type EnvPrinter struct {
 	EthereumSecondaryURLs                      []string        `json:"ETH_SECONDARY_URLS"`
 	EthereumURL                                string          `json:"ETH_URL"`
 	ExplorerURL                                string          `json:"EXPLORER_URL"`
+	FMBatchFlushInterval                       models.Duration `json:"FM_BATCH_FLUSH_INTERVAL"`
+	FMBatchSubmissions                         bool            `json:"FM_BATCH_SUBMISSIONS"`
 	FMDefaultTransactionQueueDepth             uint32          `json:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
 	FeatureExternalInitiators                  bool            `json:"FEATURE_EXTERNAL_INITIATORS"`
 	FeatureOffchainReporting                   bool            `json:"FEATURE_OFFCHAIN_REPORTING"`
@@ -55,6 +62,7 @@ type EnvPrinter struct {
 	JSONConsole                                bool            `json:"JSON_CONSOLE"`
 	JobPipelineReaperInterval                  time.Duration   `json:"JOB_PIPELINE_REAPER_INTERVAL"`
 	JobPipelineReaperThreshold                 time.Duration   `json:"JOB_PIPELINE_REAPER_THRESHOLD"`
+	JobPipelineRunsArchiveURL                  string          `json:"JOB_PIPELINE_RUNS_ARCHIVE_URL,omitempty"`
 	KeeperDefaultTransactionQueueDepth         uint32          `json:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH"`
 	KeeperGasPriceBufferPercent                uint32          `json:"KEEPER_GAS_PRICE_BUFFER_PERCENT"`
 	KeeperGasTipCapBufferPercent               uint32          `json:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT"`
@@ -70,6 +78,7 @@ type EnvPrinter struct {
 	LogSQLMigrations                           bool            `json:"LOG_SQL_MIGRATIONS"`
 	LogSQLStatements                           bool            `json:"LOG_SQL"`
 	LogToDisk                                  bool            `json:"LOG_TO_DISK"`
+	MulticallContractAddress                   string          `json:"MULTICALL_CONTRACT_ADDRESS,omitempty"`
 	OCRBootstrapCheckInterval                  time.Duration   `json:"OCR_BOOTSTRAP_CHECK_INTERVAL"`
 	TriggerFallbackDBPollInterval              time.Duration   `json:"JOB_PIPELINE_DB_POLL_INTERVAL"`
 	OCRContractTransmitterTransmitTimeout      time.Duration   `json:"OCR_CONTRACT_TRANSMITTER_TRANSMIT_TIMEOUT"`
@@ -90,13 +99,25 @@ type EnvPrinter struct {
 	OCRNewStreamTimeout                        time.Duration   `json:"OCR_NEW_STREAM_TIMEOUT"`
 	OCRDHTLookupInterval                       int             `json:"OCR_DHT_LOOKUP_INTERVAL"`
 	OCRTraceLogging                            bool            `json:"OCR_TRACE_LOGGING"`
+	OIDCAllowedDomains                         []string        `json:"OIDC_ALLOWED_DOMAINS"`
+	OIDCClientID                               string          `json:"OIDC_CLIENT_ID"`
+	OIDCDefaultRole                            string          `json:"OIDC_DEFAULT_ROLE"`
+	OIDCIssuer                                 string          `json:"OIDC_ISSUER"`
+	OIDCRedirectURL                            string          `json:"OIDC_REDIRECT_URL"`
+	OIDCRoleMapping                            string          `json:"OIDC_ROLE_MAPPING"`
 	Port                                       uint16          `json:"CHAINLINK_PORT"`
 	ReaperExpiration                           models.Duration `json:"REAPER_EXPIRATION"`
 	ReplayFromBlock                            int64           `json:"REPLAY_FROM_BLOCK"`
 	RootDir                                    string          `json:"ROOT"`
 	SecureCookies                              bool            `json:"SECURE_COOKIES"`
+	SessionAbsoluteTimeout                     models.Duration `json:"SESSION_ABSOLUTE_TIMEOUT"`
 	SessionTimeout                             models.Duration `json:"SESSION_TIMEOUT"`
+	TelemetryIngressBufferDir                  string          `json:"TELEMETRY_INGRESS_BUFFER_DIR"`
+	TelemetryIngressEndpoints                  string          `json:"TELEMETRY_INGRESS_ENDPOINTS"`
 	TelemetryIngressLogging                    bool            `json:"TELEMETRY_INGRESS_LOGGING"`
+	TracingCollectorTarget                     string          `json:"TRACING_COLLECTOR_TARGET"`
+	TracingEnabled                             bool            `json:"TRACING_ENABLED"`
+	TracingSamplingRatio                       float32         `json:"TRACING_SAMPLING_RATIO"`
 	TelemetryIngressServerPubKey               string          `json:"TELEMETRY_INGRESS_SERVER_PUB_KEY"`
 	TelemetryIngressURL                        string          `json:"TELEMETRY_INGRESS_URL"`
 	TLSHost                                    string          `json:"CHAINLINK_TLS_HOST"`
@@ -119,12 +140,21 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 	if cfg.TelemetryIngressURL() != nil {
 		telemetryIngressURL = cfg.TelemetryIngressURL().String()
 	}
+	auditLogHTTPURL := ""
+	if cfg.AuditLogHTTPURL() != nil {
+		auditLogHTTPURL = cfg.AuditLogHTTPURL().String()
+	}
 	return ConfigPrinter{
 		EnvPrinter: EnvPrinter{
 			AllowOrigins:                          cfg.AllowOrigins(),
+			AuditLogHTTPURL:                       auditLogHTTPURL,
+			AuditLogS3Bucket:                      cfg.AuditLogS3Bucket(),
+			AuditLogS3Region:                      cfg.AuditLogS3Region(),
+			AuditLogSink:                          cfg.AuditLogSink(),
 			BlockBackfillDepth:                    cfg.BlockBackfillDepth(),
 			BridgeResponseURL:                     cfg.BridgeResponseURL().String(),
 			ClientNodeURL:                         cfg.ClientNodeURL(),
+			ConfigFile:                            cfg.ConfigFile(),
 			DatabaseBackupFrequency:               cfg.DatabaseBackupFrequency(),
 			DatabaseBackupMode:                    string(cfg.DatabaseBackupMode()),
 			DatabaseMaximumTxDuration:             cfg.DatabaseMaximumTxDuration(),
@@ -139,6 +169,8 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			EthereumSecondaryURLs:                 mapToStringA(cfg.EthereumSecondaryURLs()),
 			EthereumURL:                           cfg.EthereumURL(),
 			ExplorerURL:                           explorerURL,
+			FMBatchFlushInterval:                  cfg.FMBatchFlushInterval(),
+			FMBatchSubmissions:                    cfg.FMBatchSubmissions(),
 			FMDefaultTransactionQueueDepth:        cfg.FMDefaultTransactionQueueDepth(),
 			FeatureExternalInitiators:             cfg.FeatureExternalInitiators(),
 			FeatureOffchainReporting:              cfg.FeatureOffchainReporting(),
@@ -146,6 +178,7 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			JSONConsole:                           cfg.JSONConsole(),
 			JobPipelineReaperInterval:             cfg.JobPipelineReaperInterval(),
 			JobPipelineReaperThreshold:            cfg.JobPipelineReaperThreshold(),
+			JobPipelineRunsArchiveURL:             cfg.JobPipelineRunsArchiveURL(),
 			KeeperDefaultTransactionQueueDepth:    cfg.KeeperDefaultTransactionQueueDepth(),
 			KeeperGasPriceBufferPercent:           cfg.KeeperGasPriceBufferPercent(),
 			KeeperGasTipCapBufferPercent:          cfg.KeeperGasTipCapBufferPercent(),
@@ -153,6 +186,7 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			LogSQLMigrations:                      cfg.LogSQLMigrations(),
 			LogSQLStatements:                      cfg.LogSQLStatements(),
 			LogToDisk:                             cfg.LogToDisk(),
+			MulticallContractAddress:              cfg.MulticallContractAddress(),
 			OCRBootstrapCheckInterval:             cfg.OCRBootstrapCheckInterval(),
 			OCRContractTransmitterTransmitTimeout: cfg.OCRContractTransmitterTransmitTimeout(),
 			OCRDHTLookupInterval:                  cfg.OCRDHTLookupInterval(),
@@ -162,6 +196,12 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			OCRNewStreamTimeout:                   cfg.OCRNewStreamTimeout(),
 			OCROutgoingMessageBufferSize:          cfg.OCROutgoingMessageBufferSize(),
 			OCRTraceLogging:                       cfg.OCRTraceLogging(),
+			OIDCAllowedDomains:                    cfg.OIDCAllowedDomains(),
+			OIDCClientID:                          cfg.OIDCClientID(),
+			OIDCDefaultRole:                       cfg.OIDCDefaultRole(),
+			OIDCIssuer:                            cfg.OIDCIssuer(),
+			OIDCRedirectURL:                       cfg.OIDCRedirectURL(),
+			OIDCRoleMapping:                       cfg.OIDCRoleMapping(),
 			P2PBootstrapPeers:                     p2pBootstrapPeers,
 			P2PListenIP:                           cfg.P2PListenIP().String(),
 			P2PListenPort:                         cfg.P2PListenPortRaw(),
@@ -177,11 +217,17 @@ func NewConfigPrinter(cfg GeneralConfig) (ConfigPrinter, error) {
 			ReplayFromBlock:                       cfg.ReplayFromBlock(),
 			RootDir:                               cfg.RootDir(),
 			SecureCookies:                         cfg.SecureCookies(),
+			SessionAbsoluteTimeout:                cfg.SessionAbsoluteTimeout(),
 			SessionTimeout:                        cfg.SessionTimeout(),
 			TLSHost:                               cfg.TLSHost(),
 			TLSPort:                               cfg.TLSPort(),
 			TLSRedirect:                           cfg.TLSRedirect(),
+			TelemetryIngressBufferDir:             cfg.TelemetryIngressBufferDir(),
+			TelemetryIngressEndpoints:             cfg.TelemetryIngressEndpoints(),
 			TelemetryIngressLogging:               cfg.TelemetryIngressLogging(),
+			TracingCollectorTarget:                cfg.TracingCollectorTarget(),
+			TracingEnabled:                        cfg.TracingEnabled(),
+			TracingSamplingRatio:                  cfg.TracingSamplingRatio(),
 			TelemetryIngressServerPubKey:          cfg.TelemetryIngressServerPubKey(),
 			TelemetryIngressURL:                   telemetryIngressURL,
 			TriggerFallbackDBPollInterval:         cfg.TriggerFallbackDBPollInterval(),
@@ -19,6 +19,10 @@ import (
 type ConfigSchema struct {
 	AdminCredentialsFile                       string                        `env:"ADMIN_CREDENTIALS_FILE" default:"$ROOT/apicredentials"`
 	AllowOrigins                               string                        `env:"ALLOW_ORIGINS" default:"http://localhost:3000,http://localhost:6688"`
+	AuditLogHTTPURL                            *url.URL                      `env:"AUDIT_LOG_HTTP_URL"`
+	AuditLogS3Bucket                           string                        `env:"AUDIT_LOG_S3_BUCKET"`
+	AuditLogS3Region                           string                        `env:"AUDIT_LOG_S3_REGION"`
+	AuditLogSink                               string                        `env:"AUDIT_LOG_SINK"`
 	AuthenticatedRateLimit                     int64                         `env:"AUTHENTICATED_RATE_LIMIT" default:"1000"`
 	AuthenticatedRateLimitPeriod               time.Duration                 `env:"AUTHENTICATED_RATE_LIMIT_PERIOD" default:"1m"`
 	BalanceMonitorEnabled                      bool                          `env:"BALANCE_MONITOR_ENABLED"`
@@ -32,14 +36,20 @@ type ConfigSchema struct {
 	BridgeResponseURL                          url.URL                       `env:"BRIDGE_RESPONSE_URL"`
 	ChainType                                  string                        `env:"CHAIN_TYPE"`
 	ClientNodeURL                              string                        `env:"CLIENT_NODE_URL" default:"http://localhost:6688"`
+	ConfigFile                                 string                        `env:"CONFIG_FILE"`
 	DatabaseBackupDir                          string                        `env:"DATABASE_BACKUP_DIR" default:""`
 	DatabaseBackupFrequency                    time.Duration                 `env:"DATABASE_BACKUP_FREQUENCY" default:"1h"`
 	DatabaseBackupMode                         string                        `env:"DATABASE_BACKUP_MODE" default:"none"`
 	DatabaseBackupURL                          *url.URL                      `env:"DATABASE_BACKUP_URL" default:""`
+	DatabaseDefaultBatchSize                   uint                          `env:"DATABASE_DEFAULT_BATCH_SIZE" default:"1000"`
 	DatabaseListenerMaxReconnectDuration       time.Duration                 `env:"DATABASE_LISTENER_MAX_RECONNECT_DURATION" default:"10m"`
 	DatabaseListenerMinReconnectInterval       time.Duration                 `env:"DATABASE_LISTENER_MIN_RECONNECT_INTERVAL" default:"1m"`
 	DatabaseLockingMode                        string                        `env:"DATABASE_LOCKING_MODE" default:"dual"`
+	DatabaseMaintenanceWindow                  string                        `env:"DATABASE_MAINTENANCE_WINDOW" default:""`
 	DatabaseMaximumTxDuration                  time.Duration                 `env:"DATABASE_MAXIMUM_TX_DURATION" default:"30m"`
+	DatabaseReadReplicaMaxLag                  time.Duration                 `env:"DATABASE_READ_REPLICA_MAX_LAG" default:"1m"`
+	DatabaseReadReplicaURL                     string                        `env:"DATABASE_READ_REPLICA_URL"`
+	DatabaseSlowQueryThreshold                 time.Duration                 `env:"DATABASE_SLOW_QUERY_THRESHOLD" default:"1s"`
 	DatabaseTimeout                            models.Duration               `env:"DATABASE_TIMEOUT" default:"0"`
 	DatabaseURL                                string                        `env:"DATABASE_URL"`
 	DefaultChainID                             *big.Int                      `env:"ETH_CHAIN_ID"`
@@ -49,6 +59,8 @@ type ConfigSchema struct {
 	DefaultMaxHTTPAttempts                     uint                          `env:"MAX_HTTP_ATTEMPTS" default:"5"`
 	Dev                                        bool                          `env:"CHAINLINK_DEV" default:"false"`
 	EVMDisabled                                bool                          `env:"EVM_DISABLED" default:"false"`
+	EthTxAttemptGCKeepCount                    uint16                        `env:"ETH_TX_ATTEMPT_GC_KEEP_COUNT"`
+	EthTxReaperArchiveEnabled                  bool                          `env:"ETH_TX_REAPER_ARCHIVE_ENABLED"`
 	EthTxReaperInterval                        time.Duration                 `env:"ETH_TX_REAPER_INTERVAL"`
 	EthTxReaperThreshold                       time.Duration                 `env:"ETH_TX_REAPER_THRESHOLD"`
 	EthTxResendAfterThreshold                  time.Duration                 `env:"ETH_TX_RESEND_AFTER_THRESHOLD"`
@@ -60,6 +72,8 @@ type ConfigSchema struct {
 	EvmDefaultBatchSize                        uint32                        `env:"ETH_DEFAULT_BATCH_SIZE"`
 	EvmEIP1559DynamicFees                      bool                          `env:"EVM_EIP1559_DYNAMIC_FEES"`
 	EvmFinalityDepth                           uint32                        `env:"ETH_FINALITY_DEPTH"`
+	EvmFinalityTagEnabled                      bool                          `env:"ETH_FINALITY_TAG_ENABLED"`
+	EvmFundingTopUpMaxDailyWei                 *big.Int                      `env:"ETH_FUNDING_TOP_UP_MAX_DAILY_WEI"`
 	EvmGasBumpPercent                          uint16                        `env:"ETH_GAS_BUMP_PERCENT"`
 	EvmGasBumpThreshold                        uint64                        `env:"ETH_GAS_BUMP_THRESHOLD"`
 	EvmGasBumpTxDepth                          uint16                        `env:"ETH_GAS_BUMP_TX_DEPTH"`
@@ -70,26 +84,42 @@ type ConfigSchema struct {
 	EvmGasPriceDefault                         *big.Int                      `env:"ETH_GAS_PRICE_DEFAULT"`
 	EvmGasTipCapDefault                        *big.Int                      `env:"EVM_GAS_TIP_CAP_DEFAULT"`
 	EvmGasTipCapMinimum                        *big.Int                      `env:"EVM_GAS_TIP_CAP_MINIMUM"`
+	EvmHeadTrackerCrossValidationEnabled       bool                          `env:"ETH_HEAD_TRACKER_CROSS_VALIDATION_ENABLED"`
 	EvmHeadTrackerHistoryDepth                 uint                          `env:"ETH_HEAD_TRACKER_HISTORY_DEPTH"`
+	EvmHeadTrackerMaxBackfillDepth             uint                          `env:"ETH_HEAD_TRACKER_MAX_BACKFILL_DEPTH"`
+	EvmHeadTrackerMaxBatchSize                 uint                          `env:"ETH_HEAD_TRACKER_MAX_BATCH_SIZE"`
 	EvmHeadTrackerMaxBufferSize                uint                          `env:"ETH_HEAD_TRACKER_MAX_BUFFER_SIZE"`
+	EvmHeadTrackerMaxHeadLag                   time.Duration                 `env:"ETH_HEAD_TRACKER_MAX_HEAD_LAG"`
 	EvmHeadTrackerSamplingInterval             time.Duration                 `env:"ETH_HEAD_TRACKER_SAMPLING_INTERVAL"`
+	EvmKeyMinimumBalanceWei                    *big.Int                      `env:"ETH_KEY_MINIMUM_BALANCE_WEI"`
 	EvmLogBackfillBatchSize                    uint32                        `env:"ETH_LOG_BACKFILL_BATCH_SIZE"`
 	EvmMaxGasPriceWei                          *big.Int                      `env:"ETH_MAX_GAS_PRICE_WEI"`
 	EvmMaxInFlightTransactions                 uint32                        `env:"ETH_MAX_IN_FLIGHT_TRANSACTIONS"`
 	EvmMaxQueuedTransactions                   uint64                        `env:"ETH_MAX_QUEUED_TRANSACTIONS"`
 	EvmMinGasPriceWei                          *big.Int                      `env:"ETH_MIN_GAS_PRICE_WEI"`
 	EvmNonceAutoSync                           bool                          `env:"ETH_NONCE_AUTO_SYNC"`
+	EvmAutoCreateAccessList                    bool                          `env:"ETH_AUTO_CREATE_ACCESS_LIST"`
+	EvmNonceGapRepair                          bool                          `env:"ETH_NONCE_GAP_REPAIR"`
+	EvmPrivateRelayFallbackBlocks              uint32                        `env:"ETH_PRIVATE_RELAY_FALLBACK_BLOCKS"`
+	EvmPrivateRelayURL                         string                        `env:"ETH_PRIVATE_RELAY_URL"`
 	EvmRPCDefaultBatchSize                     uint32                        `env:"ETH_RPC_DEFAULT_BATCH_SIZE"`
 	ExplorerAccessKey                          string                        `env:"EXPLORER_ACCESS_KEY"`
 	ExplorerSecret                             string                        `env:"EXPLORER_SECRET"`
 	ExplorerURL                                *url.URL                      `env:"EXPLORER_URL"`
+	FMBatchFlushInterval                       models.Duration               `env:"FM_BATCH_FLUSH_INTERVAL" default:"10s"`
+	FMBatchSubmissions                         bool                          `env:"FM_BATCH_SUBMISSIONS" default:"false"`
 	FMDefaultTransactionQueueDepth             uint32                        `env:"FM_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
 	FMSimulateTransactions                     bool                          `env:"FM_SIMULATE_TRANSACTIONS" default:"false"`
 	FeatureExternalInitiators                  bool                          `env:"FEATURE_EXTERNAL_INITIATORS" default:"false"`
 	FeatureOffchainReporting                   bool                          `env:"FEATURE_OFFCHAIN_REPORTING" default:"false"`
 	FeatureUICSAKeys                           bool                          `env:"FEATURE_UI_CSA_KEYS" default:"false"`
 	FeatureUIFeedsManager                      bool                          `env:"FEATURE_UI_FEEDS_MANAGER" default:"false"`
+	FeeHistoryEstimatorBaseFeeHeadroom         float32                       `env:"FEE_HISTORY_ESTIMATOR_BASE_FEE_HEADROOM"`
+	FeeHistoryEstimatorBlockHistorySize        uint16                        `env:"FEE_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE"`
+	FeeHistoryEstimatorCapBehavior             string                        `env:"FEE_HISTORY_ESTIMATOR_CAP_BEHAVIOR"`
+	FeeHistoryEstimatorRewardPercentile        uint16                        `env:"FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE"`
 	FlagsContractAddress                       string                        `env:"FLAGS_CONTRACT_ADDRESS"`
+	GasEstimatorExternalURL                    string                        `env:"GAS_ESTIMATOR_EXTERNAL_URL"`
 	GasEstimatorMode                           string                        `env:"GAS_ESTIMATOR_MODE"`
 	GlobalLockRetryInterval                    models.Duration               `env:"GLOBAL_LOCK_RETRY_INTERVAL" default:"1s"`
 	HTTPServerWriteTimeout                     time.Duration                 `env:"HTTP_SERVER_WRITE_TIMEOUT" default:"10s"`
@@ -100,6 +130,8 @@ type ConfigSchema struct {
 	JobPipelineReaperInterval                  time.Duration                 `env:"JOB_PIPELINE_REAPER_INTERVAL" default:"1h"`
 	JobPipelineReaperThreshold                 time.Duration                 `env:"JOB_PIPELINE_REAPER_THRESHOLD" default:"24h"`
 	JobPipelineResultWriteQueueDepth           uint64                        `env:"JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH" default:"100"`
+	JobPipelineRunsArchiveURL                  string                        `env:"JOB_PIPELINE_RUNS_ARCHIVE_URL"`
+	JobQuarantineFailureThreshold              uint32                        `env:"JOB_QUARANTINE_FAILURE_THRESHOLD" default:"0"`
 	KeeperDefaultTransactionQueueDepth         uint32                        `env:"KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH" default:"1"`
 	KeeperGasPriceBufferPercent                uint32                        `env:"KEEPER_GAS_PRICE_BUFFER_PERCENT" default:"20"`
 	KeeperGasTipCapBufferPercent               uint32                        `env:"KEEPER_GAS_TIP_CAP_BUFFER_PERCENT" default:"20"`
@@ -119,6 +151,7 @@ type ConfigSchema struct {
 	MinIncomingConfirmations                   uint32                        `env:"MIN_INCOMING_CONFIRMATIONS"`
 	MinRequiredOutgoingConfirmations           uint64                        `env:"MIN_OUTGOING_CONFIRMATIONS"`
 	MinimumContractPayment                     assets.Link                   `env:"MINIMUM_CONTRACT_PAYMENT_LINK_JUELS"`
+	MulticallContractAddress                   string                        `env:"MULTICALL_CONTRACT_ADDRESS"`
 	OCRBlockchainTimeout                       time.Duration                 `env:"OCR_BLOCKCHAIN_TIMEOUT" default:"20s"`
 	OCRBootstrapCheckInterval                  time.Duration                 `env:"OCR_BOOTSTRAP_CHECK_INTERVAL" default:"20s"`
 	OCRContractConfirmations                   uint                          `env:"OCR_CONTRACT_CONFIRMATIONS"`
@@ -138,6 +171,13 @@ type ConfigSchema struct {
 	OCRSimulateTransactions                    bool                          `env:"OCR_SIMULATE_TRANSACTIONS" default:"false"`
 	OCRTraceLogging                            bool                          `env:"OCR_TRACE_LOGGING" default:"false"`
 	OCRTransmitterAddress                      string                        `env:"OCR_TRANSMITTER_ADDRESS"`
+	OIDCAllowedDomains                         []string                      `env:"OIDC_ALLOWED_DOMAINS"`
+	OIDCClientID                               string                        `env:"OIDC_CLIENT_ID"`
+	OIDCClientSecret                           string                        `env:"OIDC_CLIENT_SECRET"`
+	OIDCDefaultRole                            string                        `env:"OIDC_DEFAULT_ROLE" default:"view"`
+	OIDCIssuer                                 string                        `env:"OIDC_ISSUER"`
+	OIDCRedirectURL                            string                        `env:"OIDC_REDIRECT_URL"`
+	OIDCRoleMapping                            string                        `env:"OIDC_ROLE_MAPPING"`
 	ORMMaxIdleConns                            int                           `env:"ORM_MAX_IDLE_CONNS" default:"10"`
 	ORMMaxOpenConns                            int                           `env:"ORM_MAX_OPEN_CONNS" default:"20"`
 	P2PAnnounceIP                              net.IP                        `env:"P2P_ANNOUNCE_IP"`
@@ -161,6 +201,7 @@ type ConfigSchema struct {
 	ReplayFromBlock                            int64                         `env:"REPLAY_FROM_BLOCK" default:"-1"`
 	RootDir                                    string                        `env:"ROOT" default:"~/.chainlink"`
 	SecureCookies                              bool                          `env:"SECURE_COOKIES" default:"true"`
+	SessionAbsoluteTimeout                     models.Duration               `env:"SESSION_ABSOLUTE_TIMEOUT" default:"0s"`
 	SessionTimeout                             models.Duration               `env:"SESSION_TIMEOUT" default:"15m"`
 	StatsPusherLogging                         string                        `env:"STATS_PUSHER_LOGGING" default:"false"`
 	TLSCertPath                                string                        `env:"TLS_CERT_PATH" `
@@ -168,9 +209,14 @@ type ConfigSchema struct {
 	TLSKeyPath                                 string                        `env:"TLS_KEY_PATH" `
 	TLSPort                                    uint16                        `env:"CHAINLINK_TLS_PORT" default:"6689"`
 	TLSRedirect                                bool                          `env:"CHAINLINK_TLS_REDIRECT" default:"false"`
+	TelemetryIngressBufferDir                  string                        `env:"TELEMETRY_INGRESS_BUFFER_DIR"`
+	TelemetryIngressEndpoints                  string                        `env:"TELEMETRY_INGRESS_ENDPOINTS"`
 	TelemetryIngressLogging                    bool                          `env:"TELEMETRY_INGRESS_LOGGING" default:"false"`
 	TelemetryIngressServerPubKey               string                        `env:"TELEMETRY_INGRESS_SERVER_PUB_KEY"`
 	TelemetryIngressURL                        *url.URL                      `env:"TELEMETRY_INGRESS_URL"`
+	TracingCollectorTarget                     string                        `env:"TRACING_COLLECTOR_TARGET"`
+	TracingEnabled                             bool                          `env:"TRACING_ENABLED" default:"false"`
+	TracingSamplingRatio                       float32                       `env:"TRACING_SAMPLING_RATIO" default:"1"`
 	TriggerFallbackDBPollInterval              time.Duration                 `env:"TRIGGER_FALLBACK_DB_POLL_INTERVAL" default:"30s"`
 	UnAuthenticatedRateLimit                   int64                         `env:"UNAUTHENTICATED_RATE_LIMIT" default:"5"`
 	UnAuthenticatedRateLimitPeriod             time.Duration                 `env:"UNAUTHENTICATED_RATE_LIMIT_PERIOD" default:"20s"`
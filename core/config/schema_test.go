@@ -12,6 +12,10 @@ func TestConfigSchema(t *testing.T) {
 	items := map[string]string{
 		"AdminCredentialsFile":                       "ADMIN_CREDENTIALS_FILE",
 		"AllowOrigins":                               "ALLOW_ORIGINS",
+		"AuditLogHTTPURL":                            "AUDIT_LOG_HTTP_URL",
+		"AuditLogS3Bucket":                           "AUDIT_LOG_S3_BUCKET",
+		"AuditLogS3Region":                           "AUDIT_LOG_S3_REGION",
+		"AuditLogSink":                               "AUDIT_LOG_SINK",
 		"AuthenticatedRateLimit":                     "AUTHENTICATED_RATE_LIMIT",
 		"AuthenticatedRateLimitPeriod":               "AUTHENTICATED_RATE_LIMIT_PERIOD",
 		"BalanceMonitorEnabled":                      "BALANCE_MONITOR_ENABLED",
@@ -25,14 +29,20 @@ func TestConfigSchema(t *testing.T) {
 		"BridgeResponseURL":                          "BRIDGE_RESPONSE_URL",
 		"ChainType":                                  "CHAIN_TYPE",
 		"ClientNodeURL":                              "CLIENT_NODE_URL",
+		"ConfigFile":                                 "CONFIG_FILE",
 		"DatabaseBackupDir":                          "DATABASE_BACKUP_DIR",
 		"DatabaseBackupFrequency":                    "DATABASE_BACKUP_FREQUENCY",
 		"DatabaseBackupMode":                         "DATABASE_BACKUP_MODE",
 		"DatabaseBackupURL":                          "DATABASE_BACKUP_URL",
+		"DatabaseDefaultBatchSize":                   "DATABASE_DEFAULT_BATCH_SIZE",
 		"DatabaseListenerMaxReconnectDuration":       "DATABASE_LISTENER_MAX_RECONNECT_DURATION",
 		"DatabaseListenerMinReconnectInterval":       "DATABASE_LISTENER_MIN_RECONNECT_INTERVAL",
 		"DatabaseLockingMode":                        "DATABASE_LOCKING_MODE",
+		"DatabaseMaintenanceWindow":                  "DATABASE_MAINTENANCE_WINDOW",
 		"DatabaseMaximumTxDuration":                  "DATABASE_MAXIMUM_TX_DURATION",
+		"DatabaseReadReplicaMaxLag":                  "DATABASE_READ_REPLICA_MAX_LAG",
+		"DatabaseReadReplicaURL":                     "DATABASE_READ_REPLICA_URL",
+		"DatabaseSlowQueryThreshold":                 "DATABASE_SLOW_QUERY_THRESHOLD",
 		"DatabaseTimeout":                            "DATABASE_TIMEOUT",
 		"DatabaseURL":                                "DATABASE_URL",
 		"DefaultChainID":                             "ETH_CHAIN_ID",
@@ -42,6 +52,8 @@ func TestConfigSchema(t *testing.T) {
 		"DefaultMaxHTTPAttempts":                     "MAX_HTTP_ATTEMPTS",
 		"Dev":                                        "CHAINLINK_DEV",
 		"EVMDisabled":                                "EVM_DISABLED",
+		"EthTxAttemptGCKeepCount":                    "ETH_TX_ATTEMPT_GC_KEEP_COUNT",
+		"EthTxReaperArchiveEnabled":                  "ETH_TX_REAPER_ARCHIVE_ENABLED",
 		"EthTxReaperInterval":                        "ETH_TX_REAPER_INTERVAL",
 		"EthTxReaperThreshold":                       "ETH_TX_REAPER_THRESHOLD",
 		"EthTxResendAfterThreshold":                  "ETH_TX_RESEND_AFTER_THRESHOLD",
@@ -54,6 +66,8 @@ func TestConfigSchema(t *testing.T) {
 		"EvmDefaultBatchSize":                        "ETH_DEFAULT_BATCH_SIZE",
 		"EvmEIP1559DynamicFees":                      "EVM_EIP1559_DYNAMIC_FEES",
 		"EvmFinalityDepth":                           "ETH_FINALITY_DEPTH",
+		"EvmFinalityTagEnabled":                      "ETH_FINALITY_TAG_ENABLED",
+		"EvmFundingTopUpMaxDailyWei":                 "ETH_FUNDING_TOP_UP_MAX_DAILY_WEI",
 		"EvmGasBumpPercent":                          "ETH_GAS_BUMP_PERCENT",
 		"EvmGasBumpThreshold":                        "ETH_GAS_BUMP_THRESHOLD",
 		"EvmGasBumpTxDepth":                          "ETH_GAS_BUMP_TX_DEPTH",
@@ -64,26 +78,42 @@ func TestConfigSchema(t *testing.T) {
 		"EvmGasPriceDefault":                         "ETH_GAS_PRICE_DEFAULT",
 		"EvmGasTipCapDefault":                        "EVM_GAS_TIP_CAP_DEFAULT",
 		"EvmGasTipCapMinimum":                        "EVM_GAS_TIP_CAP_MINIMUM",
+		"EvmHeadTrackerCrossValidationEnabled":       "ETH_HEAD_TRACKER_CROSS_VALIDATION_ENABLED",
 		"EvmHeadTrackerHistoryDepth":                 "ETH_HEAD_TRACKER_HISTORY_DEPTH",
+		"EvmHeadTrackerMaxBackfillDepth":             "ETH_HEAD_TRACKER_MAX_BACKFILL_DEPTH",
+		"EvmHeadTrackerMaxBatchSize":                 "ETH_HEAD_TRACKER_MAX_BATCH_SIZE",
 		"EvmHeadTrackerMaxBufferSize":                "ETH_HEAD_TRACKER_MAX_BUFFER_SIZE",
+		"EvmHeadTrackerMaxHeadLag":                   "ETH_HEAD_TRACKER_MAX_HEAD_LAG",
 		"EvmHeadTrackerSamplingInterval":             "ETH_HEAD_TRACKER_SAMPLING_INTERVAL",
+		"EvmKeyMinimumBalanceWei":                    "ETH_KEY_MINIMUM_BALANCE_WEI",
 		"EvmLogBackfillBatchSize":                    "ETH_LOG_BACKFILL_BATCH_SIZE",
 		"EvmMaxGasPriceWei":                          "ETH_MAX_GAS_PRICE_WEI",
 		"EvmMaxInFlightTransactions":                 "ETH_MAX_IN_FLIGHT_TRANSACTIONS",
 		"EvmMaxQueuedTransactions":                   "ETH_MAX_QUEUED_TRANSACTIONS",
 		"EvmMinGasPriceWei":                          "ETH_MIN_GAS_PRICE_WEI",
 		"EvmNonceAutoSync":                           "ETH_NONCE_AUTO_SYNC",
+		"EvmAutoCreateAccessList":                    "ETH_AUTO_CREATE_ACCESS_LIST",
+		"EvmNonceGapRepair":                          "ETH_NONCE_GAP_REPAIR",
+		"EvmPrivateRelayFallbackBlocks":              "ETH_PRIVATE_RELAY_FALLBACK_BLOCKS",
+		"EvmPrivateRelayURL":                         "ETH_PRIVATE_RELAY_URL",
 		"EvmRPCDefaultBatchSize":                     "ETH_RPC_DEFAULT_BATCH_SIZE",
 		"ExplorerAccessKey":                          "EXPLORER_ACCESS_KEY",
 		"ExplorerSecret":                             "EXPLORER_SECRET",
 		"ExplorerURL":                                "EXPLORER_URL",
+		"FMBatchFlushInterval":                       "FM_BATCH_FLUSH_INTERVAL",
+		"FMBatchSubmissions":                         "FM_BATCH_SUBMISSIONS",
 		"FMDefaultTransactionQueueDepth":             "FM_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"FMSimulateTransactions":                     "FM_SIMULATE_TRANSACTIONS",
 		"FeatureExternalInitiators":                  "FEATURE_EXTERNAL_INITIATORS",
 		"FeatureOffchainReporting":                   "FEATURE_OFFCHAIN_REPORTING",
 		"FeatureUICSAKeys":                           "FEATURE_UI_CSA_KEYS",
 		"FeatureUIFeedsManager":                      "FEATURE_UI_FEEDS_MANAGER",
+		"FeeHistoryEstimatorBaseFeeHeadroom":         "FEE_HISTORY_ESTIMATOR_BASE_FEE_HEADROOM",
+		"FeeHistoryEstimatorBlockHistorySize":        "FEE_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE",
+		"FeeHistoryEstimatorCapBehavior":             "FEE_HISTORY_ESTIMATOR_CAP_BEHAVIOR",
+		"FeeHistoryEstimatorRewardPercentile":        "FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE",
 		"FlagsContractAddress":                       "FLAGS_CONTRACT_ADDRESS",
+		"GasEstimatorExternalURL":                    "GAS_ESTIMATOR_EXTERNAL_URL",
 		"GasEstimatorMode":                           "GAS_ESTIMATOR_MODE",
 		"GasUpdaterBatchSize":                        "GAS_UPDATER_BATCH_SIZE",
 		"GasUpdaterBlockDelay":                       "GAS_UPDATER_BLOCK_DELAY",
@@ -99,6 +129,8 @@ func TestConfigSchema(t *testing.T) {
 		"JobPipelineReaperInterval":                  "JOB_PIPELINE_REAPER_INTERVAL",
 		"JobPipelineReaperThreshold":                 "JOB_PIPELINE_REAPER_THRESHOLD",
 		"JobPipelineResultWriteQueueDepth":           "JOB_PIPELINE_RESULT_WRITE_QUEUE_DEPTH",
+		"JobPipelineRunsArchiveURL":                  "JOB_PIPELINE_RUNS_ARCHIVE_URL",
+		"JobQuarantineFailureThreshold":              "JOB_QUARANTINE_FAILURE_THRESHOLD",
 		"KeeperDefaultTransactionQueueDepth":         "KEEPER_DEFAULT_TRANSACTION_QUEUE_DEPTH",
 		"KeeperGasPriceBufferPercent":                "KEEPER_GAS_PRICE_BUFFER_PERCENT",
 		"KeeperGasTipCapBufferPercent":               "KEEPER_GAS_TIP_CAP_BUFFER_PERCENT",
@@ -120,6 +152,7 @@ func TestConfigSchema(t *testing.T) {
 		"MinRequiredOutgoingConfirmations":           "MIN_OUTGOING_CONFIRMATIONS",
 		"MinimumContractPayment":                     "MINIMUM_CONTRACT_PAYMENT_LINK_JUELS",
 		"MinimumServiceDuration":                     "MINIMUM_SERVICE_DURATION",
+		"MulticallContractAddress":                   "MULTICALL_CONTRACT_ADDRESS",
 		"OCRBlockchainTimeout":                       "OCR_BLOCKCHAIN_TIMEOUT",
 		"OCRBootstrapCheckInterval":                  "OCR_BOOTSTRAP_CHECK_INTERVAL",
 		"OCRContractConfirmations":                   "OCR_CONTRACT_CONFIRMATIONS",
@@ -139,6 +172,13 @@ func TestConfigSchema(t *testing.T) {
 		"OCRSimulateTransactions":                    "OCR_SIMULATE_TRANSACTIONS",
 		"OCRTraceLogging":                            "OCR_TRACE_LOGGING",
 		"OCRTransmitterAddress":                      "OCR_TRANSMITTER_ADDRESS",
+		"OIDCAllowedDomains":                         "OIDC_ALLOWED_DOMAINS",
+		"OIDCClientID":                               "OIDC_CLIENT_ID",
+		"OIDCClientSecret":                           "OIDC_CLIENT_SECRET",
+		"OIDCDefaultRole":                            "OIDC_DEFAULT_ROLE",
+		"OIDCIssuer":                                 "OIDC_ISSUER",
+		"OIDCRedirectURL":                            "OIDC_REDIRECT_URL",
+		"OIDCRoleMapping":                            "OIDC_ROLE_MAPPING",
 		"ORMMaxIdleConns":                            "ORM_MAX_IDLE_CONNS",
 		"ORMMaxOpenConns":                            "ORM_MAX_OPEN_CONNS",
 		"OptimismGasFees":                            "OPTIMISM_GAS_FEES",
@@ -164,6 +204,7 @@ func TestConfigSchema(t *testing.T) {
 		"ReplayFromBlock":                            "REPLAY_FROM_BLOCK",
 		"RootDir":                                    "ROOT",
 		"SecureCookies":                              "SECURE_COOKIES",
+		"SessionAbsoluteTimeout":                     "SESSION_ABSOLUTE_TIMEOUT",
 		"SessionTimeout":                             "SESSION_TIMEOUT",
 		"StatsPusherLogging":                         "STATS_PUSHER_LOGGING",
 		"TLSCertPath":                                "TLS_CERT_PATH",
@@ -171,9 +212,14 @@ func TestConfigSchema(t *testing.T) {
 		"TLSKeyPath":                                 "TLS_KEY_PATH",
 		"TLSPort":                                    "CHAINLINK_TLS_PORT",
 		"TLSRedirect":                                "CHAINLINK_TLS_REDIRECT",
+		"TelemetryIngressBufferDir":                  "TELEMETRY_INGRESS_BUFFER_DIR",
+		"TelemetryIngressEndpoints":                  "TELEMETRY_INGRESS_ENDPOINTS",
 		"TelemetryIngressLogging":                    "TELEMETRY_INGRESS_LOGGING",
 		"TelemetryIngressServerPubKey":               "TELEMETRY_INGRESS_SERVER_PUB_KEY",
 		"TelemetryIngressURL":                        "TELEMETRY_INGRESS_URL",
+		"TracingCollectorTarget":                     "TRACING_COLLECTOR_TARGET",
+		"TracingEnabled":                             "TRACING_ENABLED",
+		"TracingSamplingRatio":                       "TRACING_SAMPLING_RATIO",
 		"TriggerFallbackDBPollInterval":              "TRIGGER_FALLBACK_DB_POLL_INTERVAL",
 		"UnAuthenticatedRateLimit":                   "UNAUTHENTICATED_RATE_LIMIT",
 		"UnAuthenticatedRateLimitPeriod":             "UNAUTHENTICATED_RATE_LIMIT_PERIOD",
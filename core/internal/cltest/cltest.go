@@ -13,6 +13,7 @@ import (
 	"net/http/httptest"
 	"net/url"
 	"reflect"
+	"strconv"
 	"testing"
 	"time"
 
@@ -357,7 +358,7 @@ func NewApplicationWithConfig(t testing.TB, cfg *configtest.TestGeneralConfig, f
 		default:
 			switch flag {
 			case UseRealExternalInitiatorManager:
-				externalInitiatorManager = webhook.NewExternalInitiatorManager(db, utils.UnrestrictedClient)
+				externalInitiatorManager = webhook.NewExternalInitiatorManager(db, utils.UnrestrictedClient, lggr)
 			}
 
 		}
@@ -778,6 +779,7 @@ func CreateJobRunViaExternalInitiatorV2(
 	app *TestApplication,
 	jobID uuid.UUID,
 	eia auth.Token,
+	signingKey string,
 	body string,
 ) webpresenters.PipelineRunResource {
 	t.Helper()
@@ -785,6 +787,13 @@ func CreateJobRunViaExternalInitiatorV2(
 	headers := make(map[string]string)
 	headers[static.ExternalInitiatorAccessKeyHeader] = eia.AccessKey
 	headers[static.ExternalInitiatorSecretHeader] = eia.Secret
+	if signingKey != "" {
+		timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+		nonce := uuid.NewV4().String()
+		headers[static.ExternalInitiatorTimestampHeader] = timestamp
+		headers[static.ExternalInitiatorNonceHeader] = nonce
+		headers[static.ExternalInitiatorSignatureHeader] = bridges.SignRequest(signingKey, timestamp, nonce, []byte(body))
+	}
 
 	url := app.Config.ClientNodeURL() + "/v2/jobs/" + jobID.String() + "/runs"
 	bodyBuf := bytes.NewBufferString(body)
@@ -307,6 +307,19 @@ func (c *SimulatedBackendClient) HeadByNumber(ctx context.Context, n *big.Int) (
 	}, nil
 }
 
+func (c *SimulatedBackendClient) HeadByTag(ctx context.Context, tag string) (*eth.Head, error) {
+	// the simulated backend has no consensus layer; every mined block is final
+	return c.HeadByNumber(ctx, nil)
+}
+
+func (c *SimulatedBackendClient) HeadByNumberAllNodes(ctx context.Context, n *big.Int) (map[string]*eth.Head, error) {
+	head, err := c.HeadByNumber(ctx, n)
+	if err != nil {
+		return nil, err
+	}
+	return map[string]*eth.Head{"simulated": head}, nil
+}
+
 func (c *SimulatedBackendClient) BlockByNumber(ctx context.Context, n *big.Int) (*types.Block, error) {
 	return c.b.BlockByNumber(ctx, n)
 }
@@ -455,6 +468,10 @@ func (c *SimulatedBackendClient) BatchCallContext(ctx context.Context, b []rpc.B
 	return nil
 }
 
+func (c *SimulatedBackendClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return c.BatchCallContext(ctx, b)
+}
+
 func (c *SimulatedBackendClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
 	return nil, nil
 }
@@ -126,6 +126,7 @@ func TestIntegration_ExternalInitiatorV2(t *testing.T) {
 
 	// Create the EI record on the Core node
 	var eia *auth.Token
+	var eiSigningKey string
 	{
 		eiCreate := map[string]string{
 			"name": eiName,
@@ -138,6 +139,7 @@ func TestIntegration_ExternalInitiatorV2(t *testing.T) {
 			AccessKey: eip.AccessKey,
 			Secret:    eip.Secret,
 		}
+		eiSigningKey = eip.SigningKey
 	}
 
 	// Create the bridge on the Core node
@@ -219,7 +221,7 @@ observationSource   = """
 		// Simulate request from EI -> Core node
 		cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
 
-		_ = cltest.CreateJobRunViaExternalInitiatorV2(t, app, jobUUID, *eia, cltest.MustJSONMarshal(t, eiRequest))
+		_ = cltest.CreateJobRunViaExternalInitiatorV2(t, app, jobUUID, *eia, eiSigningKey, cltest.MustJSONMarshal(t, eiRequest))
 
 		pipelineORM := pipeline.NewORM(app.GetSqlxDB(), logger.TestLogger(t))
 		jobORM := job.NewORM(app.GetSqlxDB(), app.GetChainSet(), pipelineORM, app.KeyStore, logger.TestLogger(t))
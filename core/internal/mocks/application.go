@@ -5,6 +5,8 @@ package mocks
 import (
 	big "math/big"
 
+	audit "github.com/smartcontractkit/chainlink/core/audit"
+
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 
@@ -66,6 +68,20 @@ func (_m *Application) AddJobV2(ctx context.Context, _a1 *job.Job) error {
 	return r0
 }
 
+// ArchiveJob provides a mock function with given fields: ctx, jobID
+func (_m *Application) ArchiveJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BPTXMORM provides a mock function with given fields:
 func (_m *Application) BPTXMORM() bulletprooftxmanager.ORM {
 	ret := _m.Called()
@@ -144,6 +160,22 @@ func (_m *Application) GetChainSet() evm.ChainSet {
 	return r0
 }
 
+// GetAuditLogger provides a mock function with given fields:
+func (_m *Application) GetAuditLogger() audit.AuditLogger {
+	ret := _m.Called()
+
+	var r0 audit.AuditLogger
+	if rf, ok := ret.Get(0).(func() audit.AuditLogger); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(audit.AuditLogger)
+		}
+	}
+
+	return r0
+}
+
 // GetConfig provides a mock function with given fields:
 func (_m *Application) GetConfig() config.GeneralConfig {
 	ret := _m.Called()
@@ -272,6 +304,22 @@ func (_m *Application) GetLogger() logger.Logger {
 	return r0
 }
 
+// GetReadReplicaDB provides a mock function with given fields:
+func (_m *Application) GetReadReplicaDB() *sqlx.DB {
+	ret := _m.Called()
+
+	var r0 *sqlx.DB
+	if rf, ok := ret.Get(0).(func() *sqlx.DB); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*sqlx.DB)
+		}
+	}
+
+	return r0
+}
+
 // GetSqlxDB provides a mock function with given fields:
 func (_m *Application) GetSqlxDB() *sqlx.DB {
 	ret := _m.Called()
@@ -429,20 +477,20 @@ func (_m *Application) RunJobV2(ctx context.Context, jobID int32, meta map[strin
 	return r0, r1
 }
 
-// RunWebhookJobV2 provides a mock function with given fields: ctx, jobUUID, requestBody, meta
-func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
-	ret := _m.Called(ctx, jobUUID, requestBody, meta)
+// RunWebhookJobV2 provides a mock function with given fields: ctx, jobUUID, requestBody, meta, callbackURL
+func (_m *Application) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable, callbackURL string) (int64, error) {
+	ret := _m.Called(ctx, jobUUID, requestBody, meta, callbackURL)
 
 	var r0 int64
-	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, pipeline.JSONSerializable) int64); ok {
-		r0 = rf(ctx, jobUUID, requestBody, meta)
+	if rf, ok := ret.Get(0).(func(context.Context, uuid.UUID, string, pipeline.JSONSerializable, string) int64); ok {
+		r0 = rf(ctx, jobUUID, requestBody, meta, callbackURL)
 	} else {
 		r0 = ret.Get(0).(int64)
 	}
 
 	var r1 error
-	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, pipeline.JSONSerializable) error); ok {
-		r1 = rf(ctx, jobUUID, requestBody, meta)
+	if rf, ok := ret.Get(1).(func(context.Context, uuid.UUID, string, pipeline.JSONSerializable, string) error); ok {
+		r1 = rf(ctx, jobUUID, requestBody, meta, callbackURL)
 	} else {
 		r1 = ret.Error(1)
 	}
@@ -71,6 +71,10 @@ type GeneralConfigOverrides struct {
 	GlobalEvmMaxGasPriceWei                   *big.Int
 	GlobalEvmMinGasPriceWei                   *big.Int
 	GlobalEvmNonceAutoSync                    null.Bool
+	GlobalEvmAutoCreateAccessList             null.Bool
+	GlobalEvmNonceGapRepair                   null.Bool
+	GlobalEvmPrivateRelayFallbackBlocks       null.Int
+	GlobalEvmPrivateRelayURL                  null.String
 	GlobalEvmRPCDefaultBatchSize              null.Int
 	GlobalFlagsContractAddress                null.String
 	GlobalGasEstimatorMode                    null.String
@@ -458,6 +462,34 @@ func (c *TestGeneralConfig) GlobalEvmNonceAutoSync() (bool, bool) {
 	}
 	return c.GeneralConfig.GlobalEvmNonceAutoSync()
 }
+
+func (c *TestGeneralConfig) GlobalEvmAutoCreateAccessList() (bool, bool) {
+	if c.Overrides.GlobalEvmAutoCreateAccessList.Valid {
+		return c.Overrides.GlobalEvmAutoCreateAccessList.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmAutoCreateAccessList()
+}
+
+func (c *TestGeneralConfig) GlobalEvmNonceGapRepair() (bool, bool) {
+	if c.Overrides.GlobalEvmNonceGapRepair.Valid {
+		return c.Overrides.GlobalEvmNonceGapRepair.Bool, true
+	}
+	return c.GeneralConfig.GlobalEvmNonceGapRepair()
+}
+
+func (c *TestGeneralConfig) GlobalEvmPrivateRelayFallbackBlocks() (uint32, bool) {
+	if c.Overrides.GlobalEvmPrivateRelayFallbackBlocks.Valid {
+		return uint32(c.Overrides.GlobalEvmPrivateRelayFallbackBlocks.Int64), true
+	}
+	return c.GeneralConfig.GlobalEvmPrivateRelayFallbackBlocks()
+}
+
+func (c *TestGeneralConfig) GlobalEvmPrivateRelayURL() (string, bool) {
+	if c.Overrides.GlobalEvmPrivateRelayURL.Valid {
+		return c.Overrides.GlobalEvmPrivateRelayURL.String, true
+	}
+	return c.GeneralConfig.GlobalEvmPrivateRelayURL()
+}
 func (c *TestGeneralConfig) GlobalBalanceMonitorEnabled() (bool, bool) {
 	if c.Overrides.GlobalBalanceMonitorEnabled.Valid {
 		return c.Overrides.GlobalBalanceMonitorEnabled.Bool, true
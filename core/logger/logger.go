@@ -92,7 +92,7 @@ type zapLogger struct {
 }
 
 func newZapLogger(cfg zap.Config) (Logger, error) {
-	zl, err := cfg.Build()
+	zl, err := cfg.Build(redactionOption)
 	if err != nil {
 		return nil, err
 	}
@@ -150,7 +150,7 @@ func (l *zapLogger) Named(name string) Logger {
 func (l *zapLogger) NewRootLogger(lvl zapcore.Level) (Logger, error) {
 	newLogger := *l
 	newLogger.config.Level = zap.NewAtomicLevelAt(lvl)
-	zl, err := newLogger.config.Build()
+	zl, err := newLogger.config.Build(redactionOption)
 	if err != nil {
 		return nil, err
 	}
@@ -11,6 +11,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
@@ -32,11 +33,20 @@ type (
 		service.Service
 	}
 
+	// BalanceMonitorConfig is the subset of the chain scoped config used by
+	// the balance monitor
+	BalanceMonitorConfig interface {
+		EvmFundingTopUpMaxDailyWei() *big.Int
+		EvmGasLimitTransfer() uint64
+		EvmKeyMinimumBalanceWei() *big.Int
+	}
+
 	balanceMonitor struct {
 		utils.StartStopOnce
 		logger         logger.Logger
 		db             *gorm.DB
 		ethClient      eth.Client
+		config         BalanceMonitorConfig
 		chainID        string
 		ethKeyStore    keystore.Eth
 		ethBalances    map[gethCommon.Address]*assets.Eth
@@ -48,12 +58,13 @@ type (
 )
 
 // NewBalanceMonitor returns a new balanceMonitor
-func NewBalanceMonitor(db *gorm.DB, ethClient eth.Client, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
+func NewBalanceMonitor(db *gorm.DB, ethClient eth.Client, config BalanceMonitorConfig, ethKeyStore keystore.Eth, logger logger.Logger) BalanceMonitor {
 	bm := &balanceMonitor{
 		utils.StartStopOnce{},
 		logger,
 		db,
 		ethClient,
+		config,
 		ethClient.ChainID().String(),
 		ethKeyStore,
 		make(map[gethCommon.Address]*assets.Eth),
@@ -126,6 +137,79 @@ func (bm *balanceMonitor) updateBalance(ethBal assets.Eth, address gethCommon.Ad
 	}
 }
 
+// checkTopUp sends ether from the funding key if the given key's balance has
+// dipped below the configured minimum
+func (bm *balanceMonitor) checkTopUp(address gethCommon.Address, bal *big.Int) {
+	minimum := bm.config.EvmKeyMinimumBalanceWei()
+	if minimum == nil || minimum.Sign() == 0 || bal.Cmp(minimum) >= 0 {
+		return
+	}
+	lgr := bm.logger.With(
+		"address", address.Hex(),
+		"weiBalance", bal.String(),
+		"minimumBalanceWei", minimum.String(),
+	)
+	fundingKeys, err := bm.ethKeyStore.FundingKeys()
+	if err != nil {
+		lgr.Errorw("BalanceMonitor: error getting funding keys", "err", err)
+		return
+	}
+	if len(fundingKeys) == 0 {
+		lgr.Errorw("BalanceMonitor: key is below its minimum balance but there is no funding key to top it up")
+		return
+	}
+	fundingAddress := fundingKeys[0].Address.Address()
+
+	// Skip if a previous top-up to this key is still waiting to confirm,
+	// otherwise we would send one on every head until it lands. Only count
+	// genuinely in-flight states; terminal states like fatal_error or expired
+	// must not block future top-ups.
+	var pending int64
+	err = bm.db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND to_address = ? AND state IN ('unstarted', 'in_progress', 'unconfirmed', 'confirmed_missing_receipt') AND evm_chain_id = ?`, fundingAddress, address, bm.chainID).Scan(&pending).Error
+	if err != nil {
+		lgr.Errorw("BalanceMonitor: error checking for pending top-ups", "err", err)
+		return
+	}
+	if pending > 0 {
+		lgr.Debugw("BalanceMonitor: skipping top-up, a previous top-up has not confirmed yet", "pendingTopUps", pending)
+		return
+	}
+
+	// Top up to twice the minimum so we do not immediately dip below it again
+	amount := new(big.Int).Sub(new(big.Int).Mul(minimum, big.NewInt(2)), bal)
+
+	dailyCap := bm.config.EvmFundingTopUpMaxDailyWei()
+	if dailyCap != nil && dailyCap.Sign() > 0 {
+		var spent utils.Big
+		err = bm.db.Raw(`SELECT COALESCE(SUM(value), 0) FROM eth_txes WHERE from_address = ? AND evm_chain_id = ? AND created_at > NOW() - interval '24 hours'`, fundingAddress, bm.chainID).Row().Scan(&spent)
+		if err != nil {
+			lgr.Errorw("BalanceMonitor: error checking funding key spend", "err", err)
+			return
+		}
+		if new(big.Int).Add(spent.ToInt(), amount).Cmp(dailyCap) > 0 {
+			lgr.Errorw("BalanceMonitor: key is below its minimum balance but the daily funding cap has been reached",
+				"fundingAddress", fundingAddress.Hex(),
+				"spentLast24HoursWei", spent.String(),
+				"topUpAmountWei", amount.String(),
+				"maxDailyWei", dailyCap.String(),
+			)
+			return
+		}
+	}
+
+	etx, err := bulletprooftxmanager.SendEther(bm.db, bm.ethClient.ChainID(), fundingAddress, address, assets.Eth(*amount), bm.config.EvmGasLimitTransfer())
+	if err != nil {
+		lgr.Errorw("BalanceMonitor: failed to send top-up transaction", "fundingAddress", fundingAddress.Hex(), "err", err)
+		return
+	}
+	promFundingTopUps.WithLabelValues(address.Hex(), bm.chainID).Inc()
+	lgr.Infow("BalanceMonitor: sent top-up transaction from funding key",
+		"fundingAddress", fundingAddress.Hex(),
+		"amountWei", amount.String(),
+		"ethTxID", etx.ID,
+	)
+}
+
 func (bm *balanceMonitor) GetEthBalance(address gethCommon.Address) *assets.Eth {
 	bm.ethBalancesMtx.RLock()
 	defer bm.ethBalancesMtx.RUnlock()
@@ -140,6 +224,14 @@ var promETHBalance = promauto.NewGaugeVec(
 	[]string{"account", "evmChainID"},
 )
 
+var promFundingTopUps = promauto.NewCounterVec(
+	prometheus.CounterOpts{
+		Name: "eth_balance_monitor_top_ups",
+		Help: "Number of top-up transactions sent from the funding key to underfunded keys",
+	},
+	[]string{"account", "evmChainID"},
+)
+
 func (bm *balanceMonitor) promUpdateEthBalance(balance *assets.Eth, from gethCommon.Address) {
 	balanceFloat, err := ApproximateFloat64(balance)
 
@@ -194,6 +286,7 @@ func (w *worker) checkAccountBalance(k ethkey.KeyV2) {
 	} else {
 		ethBal := assets.Eth(*bal)
 		w.bm.updateBalance(ethBal, k.Address.Address())
+		w.bm.checkTopUp(k.Address.Address(), bal)
 	}
 }
 
@@ -12,17 +12,34 @@ import (
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
 	"go.uber.org/atomic"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 )
 
 var nilBigInt *big.Int
 
+type balanceMonitorConfig struct {
+	keyMinimumBalanceWei    *big.Int
+	fundingTopUpMaxDailyWei *big.Int
+}
+
+func (c *balanceMonitorConfig) EvmKeyMinimumBalanceWei() *big.Int { return c.keyMinimumBalanceWei }
+func (c *balanceMonitorConfig) EvmFundingTopUpMaxDailyWei() *big.Int {
+	return c.fundingTopUpMaxDailyWei
+}
+func (c *balanceMonitorConfig) EvmGasLimitTransfer() uint64 { return 21000 }
+
+func newBalanceMonitorConfig() *balanceMonitorConfig {
+	return &balanceMonitorConfig{big.NewInt(0), big.NewInt(0)}
+}
+
 func TestBalanceMonitor_Start(t *testing.T) {
 	t.Run("updates balance from nil for multiple keys", func(t *testing.T) {
 		db := pgtest.NewGormDB(t)
@@ -34,7 +51,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := services.NewBalanceMonitor(db, ethClient, newBalanceMonitorConfig(), ethKeyStore, logger.TestLogger(t))
 		defer bm.Close()
 
 		k0bal := big.NewInt(42)
@@ -64,7 +81,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := services.NewBalanceMonitor(db, ethClient, newBalanceMonitorConfig(), ethKeyStore, logger.TestLogger(t))
 		defer bm.Close()
 		k0bal := big.NewInt(42)
 
@@ -86,7 +103,7 @@ func TestBalanceMonitor_Start(t *testing.T) {
 
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := services.NewBalanceMonitor(db, ethClient, newBalanceMonitorConfig(), ethKeyStore, logger.TestLogger(t))
 		defer bm.Close()
 
 		ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).
@@ -112,7 +129,7 @@ func TestBalanceMonitor_OnNewLongestChain_UpdatesBalance(t *testing.T) {
 		_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 		_, k1Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
 
-		bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, logger.TestLogger(t))
+		bm := services.NewBalanceMonitor(db, ethClient, newBalanceMonitorConfig(), ethKeyStore, logger.TestLogger(t))
 		k0bal := big.NewInt(42)
 		// Deliberately larger than a 64 bit unsigned integer to test overflow
 		k1bal := big.NewInt(0)
@@ -171,7 +188,7 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 
 	ethClient := NewEthClientMock(t)
 
-	bm := services.NewBalanceMonitor(db, ethClient, ethKeyStore, logger.TestLogger(t))
+	bm := services.NewBalanceMonitor(db, ethClient, newBalanceMonitorConfig(), ethKeyStore, logger.TestLogger(t))
 	ethClient.On("BalanceAt", mock.Anything, mock.Anything, mock.Anything).
 		Once().
 		Return(big.NewInt(1), nil)
@@ -212,6 +229,68 @@ func TestBalanceMonitor_FewerRPCCallsWhenBehind(t *testing.T) {
 	ethClient.AssertExpectations(t)
 }
 
+func TestBalanceMonitor_TopsUpUnderfundedKeys(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+
+	_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0, true)
+
+	ethClient := NewEthClientMock(t)
+	defer ethClient.AssertExpectations(t)
+
+	cfg := &balanceMonitorConfig{big.NewInt(100), big.NewInt(1000)}
+	bm := services.NewBalanceMonitor(db, ethClient, cfg, ethKeyStore, logger.TestLogger(t))
+	defer bm.Close()
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+
+	require.NoError(t, bm.Start())
+
+	gomega.NewGomegaWithT(t).Eventually(func() int64 {
+		var count int64
+		require.NoError(t, db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND to_address = ?`, fundingAddr, k0Addr).Scan(&count).Error)
+		return count
+	}).Should(gomega.Equal(int64(1)))
+
+	// Topped up to twice the minimum balance
+	var value string
+	require.NoError(t, db.Raw(`SELECT value::text FROM eth_txes WHERE from_address = ?`, fundingAddr).Row().Scan(&value))
+	assert.Equal(t, "158", value)
+}
+
+func TestBalanceMonitor_TopsUpDespiteTerminalPreviousTopUp(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+
+	_, k0Addr := cltest.MustInsertRandomKey(t, ethKeyStore, 0)
+	_, fundingAddr := cltest.MustInsertRandomKey(t, ethKeyStore, 0, true)
+
+	// A previous top-up in a terminal state must not block future top-ups
+	etx := cltest.NewEthTx(t, fundingAddr)
+	etx.ToAddress = k0Addr
+	etx.State = bulletprooftxmanager.EthTxFatalError
+	etx.Error = null.StringFrom("something exploded")
+	require.NoError(t, db.Save(&etx).Error)
+
+	ethClient := NewEthClientMock(t)
+	defer ethClient.AssertExpectations(t)
+
+	cfg := &balanceMonitorConfig{big.NewInt(100), big.NewInt(1000)}
+	bm := services.NewBalanceMonitor(db, ethClient, cfg, ethKeyStore, logger.TestLogger(t))
+	defer bm.Close()
+
+	ethClient.On("BalanceAt", mock.Anything, k0Addr, nilBigInt).Once().Return(big.NewInt(42), nil)
+
+	require.NoError(t, bm.Start())
+
+	gomega.NewGomegaWithT(t).Eventually(func() int64 {
+		var count int64
+		require.NoError(t, db.Raw(`SELECT count(*) FROM eth_txes WHERE from_address = ? AND to_address = ? AND state = 'unstarted'`, fundingAddr, k0Addr).Scan(&count).Error)
+		return count
+	}).Should(gomega.Equal(int64(1)))
+}
+
 func Test_ApproximateFloat64(t *testing.T) {
 	tests := []struct {
 		name      string
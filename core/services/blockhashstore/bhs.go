@@ -0,0 +1,131 @@
+package blockhashstore
+
+import (
+	"context"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// blockhashStoreABI covers the two methods of the BlockhashStore contract the
+// feeder needs; there is no generated wrapper for this contract.
+const blockhashStoreABI = `[
+	{"inputs":[{"internalType":"uint256","name":"n","type":"uint256"}],"name":"store","outputs":[],"stateMutability":"nonpayable","type":"function"},
+	{"inputs":[{"internalType":"uint256","name":"n","type":"uint256"}],"name":"getBlockhash","outputs":[{"internalType":"bytes32","name":"","type":"bytes32"}],"stateMutability":"view","type":"function"}
+]`
+
+var _ BHS = &BulletproofBHS{}
+
+type bpBHSConfig interface {
+	EvmGasLimitDefault() uint64
+}
+
+type bpBHSKeystore interface {
+	GetRoundRobinAddress(addresses ...common.Address) (common.Address, error)
+}
+
+type bpBHSClient interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, blockNumber *big.Int) ([]byte, error)
+}
+
+type transmitter interface {
+	CreateEthTransaction(newTx bulletprooftxmanager.NewTx, qopts ...postgres.QOpt) (etx bulletprooftxmanager.EthTx, err error)
+}
+
+// BulletproofBHS is an implementation of BHS that writes "store" transactions
+// through the node's transaction manager, so stores get the node's usual gas
+// bumping and retry handling.
+type BulletproofBHS struct {
+	config      bpBHSConfig
+	jobID       uuid.UUID
+	txm         transmitter
+	client      bpBHSClient
+	abi         abi.ABI
+	address     common.Address
+	gethks      bpBHSKeystore
+	sendingKeys []common.Address
+}
+
+// NewBulletproofBHS creates a new instance with the given transaction manager
+// and blockhash store address. If sendingKeys is empty, any of the node's
+// sending keys may be used.
+func NewBulletproofBHS(
+	config bpBHSConfig,
+	jobID uuid.UUID,
+	txm transmitter,
+	client bpBHSClient,
+	address common.Address,
+	gethks bpBHSKeystore,
+	sendingKeys []common.Address,
+) (*BulletproofBHS, error) {
+	bhsABI, err := abi.JSON(strings.NewReader(blockhashStoreABI))
+	if err != nil {
+		return nil, errors.Wrap(err, "building ABI")
+	}
+	return &BulletproofBHS{
+		config:      config,
+		jobID:       jobID,
+		txm:         txm,
+		client:      client,
+		abi:         bhsABI,
+		address:     address,
+		gethks:      gethks,
+		sendingKeys: sendingKeys,
+	}, nil
+}
+
+// Store satisfies the BHS interface.
+func (c *BulletproofBHS) Store(ctx context.Context, blockNum uint64) error {
+	payload, err := c.abi.Pack("store", new(big.Int).SetUint64(blockNum))
+	if err != nil {
+		return errors.Wrap(err, "packing args")
+	}
+
+	fromAddress, err := c.gethks.GetRoundRobinAddress(c.sendingKeys...)
+	if err != nil {
+		return errors.Wrap(err, "getting next from address")
+	}
+
+	_, err = c.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+		FromAddress:    fromAddress,
+		ToAddress:      c.address,
+		EncodedPayload: payload,
+		GasLimit:       c.config.EvmGasLimitDefault(),
+
+		// Set a queue size of 256. At most we store the blockhash of every
+		// block, and only the latest 256 blocks are eligible, so anything
+		// older than that is stale.
+		Strategy: bulletprooftxmanager.NewQueueingTxStrategy(c.jobID, 256, false),
+	})
+	if err != nil {
+		return errors.Wrap(err, "creating transaction")
+	}
+
+	return nil
+}
+
+// IsStored satisfies the BHS interface.
+func (c *BulletproofBHS) IsStored(ctx context.Context, blockNum uint64) (bool, error) {
+	payload, err := c.abi.Pack("getBlockhash", new(big.Int).SetUint64(blockNum))
+	if err != nil {
+		return false, errors.Wrap(err, "packing args")
+	}
+	_, err = c.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &c.address,
+		Data: payload,
+	}, nil)
+	if err != nil && strings.Contains(err.Error(), "blockhash not found in store") {
+		return false, nil
+	} else if err != nil {
+		return false, errors.Wrap(err, "getting blockhash")
+	}
+	return true, nil
+}
@@ -0,0 +1,95 @@
+package blockhashstore
+
+import (
+	"context"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// Coordinator defines an interface for fetching request and fulfillment
+// metadata of VRF requests from a coordinator contract.
+type Coordinator interface {
+	// Requests fetches VRF requests that occurred within the specified blocks.
+	Requests(ctx context.Context, fromBlock uint64, toBlock uint64) ([]Event, error)
+
+	// Fulfillments fetches VRF fulfillments that occurred since the specified
+	// block.
+	Fulfillments(ctx context.Context, fromBlock uint64) ([]Event, error)
+}
+
+// Event contains the essential metadata for a VRF request or fulfillment.
+type Event struct {
+	// ID of the relevant VRF request. For a VRF V1 request this will be the
+	// preseed, for a VRF V2 request it will be the request ID.
+	ID string
+
+	// Block that the request or fulfillment was included in.
+	Block uint64
+}
+
+// BHS defines an interface for interacting with a BlockhashStore contract.
+type BHS interface {
+	// Store the hash associated with blockNum.
+	Store(ctx context.Context, blockNum uint64) error
+
+	// IsStored checks whether the hash associated with blockNum is already
+	// stored.
+	IsStored(ctx context.Context, blockNum uint64) (bool, error)
+}
+
+// getUnfulfilledBlocksAndRequests returns unfulfilled request IDs grouped by
+// the block the request was made in. Fetch errors are logged rather than
+// returned; a partial result just means some hashes are stored a run later.
+func getUnfulfilledBlocksAndRequests(
+	ctx context.Context,
+	lggr logger.Logger,
+	coordinator Coordinator,
+	fromBlock, toBlock uint64,
+) map[uint64]map[string]struct{} {
+	blockToRequests := make(map[uint64]map[string]struct{})
+	requestIDToBlock := make(map[string]uint64)
+
+	reqs, err := coordinator.Requests(ctx, fromBlock, toBlock)
+	if err != nil {
+		lggr.Errorw("Failed to fetch VRF requests",
+			"error", err)
+	}
+	for _, req := range reqs {
+		if _, ok := blockToRequests[req.Block]; !ok {
+			blockToRequests[req.Block] = make(map[string]struct{})
+		}
+		blockToRequests[req.Block][req.ID] = struct{}{}
+		requestIDToBlock[req.ID] = req.Block
+	}
+
+	fuls, err := coordinator.Fulfillments(ctx, fromBlock)
+	if err != nil {
+		lggr.Errorw("Failed to fetch VRF fulfillments",
+			"error", err)
+	}
+	for _, ful := range fuls {
+		requestBlock, ok := requestIDToBlock[ful.ID]
+		if !ok {
+			continue
+		}
+		delete(blockToRequests[requestBlock], ful.ID)
+	}
+
+	return blockToRequests
+}
+
+// getSearchWindow returns the window of blocks to search for unfulfilled
+// requests, respecting the given waitBlocks and lookbackBlocks relative to the
+// latest block. If the chain is shorter than waitBlocks there is nothing to
+// search and (0, 0) is returned.
+func getSearchWindow(latestBlock, waitBlocks, lookbackBlocks int) (fromBlock uint64, toBlock uint64) {
+	to := latestBlock - waitBlocks
+	if to <= 0 {
+		return 0, 0
+	}
+	from := latestBlock - lookbackBlocks
+	if from < 0 {
+		from = 0
+	}
+	return uint64(from), uint64(to)
+}
@@ -0,0 +1,184 @@
+package blockhashstore
+
+import (
+	"context"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	v1 "github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/solidity_vrf_coordinator_interface"
+	v2 "github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ job.Delegate = (*Delegate)(nil)
+
+// Delegate creates BlockhashStore feeder jobs.
+type Delegate struct {
+	logger   logger.Logger
+	chainSet evm.ChainSet
+	ks       keystore.Eth
+}
+
+// NewDelegate creates a new Delegate.
+func NewDelegate(
+	logger logger.Logger,
+	chainSet evm.ChainSet,
+	ks keystore.Eth,
+) *Delegate {
+	return &Delegate{
+		logger:   logger,
+		chainSet: chainSet,
+		ks:       ks,
+	}
+}
+
+// JobType satisfies the job.Delegate interface.
+func (d *Delegate) JobType() job.Type {
+	return job.BlockhashStore
+}
+
+// AfterJobCreated satisfies the job.Delegate interface.
+func (d *Delegate) AfterJobCreated(spec job.Job) {}
+
+// BeforeJobDeleted satisfies the job.Delegate interface.
+func (d *Delegate) BeforeJobDeleted(spec job.Job) {}
+
+// ServicesForSpec satisfies the job.Delegate interface.
+func (d *Delegate) ServicesForSpec(jb job.Job) ([]job.Service, error) {
+	if jb.BlockhashStoreSpec == nil {
+		return nil, errors.Errorf("Delegate expects a *job.BlockhashStoreSpec to be present, got %v", jb)
+	}
+
+	chain, err := d.chainSet.Get(jb.BlockhashStoreSpec.EVMChainID.ToInt())
+	if err != nil {
+		return nil, errors.Wrapf(err, "getting chain ID %v", jb.BlockhashStoreSpec.EVMChainID)
+	}
+
+	if jb.BlockhashStoreSpec.WaitBlocks < int32(chain.Config().EvmFinalityDepth()) {
+		return nil, errors.Errorf(
+			"waitBlocks must be greater than or equal to chain's finality depth (%d), currently %d",
+			chain.Config().EvmFinalityDepth(), jb.BlockhashStoreSpec.WaitBlocks)
+	}
+
+	var coordinators []Coordinator
+	if jb.BlockhashStoreSpec.CoordinatorV1Address != nil {
+		var c *v1.VRFCoordinator
+		if c, err = v1.NewVRFCoordinator(
+			jb.BlockhashStoreSpec.CoordinatorV1Address.Address(), chain.Client()); err != nil {
+
+			return nil, errors.Wrap(err, "building V1 coordinator")
+		}
+		coordinators = append(coordinators, NewV1Coordinator(c))
+	}
+	if jb.BlockhashStoreSpec.CoordinatorV2Address != nil {
+		var c *v2.VRFCoordinatorV2
+		if c, err = v2.NewVRFCoordinatorV2(
+			jb.BlockhashStoreSpec.CoordinatorV2Address.Address(), chain.Client()); err != nil {
+
+			return nil, errors.Wrap(err, "building V2 coordinator")
+		}
+		coordinators = append(coordinators, NewV2Coordinator(c))
+	}
+
+	var sendingKeys []common.Address
+	if jb.BlockhashStoreSpec.FromAddress != nil {
+		sendingKeys = append(sendingKeys, jb.BlockhashStoreSpec.FromAddress.Address())
+	}
+	bhs, err := NewBulletproofBHS(
+		chain.Config(),
+		jb.ExternalJobID,
+		chain.TxManager(),
+		chain.Client(),
+		jb.BlockhashStoreSpec.BlockhashStoreAddress.Address(),
+		d.ks,
+		sendingKeys,
+	)
+	if err != nil {
+		return nil, errors.Wrap(err, "building BHS")
+	}
+
+	log := d.logger.Named("BlockhashStore").With(
+		"jobID", jb.ID,
+		"externalJobID", jb.ExternalJobID)
+	feeder := NewFeeder(
+		log,
+		NewMultiCoordinator(coordinators...),
+		bhs,
+		int(jb.BlockhashStoreSpec.WaitBlocks),
+		int(jb.BlockhashStoreSpec.LookbackBlocks),
+		func(ctx context.Context) (uint64, error) {
+			head, err := chain.Client().HeadByNumber(ctx, nil)
+			if err != nil {
+				return 0, err
+			}
+			return uint64(head.Number), nil
+		})
+
+	return []job.Service{&service{
+		feeder:     feeder,
+		pollPeriod: jb.BlockhashStoreSpec.PollPeriod,
+		runTimeout: jb.BlockhashStoreSpec.RunTimeout,
+		logger:     log,
+		done:       make(chan struct{}),
+	}}, nil
+}
+
+// service is a job.Service that runs the BHS feeder every pollPeriod.
+type service struct {
+	utils.StartStopOnce
+	feeder     *Feeder
+	done       chan struct{}
+	pollPeriod time.Duration
+	runTimeout time.Duration
+	logger     logger.Logger
+	parentCtx  context.Context
+	cancel     context.CancelFunc
+}
+
+// Start starts the BHS feeder, satisfying the job.Service interface.
+func (s *service) Start() error {
+	return s.StartOnce("BHSFeeder", func() error {
+		s.logger.Debug("Starting BHS feeder")
+		ticker := time.NewTicker(utils.WithJitter(s.pollPeriod))
+		s.parentCtx, s.cancel = context.WithCancel(context.Background())
+		go func() {
+			defer close(s.done)
+			defer ticker.Stop()
+			for {
+				select {
+				case <-ticker.C:
+					s.runFeeder()
+				case <-s.parentCtx.Done():
+					return
+				}
+			}
+		}()
+		return nil
+	})
+}
+
+// Close stops the BHS feeder, satisfying the job.Service interface.
+func (s *service) Close() error {
+	return s.StopOnce("BHSFeeder", func() error {
+		s.logger.Debug("Stopping BHS feeder")
+		s.cancel()
+		<-s.done
+		return nil
+	})
+}
+
+func (s *service) runFeeder() {
+	s.logger.Debug("Running BHS feeder")
+	ctx, cancel := context.WithTimeout(s.parentCtx, s.runTimeout)
+	defer cancel()
+	err := s.feeder.Run(ctx)
+	if err == nil {
+		s.logger.Debug("BHS feeder run completed successfully")
+	}
+}
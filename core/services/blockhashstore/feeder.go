@@ -0,0 +1,124 @@
+package blockhashstore
+
+import (
+	"context"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// NewFeeder creates a new Feeder instance.
+func NewFeeder(
+	lggr logger.Logger,
+	coordinator Coordinator,
+	bhs BHS,
+	waitBlocks int,
+	lookbackBlocks int,
+	latestBlock func(ctx context.Context) (uint64, error),
+) *Feeder {
+	return &Feeder{
+		lggr:           lggr,
+		coordinator:    coordinator,
+		bhs:            bhs,
+		waitBlocks:     waitBlocks,
+		lookbackBlocks: lookbackBlocks,
+		latestBlock:    latestBlock,
+		stored:         make(map[uint64]struct{}),
+	}
+}
+
+// Feeder checks recent VRF coordinator events and stores any blockhashes for
+// blocks within waitBlocks and lookbackBlocks that have unfulfilled requests.
+type Feeder struct {
+	lggr           logger.Logger
+	coordinator    Coordinator
+	bhs            BHS
+	waitBlocks     int
+	lookbackBlocks int
+	latestBlock    func(ctx context.Context) (uint64, error)
+
+	stored       map[uint64]struct{}
+	lastRunBlock uint64
+}
+
+// Run the feeder once: find unfulfilled requests old enough to need their
+// blockhash stored and store those hashes. Individual store failures are
+// logged and retried on the next run; only being unable to determine what to
+// store is a run error.
+func (f *Feeder) Run(ctx context.Context) error {
+	latestBlock, err := f.latestBlock(ctx)
+	if err != nil {
+		f.lggr.Errorw("Failed to fetch current block number", "error", err)
+		return errors.Wrap(err, "fetching block number")
+	}
+
+	fromBlock, toBlock := getSearchWindow(int(latestBlock), f.waitBlocks, f.lookbackBlocks)
+	if toBlock == 0 {
+		// Nothing to process, no blocks are in range.
+		return nil
+	}
+
+	lggr := f.lggr.With(
+		"latestBlock", latestBlock,
+		"fromBlock", fromBlock,
+		"toBlock", toBlock)
+	blockToRequests := getUnfulfilledBlocksAndRequests(ctx, lggr, f.coordinator, fromBlock, toBlock)
+	for block, unfulfilledReqs := range blockToRequests {
+		if len(unfulfilledReqs) == 0 {
+			continue
+		}
+		if _, ok := f.stored[block]; ok {
+			// Already stored
+			continue
+		}
+		stored, err := f.bhs.IsStored(ctx, block)
+		if err != nil {
+			lggr.Errorw("Failed to check if block is already stored, attempting to store anyway",
+				"error", err,
+				"block", block)
+		} else if stored {
+			lggr.Infow("Blockhash already stored",
+				"block", block,
+				"unfulfilledReqIDs", limitReqIDs(unfulfilledReqs))
+			f.stored[block] = struct{}{}
+			continue
+		}
+
+		// Block needs to be stored
+		err = f.bhs.Store(ctx, block)
+		if err != nil {
+			lggr.Errorw("Failed to store block",
+				"error", err,
+				"block", block)
+			continue
+		}
+
+		lggr.Infow("Stored blockhash",
+			"block", block,
+			"unfulfilledReqIDs", limitReqIDs(unfulfilledReqs))
+		f.stored[block] = struct{}{}
+	}
+
+	if f.lastRunBlock != 0 {
+		// Prune stored, anything older than fromBlock can be discarded
+		for block := f.lastRunBlock - uint64(f.lookbackBlocks); block < fromBlock; block++ {
+			delete(f.stored, block)
+		}
+	}
+	f.lastRunBlock = latestBlock
+	return nil
+}
+
+// limitReqIDs converts a set of request IDs to a slice limited to 50 IDs max,
+// to avoid unbounded log line lengths.
+func limitReqIDs(reqs map[string]struct{}) []string {
+	var reqIDs []string
+	for id := range reqs {
+		reqIDs = append(reqIDs, id)
+		if len(reqIDs) >= 50 {
+			break
+		}
+	}
+	return reqIDs
+}
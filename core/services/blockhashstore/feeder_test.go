@@ -0,0 +1,228 @@
+package blockhashstore_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+)
+
+type testCoordinator struct {
+	requests     []blockhashstore.Event
+	fulfillments []blockhashstore.Event
+
+	requestsErr     error
+	fulfillmentsErr error
+}
+
+func (t *testCoordinator) Requests(_ context.Context, fromBlock uint64, toBlock uint64) ([]blockhashstore.Event, error) {
+	if t.requestsErr != nil {
+		return nil, t.requestsErr
+	}
+	var result []blockhashstore.Event
+	for _, req := range t.requests {
+		if req.Block >= fromBlock && req.Block <= toBlock {
+			result = append(result, req)
+		}
+	}
+	return result, nil
+}
+
+func (t *testCoordinator) Fulfillments(_ context.Context, fromBlock uint64) ([]blockhashstore.Event, error) {
+	if t.fulfillmentsErr != nil {
+		return nil, t.fulfillmentsErr
+	}
+	var result []blockhashstore.Event
+	for _, ful := range t.fulfillments {
+		if ful.Block >= fromBlock {
+			result = append(result, ful)
+		}
+	}
+	return result, nil
+}
+
+type testBHS struct {
+	stored []uint64
+
+	storeErr    error
+	isStoredErr error
+}
+
+func (t *testBHS) Store(_ context.Context, blockNum uint64) error {
+	if t.storeErr != nil {
+		return t.storeErr
+	}
+	t.stored = append(t.stored, blockNum)
+	return nil
+}
+
+func (t *testBHS) IsStored(_ context.Context, blockNum uint64) (bool, error) {
+	if t.isStoredErr != nil {
+		return false, t.isStoredErr
+	}
+	for _, stored := range t.stored {
+		if stored == blockNum {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+func TestFeeder(t *testing.T) {
+	tests := []struct {
+		name         string
+		requests     []blockhashstore.Event
+		fulfillments []blockhashstore.Event
+		wait         int
+		lookback     int
+		latest       uint64
+
+		expectedStored []uint64
+	}{
+		{
+			name:           "single unfulfilled request",
+			requests:       []blockhashstore.Event{{Block: 150, ID: "request"}},
+			wait:           25,
+			lookback:       100,
+			latest:         200,
+			expectedStored: []uint64{150},
+		},
+		{
+			name:           "single fulfilled request",
+			requests:       []blockhashstore.Event{{Block: 150, ID: "request"}},
+			fulfillments:   []blockhashstore.Event{{Block: 155, ID: "request"}},
+			wait:           25,
+			lookback:       100,
+			latest:         200,
+			expectedStored: nil,
+		},
+		{
+			name: "mixed fulfilled and unfulfilled",
+			requests: []blockhashstore.Event{
+				{Block: 150, ID: "request1"},
+				{Block: 150, ID: "request2"},
+				{Block: 160, ID: "request3"},
+			},
+			fulfillments:   []blockhashstore.Event{{Block: 155, ID: "request1"}},
+			wait:           25,
+			lookback:       100,
+			latest:         200,
+			expectedStored: []uint64{150, 160},
+		},
+		{
+			name:           "request too recent",
+			requests:       []blockhashstore.Event{{Block: 185, ID: "request"}},
+			wait:           25,
+			lookback:       100,
+			latest:         200,
+			expectedStored: nil,
+		},
+		{
+			name:           "request before lookback",
+			requests:       []blockhashstore.Event{{Block: 99, ID: "request"}},
+			wait:           25,
+			lookback:       100,
+			latest:         200,
+			expectedStored: nil,
+		},
+		{
+			name:           "nothing eligible on a young chain",
+			requests:       []blockhashstore.Event{{Block: 10, ID: "request"}},
+			wait:           25,
+			lookback:       100,
+			latest:         20,
+			expectedStored: nil,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			coordinator := &testCoordinator{
+				requests:     test.requests,
+				fulfillments: test.fulfillments,
+			}
+			bhs := &testBHS{}
+			feeder := blockhashstore.NewFeeder(
+				logger.TestLogger(t),
+				coordinator,
+				bhs,
+				test.wait,
+				test.lookback,
+				func(ctx context.Context) (uint64, error) {
+					return test.latest, nil
+				})
+
+			err := feeder.Run(context.Background())
+			require.NoError(t, err)
+
+			assert.ElementsMatch(t, test.expectedStored, bhs.stored)
+		})
+	}
+}
+
+func TestFeeder_CachesStoredBlocks(t *testing.T) {
+	coordinator := &testCoordinator{
+		requests: []blockhashstore.Event{{Block: 100, ID: "request"}},
+	}
+	bhs := &testBHS{}
+	feeder := blockhashstore.NewFeeder(
+		logger.TestLogger(t),
+		coordinator,
+		bhs,
+		100,
+		200,
+		func(ctx context.Context) (uint64, error) {
+			return 250, nil
+		})
+
+	// Running twice should only store the block once
+	require.NoError(t, feeder.Run(context.Background()))
+	require.NoError(t, feeder.Run(context.Background()))
+	require.Equal(t, []uint64{100}, bhs.stored)
+}
+
+func TestFeeder_Errors(t *testing.T) {
+	t.Run("latest block fails", func(t *testing.T) {
+		feeder := blockhashstore.NewFeeder(
+			logger.TestLogger(t),
+			&testCoordinator{},
+			&testBHS{},
+			25,
+			100,
+			func(ctx context.Context) (uint64, error) {
+				return 0, errors.New("block not found")
+			})
+
+		err := feeder.Run(context.Background())
+		require.Error(t, err)
+	})
+
+	t.Run("store fails and is retried next run", func(t *testing.T) {
+		coordinator := &testCoordinator{
+			requests: []blockhashstore.Event{{Block: 150, ID: "request"}},
+		}
+		bhs := &testBHS{storeErr: errors.New("insufficient funds")}
+		feeder := blockhashstore.NewFeeder(
+			logger.TestLogger(t),
+			coordinator,
+			bhs,
+			25,
+			100,
+			func(ctx context.Context) (uint64, error) {
+				return 200, nil
+			})
+
+		// Store errors do not fail the run; the block is retried next run.
+		require.NoError(t, feeder.Run(context.Background()))
+		require.Empty(t, bhs.stored)
+
+		bhs.storeErr = nil
+		require.NoError(t, feeder.Run(context.Background()))
+		require.Equal(t, []uint64{150}, bhs.stored)
+	})
+}
@@ -0,0 +1,67 @@
+package blockhashstore
+
+import (
+	"time"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// ValidatedSpec validates and converts the given toml string to a job.Job.
+func ValidatedSpec(tomlString string) (job.Job, error) {
+	jb := job.Job{
+		// Default to generating a UUID, can be overwritten by the specified one in tomlString.
+		ExternalJobID: uuid.NewV4(),
+	}
+
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+
+	var spec job.BlockhashStoreSpec
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on job")
+	}
+
+	jb.BlockhashStoreSpec = &spec
+	if jb.Type != job.BlockhashStore {
+		return jb, errors.Errorf("unsupported type %s", jb.Type)
+	}
+
+	if jb.BlockhashStoreSpec.CoordinatorV1Address == nil && jb.BlockhashStoreSpec.CoordinatorV2Address == nil {
+		return jb, errors.New(`at least one of "coordinatorV1Address" and "coordinatorV2Address" must be set`)
+	}
+	if jb.BlockhashStoreSpec.WaitBlocks == 0 {
+		jb.BlockhashStoreSpec.WaitBlocks = 100
+	}
+	if jb.BlockhashStoreSpec.LookbackBlocks == 0 {
+		jb.BlockhashStoreSpec.LookbackBlocks = 200
+	}
+	if jb.BlockhashStoreSpec.WaitBlocks >= 256 {
+		return jb, errors.New(`"waitBlocks" must be less than 256`)
+	}
+	if jb.BlockhashStoreSpec.LookbackBlocks >= 256 {
+		return jb, errors.New(`"lookbackBlocks" must be less than 256`)
+	}
+	if jb.BlockhashStoreSpec.WaitBlocks >= jb.BlockhashStoreSpec.LookbackBlocks {
+		return jb, errors.New(`"waitBlocks" must be less than "lookbackBlocks"`)
+	}
+	if jb.BlockhashStoreSpec.PollPeriod == 0 {
+		jb.BlockhashStoreSpec.PollPeriod = 30 * time.Second
+	}
+	if jb.BlockhashStoreSpec.RunTimeout == 0 {
+		jb.BlockhashStoreSpec.RunTimeout = 30 * time.Second
+	}
+
+	return jb, nil
+}
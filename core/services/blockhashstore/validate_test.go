@@ -0,0 +1,145 @@
+package blockhashstore_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+func TestValidate(t *testing.T) {
+	v1Coordinator := "0x1F72B4A5DCf7CC6d2E38423bF2f4BFA7db97d139"
+	v2Coordinator := "0x2be990eE17832b59E0086534c5ea2459Aa75E38F"
+	fromAddress := "0x469aA2CD13e037DC5236320783dCfd0e641c0559"
+
+	var tests = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, os job.Job, err error)
+	}{
+		{
+			name: "valid",
+			toml: `
+type = "blockhashstore"
+name = "valid test"
+coordinatorV1Address = "` + v1Coordinator + `"
+coordinatorV2Address = "` + v2Coordinator + `"
+waitBlocks = 59
+lookbackBlocks = 159
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"
+pollPeriod = "23s"
+runTimeout = "7s"
+evmChainID = "4"
+fromAddress = "` + fromAddress + `"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, os.BlockhashStoreSpec)
+				assert.Equal(t, v1Coordinator,
+					os.BlockhashStoreSpec.CoordinatorV1Address.String())
+				assert.Equal(t, v2Coordinator,
+					os.BlockhashStoreSpec.CoordinatorV2Address.String())
+				assert.Equal(t, int32(59), os.BlockhashStoreSpec.WaitBlocks)
+				assert.Equal(t, int32(159), os.BlockhashStoreSpec.LookbackBlocks)
+				assert.Equal(t, "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17",
+					os.BlockhashStoreSpec.BlockhashStoreAddress.String())
+				assert.Equal(t, 23*time.Second, os.BlockhashStoreSpec.PollPeriod)
+				assert.Equal(t, 7*time.Second, os.BlockhashStoreSpec.RunTimeout)
+				assert.Equal(t, "4", os.BlockhashStoreSpec.EVMChainID.String())
+				assert.Equal(t, fromAddress,
+					os.BlockhashStoreSpec.FromAddress.String())
+			},
+		},
+		{
+			name: "defaults",
+			toml: `
+type = "blockhashstore"
+name = "defaults test"
+coordinatorV2Address = "` + v2Coordinator + `"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, os.BlockhashStoreSpec)
+				assert.Nil(t, os.BlockhashStoreSpec.CoordinatorV1Address)
+				assert.Equal(t, int32(100), os.BlockhashStoreSpec.WaitBlocks)
+				assert.Equal(t, int32(200), os.BlockhashStoreSpec.LookbackBlocks)
+				assert.Equal(t, 30*time.Second, os.BlockhashStoreSpec.PollPeriod)
+				assert.Equal(t, 30*time.Second, os.BlockhashStoreSpec.RunTimeout)
+				assert.Nil(t, os.BlockhashStoreSpec.FromAddress)
+			},
+		},
+		{
+			name: "no coordinators",
+			toml: `
+type = "blockhashstore"
+name = "no coordinators test"
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `at least one of "coordinatorV1Address" and "coordinatorV2Address" must be set`)
+			},
+		},
+		{
+			name: "wait blocks too high",
+			toml: `
+type = "blockhashstore"
+name = "wait blocks too high test"
+coordinatorV2Address = "` + v2Coordinator + `"
+waitBlocks = 256
+lookbackBlocks = 200
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `"waitBlocks" must be less than 256`)
+			},
+		},
+		{
+			name: "lookback blocks too high",
+			toml: `
+type = "blockhashstore"
+name = "lookback blocks too high test"
+coordinatorV2Address = "` + v2Coordinator + `"
+waitBlocks = 100
+lookbackBlocks = 256
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `"lookbackBlocks" must be less than 256`)
+			},
+		},
+		{
+			name: "wait blocks not less than lookback blocks",
+			toml: `
+type = "blockhashstore"
+name = "wait blocks not less than lookback blocks test"
+coordinatorV2Address = "` + v2Coordinator + `"
+waitBlocks = 200
+lookbackBlocks = 200
+blockhashStoreAddress = "0x3e20Cef636EdA7ba135bCbA4fe6177Bd3cE0aB17"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), `"waitBlocks" must be less than "lookbackBlocks"`)
+			},
+		},
+		{
+			name: "wrong job type",
+			toml: `
+type = "cron"
+name = "wrong job type test"`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "unsupported type")
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := blockhashstore.ValidatedSpec(tc.toml)
+			tc.assertion(t, s, err)
+		})
+	}
+}
@@ -17,22 +17,30 @@ func (c *ChainKeyStore) NewDynamicFeeAttempt(etx EthTx, fee gas.DynamicFee, gasL
 		return attempt, errors.Wrap(err, "error validating gas")
 	}
 
-	var al types.AccessList
-	if etx.AccessList.Valid {
-		al = etx.AccessList.AccessList
-	}
-	d := newDynamicFeeTransaction(
-		uint64(*etx.Nonce),
-		etx.ToAddress,
-		etx.Value.ToInt(),
-		gasLimit,
-		&c.chainID,
-		fee.TipCap,
-		fee.FeeCap,
-		etx.EncodedPayload,
-		al,
-	)
-	tx := types.NewTx(&d)
+	var tx *types.Transaction
+	if encoder := txEncoderFor(c.config.ChainType()); encoder != nil {
+		tx, err = encoder.NewDynamicFeeTx(&c.chainID, etx, fee, gasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "chain-specific tx encoder failed")
+		}
+	} else {
+		var al types.AccessList
+		if etx.AccessList.Valid {
+			al = etx.AccessList.AccessList
+		}
+		d := newDynamicFeeTransaction(
+			uint64(*etx.Nonce),
+			etx.ToAddress,
+			etx.Value.ToInt(),
+			gasLimit,
+			&c.chainID,
+			fee.TipCap,
+			fee.FeeCap,
+			etx.EncodedPayload,
+			al,
+		)
+		tx = types.NewTx(&d)
+	}
 	attempt, err = c.newSignedAttempt(etx, tx)
 	if err != nil {
 		return attempt, err
@@ -102,16 +110,23 @@ func (c *ChainKeyStore) NewLegacyAttempt(etx EthTx, gasPrice *big.Int, gasLimit
 		return attempt, errors.Wrap(err, "error validating gas")
 	}
 
-	tx := newLegacyTransaction(
-		uint64(*etx.Nonce),
-		etx.ToAddress,
-		etx.Value.ToInt(),
-		gasLimit,
-		gasPrice,
-		etx.EncodedPayload,
-	)
-
-	transaction := types.NewTx(&tx)
+	var transaction *types.Transaction
+	if encoder := txEncoderFor(c.config.ChainType()); encoder != nil {
+		transaction, err = encoder.NewLegacyTx(&c.chainID, etx, gasPrice, gasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "chain-specific tx encoder failed")
+		}
+	} else {
+		tx := newLegacyTransaction(
+			uint64(*etx.Nonce),
+			etx.ToAddress,
+			etx.Value.ToInt(),
+			gasLimit,
+			gasPrice,
+			etx.EncodedPayload,
+		)
+		transaction = types.NewTx(&tx)
+	}
 	hash, signedTxBytes, err := c.SignTx(etx.FromAddress, transaction)
 	if err != nil {
 		return attempt, errors.Wrapf(err, "error using account %s to sign transaction %v", etx.FromAddress.String(), etx.ID)
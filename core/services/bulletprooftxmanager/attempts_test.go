@@ -9,8 +9,10 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/chains"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/configtest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/evmtest"
@@ -113,3 +115,52 @@ func TestBulletproofTxManager_NewLegacyAttempt(t *testing.T) {
 		assert.Contains(t, err.Error(), fmt.Sprintf("specified gas price of 100 would exceed max configured gas price of 50 for key %s", addr.Hex()))
 	})
 }
+
+// markerTxEncoder builds transactions with a recognisable gas limit so tests
+// can tell them apart from the standard ones
+type markerTxEncoder struct{}
+
+const markerGasLimit uint64 = 777
+
+func (markerTxEncoder) NewLegacyTx(chainID *big.Int, etx bulletprooftxmanager.EthTx, gasPrice *big.Int, gasLimit uint64) (*types.Transaction, error) {
+	return types.NewTx(&types.LegacyTx{Nonce: uint64(*etx.Nonce), To: &etx.ToAddress, Gas: markerGasLimit, GasPrice: gasPrice, Data: etx.EncodedPayload}), nil
+}
+
+func (markerTxEncoder) NewDynamicFeeTx(chainID *big.Int, etx bulletprooftxmanager.EthTx, fee gas.DynamicFee, gasLimit uint64) (*types.Transaction, error) {
+	return types.NewTx(&types.DynamicFeeTx{ChainID: chainID, Nonce: uint64(*etx.Nonce), To: &etx.ToAddress, Gas: markerGasLimit, GasTipCap: fee.TipCap, GasFeeCap: fee.FeeCap, Data: etx.EncodedPayload}), nil
+}
+
+func TestBulletproofTxManager_ChainSpecificTxEncoder(t *testing.T) {
+	bulletprooftxmanager.RegisterTxEncoder(chains.Celo, markerTxEncoder{})
+
+	t.Run("panics if the chain type already has an encoder", func(t *testing.T) {
+		assert.Panics(t, func() {
+			bulletprooftxmanager.RegisterTxEncoder(chains.Celo, markerTxEncoder{})
+		})
+	})
+
+	addr := cltest.NewAddress()
+	gcfg := cltest.NewTestGeneralConfig(t)
+	gcfg.Overrides.GlobalChainType = null.StringFrom(string(chains.Celo))
+	cfg := evmtest.NewChainScopedConfig(t, gcfg)
+	kst := new(ksmocks.Eth)
+	kst.Test(t)
+	signed := types.NewTx(&types.LegacyTx{})
+	kst.On("SignTx", addr, mock.MatchedBy(func(tx *types.Transaction) bool {
+		return tx.Gas() == markerGasLimit
+	}), big.NewInt(1)).Return(signed, nil)
+	cks := bulletprooftxmanager.NewChainKeyStore(*big.NewInt(1), cfg, kst)
+	var n int64
+
+	t.Run("uses the registered encoder for legacy attempts", func(t *testing.T) {
+		_, err := cks.NewLegacyAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr}, assets.GWei(25), 100)
+		require.NoError(t, err)
+	})
+
+	t.Run("uses the registered encoder for dynamic fee attempts", func(t *testing.T) {
+		_, err := cks.NewDynamicFeeAttempt(bulletprooftxmanager.EthTx{Nonce: &n, FromAddress: addr}, gas.DynamicFee{TipCap: assets.GWei(100), FeeCap: assets.GWei(200)}, 100)
+		require.NoError(t, err)
+	})
+
+	kst.AssertExpectations(t)
+}
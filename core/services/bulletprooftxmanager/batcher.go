@@ -0,0 +1,185 @@
+package bulletprooftxmanager
+
+import (
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// MulticallABI is the subset of the Multicall contract ABI needed to
+// aggregate batched calls.
+var MulticallABI = eth.MustGetABI(`[{"inputs":[{"components":[{"internalType":"address","name":"target","type":"address"},{"internalType":"bytes","name":"callData","type":"bytes"}],"internalType":"struct Multicall.Call[]","name":"calls","type":"tuple[]"}],"name":"aggregate","outputs":[{"internalType":"uint256","name":"blockNumber","type":"uint256"},{"internalType":"bytes[]","name":"returnData","type":"bytes[]"}],"stateMutability":"nonpayable","type":"function"}]`)
+
+// batchGasOverhead is added on top of the summed gas limits of the batched
+// calls to cover the Multicall contract's own dispatch cost
+const batchGasOverhead uint64 = 50000
+
+// BatchedCall is a single call queued for inclusion in a Multicall
+// transaction
+type BatchedCall struct {
+	FromAddress    common.Address
+	ToAddress      common.Address
+	EncodedPayload []byte
+	GasLimit       uint64
+}
+
+// Batcher aggregates compatible outgoing calls into a single Multicall
+// transaction per key per interval. Job types which emit many small calls
+// (e.g. keeper upkeeps or feed submissions) can queue their calls here
+// instead of creating one eth_tx each, cutting gas overhead and nonce
+// pressure.
+//
+// Batching is opt-in and configured per job type; each job type that wants it
+// constructs its own Batcher with its own interval and Multicall contract
+// address. Calls routed through a Batcher must tolerate being executed from
+// the Multicall contract rather than directly from the sending key.
+type Batcher struct {
+	txm              TxManager
+	multicallAddress common.Address
+	interval         time.Duration
+	strategy         TxStrategy
+	logger           logger.Logger
+
+	mu      sync.Mutex
+	pending map[common.Address][]BatchedCall
+
+	// A single Batcher is shared by every job routing calls through it, so
+	// Start and Close are reference counted: the flush loop runs while at
+	// least one job holds the Batcher open
+	refs   int
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+// NewBatcher returns a new Batcher which submits batches through the given
+// transaction manager
+func NewBatcher(lggr logger.Logger, txm TxManager, multicallAddress common.Address, interval time.Duration, strategy TxStrategy) *Batcher {
+	if interval == 0 {
+		panic("Batcher requires a non-zero interval")
+	}
+	return &Batcher{
+		txm:              txm,
+		multicallAddress: multicallAddress,
+		interval:         interval,
+		strategy:         strategy,
+		logger:           lggr.Named("Batcher"),
+		pending:          make(map[common.Address][]BatchedCall),
+	}
+}
+
+// Start opens a reference to the Batcher, starting the flush loop on the
+// first one. It never errors; the signature satisfies job.Service so that a
+// shared Batcher can be returned from ServicesForSpec by every job using it.
+func (b *Batcher) Start() error {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.refs++
+	if b.refs == 1 {
+		b.logger.Debugf("Enabled with flush interval of %s and Multicall contract %s", b.interval, b.multicallAddress.Hex())
+		b.chStop = make(chan struct{})
+		b.chDone = make(chan struct{})
+		go b.runLoop(b.chStop, b.chDone)
+	}
+	return nil
+}
+
+// Close releases a reference to the Batcher, stopping the flush loop (after
+// a final flush) when the last one is released
+func (b *Batcher) Close() error {
+	b.mu.Lock()
+	if b.refs == 0 {
+		b.mu.Unlock()
+		return errors.New("Batcher is not started")
+	}
+	b.refs--
+	last := b.refs == 0
+	chStop, chDone := b.chStop, b.chDone
+	b.mu.Unlock()
+
+	if last {
+		close(chStop)
+		<-chDone
+	}
+	return nil
+}
+
+// QueueCall adds a call to the next batch for its from address
+func (b *Batcher) QueueCall(call BatchedCall) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.pending[call.FromAddress] = append(b.pending[call.FromAddress], call)
+}
+
+func (b *Batcher) runLoop(chStop, chDone chan struct{}) {
+	defer close(chDone)
+
+	ticker := time.NewTicker(utils.WithJitter(b.interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			b.flush()
+		case <-chStop:
+			// Flush any stragglers before shutting down
+			b.flush()
+			return
+		}
+	}
+}
+
+// flush sends one Multicall transaction per key for all queued calls. Calls
+// which could not be sent are requeued for the next interval.
+func (b *Batcher) flush() {
+	b.mu.Lock()
+	pending := b.pending
+	b.pending = make(map[common.Address][]BatchedCall)
+	b.mu.Unlock()
+
+	for from, calls := range pending {
+		if err := b.sendBatch(from, calls); err != nil {
+			b.logger.Errorw("Failed to send batch, requeueing calls", "fromAddress", from, "nCalls", len(calls), "err", err)
+			b.mu.Lock()
+			b.pending[from] = append(calls, b.pending[from]...)
+			b.mu.Unlock()
+		}
+	}
+}
+
+func (b *Batcher) sendBatch(from common.Address, calls []BatchedCall) error {
+	type multicallCall struct {
+		Target   common.Address
+		CallData []byte
+	}
+
+	mcalls := make([]multicallCall, len(calls))
+	gasLimit := batchGasOverhead
+	for i, call := range calls {
+		mcalls[i] = multicallCall{Target: call.ToAddress, CallData: call.EncodedPayload}
+		gasLimit += call.GasLimit
+	}
+
+	payload, err := MulticallABI.Pack("aggregate", mcalls)
+	if err != nil {
+		return errors.Wrap(err, "Batcher: failed to pack calls")
+	}
+
+	etx, err := b.txm.CreateEthTransaction(NewTx{
+		FromAddress:    from,
+		ToAddress:      b.multicallAddress,
+		EncodedPayload: payload,
+		GasLimit:       gasLimit,
+		Strategy:       b.strategy,
+	})
+	if err != nil {
+		return errors.Wrap(err, "Batcher: failed to create batch transaction")
+	}
+
+	b.logger.Debugw("Sent batch transaction", "fromAddress", from, "nCalls", len(calls), "gasLimit", gasLimit, "ethTxID", etx.ID)
+	return nil
+}
@@ -0,0 +1,108 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_Batcher_QueueCall(t *testing.T) {
+	t.Parallel()
+
+	var (
+		multicallAddress = cltest.NewAddress()
+		fromAddress      = cltest.NewAddress()
+		otherFromAddress = cltest.NewAddress()
+		target           = cltest.NewAddress()
+	)
+
+	txm := new(bptxmmocks.TxManager)
+	b := bulletprooftxmanager.NewBatcher(logger.TestLogger(t), txm, multicallAddress, time.Minute, bulletprooftxmanager.SendEveryStrategy{})
+
+	b.QueueCall(bulletprooftxmanager.BatchedCall{
+		FromAddress:    fromAddress,
+		ToAddress:      target,
+		EncodedPayload: []byte{0x01},
+		GasLimit:       100000,
+	})
+	b.QueueCall(bulletprooftxmanager.BatchedCall{
+		FromAddress:    fromAddress,
+		ToAddress:      target,
+		EncodedPayload: []byte{0x02},
+		GasLimit:       150000,
+	})
+	b.QueueCall(bulletprooftxmanager.BatchedCall{
+		FromAddress:    otherFromAddress,
+		ToAddress:      target,
+		EncodedPayload: []byte{0x03},
+		GasLimit:       100000,
+	})
+
+	// One batch per from address, each sent to the Multicall contract with
+	// the summed gas limit plus overhead
+	txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+		return newTx.FromAddress == fromAddress && newTx.ToAddress == multicallAddress && newTx.GasLimit == 300000
+	})).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+	txm.On("CreateEthTransaction", mock.MatchedBy(func(newTx bulletprooftxmanager.NewTx) bool {
+		return newTx.FromAddress == otherFromAddress && newTx.ToAddress == multicallAddress && newTx.GasLimit == 150000
+	})).Return(bulletprooftxmanager.EthTx{}, nil).Once()
+
+	bulletprooftxmanager.FlushBatcher(b)
+
+	txm.AssertExpectations(t)
+}
+
+func Test_Batcher_EncodesAggregateCall(t *testing.T) {
+	t.Parallel()
+
+	var (
+		multicallAddress = cltest.NewAddress()
+		fromAddress      = cltest.NewAddress()
+		target           = cltest.NewAddress()
+		payload          = []byte{0xde, 0xad, 0xbe, 0xef}
+	)
+
+	txm := new(bptxmmocks.TxManager)
+	b := bulletprooftxmanager.NewBatcher(logger.TestLogger(t), txm, multicallAddress, time.Minute, bulletprooftxmanager.SendEveryStrategy{})
+
+	b.QueueCall(bulletprooftxmanager.BatchedCall{
+		FromAddress:    fromAddress,
+		ToAddress:      target,
+		EncodedPayload: payload,
+		GasLimit:       100000,
+	})
+
+	var encodedPayload []byte
+	txm.On("CreateEthTransaction", mock.AnythingOfType("bulletprooftxmanager.NewTx")).
+		Run(func(args mock.Arguments) {
+			encodedPayload = args.Get(0).(bulletprooftxmanager.NewTx).EncodedPayload
+		}).
+		Return(bulletprooftxmanager.EthTx{}, nil).Once()
+
+	bulletprooftxmanager.FlushBatcher(b)
+
+	txm.AssertExpectations(t)
+
+	method := bulletprooftxmanager.MulticallABI.Methods["aggregate"]
+	require.Equal(t, method.ID, encodedPayload[:4])
+
+	unpacked, err := method.Inputs.Unpack(encodedPayload[4:])
+	require.NoError(t, err)
+	require.Len(t, unpacked, 1)
+
+	calls := unpacked[0].([]struct {
+		Target   common.Address `json:"target"`
+		CallData []byte         `json:"callData"`
+	})
+	require.Len(t, calls, 1)
+	assert.Equal(t, target, calls[0].Target)
+	assert.Equal(t, payload, calls[0].CallData)
+}
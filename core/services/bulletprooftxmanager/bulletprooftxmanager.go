@@ -14,11 +14,14 @@ import (
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	exchainutils "github.com/okex/exchain-ethereum-compatible/utils"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
 	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/chains/evm/forwarders"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
 	"github.com/smartcontractkit/chainlink/core/service"
@@ -36,6 +39,8 @@ import (
 //go:generate mockery --recursive --name Config --output ./mocks/ --case=underscore --structname Config --filename config.go
 type Config interface {
 	gas.Config
+	EthTxAttemptGCKeepCount() uint16
+	EthTxReaperArchiveEnabled() bool
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EthTxResendAfterThreshold() time.Duration
@@ -45,6 +50,10 @@ type Config interface {
 	EvmMaxInFlightTransactions() uint32
 	EvmMaxQueuedTransactions() uint64
 	EvmNonceAutoSync() bool
+	EvmAutoCreateAccessList() bool
+	EvmNonceGapRepair() bool
+	EvmPrivateRelayFallbackBlocks() uint32
+	EvmPrivateRelayURL() string
 	EvmRPCDefaultBatchSize() uint32
 	KeySpecificMaxGasPriceWei(addr common.Address) *big.Int
 	TriggerFallbackDBPollInterval() time.Duration
@@ -71,6 +80,7 @@ type TxManager interface {
 	service.Service
 	Trigger(addr common.Address)
 	CreateEthTransaction(newTx NewTx, qopts ...postgres.QOpt) (etx EthTx, err error)
+	BumpGasForEthTx(ctx context.Context, etxID int64, gasPriceWei *big.Int) (attempt EthTxAttempt, err error)
 	GetGasEstimator() gas.Estimator
 	RegisterResumeCallback(fn ResumeCallback)
 }
@@ -95,8 +105,9 @@ type BulletproofTxManager struct {
 	chSubbed chan struct{}
 	wg       sync.WaitGroup
 
-	reaper      *Reaper
-	ethResender *EthResender
+	reaper          *Reaper
+	ethResender     *EthResender
+	nonceGapMonitor *NonceGapMonitor
 }
 
 func (b *BulletproofTxManager) RegisterResumeCallback(fn ResumeCallback) {
@@ -172,6 +183,11 @@ func (b *BulletproofTxManager) Start() (merr error) {
 			b.ethResender.Start()
 		}
 
+		if len(keyStates) > 0 {
+			b.nonceGapMonitor = NewNonceGapMonitor(b.logger, b.db, b.ethClient, b.config, b.keyStore, keyStates, defaultNonceGapMonitorPollInterval)
+			b.nonceGapMonitor.Start()
+		}
+
 		return nil
 	})
 }
@@ -186,6 +202,9 @@ func (b *BulletproofTxManager) Close() (merr error) {
 		if b.ethResender != nil {
 			b.ethResender.Stop()
 		}
+		if b.nonceGapMonitor != nil {
+			b.nonceGapMonitor.Stop()
+		}
 
 		b.wg.Wait()
 
@@ -269,6 +288,28 @@ type NewTx struct {
 	GasLimit       uint64
 	Meta           *EthTxMeta
 
+	// GasPriceMultiplier optionally scales the estimated gas price when
+	// attempts are created, allowing individual jobs to override the chain
+	// defaults
+	GasPriceMultiplier *float64
+
+	// SimulationPolicy controls what happens if pre-broadcast simulation
+	// reverts; an empty value is treated as SimulationPolicyAbort
+	SimulationPolicy SimulationPolicy
+
+	// ExpiresAt optionally sets a TTL on the transaction; see EthTx.ExpiresAt
+	ExpiresAt *time.Time
+
+	// Priority optionally scales gas pricing relative to other transactions;
+	// an empty value is treated as TxPriorityNormal
+	Priority TxPriority
+
+	// UseForwarder routes the transaction through an operator-owned forwarder
+	// contract registered for this chain: the payload is wrapped in a
+	// forward(to, data) call and the wrapped transaction is sent to the
+	// forwarder instead
+	UseForwarder bool
+
 	MinConfirmations  null.Uint32
 	PipelineTaskRunID *uuid.UUID
 
@@ -278,6 +319,13 @@ type NewTx struct {
 // CreateEthTransaction inserts a new transaction
 func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...postgres.QOpt) (etx EthTx, err error) {
 	q := postgres.NewQ(postgres.UnwrapGormDB(b.db), qs...)
+	q.QueryClass = postgres.QueryClassCritical
+
+	if newTx.UseForwarder {
+		if err = b.wrapForForwarder(&newTx); err != nil {
+			return etx, errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to route through forwarder")
+		}
+	}
 
 	err = CheckEthTxQueueCapacity(q, newTx.FromAddress, b.config.EvmMaxQueuedTransactions(), b.chainID)
 	if err != nil {
@@ -301,12 +349,12 @@ func (b *BulletproofTxManager) CreateEthTransaction(newTx NewTx, qs ...postgres.
 			return err
 		}
 		err := tx.Get(&etx, `
-INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate)
+INSERT INTO eth_txes (from_address, to_address, encoded_payload, value, gas_limit, gas_price_multiplier, state, created_at, meta, subject, evm_chain_id, min_confirmations, pipeline_task_run_id, simulate, simulation_policy, expires_at, priority)
 VALUES (
-$1,$2,$3,$4,$5,'unstarted',NOW(),$6,$7,$8,$9,$10,$11
+$1,$2,$3,$4,$5,$6,'unstarted',NOW(),$7,$8,$9,$10,$11,$12,NULLIF($13, ''),$14,COALESCE(NULLIF($15, ''), 'normal')
 )
 RETURNING "eth_txes".*
-`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate())
+`, newTx.FromAddress, newTx.ToAddress, newTx.EncodedPayload, value, newTx.GasLimit, newTx.GasPriceMultiplier, newTx.Meta, newTx.Strategy.Subject(), b.chainID.String(), newTx.MinConfirmations, newTx.PipelineTaskRunID, newTx.Strategy.Simulate(), string(newTx.SimulationPolicy), newTx.ExpiresAt, string(newTx.Priority))
 		if err != nil {
 			return errors.Wrap(err, "BulletproofTxManager#CreateEthTransaction failed to insert eth_tx")
 		}
@@ -320,9 +368,103 @@ RETURNING "eth_txes".*
 		}
 		return nil
 	})
+	if err == nil && etx.State == EthTxUnstarted {
+		notifyTxLifecycleEvent(b.db, b.logger, TxLifecycleEvent{EthTxID: etx.ID, Event: TxEventEnqueued, FromAddress: etx.FromAddress})
+	}
 	return
 }
 
+// wrapForForwarder rewrites the transaction to go through the most recently
+// registered forwarder contract for this chain: the original payload is
+// wrapped in a forward(to, data) call and the forwarder becomes the target
+func (b *BulletproofTxManager) wrapForForwarder(newTx *NewTx) error {
+	fwds, err := forwarders.NewORM(postgres.UnwrapGormDB(b.db)).FindForwardersByChain(*utils.NewBig(&b.chainID))
+	if err != nil {
+		return errors.Wrap(err, "failed to look up forwarders")
+	}
+	if len(fwds) == 0 {
+		return errors.Errorf("no forwarder contract is registered for chain %s", b.chainID.String())
+	}
+	fwd := fwds[0]
+	wrapped, err := forwarders.WrapCalldata(newTx.ToAddress, newTx.EncodedPayload)
+	if err != nil {
+		return errors.Wrap(err, "failed to wrap calldata")
+	}
+	b.logger.Debugw("Routing transaction through forwarder", "forwarder", fwd.Address, "toAddress", newTx.ToAddress)
+	newTx.ToAddress = fwd.Address.Address()
+	newTx.EncodedPayload = wrapped
+	return nil
+}
+
+// BumpGasForEthTx creates a replacement attempt at a higher gas price for an
+// unconfirmed transaction so that operators can unstick a critical
+// transaction without waiting for the automatic bump threshold. If
+// gasPriceWei is nil, the price is bumped over the latest attempt using the
+// configured bump parameters; otherwise the user-specified price is used.
+// Configured gas price caps are enforced in both cases. The replacement
+// attempt is saved in_progress and will be broadcast by the EthConfirmer on
+// the next head.
+func (b *BulletproofTxManager) BumpGasForEthTx(ctx context.Context, etxID int64, gasPriceWei *big.Int) (attempt EthTxAttempt, err error) {
+	q := postgres.NewQ(postgres.UnwrapGormDB(b.db), postgres.WithParentCtx(ctx))
+
+	var etx EthTx
+	if err = q.Get(&etx, `SELECT * FROM eth_txes WHERE id = $1 AND evm_chain_id = $2`, etxID, b.chainID.String()); err != nil {
+		return attempt, errors.Wrap(err, "BumpGasForEthTx failed to load eth_tx")
+	}
+	if etx.State != EthTxUnconfirmed {
+		return attempt, errors.Errorf("cannot bump gas for transaction %d in state %s, transaction must be unconfirmed", etx.ID, etx.State)
+	}
+
+	var previousAttempt EthTxAttempt
+	if err = q.Get(&previousAttempt, `SELECT * FROM eth_tx_attempts WHERE eth_tx_id = $1 ORDER BY id DESC LIMIT 1`, etx.ID); err != nil {
+		return attempt, errors.Wrap(err, "BumpGasForEthTx failed to load latest attempt")
+	}
+	if previousAttempt.State == EthTxAttemptInProgress {
+		return attempt, errors.Errorf("transaction %d already has an attempt in progress", etx.ID)
+	}
+	previousAttempt.EthTx = etx
+
+	cks := NewChainKeyStore(b.chainID, b.config, b.keyStore)
+	switch previousAttempt.TxType {
+	case 0x0:
+		bumpedGasPrice := gasPriceWei
+		bumpedGasLimit := etx.GasLimit
+		if bumpedGasPrice == nil {
+			bumpedGasPrice, bumpedGasLimit, err = b.gasEstimator.BumpLegacyGas(previousAttempt.GasPrice.ToInt(), etx.GasLimit)
+			if err != nil {
+				return attempt, errors.Wrap(err, "BumpGasForEthTx failed to bump gas price")
+			}
+		} else if bumpedGasPrice.Cmp(previousAttempt.GasPrice.ToInt()) <= 0 {
+			return attempt, errors.Errorf("specified gas price of %s wei must be higher than the latest attempt's gas price of %s wei", bumpedGasPrice.String(), previousAttempt.GasPrice.String())
+		}
+		attempt, err = cks.NewLegacyAttempt(etx, bumpedGasPrice, bumpedGasLimit)
+	case 0x2:
+		if gasPriceWei != nil {
+			return attempt, errors.New("cannot specify a gas price for a dynamic fee transaction")
+		}
+		var bumpedFee gas.DynamicFee
+		var bumpedGasLimit uint64
+		bumpedFee, bumpedGasLimit, err = b.gasEstimator.BumpDynamicFee(previousAttempt.DynamicFee(), etx.GasLimit)
+		if err != nil {
+			return attempt, errors.Wrap(err, "BumpGasForEthTx failed to bump dynamic fee")
+		}
+		attempt, err = cks.NewDynamicFeeAttempt(etx, bumpedFee, bumpedGasLimit)
+	default:
+		return attempt, errors.Errorf("attempt %v has unrecognised transaction type %v", previousAttempt.ID, previousAttempt.TxType)
+	}
+	if err != nil {
+		return attempt, errors.Wrap(err, "BumpGasForEthTx failed to create replacement attempt")
+	}
+
+	if err = b.db.Create(&attempt).Error; err != nil {
+		return attempt, errors.Wrap(err, "BumpGasForEthTx failed to save replacement attempt")
+	}
+	attempt.EthTx = etx
+
+	b.logger.Infow("Created manually bumped attempt, it will be broadcast on the next head", "ethTxID", etx.ID, "attemptID", attempt.ID, "previousAttemptID", previousAttempt.ID)
+	return attempt, nil
+}
+
 func (b *BulletproofTxManager) checkStateExists(q postgres.Queryer, addr common.Address) error {
 	var state ethkey.State
 	err := q.Get(&state, `SELECT * FROM eth_key_states WHERE address = $1`, addr)
@@ -423,9 +565,34 @@ func sendTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt,
 	return eth.NewSendError(err)
 }
 
-// gimulateTransaction pretends to "send" the transaction using eth_call
+// sendTransactionViaPrivateRelay submits the attempt through the given
+// private relay, falling back to the public mempool if the relay rejects it.
+// Privacy is best-effort: a transaction that the relay will not take is still
+// better broadcast publicly than stuck forever
+func sendTransactionViaPrivateRelay(ctx context.Context, relayer *PrivateRelayer, ethClient eth.Client, a EthTxAttempt, e EthTx, logger logger.Logger) *eth.SendError {
+	signedTx, err := a.GetSignedTx()
+	if err != nil {
+		return eth.NewFatalSendError(err)
+	}
+
+	relayCtx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+
+	err = relayer.SendTransaction(relayCtx, signedTx)
+	if err == nil {
+		a.EthTx = e // for logging
+		logger.Debugw("Sent transaction to private relay", "ethTxAttemptID", a.ID, "txHash", a.Hash, "meta", e.Meta, "gasLimit", e.GasLimit, "attempt", a)
+		return nil
+	}
+	logger.Warnw("Failed to send transaction to private relay; falling back to public mempool", "ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err)
+	return sendTransaction(ctx, ethClient, a, e, logger)
+}
+
+// simulateTransaction pretends to "send" the transaction using eth_call
 // returns error on revert
-func simulateTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt, e EthTx) (hexutil.Bytes, error) {
+// If the call succeeds it additionally sanity checks the gas limit using
+// eth_estimateGas
+func simulateTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttempt, e EthTx, lggr logger.Logger) (hexutil.Bytes, error) {
 	ctx, cancel := eth.DefaultQueryCtx(ctx)
 	defer cancel()
 
@@ -444,7 +611,25 @@ func simulateTransaction(ctx context.Context, ethClient eth.Client, a EthTxAttem
 	}
 	var b hexutil.Bytes
 	baseErr := ethClient.CallContext(ctx, &b, "eth_call", callArg, eth.ToBlockNumArg(nil)) // always run simulation on "latest" block
-	return b, errors.Wrap(baseErr, "transaction simulation using eth_call failed")
+	if baseErr != nil {
+		return b, errors.Wrap(baseErr, "transaction simulation using eth_call failed")
+	}
+
+	// Re-run the call through eth_estimateGas (without the gas cap) so we can
+	// sanity check the gas limit. This is best-effort only; not every node
+	// supports estimation with these args so a failed estimate is not an error
+	estimateArg := make(map[string]interface{}, len(callArg))
+	for k, v := range callArg {
+		estimateArg[k] = v
+	}
+	delete(estimateArg, "gas")
+	var estimate hexutil.Uint64
+	if err := ethClient.CallContext(ctx, &estimate, "eth_estimateGas", estimateArg); err != nil {
+		lggr.Debugw("Transaction simulation gas estimation failed", "ethTxAttemptID", a.ID, "txHash", a.Hash, "err", err)
+	} else if uint64(estimate) > a.ChainSpecificGasLimit {
+		return b, errors.Errorf("transaction simulation using eth_estimateGas returned estimate of %v which exceeds the attempt's gas limit of %v", uint64(estimate), a.ChainSpecificGasLimit)
+	}
+	return b, nil
 }
 
 // sendEmptyTransaction sends a transaction with 0 Eth and an empty payload to the burn address
@@ -510,6 +695,11 @@ func countTransactionsWithState(db *gorm.DB, fromAddress common.Address, state E
 	return
 }
 
+var promTxQueueFull = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_tx_queue_full",
+	Help: "Number of transactions that could not be created because the per-key queue depth limit (ETH_MAX_QUEUED_TRANSACTIONS) was reached. Any counts of this type indicate transactions being rejected under burst load",
+}, []string{"fromAddress", "evmChainID"})
+
 // CheckEthTxQueueCapacity returns an error if inserting this transaction would
 // exceed the maximum queue size.
 func CheckEthTxQueueCapacity(q postgres.Queryer, fromAddress common.Address, maxQueuedTransactions uint64, chainID big.Int) (err error) {
@@ -524,6 +714,7 @@ func CheckEthTxQueueCapacity(q postgres.Queryer, fromAddress common.Address, max
 	}
 
 	if count >= maxQueuedTransactions {
+		promTxQueueFull.WithLabelValues(fromAddress.Hex(), chainID.String()).Inc()
 		err = errors.Errorf("cannot create transaction; too many unstarted transactions in the queue (%v/%v). %s", count, maxQueuedTransactions, static.EvmMaxQueuedTransactionsLabel)
 	}
 	return
@@ -542,6 +733,9 @@ func (n *NullTxManager) Trigger(common.Address)                      { panic(n.E
 func (n *NullTxManager) CreateEthTransaction(NewTx, ...postgres.QOpt) (etx EthTx, err error) {
 	return etx, errors.New(n.ErrMsg)
 }
+func (n *NullTxManager) BumpGasForEthTx(context.Context, int64, *big.Int) (attempt EthTxAttempt, err error) {
+	return attempt, errors.New(n.ErrMsg)
+}
 func (n *NullTxManager) Healthy() error                           { return nil }
 func (n *NullTxManager) Ready() error                             { return nil }
 func (n *NullTxManager) GetGasEstimator() gas.Estimator           { return nil }
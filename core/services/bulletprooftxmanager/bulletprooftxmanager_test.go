@@ -13,6 +13,7 @@ import (
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
 	"github.com/stretchr/testify/require"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains"
@@ -307,6 +308,94 @@ func newMockTxStrategy(t *testing.T) *bptxmmocks.TxStrategy {
 	return strategy
 }
 
+func TestEthTx_ScaledGasPrice(t *testing.T) {
+	t.Parallel()
+
+	etx := bulletprooftxmanager.EthTx{}
+	assert.Equal(t, big.NewInt(100), etx.ScaledGasPrice(big.NewInt(100), big.NewInt(1000)))
+
+	etx.GasPriceMultiplier = null.FloatFrom(1.5)
+	assert.Equal(t, big.NewInt(150), etx.ScaledGasPrice(big.NewInt(100), big.NewInt(1000)))
+
+	// Scaled price is clamped to the maximum
+	assert.Equal(t, big.NewInt(120), etx.ScaledGasPrice(big.NewInt(100), big.NewInt(120)))
+
+	// Priority multiplier compounds with the explicit multiplier
+	etx.Priority = bulletprooftxmanager.TxPriorityUrgent
+	assert.Equal(t, big.NewInt(225), etx.ScaledGasPrice(big.NewInt(100), big.NewInt(1000)))
+
+	etx.GasPriceMultiplier = null.Float{}
+	etx.Priority = bulletprooftxmanager.TxPriorityLow
+	assert.Equal(t, big.NewInt(80), etx.ScaledGasPrice(big.NewInt(100), big.NewInt(1000)))
+}
+
+func TestTxPriority_ScaleBump(t *testing.T) {
+	t.Parallel()
+
+	// Normal priority leaves the bumped price untouched
+	assert.Equal(t, big.NewInt(120), bulletprooftxmanager.TxPriorityNormal.ScaleBump(big.NewInt(100), big.NewInt(120), big.NewInt(1000)))
+
+	// Urgent priority doubles the increment
+	assert.Equal(t, big.NewInt(140), bulletprooftxmanager.TxPriorityUrgent.ScaleBump(big.NewInt(100), big.NewInt(120), big.NewInt(1000)))
+
+	// Scaled bump is clamped to the maximum
+	assert.Equal(t, big.NewInt(130), bulletprooftxmanager.TxPriorityUrgent.ScaleBump(big.NewInt(100), big.NewInt(120), big.NewInt(130)))
+}
+
+func TestBulletproofTxManager_BumpGasForEthTx(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	keyStore := cltest.NewKeyStore(t, sqlxdb)
+	_, fromAddress := cltest.MustInsertRandomKey(t, keyStore.Eth(), 0)
+
+	config := new(bptxmmocks.Config)
+	config.On("EthTxResendAfterThreshold").Return(time.Duration(0))
+	config.On("EthTxReaperThreshold").Return(time.Duration(0))
+	config.On("GasEstimatorMode").Return("FixedPrice")
+	config.On("KeySpecificMaxGasPriceWei", fromAddress).Return(big.NewInt(1000000000000))
+	config.On("EvmMinGasPriceWei").Return(big.NewInt(1))
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	lggr := logger.TestLogger(t)
+	bptxm := bulletprooftxmanager.NewBulletproofTxManager(db, ethClient, config, keyStore.Eth(), nil, lggr)
+
+	t.Run("returns error if eth_tx does not exist", func(t *testing.T) {
+		_, err := bptxm.BumpGasForEthTx(context.Background(), 42, nil)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "failed to load eth_tx")
+	})
+
+	etx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+
+	t.Run("errors if the specified gas price is not higher than the latest attempt", func(t *testing.T) {
+		_, err := bptxm.BumpGasForEthTx(context.Background(), etx.ID, big.NewInt(1))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "must be higher than the latest attempt's gas price")
+	})
+
+	t.Run("creates an in_progress replacement attempt at the specified gas price", func(t *testing.T) {
+		attempt, err := bptxm.BumpGasForEthTx(context.Background(), etx.ID, big.NewInt(5))
+		require.NoError(t, err)
+
+		assert.Equal(t, etx.ID, attempt.EthTxID)
+		assert.Equal(t, "5", attempt.GasPrice.String())
+		assert.Equal(t, bulletprooftxmanager.EthTxAttemptInProgress, attempt.State)
+
+		etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+		require.NoError(t, err)
+		assert.Len(t, etx.EthTxAttempts, 2)
+	})
+
+	t.Run("errors if an attempt is already in progress", func(t *testing.T) {
+		_, err := bptxm.BumpGasForEthTx(context.Background(), etx.ID, big.NewInt(10))
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "already has an attempt in progress")
+	})
+}
+
 func TestBulletproofTxManager_CreateEthTransaction_OutOfEth(t *testing.T) {
 	db := pgtest.NewGormDB(t)
 	sqlxdb := postgres.UnwrapGormDB(db)
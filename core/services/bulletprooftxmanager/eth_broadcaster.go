@@ -10,6 +10,8 @@ import (
 
 	"github.com/jackc/pgconn"
 	"github.com/lib/pq"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/gas"
@@ -20,6 +22,8 @@ import (
 	"gopkg.in/guregu/null.v4"
 
 	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	"gorm.io/gorm"
 )
@@ -31,6 +35,21 @@ const InFlightTransactionRecheckInterval = 1 * time.Second
 
 var errEthTxRemoved = errors.New("eth_tx removed")
 
+var (
+	promNumInFlightTxs = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_num_tx_in_flight",
+		Help: "The number of in-flight (unconfirmed) transactions per key",
+	}, []string{"fromAddress", "evmChainID"})
+	promTxQueueDepth = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "tx_manager_tx_queue_depth",
+		Help: "The number of unstarted transactions queued behind the per-key in-flight transaction limit",
+	}, []string{"fromAddress", "evmChainID"})
+	promTxThrottled = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "tx_manager_num_tx_throttled",
+		Help: "Number of times broadcasting was throttled because the per-key in-flight transaction limit (ETH_MAX_IN_FLIGHT_TRANSACTIONS) was reached",
+	}, []string{"fromAddress", "evmChainID"})
+)
+
 // EthBroadcaster monitors eth_txes for transactions that need to
 // be broadcast, assigns nonces and ensures that at least one eth node
 // somewhere has received the transaction successfully.
@@ -52,6 +71,10 @@ type EthBroadcaster struct {
 	estimator      gas.Estimator
 	resumeCallback ResumeCallback
 
+	// privateRelayer is non-nil if a private relay URL is configured for this
+	// chain; initial broadcasts then bypass the public mempool
+	privateRelayer *PrivateRelayer
+
 	ethTxInsertListener postgres.Subscription
 	eventBroadcaster    postgres.EventBroadcaster
 
@@ -85,6 +108,7 @@ func NewEthBroadcaster(db *gorm.DB, ethClient eth.Client, config Config, keystor
 			keystore: keystore,
 		},
 		estimator:        estimator,
+		privateRelayer:   newPrivateRelayerFromConfig(config, logger),
 		eventBroadcaster: eventBroadcaster,
 		keyStates:        keyStates,
 		triggers:         triggers,
@@ -135,6 +159,10 @@ func (eb *EthBroadcaster) Close() error {
 		close(eb.chStop)
 		eb.wg.Wait()
 
+		if eb.privateRelayer != nil {
+			eb.privateRelayer.Close()
+		}
+
 		return nil
 	})
 }
@@ -239,11 +267,14 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			if err != nil {
 				return errors.Wrap(err, "CountUnconfirmedTransactions failed")
 			}
+			promNumInFlightTxs.WithLabelValues(fromAddress.Hex(), eb.chainID.String()).Set(float64(nUnconfirmed))
 			if nUnconfirmed >= maxInFlightTransactions {
 				nUnstarted, err := CountUnstartedTransactions(eb.db, fromAddress, eb.chainID)
 				if err != nil {
 					return errors.Wrap(err, "CountUnstartedTransactions failed")
 				}
+				promTxQueueDepth.WithLabelValues(fromAddress.Hex(), eb.chainID.String()).Set(float64(nUnstarted))
+				promTxThrottled.WithLabelValues(fromAddress.Hex(), eb.chainID.String()).Inc()
 				eb.logger.Warnw(fmt.Sprintf(`Transaction throttling; %d transactions in-flight and %d unstarted transactions pending (maximum number of in-flight transactions is %d per key). %s`, nUnconfirmed, nUnstarted, maxInFlightTransactions, static.EvmMaxInFlightTransactionsWarningLabel), "maxInFlightTransactions", maxInFlightTransactions, "nUnconfirmed", nUnconfirmed, "nUnstarted", nUnstarted)
 				time.Sleep(InFlightTransactionRecheckInterval)
 				continue
@@ -254,15 +285,22 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			return errors.Wrap(err, "processUnstartedEthTxs failed")
 		}
 		if etx == nil {
+			// The queue is drained
+			promTxQueueDepth.WithLabelValues(fromAddress.Hex(), eb.chainID.String()).Set(0)
 			return nil
 		}
 		n++
 		var a EthTxAttempt
 		if eb.config.EvmEIP1559DynamicFees() {
+			if eb.config.EvmAutoCreateAccessList() && !etx.AccessList.Valid {
+				eb.generateAccessList(etx)
+			}
 			fee, gasLimit, err := eb.estimator.GetDynamicFee(etx.GasLimit)
 			if err != nil {
 				return errors.Wrap(err, "failed to get dynamic gas fee")
 			}
+			fee.FeeCap = etx.ScaledGasPrice(fee.FeeCap, eb.config.KeySpecificMaxGasPriceWei(etx.FromAddress))
+			fee.TipCap = etx.ScaledGasPrice(fee.TipCap, fee.FeeCap)
 			a, err = eb.NewDynamicFeeAttempt(*etx, fee, gasLimit)
 			if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed")
@@ -272,6 +310,7 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 			if err != nil {
 				return errors.Wrap(err, "failed to estimate gas")
 			}
+			gasPrice = etx.ScaledGasPrice(gasPrice, eb.config.KeySpecificMaxGasPriceWei(etx.FromAddress))
 			a, err = eb.NewLegacyAttempt(*etx, gasPrice, gasLimit)
 			if err != nil {
 				return errors.Wrap(err, "processUnstartedEthTxs failed")
@@ -291,6 +330,58 @@ func (eb *EthBroadcaster) processUnstartedEthTxs(ctx context.Context, fromAddres
 	}
 }
 
+// createAccessListResult is the return shape of eth_createAccessList
+type createAccessListResult struct {
+	AccessList gethTypes.AccessList `json:"accessList"`
+	Error      string               `json:"error"`
+	GasUsed    hexutil.Uint64       `json:"gasUsed"`
+}
+
+// generateAccessList asks the eth node to generate an EIP-2930 access list
+// for the transaction using eth_createAccessList and attaches it only if
+// doing so reduces the estimated gas. Failures here are never fatal; the
+// transaction is simply sent without an access list
+func (eb *EthBroadcaster) generateAccessList(etx *EthTx) {
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+
+	callArg := map[string]interface{}{
+		"from":  etx.FromAddress,
+		"to":    &etx.ToAddress,
+		"gas":   hexutil.Uint64(etx.GasLimit),
+		"value": (*hexutil.Big)(etx.Value.ToInt()),
+		"data":  hexutil.Bytes(etx.EncodedPayload),
+	}
+	var result createAccessListResult
+	if err := eb.ethClient.CallContext(ctx, &result, "eth_createAccessList", callArg, eth.ToBlockNumArg(nil)); err != nil {
+		eb.logger.Debugw("eth_createAccessList failed; sending without access list", "ethTxID", etx.ID, "err", err)
+		return
+	}
+	if result.Error != "" {
+		eb.logger.Debugw("eth_createAccessList returned an error; sending without access list", "ethTxID", etx.ID, "err", result.Error)
+		return
+	}
+	if len(result.AccessList) == 0 {
+		return
+	}
+	var baseline hexutil.Uint64
+	if err := eb.ethClient.CallContext(ctx, &baseline, "eth_estimateGas", callArg); err != nil {
+		eb.logger.Debugw("Baseline gas estimation failed; sending without access list", "ethTxID", etx.ID, "err", err)
+		return
+	}
+	if uint64(result.GasUsed) >= uint64(baseline) {
+		eb.logger.Debugw("Access list does not reduce estimated gas; sending without it", "ethTxID", etx.ID, "gasUsedWithAccessList", uint64(result.GasUsed), "gasUsedWithout", uint64(baseline))
+		return
+	}
+	eb.logger.Debugw("Attaching access list", "ethTxID", etx.ID, "accessList", result.AccessList, "gasUsedWithAccessList", uint64(result.GasUsed), "gasUsedWithout", uint64(baseline))
+	etx.AccessList = NullableEIP2930AccessListFrom(result.AccessList)
+	// Persist it so that bumped attempts created later include the same
+	// access list
+	if err := eb.db.Exec(`UPDATE eth_txes SET access_list = ? WHERE id = ?`, etx.AccessList, etx.ID).Error; err != nil {
+		eb.logger.Warnw("Failed to save generated access list", "ethTxID", etx.ID, "err", err)
+	}
+}
+
 // handleInProgressEthTx checks if there is any transaction
 // in_progress and if so, finishes the job
 func (eb *EthBroadcaster) handleAnyInProgressEthTx(ctx context.Context, fromAddress gethCommon.Address) error {
@@ -346,19 +437,37 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	if etx.Simulate {
 		simulationCtx, cancel := context.WithTimeout(parentCtx, SimulationTimeout)
 		defer cancel()
-		if b, err := simulateTransaction(simulationCtx, eb.ethClient, attempt, etx); err != nil {
+		b, err := simulateTransaction(simulationCtx, eb.ethClient, attempt, etx, eb.logger)
+		simResult := SimulationResult{Passed: err == nil, ReturnValue: b.String()}
+		if err != nil {
+			simResult.Error = err.Error()
 			if jErr := eth.ExtractRPCError(err); jErr != nil {
-				eb.logger.Errorw("Transaction reverted during simulation", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "rpcErr", jErr.String(), "returnValue", b.String())
-				etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", jErr.String()))
-				return eb.saveFatallyErroredTransaction(&etx)
+				if reason, reasonErr := eth.ExtractRevertReasonFromRPCError(err); reasonErr == nil {
+					simResult.RevertReason = reason
+				}
+				if etx.SimulationPolicy.String == string(SimulationPolicyProceed) {
+					eb.logger.Errorw("Transaction reverted during simulation, sending anyway per simulation policy", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", simResult.RevertReason, "returnValue", b.String())
+				} else {
+					eb.logger.Errorw("Transaction reverted during simulation", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "rpcErr", jErr.String(), "revertReason", simResult.RevertReason, "returnValue", b.String())
+					etx.Error = null.StringFrom(fmt.Sprintf("transaction reverted during simulation: %s", jErr.String()))
+					return eb.saveFatallyErroredTransaction(&etx)
+				}
+			} else {
+				eb.logger.Warnw("Transaction simulation failed, will attempt to send anyway", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "returnValue", b.String())
 			}
-			eb.logger.Warnw("Transaction simulation failed, will attempt to send anyway", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "err", err, "returnValue", b.String())
 		} else {
 			eb.logger.Debugw("Transaction simulation succeeded", "ethTxAttemptID", attempt.ID, "txHash", attempt.Hash, "returnValue", b.String())
 		}
+		// Stored on the attempt so failed transactions carry diagnostics
+		attempt.SimulationResult = &simResult
 	}
 
-	sendError := sendTransaction(parentCtx, eb.ethClient, attempt, etx, eb.logger)
+	var sendError *eth.SendError
+	if eb.privateRelayer != nil {
+		sendError = sendTransactionViaPrivateRelay(parentCtx, eb.privateRelayer, eb.ethClient, attempt, etx, eb.logger)
+	} else {
+		sendError = sendTransaction(parentCtx, eb.ethClient, attempt, etx, eb.logger)
+	}
 
 	if sendError.IsTooExpensive() {
 		eb.logger.Errorw("Transaction gas price was rejected by the eth node for being too high. Consider increasing your eth node's RPCTxFeeCap (it is suggested to run geth with no cap i.e. --rpc.gascap=0 --rpc.txfeecap=0)",
@@ -455,7 +564,11 @@ func (eb *EthBroadcaster) handleInProgressEthTx(etx EthTx, attempt EthTxAttempt,
 	}
 
 	if sendError == nil {
-		return saveAttempt(eb.db, &etx, attempt, EthTxAttemptBroadcast)
+		if err := saveAttempt(eb.db, &etx, attempt, EthTxAttemptBroadcast); err != nil {
+			return err
+		}
+		notifyTxLifecycleEvent(eb.db, eb.logger, TxLifecycleEvent{EthTxID: etx.ID, Event: TxEventBroadcast, FromAddress: etx.FromAddress, Nonce: etx.Nonce, TxHash: &attempt.Hash})
+		return nil
 	}
 
 	// Any other type of error is considered temporary or resolvable by the
@@ -574,6 +687,7 @@ func (eb *EthBroadcaster) tryAgainWithNewEstimation(sendError *eth.SendError, et
 	if err != nil {
 		return errors.Wrap(err, "tryAgainWithNewEstimation failed to estimate gas")
 	}
+	gasPrice = etx.ScaledGasPrice(gasPrice, eb.config.KeySpecificMaxGasPriceWei(etx.FromAddress))
 	eb.logger.Debugw("Optimism rejected transaction due to incorrect fee, re-estimated and will try again",
 		"etxID", etx.ID, "err", err, "newGasPrice", gasPrice, "newGasLimit", gasLimit)
 	return eb.tryAgainWithNewGas(etx, attempt, initialBroadcastAt, gasPrice, gasLimit)
@@ -620,12 +734,16 @@ func (eb *EthBroadcaster) saveFatallyErroredTransaction(etx *EthTx) error {
 	}
 	etx.Nonce = nil
 	etx.State = EthTxFatalError
-	return postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
+	err := postgres.GormTransactionWithDefaultContext(eb.db, func(tx *gorm.DB) error {
 		if err := tx.Exec(`DELETE FROM eth_tx_attempts WHERE eth_tx_id = ?`, etx.ID).Error; err != nil {
 			return errors.Wrapf(err, "saveFatallyErroredTransaction failed to delete eth_tx_attempt with eth_tx.ID %v", etx.ID)
 		}
 		return errors.Wrap(tx.Save(etx).Error, "saveFatallyErroredTransaction failed to save eth_tx")
 	})
+	if err == nil {
+		notifyTxLifecycleEvent(eb.db, eb.logger, TxLifecycleEvent{EthTxID: etx.ID, Event: TxEventFatallyErrored, FromAddress: etx.FromAddress, Error: etx.Error.String})
+	}
+	return err
 }
 
 // GetNextNonce returns keys.next_nonce for the given address
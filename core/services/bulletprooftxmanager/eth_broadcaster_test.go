@@ -333,6 +333,17 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 				}
 				return false
 			}), "latest").Return(nil).Once()
+			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Uint64"), "eth_estimateGas", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+				if fmt.Sprintf("%s", callarg["value"]) == "0x1ba" { // 442
+					_, hasGas := callarg["gas"]
+					assert.False(t, hasGas)
+					return true
+				}
+				return false
+			})).Return(nil).Run(func(args mock.Arguments) {
+				estimate := args.Get(1).(*hexutil.Uint64)
+				*estimate = hexutil.Uint64(21000)
+			}).Once()
 
 			require.NoError(t, db.Save(&ethTx).Error)
 
@@ -342,6 +353,9 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			ethTx, err := cltest.FindEthTxWithAttempts(db, ethTx.ID)
 			require.NoError(t, err)
 			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, ethTx.State)
+			require.Len(t, ethTx.EthTxAttempts, 1)
+			require.NotNil(t, ethTx.EthTxAttempts[0].SimulationResult)
+			assert.True(t, ethTx.EthTxAttempts[0].SimulationResult.Passed)
 
 			ethClient.AssertExpectations(t)
 		})
@@ -404,6 +418,45 @@ func TestEthBroadcaster_ProcessUnstartedEthTxs_Success(t *testing.T) {
 			assert.True(t, ethTx.Error.Valid)
 			assert.Equal(t, "transaction reverted during simulation: json-rpc error { Code = 42, Message = 'oh no, it reverted', Data = 'KqYi' }", ethTx.Error.String)
 
+			ethClient.AssertExpectations(t)
+		})
+		t.Run("on revert with proceed policy, sends anyway and records the simulation result on the attempt", func(t *testing.T) {
+			ethTx := bulletprooftxmanager.EthTx{
+				FromAddress:      fromAddress,
+				ToAddress:        toAddress,
+				EncodedPayload:   []byte{42, 0, 0},
+				Value:            assets.NewEthValue(742),
+				GasLimit:         gasLimit,
+				CreatedAt:        time.Unix(0, 0),
+				State:            bulletprooftxmanager.EthTxUnstarted,
+				Simulate:         true,
+				SimulationPolicy: null.StringFrom(string(bulletprooftxmanager.SimulationPolicyProceed)),
+			}
+
+			jerr := eth.JsonError{
+				Code:    42,
+				Message: "oh no, it reverted",
+				Data:    []byte{42, 166, 34},
+			}
+			ethClient.On("CallContext", mock.Anything, mock.AnythingOfType("*hexutil.Bytes"), "eth_call", mock.MatchedBy(func(callarg map[string]interface{}) bool {
+				return fmt.Sprintf("%s", callarg["value"]) == "0x2e6" // 742
+			}), "latest").Return(&jerr).Once()
+			ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+				return tx.Value().Cmp(big.NewInt(742)) == 0
+			})).Return(nil).Once()
+
+			require.NoError(t, db.Save(&ethTx).Error)
+
+			require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+			ethTx, err := cltest.FindEthTxWithAttempts(db, ethTx.ID)
+			require.NoError(t, err)
+			assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, ethTx.State)
+			require.Len(t, ethTx.EthTxAttempts, 1)
+			require.NotNil(t, ethTx.EthTxAttempts[0].SimulationResult)
+			assert.False(t, ethTx.EthTxAttempts[0].SimulationResult.Passed)
+			assert.Contains(t, ethTx.EthTxAttempts[0].SimulationResult.Error, "transaction simulation using eth_call failed")
+
 			ethClient.AssertExpectations(t)
 		})
 	})
@@ -1483,3 +1536,53 @@ func TestEthBroadcaster_EthTxInsertEventCausesTriggerToFire(t *testing.T) {
 	mustInsertUnstartedEthTx(t, db, fromAddress)
 	gomega.NewGomegaWithT(t).Eventually(ethTxInsertListener.Events()).Should(gomega.Receive())
 }
+
+func TestEthBroadcaster_ProcessUnstartedEthTxs_GeneratesAccessList(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+	keyState, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	cfg := cltest.NewTestGeneralConfig(t)
+	cfg.Overrides.GlobalEvmEIP1559DynamicFees = null.BoolFrom(true)
+	cfg.Overrides.GlobalEvmAutoCreateAccessList = null.BoolFrom(true)
+	evmcfg := evmtest.NewChainScopedConfig(t, cfg)
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+	eb := cltest.NewEthBroadcaster(t, db, ethClient, ethKeyStore, evmcfg, []ethkey.State{keyState})
+
+	toAddress := gethCommon.HexToAddress("0x6C03DDA95a2AEd917EeCc6eddD4b9D16E6380411")
+	etx := bulletprooftxmanager.EthTx{
+		FromAddress:    fromAddress,
+		ToAddress:      toAddress,
+		EncodedPayload: []byte{42, 42, 0},
+		Value:          assets.NewEthValue(142),
+		GasLimit:       50000,
+		State:          bulletprooftxmanager.EthTxUnstarted,
+	}
+	require.NoError(t, db.Save(&etx).Error)
+
+	accessList := gethTypes.AccessList{{Address: toAddress, StorageKeys: []gethCommon.Hash{{1}}}}
+	ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_createAccessList", mock.Anything, "latest").Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(1).(*bulletprooftxmanager.AccessListResult)
+		res.AccessList = accessList
+		res.GasUsed = hexutil.Uint64(45000)
+	}).Once()
+	ethClient.On("CallContext", mock.Anything, mock.Anything, "eth_estimateGas", mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(1).(*hexutil.Uint64)
+		*res = 48000
+	}).Once()
+	ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+		return tx.Type() == 0x2 && len(tx.AccessList()) == 1
+	})).Return(nil).Once()
+
+	require.NoError(t, eb.ProcessUnstartedEthTxs(context.Background(), keyState))
+
+	etx, err := cltest.FindEthTxWithAttempts(db, etx.ID)
+	require.NoError(t, err)
+	assert.True(t, etx.AccessList.Valid)
+	require.Len(t, etx.AccessList.AccessList, 1)
+	assert.Equal(t, toAddress, etx.AccessList.AccessList[0].Address)
+
+	ethClient.AssertExpectations(t)
+}
@@ -92,6 +92,11 @@ type EthConfirmer struct {
 	estimator      gas.Estimator
 	resumeCallback ResumeCallback
 
+	// privateRelayer is non-nil if a private relay URL is configured for this
+	// chain; rebroadcasts then go through the relay until the fallback window
+	// elapses
+	privateRelayer *PrivateRelayer
+
 	keyStates []ethkey.State
 
 	mb        *utils.Mailbox
@@ -119,6 +124,7 @@ func NewEthConfirmer(db *gorm.DB, ethClient eth.Client, config Config, keystore
 		},
 		estimator,
 		resumeCallback,
+		newPrivateRelayerFromConfig(config, lggr),
 		keyStates,
 		utils.NewMailbox(1),
 		context,
@@ -148,6 +154,10 @@ func (ec *EthConfirmer) Close() error {
 		ec.ctxCancel()
 		ec.wg.Wait()
 
+		if ec.privateRelayer != nil {
+			ec.privateRelayer.Close()
+		}
+
 		return nil
 	})
 }
@@ -206,6 +216,13 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head eth.Head) error {
 	ec.lggr.Debugw("Finished CheckForReceipts", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 	mark = time.Now()
 
+	if err := ec.ExpireOldTransactions(ctx); err != nil {
+		return errors.Wrap(err, "ExpireOldTransactions failed")
+	}
+
+	ec.lggr.Debugw("Finished ExpireOldTransactions", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
+	mark = time.Now()
+
 	if err := ec.RebroadcastWhereNecessary(ctx, head.Number); err != nil {
 		return errors.Wrap(err, "RebroadcastWhereNecessary failed")
 	}
@@ -219,6 +236,12 @@ func (ec *EthConfirmer) processHead(ctx context.Context, head eth.Head) error {
 
 	ec.lggr.Debugw("Finished EnsureConfirmedTransactionsInLongestChain", "headNum", head.Number, "time", time.Since(mark), "id", "eth_confirmer")
 
+	// Checkpointing is an optimization for restart recovery; failure to save
+	// one must never block transaction processing
+	if err := ec.SaveCheckpoints(head.Number); err != nil {
+		ec.lggr.Errorw("Failed to save eth_tx checkpoints", "headNum", head.Number, "err", err)
+	}
+
 	if ec.resumeCallback != nil {
 		mark = time.Now()
 		if err := ec.ResumePendingTaskRuns(ctx, head); err != nil {
@@ -356,6 +379,10 @@ func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []Eth
 	err = ec.db.
 		Joins("EthTx"). // Joins("EthTx") is needed for the query to actually return data from eth_txes table as well.
 		Joins("JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id AND eth_txes.state IN ('unconfirmed', 'confirmed_missing_receipt') AND eth_txes.evm_chain_id = ?", ec.chainID.String()).
+		// The checkpoint bounds the scan after a restart; everything below
+		// the checkpointed nonce had already settled
+		Joins("LEFT JOIN eth_tx_checkpoints ON eth_tx_checkpoints.from_address = eth_txes.from_address AND eth_tx_checkpoints.evm_chain_id = eth_txes.evm_chain_id").
+		Where("eth_tx_checkpoints.lowest_unconfirmed_nonce IS NULL OR eth_txes.nonce >= eth_tx_checkpoints.lowest_unconfirmed_nonce").
 		Order("eth_txes.nonce ASC, eth_tx_attempts.gas_price DESC, eth_tx_attempts.gas_tip_cap DESC").
 		Where("eth_tx_attempts.state != 'insufficient_eth'").
 		Find(&attempts).Error
@@ -363,6 +390,29 @@ func (ec *EthConfirmer) findEthTxAttemptsRequiringReceiptFetch() (attempts []Eth
 	return
 }
 
+// SaveCheckpoints upserts a recovery checkpoint for every key, recording the
+// lowest nonce still in flight and the block number that was just processed.
+// On restart this makes recovery O(unconfirmed) instead of O(history)
+func (ec *EthConfirmer) SaveCheckpoints(blockNum int64) error {
+	for _, state := range ec.keyStates {
+		err := ec.db.Exec(`
+INSERT INTO eth_tx_checkpoints (from_address, evm_chain_id, lowest_unconfirmed_nonce, last_processed_block_num, updated_at)
+VALUES (?, ?, (
+	SELECT MIN(nonce) FROM eth_txes
+	WHERE from_address = ? AND evm_chain_id = ? AND state IN ('in_progress', 'unconfirmed', 'confirmed_missing_receipt')
+), ?, NOW())
+ON CONFLICT (from_address, evm_chain_id) DO UPDATE SET
+	lowest_unconfirmed_nonce = EXCLUDED.lowest_unconfirmed_nonce,
+	last_processed_block_num = EXCLUDED.last_processed_block_num,
+	updated_at = EXCLUDED.updated_at
+`, state.Address.Address(), ec.chainID.String(), state.Address.Address(), ec.chainID.String(), blockNum).Error
+		if err != nil {
+			return errors.Wrapf(err, "failed to save checkpoint for key %s", state.Address.Hex())
+		}
+	}
+	return nil
+}
+
 func (ec *EthConfirmer) getNonceForLatestBlock(ctx context.Context, from gethCommon.Address) (nonce uint64, err error) {
 	return ec.ethClient.NonceAt(ctx, from, nil)
 }
@@ -522,6 +572,7 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	FROM updated_eth_tx_attempts
 	WHERE updated_eth_tx_attempts.eth_tx_id = eth_txes.id
 	AND evm_chain_id = ?
+	RETURNING eth_txes.id, eth_txes.nonce, eth_txes.from_address
 	`
 
 	stmt := fmt.Sprintf(sql, strings.Join(valueStrs, ","))
@@ -529,8 +580,19 @@ func (ec *EthConfirmer) saveFetchedReceipts(receipts []Receipt) (err error) {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
 
-	err = ec.db.WithContext(ctx).Exec(stmt, valueArgs...).Error
-	return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	var confirmed []struct {
+		ID          int64
+		Nonce       *int64
+		FromAddress gethCommon.Address
+	}
+	err = ec.db.WithContext(ctx).Raw(stmt, valueArgs...).Scan(&confirmed).Error
+	if err != nil {
+		return errors.Wrap(err, "saveFetchedReceipts failed to save receipts")
+	}
+	for _, c := range confirmed {
+		notifyTxLifecycleEvent(ec.db, ec.lggr, TxLifecycleEvent{EthTxID: c.ID, Event: TxEventConfirmed, FromAddress: c.FromAddress, Nonce: c.Nonce})
+	}
+	return nil
 }
 
 // markConfirmedMissingReceipt
@@ -636,8 +698,69 @@ RETURNING e0.id, e0.nonce, e0.from_address`, ErrCouldNotGetReceipt, cutoff, ec.c
 			" Please note that Chainlink requires exclusive ownership of it's private keys and sharing keys across multiple"+
 			" chainlink instances, or using the chainlink keys with an external wallet is NOT SUPPORTED and WILL lead to missed transactions",
 			ethTxID, blockNum, fromAddress.Hex(), nonce.Int64), "ethTxID", ethTxID, "nonce", nonce, "fromAddress", fromAddress)
+
+		var noncePtr *int64
+		if nonce.Valid {
+			noncePtr = &nonce.Int64
+		}
+		notifyTxLifecycleEvent(ec.db, ec.lggr, TxLifecycleEvent{EthTxID: ethTxID, Event: TxEventFatallyErrored, FromAddress: fromAddress, Nonce: noncePtr, Error: ErrCouldNotGetReceipt})
+	}
+
+	return nil
+}
+
+// ExpireOldTransactions finds unconfirmed transactions whose expires_at has
+// passed and cancels them. We stop bumping the transaction, broadcast a
+// zero-value self-transfer to consume the nonce and mark the eth_tx as
+// expired. The cancellation is priced as a bump on the highest-priced
+// attempt so that it can replace it in the mempool.
+func (ec *EthConfirmer) ExpireOldTransactions(ctx context.Context) error {
+	var etxs []EthTx
+	err := ec.db.Raw(`SELECT * FROM eth_txes WHERE state = 'unconfirmed' AND expires_at IS NOT NULL AND expires_at < NOW() AND evm_chain_id = ? ORDER BY nonce ASC`, ec.chainID.String()).Scan(&etxs).Error
+	if err != nil {
+		return errors.Wrap(err, "ExpireOldTransactions failed to load expired eth_txes")
+	}
+	for _, etx := range etxs {
+		if err := ec.expireTransaction(ctx, etx); err != nil {
+			return errors.Wrapf(err, "failed to expire eth_tx %v", etx.ID)
+		}
+	}
+	return nil
+}
+
+func (ec *EthConfirmer) expireTransaction(ctx context.Context, etx EthTx) error {
+	var attempt EthTxAttempt
+	err := ec.db.Where("eth_tx_id = ?", etx.ID).Order("gas_price DESC NULLS LAST, id DESC").First(&attempt).Error
+	if err != nil {
+		return errors.Wrap(err, "expireTransaction failed to load latest attempt")
+	}
+
+	originalGasPrice := attempt.GasPrice
+	if originalGasPrice == nil {
+		originalGasPrice = attempt.GasFeeCap
+	}
+	bumpedGasPrice, _, err := gas.BumpLegacyGasPriceOnly(ec.config, nil, originalGasPrice.ToInt(), etx.GasLimit)
+	if err != nil {
+		return errors.Wrap(err, "expireTransaction failed to price cancellation transaction")
+	}
+
+	hash, err := ec.sendEmptyTransaction(ctx, etx.FromAddress, uint(*etx.Nonce), 0, bumpedGasPrice.Uint64())
+	if err != nil {
+		// Most likely the transaction was confirmed while we were trying to
+		// cancel it; leave it alone and it will be picked up by receipt
+		// checking as normal
+		ec.lggr.Warnw("ExpireOldTransactions: failed to send cancellation transaction, tx left unexpired", "ethTxID", etx.ID, "nonce", *etx.Nonce, "err", err)
+		return nil
 	}
 
+	ec.lggr.Infow("ExpireOldTransactions: transaction expired without confirmation; sent cancellation transaction to consume the nonce", "ethTxID", etx.ID, "nonce", *etx.Nonce, "cancellationTxHash", hash, "expiresAt", etx.ExpiresAt)
+
+	expiredError := fmt.Sprintf("expired without confirmation at %s; nonce consumed by cancellation transaction %s", etx.ExpiresAt.UTC().Format(time.RFC3339), hash.Hex())
+	err = ec.db.Exec(`UPDATE eth_txes SET state = 'expired', error = ? WHERE id = ?`, expiredError, etx.ID).Error
+	if err != nil {
+		return errors.Wrap(err, "expireTransaction failed to mark eth_tx as expired")
+	}
+	notifyTxLifecycleEvent(ec.db, ec.lggr, TxLifecycleEvent{EthTxID: etx.ID, Event: TxEventExpired, FromAddress: etx.FromAddress, Nonce: etx.Nonce, Error: expiredError})
 	return nil
 }
 
@@ -692,6 +815,7 @@ func (ec *EthConfirmer) rebroadcastWhereNecessary(ctx context.Context, address g
 		if err := ec.saveInProgressAttempt(&attempt); err != nil {
 			return errors.Wrap(err, "saveInProgressAttempt failed")
 		}
+		notifyTxLifecycleEvent(ec.db, ec.lggr, TxLifecycleEvent{EthTxID: etx.ID, Event: TxEventBumped, FromAddress: etx.FromAddress, Nonce: etx.Nonce, TxHash: &attempt.Hash})
 
 		if err := ec.handleInProgressAttempt(ctx, etx, attempt, blockHeight); err != nil {
 			return errors.Wrap(err, "handleInProgressAttempt failed")
@@ -893,6 +1017,7 @@ func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt Eth
 		var bumpedGasLimit uint64
 		bumpedGasPrice, bumpedGasLimit, err = ec.estimator.BumpLegacyGas(previousAttempt.GasPrice.ToInt(), previousAttempt.EthTx.GasLimit)
 		if err == nil {
+			bumpedGasPrice = previousAttempt.EthTx.Priority.ScaleBump(previousAttempt.GasPrice.ToInt(), bumpedGasPrice, ec.config.KeySpecificMaxGasPriceWei(previousAttempt.EthTx.FromAddress))
 			promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
 			ec.lggr.Debugw("Rebroadcast bumping gas for Legacy tx", append(logFields, "bumpedGasPrice", bumpedGasPrice.String())...)
 			return ec.NewLegacyAttempt(previousAttempt.EthTx, bumpedGasPrice, bumpedGasLimit)
@@ -904,6 +1029,9 @@ func (ec *EthConfirmer) bumpGas(previousAttempt EthTxAttempt) (bumpedAttempt Eth
 		original := previousAttempt.DynamicFee()
 		bumpedFee, bumpedGasLimit, err = ec.estimator.BumpDynamicFee(original, previousAttempt.EthTx.GasLimit)
 		if err == nil {
+			priority := previousAttempt.EthTx.Priority
+			bumpedFee.FeeCap = priority.ScaleBump(original.FeeCap, bumpedFee.FeeCap, ec.config.KeySpecificMaxGasPriceWei(previousAttempt.EthTx.FromAddress))
+			bumpedFee.TipCap = priority.ScaleBump(original.TipCap, bumpedFee.TipCap, bumpedFee.FeeCap)
 			promNumGasBumps.WithLabelValues(ec.chainID.String()).Inc()
 			ec.lggr.Debugw("Rebroadcast bumping gas for DynamicFee tx", append(logFields, "bumpedTipCap", bumpedFee.TipCap.String(), "bumpedFeeCap", bumpedFee.FeeCap.String())...)
 			return ec.NewDynamicFeeAttempt(previousAttempt.EthTx, bumpedFee, bumpedGasLimit)
@@ -927,13 +1055,38 @@ func (ec *EthConfirmer) saveInProgressAttempt(attempt *EthTxAttempt) error {
 	return errors.Wrap(ec.db.Save(attempt).Error, "saveInProgressAttempt failed")
 }
 
+// privateRelayWindowExpired returns true once the eth_tx has gone unconfirmed
+// for more than EvmPrivateRelayFallbackBlocks since its first broadcast, at
+// which point rebroadcasts fall back to the public mempool rather than
+// waiting on the relay indefinitely
+func (ec *EthConfirmer) privateRelayWindowExpired(etx EthTx, blockHeight int64) bool {
+	var firstBroadcastBlock *int64
+	for _, a := range etx.EthTxAttempts {
+		if a.BroadcastBeforeBlockNum == nil {
+			continue
+		}
+		if firstBroadcastBlock == nil || *a.BroadcastBeforeBlockNum < *firstBroadcastBlock {
+			firstBroadcastBlock = a.BroadcastBeforeBlockNum
+		}
+	}
+	if firstBroadcastBlock == nil {
+		return false
+	}
+	return blockHeight-*firstBroadcastBlock >= int64(ec.config.EvmPrivateRelayFallbackBlocks())
+}
+
 func (ec *EthConfirmer) handleInProgressAttempt(ctx context.Context, etx EthTx, attempt EthTxAttempt, blockHeight int64) error {
 	if attempt.State != EthTxAttemptInProgress {
 		return errors.Errorf("invariant violation: expected eth_tx_attempt %v to be in_progress, it was %s", attempt.ID, attempt.State)
 	}
 
 	now := time.Now()
-	sendError := sendTransaction(ctx, ec.ethClient, attempt, etx, ec.lggr)
+	var sendError *eth.SendError
+	if ec.privateRelayer != nil && !ec.privateRelayWindowExpired(etx, blockHeight) {
+		sendError = sendTransactionViaPrivateRelay(ctx, ec.privateRelayer, ec.ethClient, attempt, etx, ec.lggr)
+	} else {
+		sendError = sendTransaction(ctx, ec.ethClient, attempt, etx, ec.lggr)
+	}
 
 	if sendError.IsTerminallyUnderpriced() {
 		// This should really not ever happen in normal operation since we
@@ -1225,7 +1378,11 @@ func (ec *EthConfirmer) markForRebroadcast(etx EthTx, head eth.Head) error {
 		}
 		return unbroadcastAttempt(tx, attempt)
 	})
-	return errors.Wrap(err, "markForRebroadcast failed")
+	if err != nil {
+		return errors.Wrap(err, "markForRebroadcast failed")
+	}
+	notifyTxLifecycleEvent(ec.db, ec.lggr, TxLifecycleEvent{EthTxID: etx.ID, Event: TxEventReorgedOut, FromAddress: etx.FromAddress, Nonce: etx.Nonce, TxHash: &attempt.Hash})
+	return nil
 }
 
 func deleteAllReceipts(db *gorm.DB, etxID int64) error {
@@ -2467,3 +2467,112 @@ func TestEthConfirmer_ResumePendingRuns(t *testing.T) {
 	})
 
 }
+
+func TestEthConfirmer_ExpireOldTransactions(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	expiredEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 0, fromAddress)
+	liveEtx := cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 1, fromAddress)
+
+	t.Run("does nothing if no transactions have expired", func(t *testing.T) {
+		require.NoError(t, ec.ExpireOldTransactions(context.Background()))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, expiredEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET expires_at = NOW() - interval '1 minute' WHERE id = ?`, expiredEtx.ID).Error)
+
+	t.Run("sends a cancellation transaction and marks the eth_tx as expired", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(0) && tx.Value().Cmp(big.NewInt(0)) == 0 && *tx.To() == fromAddress
+		})).Return(nil).Once()
+
+		require.NoError(t, ec.ExpireOldTransactions(context.Background()))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, expiredEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxExpired, etx.State)
+		require.True(t, etx.Error.Valid)
+		assert.Contains(t, etx.Error.String, "expired without confirmation")
+
+		etx, err = cltest.FindEthTxWithAttempts(db, liveEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+
+	require.NoError(t, db.Exec(`UPDATE eth_txes SET expires_at = NOW() - interval '1 minute' WHERE id = ?`, liveEtx.ID).Error)
+
+	t.Run("leaves the eth_tx alone if the cancellation transaction cannot be sent", func(t *testing.T) {
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *types.Transaction) bool {
+			return tx.Nonce() == uint64(1)
+		})).Return(errors.New("nonce too low")).Once()
+
+		require.NoError(t, ec.ExpireOldTransactions(context.Background()))
+
+		etx, err := cltest.FindEthTxWithAttempts(db, liveEtx.ID)
+		require.NoError(t, err)
+		assert.Equal(t, bulletprooftxmanager.EthTxUnconfirmed, etx.State)
+
+		ethClient.AssertExpectations(t)
+	})
+}
+
+func TestEthConfirmer_SaveCheckpoints(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	sqlxdb := postgres.UnwrapGormDB(db)
+
+	ethKeyStore := cltest.NewKeyStore(t, sqlxdb).Eth()
+
+	ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+
+	state, fromAddress := cltest.MustInsertRandomKeyReturningState(t, ethKeyStore, 0)
+
+	config := newTestChainScopedConfig(t)
+	ec := cltest.NewEthConfirmer(t, db, ethClient, config, ethKeyStore, []ethkey.State{state}, nil)
+
+	loadCheckpoint := func(t *testing.T) (checkpoint bulletprooftxmanager.EthTxCheckpoint) {
+		require.NoError(t, db.First(&checkpoint, "from_address = ?", fromAddress).Error)
+		return
+	}
+
+	t.Run("records a nil nonce when nothing is in flight", func(t *testing.T) {
+		require.NoError(t, ec.SaveCheckpoints(42))
+
+		checkpoint := loadCheckpoint(t)
+		assert.Nil(t, checkpoint.LowestUnconfirmedNonce)
+		assert.Equal(t, int64(42), checkpoint.LastProcessedBlockNum)
+	})
+
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 0, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 1, fromAddress)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 2, fromAddress)
+
+	t.Run("records the lowest in-flight nonce and updates the existing row", func(t *testing.T) {
+		require.NoError(t, ec.SaveCheckpoints(43))
+
+		checkpoint := loadCheckpoint(t)
+		require.NotNil(t, checkpoint.LowestUnconfirmedNonce)
+		assert.Equal(t, int64(1), *checkpoint.LowestUnconfirmedNonce)
+		assert.Equal(t, int64(43), checkpoint.LastProcessedBlockNum)
+	})
+}
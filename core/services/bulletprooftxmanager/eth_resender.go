@@ -131,7 +131,10 @@ func (er *EthResender) resendUnconfirmed() error {
 		er.logger.Debugw(fmt.Sprintf("Batch resending transactions %v thru %v", i, j))
 
 		ctx, cancel := eth.DefaultQueryCtx()
-		if err := er.ethClient.BatchCallContext(ctx, reqs[i:j]); err != nil {
+		// Rebroadcast through every node in the pool, not just one; a
+		// provider that silently dropped the tx may be paired with one that
+		// still has it
+		if err := er.ethClient.BatchCallContextAll(ctx, reqs[i:j]); err != nil {
 			return errors.Wrap(err, "failed to re-send transactions")
 		}
 		cancel()
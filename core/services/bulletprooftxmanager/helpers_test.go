@@ -1,6 +1,8 @@
 package bulletprooftxmanager
 
 import (
+	"context"
+
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 )
 
@@ -11,3 +13,14 @@ func SetEthClientOnEthConfirmer(ethClient eth.Client, ethConfirmer *EthConfirmer
 func SetResumeCallbackOnEthBroadcaster(resumeCallback ResumeCallback, ethBroadcaster *EthBroadcaster) {
 	ethBroadcaster.resumeCallback = resumeCallback
 }
+
+func FlushBatcher(b *Batcher) {
+	b.flush()
+}
+
+// AccessListResult exposes the eth_createAccessList response shape for tests
+type AccessListResult = createAccessListResult
+
+func CheckNonceGaps(m *NonceGapMonitor) error {
+	return m.checkAll(context.Background())
+}
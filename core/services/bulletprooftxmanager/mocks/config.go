@@ -89,6 +89,34 @@ func (_m *Config) ChainType() chains.ChainType {
 	return r0
 }
 
+// EthTxAttemptGCKeepCount provides a mock function with given fields:
+func (_m *Config) EthTxAttemptGCKeepCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EthTxReaperArchiveEnabled provides a mock function with given fields:
+func (_m *Config) EthTxReaperArchiveEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *Config) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
@@ -383,6 +411,62 @@ func (_m *Config) EvmNonceAutoSync() bool {
 	return r0
 }
 
+// EvmAutoCreateAccessList provides a mock function with given fields:
+func (_m *Config) EvmAutoCreateAccessList() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmNonceGapRepair provides a mock function with given fields:
+func (_m *Config) EvmNonceGapRepair() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmPrivateRelayFallbackBlocks provides a mock function with given fields:
+func (_m *Config) EvmPrivateRelayFallbackBlocks() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmPrivateRelayURL provides a mock function with given fields:
+func (_m *Config) EvmPrivateRelayURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // EvmRPCDefaultBatchSize provides a mock function with given fields:
 func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	ret := _m.Called()
@@ -397,6 +481,76 @@ func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeHeadroom provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBaseFeeHeadroom() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockHistorySize provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockHistorySize() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorCapBehavior provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorCapBehavior() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorExternalURL provides a mock function with given fields:
+func (_m *Config) GasEstimatorExternalURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
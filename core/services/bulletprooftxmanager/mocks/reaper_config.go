@@ -13,6 +13,34 @@ type ReaperConfig struct {
 	mock.Mock
 }
 
+// EthTxAttemptGCKeepCount provides a mock function with given fields:
+func (_m *ReaperConfig) EthTxAttemptGCKeepCount() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// EthTxReaperArchiveEnabled provides a mock function with given fields:
+func (_m *ReaperConfig) EthTxReaperArchiveEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EthTxReaperInterval provides a mock function with given fields:
 func (_m *ReaperConfig) EthTxReaperInterval() time.Duration {
 	ret := _m.Called()
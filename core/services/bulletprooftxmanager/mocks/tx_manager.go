@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	big "math/big"
+
 	common "github.com/ethereum/go-ethereum/common"
 	bulletprooftxmanager "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 
@@ -36,6 +38,27 @@ func (_m *TxManager) Close() error {
 	return r0
 }
 
+// BumpGasForEthTx provides a mock function with given fields: ctx, etxID, gasPriceWei
+func (_m *TxManager) BumpGasForEthTx(ctx context.Context, etxID int64, gasPriceWei *big.Int) (bulletprooftxmanager.EthTxAttempt, error) {
+	ret := _m.Called(ctx, etxID, gasPriceWei)
+
+	var r0 bulletprooftxmanager.EthTxAttempt
+	if rf, ok := ret.Get(0).(func(context.Context, int64, *big.Int) bulletprooftxmanager.EthTxAttempt); ok {
+		r0 = rf(ctx, etxID, gasPriceWei)
+	} else {
+		r0 = ret.Get(0).(bulletprooftxmanager.EthTxAttempt)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, int64, *big.Int) error); ok {
+		r1 = rf(ctx, etxID, gasPriceWei)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateEthTransaction provides a mock function with given fields: newTx, qopts
 func (_m *TxManager) CreateEthTransaction(newTx bulletprooftxmanager.NewTx, qopts ...postgres.QOpt) (bulletprooftxmanager.EthTx, error) {
 	_va := make([]interface{}, len(qopts))
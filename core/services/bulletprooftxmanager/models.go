@@ -5,6 +5,7 @@ import (
 	"database/sql/driver"
 	"encoding/json"
 	"fmt"
+	"math/big"
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -26,6 +27,9 @@ type EthTxMeta struct {
 	JobID         int32
 	RequestID     common.Hash
 	RequestTxHash common.Hash
+	// Used for VRFv2 batch fulfillments - all request IDs
+	// included in the batch
+	RequestIDs []common.Hash
 	// Used for the VRFv2 - max link this tx will bill
 	// should it get bumped
 	MaxLink string
@@ -45,12 +49,105 @@ const (
 	EthTxUnconfirmed             = EthTxState("unconfirmed")
 	EthTxConfirmed               = EthTxState("confirmed")
 	EthTxConfirmedMissingReceipt = EthTxState("confirmed_missing_receipt")
+	EthTxExpired                 = EthTxState("expired")
 
 	EthTxAttemptInProgress      = EthTxAttemptState("in_progress")
 	EthTxAttemptInsufficientEth = EthTxAttemptState("insufficient_eth")
 	EthTxAttemptBroadcast       = EthTxAttemptState("broadcast")
 )
 
+// TxPriority determines how aggressively an eth_tx is priced relative to
+// others sent from the same node. It maps to a multiplier on the estimator's
+// initial gas price and on the increment applied when gas is bumped, so e.g.
+// a VRF fulfillment can outbid a routine keeper upkeep
+type TxPriority string
+
+const (
+	TxPriorityLow    = TxPriority("low")
+	TxPriorityNormal = TxPriority("normal")
+	TxPriorityUrgent = TxPriority("urgent")
+)
+
+// gasPriceMultiplier is the factor applied to the estimator's gas price when
+// attempts for a transaction of this priority are created
+func (p TxPriority) gasPriceMultiplier() float64 {
+	switch p {
+	case TxPriorityLow:
+		return 0.8
+	case TxPriorityUrgent:
+		return 1.5
+	default:
+		return 1.0
+	}
+}
+
+// bumpMultiplier is the factor applied to the gas bump increment, so urgent
+// transactions climb in price faster than normal ones
+func (p TxPriority) bumpMultiplier() float64 {
+	switch p {
+	case TxPriorityUrgent:
+		return 2.0
+	default:
+		return 1.0
+	}
+}
+
+// ScaleBump amplifies the increment of a bumped gas price according to the
+// priority. The result is capped at maxGasPriceWei
+func (p TxPriority) ScaleBump(original, bumped, maxGasPriceWei *big.Int) *big.Int {
+	mult := p.bumpMultiplier()
+	if mult == 1.0 || original == nil || bumped == nil {
+		return bumped
+	}
+	increment := new(big.Int).Sub(bumped, original)
+	if increment.Sign() <= 0 {
+		return bumped
+	}
+	scaled, _ := new(big.Float).Mul(new(big.Float).SetInt(increment), big.NewFloat(mult)).Int(nil)
+	result := new(big.Int).Add(original, scaled)
+	if maxGasPriceWei != nil && result.Cmp(maxGasPriceWei) > 0 {
+		return maxGasPriceWei
+	}
+	return result
+}
+
+type SimulationPolicy string
+
+const (
+	// SimulationPolicyAbort fatally errors the eth_tx if simulation reverts (the default)
+	SimulationPolicyAbort = SimulationPolicy("abort")
+	// SimulationPolicyProceed logs the revert and broadcasts the eth_tx anyway
+	SimulationPolicyProceed = SimulationPolicy("proceed")
+)
+
+// SimulationResult captures the outcome of a pre-broadcast eth_call
+// simulation so that failed transactions carry actionable diagnostics
+type SimulationResult struct {
+	Passed       bool   `json:"passed"`
+	ReturnValue  string `json:"returnValue,omitempty"`
+	Error        string `json:"error,omitempty"`
+	RevertReason string `json:"revertReason,omitempty"`
+}
+
+// Value returns this instance serialized for database storage
+func (s SimulationResult) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
+
+// Scan returns the simulation result from its serialization in the database
+func (s *SimulationResult) Scan(value interface{}) error {
+	switch v := value.(type) {
+	case []byte:
+		return json.Unmarshal(v, s)
+	default:
+		return errors.Errorf("unable to convert %v of %T to SimulationResult", value, value)
+	}
+}
+
+func (SimulationResult) GormDataType() string {
+	return "jsonb"
+}
+
 type NullableEIP2930AccessList struct {
 	AccessList types.AccessList
 	Valid      bool
@@ -123,7 +220,11 @@ type EthTx struct {
 	// GasLimit on the EthTx is always the conceptual gas limit, which is not
 	// necessarily the same as the on-chain encoded value (i.e. Optimism)
 	GasLimit uint64
-	Error    null.String
+	// GasPriceMultiplier optionally scales the estimator's gas price when
+	// attempts for this eth_tx are created. It is recorded on the eth_tx so
+	// operators can see which job requested what.
+	GasPriceMultiplier null.Float
+	Error              null.String
 	// BroadcastAt is updated every time an attempt for this eth_tx is re-sent
 	// In almost all cases it will be within a second or so of the actual send time.
 	BroadcastAt   *time.Time
@@ -147,6 +248,36 @@ type EthTx struct {
 	// Simulate if set to true will cause this eth_tx to be simulated before
 	// initial send and aborted on revert
 	Simulate bool
+
+	// ExpiresAt optionally sets a TTL on the eth_tx. If it has not been
+	// confirmed by this time, the EthConfirmer stops bumping it, consumes the
+	// nonce with a zero-value self-transfer and marks the eth_tx as expired
+	ExpiresAt *time.Time
+	// SimulationPolicy controls what happens if simulation reverts: "abort"
+	// (the default) fatally errors the eth_tx, "proceed" logs the revert and
+	// broadcasts anyway
+	SimulationPolicy null.String
+
+	// Priority scales initial gas pricing and bump aggressiveness relative to
+	// other transactions from this node
+	Priority TxPriority
+}
+
+// ScaledGasPrice applies the eth_tx's gas price multiplier (if set) and
+// priority multiplier, clamping the result to the given maximum gas price
+func (e EthTx) ScaledGasPrice(gasPrice *big.Int, maxGasPriceWei *big.Int) *big.Int {
+	multiplier := e.Priority.gasPriceMultiplier()
+	if e.GasPriceMultiplier.Valid {
+		multiplier *= e.GasPriceMultiplier.Float64
+	}
+	if multiplier == 1.0 || gasPrice == nil {
+		return gasPrice
+	}
+	scaled, _ := new(big.Float).Mul(new(big.Float).SetInt(gasPrice), big.NewFloat(multiplier)).Int(nil)
+	if maxGasPriceWei != nil && scaled.Cmp(maxGasPriceWei) > 0 {
+		return maxGasPriceWei
+	}
+	return scaled
 }
 
 func (e EthTx) GetError() error {
@@ -177,8 +308,10 @@ type EthTxAttempt struct {
 	CreatedAt               time.Time
 	BroadcastBeforeBlockNum *int64
 	State                   EthTxAttemptState
-	EthReceipts             []EthReceipt `gorm:"foreignKey:TxHash;references:Hash;association_foreignkey:Hash;->" json:"-"`
-	TxType                  int
+	// SimulationResult is only set if the eth_tx was simulated before initial broadcast
+	SimulationResult *SimulationResult
+	EthReceipts      []EthReceipt `gorm:"foreignKey:TxHash;references:Hash;association_foreignkey:Hash;->" json:"-"`
+	TxType           int
 }
 
 // GetSignedTx decodes the SignedRawTx into a types.Transaction struct
@@ -199,6 +332,20 @@ func (a EthTxAttempt) DynamicFee() gas.DynamicFee {
 	}
 }
 
+// EthTxCheckpoint persists per-key recovery state so that after a restart
+// the EthConfirmer only needs to consider transactions at or above the lowest
+// nonce that had not fully settled, instead of rescanning the whole history
+type EthTxCheckpoint struct {
+	FromAddress common.Address
+	EVMChainID  utils.Big `gorm:"column:evm_chain_id"`
+	// LowestUnconfirmedNonce is the lowest nonce that was still in flight
+	// (in_progress, unconfirmed or confirmed_missing_receipt) when the
+	// checkpoint was taken; nil means nothing was in flight
+	LowestUnconfirmedNonce *int64
+	LastProcessedBlockNum  int64
+	UpdatedAt              time.Time
+}
+
 type EthReceipt struct {
 	ID               int64
 	TxHash           common.Hash
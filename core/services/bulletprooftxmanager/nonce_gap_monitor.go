@@ -0,0 +1,193 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"database/sql"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.uber.org/multierr"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/keystore/keys/ethkey"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// defaultNonceGapMonitorPollInterval is how often we compare on-chain nonces
+// with our locally tracked ones. Gaps are rare so there is no need to check
+// aggressively
+const defaultNonceGapMonitorPollInterval = 5 * time.Minute
+
+var promNonceGap = promauto.NewGaugeVec(prometheus.GaugeOpts{
+	Name: "tx_manager_nonce_gap",
+	Help: "The size of the gap between the on-chain nonce and the locally tracked nonce per key. A non-zero value indicates the key has been used outside of this node",
+}, []string{"fromAddress", "evmChainID"})
+
+// NonceGapMonitor periodically compares the on-chain account nonce with the
+// locally tracked nonces for each key. A gap can open at runtime if e.g.
+// somebody sends a transaction from the same key using an external wallet.
+//
+// Two kinds of gap are detected:
+//
+// 1. The on-chain pending nonce is ahead of the local next nonce.
+// Transactions we know nothing about have been sent from this key. If repair
+// is enabled we fastforward the local nonce the same way the NonceSyncer does
+// on application start.
+//
+// 2. The on-chain pending nonce is behind the lowest nonce of our unconfirmed
+// transactions and we hold no transaction at the on-chain nonce. A nonce
+// below our unconfirmed transactions was never filled (e.g. an externally
+// sent transaction was dropped from the mempool after we fastforwarded past
+// it) so nothing we have broadcast can ever confirm. If repair is enabled we
+// inject a zero-value self-transaction at the missing nonce to plug the gap.
+//
+// In all cases the gap is logged and exported as a prometheus gauge so
+// operators can alert on it.
+type NonceGapMonitor struct {
+	db        *gorm.DB
+	ethClient eth.Client
+	config    Config
+	keyStore  KeyStore
+	chainID   big.Int
+	keyStates []ethkey.State
+	interval  time.Duration
+	logger    logger.Logger
+
+	chStop chan struct{}
+	chDone chan struct{}
+}
+
+func NewNonceGapMonitor(lggr logger.Logger, db *gorm.DB, ethClient eth.Client, config Config, keyStore KeyStore, keyStates []ethkey.State, pollInterval time.Duration) *NonceGapMonitor {
+	return &NonceGapMonitor{
+		db,
+		ethClient,
+		config,
+		keyStore,
+		*ethClient.ChainID(),
+		keyStates,
+		pollInterval,
+		lggr.Named("NonceGapMonitor"),
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+func (m *NonceGapMonitor) Start() {
+	m.logger.Debugf("Enabled with poll interval of %s (automatic repair: %v)", m.interval, m.config.EvmNonceGapRepair())
+	go m.runLoop()
+}
+
+func (m *NonceGapMonitor) Stop() {
+	close(m.chStop)
+	<-m.chDone
+}
+
+func (m *NonceGapMonitor) runLoop() {
+	defer close(m.chDone)
+
+	ticker := time.NewTicker(utils.WithJitter(m.interval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.chStop:
+			return
+		case <-ticker.C:
+			if err := m.checkAll(context.TODO()); err != nil {
+				m.logger.Warnw("Failed to check for nonce gaps", "err", err)
+			}
+		}
+	}
+}
+
+func (m *NonceGapMonitor) checkAll(ctx context.Context) (merr error) {
+	for _, keyState := range m.keyStates {
+		if err := m.checkKey(ctx, keyState.Address.Address()); err != nil {
+			merr = multierr.Combine(merr, errors.Wrapf(err, "failed to check for nonce gap on key %s", keyState.Address.Hex()))
+		}
+	}
+	return merr
+}
+
+func (m *NonceGapMonitor) checkKey(ctx context.Context, address common.Address) error {
+	callCtx, cancel := eth.DefaultQueryCtx(ctx)
+	defer cancel()
+	chainNonce, err := m.ethClient.PendingNonceAt(callCtx, address)
+	if err != nil {
+		return errors.Wrap(err, "failed to get on-chain pending nonce")
+	}
+
+	localNonce, err := GetNextNonce(m.db, address, &m.chainID)
+	if err != nil {
+		return err
+	}
+
+	if chainNonce > uint64(localNonce) {
+		promNonceGap.WithLabelValues(address.Hex(), m.chainID.String()).Set(float64(chainNonce - uint64(localNonce)))
+		m.logger.Errorw(fmt.Sprintf("NonceGapMonitor: address %s has been used outside of this node; local next nonce is %v but the on-chain nonce is %v. "+
+			"Please note that using chainlink keys with an external wallet is NOT SUPPORTED and can lead to missed or stuck transactions",
+			address.Hex(), localNonce, chainNonce),
+			"address", address.Hex(), "localNonce", localNonce, "chainNonce", chainNonce)
+		if m.config.EvmNonceGapRepair() {
+			m.logger.Infow("NonceGapMonitor: fastforwarding local nonce to match chain", "address", address.Hex(), "localNonce", localNonce, "chainNonce", chainNonce)
+			return NewNonceSyncer(m.db, m.ethClient).fastForwardNonceIfNecessary(ctx, address)
+		}
+		return nil
+	}
+
+	lowestUnconfirmed, hasUnconfirmed, err := m.lowestUnconfirmedNonce(address)
+	if err != nil {
+		return err
+	}
+	if hasUnconfirmed && chainNonce < uint64(lowestUnconfirmed) {
+		hasTxAtChainNonce, err := m.hasTxAtNonce(address, int64(chainNonce))
+		if err != nil {
+			return err
+		}
+		if !hasTxAtChainNonce {
+			promNonceGap.WithLabelValues(address.Hex(), m.chainID.String()).Set(float64(uint64(lowestUnconfirmed) - chainNonce))
+			m.logger.Errorw(fmt.Sprintf("NonceGapMonitor: address %s has unconfirmed transactions from nonce %v but the on-chain nonce %v is not tracked by this node. "+
+				"These transactions can never confirm until the gap is filled",
+				address.Hex(), lowestUnconfirmed, chainNonce),
+				"address", address.Hex(), "lowestUnconfirmedNonce", lowestUnconfirmed, "chainNonce", chainNonce)
+			if m.config.EvmNonceGapRepair() {
+				return m.fillGapWithEmptyTransaction(address, chainNonce)
+			}
+			return nil
+		}
+	}
+
+	promNonceGap.WithLabelValues(address.Hex(), m.chainID.String()).Set(0)
+	return nil
+}
+
+func (m *NonceGapMonitor) lowestUnconfirmedNonce(address common.Address) (nonce int64, exists bool, err error) {
+	var min sql.NullInt64
+	err = m.db.Raw(`SELECT min(nonce) FROM eth_txes WHERE from_address = ? AND state = 'unconfirmed' AND evm_chain_id = ?`, address, m.chainID.String()).Scan(&min).Error
+	if err != nil {
+		return 0, false, errors.Wrap(err, "failed to query lowest unconfirmed nonce")
+	}
+	return min.Int64, min.Valid, nil
+}
+
+func (m *NonceGapMonitor) hasTxAtNonce(address common.Address, nonce int64) (exists bool, err error) {
+	err = m.db.Raw(`SELECT EXISTS(SELECT 1 FROM eth_txes WHERE from_address = ? AND nonce = ? AND evm_chain_id = ?)`, address, nonce, m.chainID.String()).Scan(&exists).Error
+	return exists, errors.Wrap(err, "failed to query for eth_tx at nonce")
+}
+
+func (m *NonceGapMonitor) fillGapWithEmptyTransaction(address common.Address, nonce uint64) error {
+	gasLimit := m.config.EvmGasLimitDefault()
+	gasPriceWei := m.config.EvmGasPriceDefault()
+	tx, err := sendEmptyTransaction(m.ethClient, m.keyStore, nonce, gasLimit, gasPriceWei, address, &m.chainID)
+	if err != nil {
+		return errors.Wrap(err, "NonceGapMonitor: failed to send empty transaction to fill nonce gap")
+	}
+	m.logger.Infow("NonceGapMonitor: sent empty transaction to fill nonce gap", "address", address.Hex(), "nonce", nonce, "txHash", tx.Hash())
+	return nil
+}
@@ -0,0 +1,127 @@
+package bulletprooftxmanager_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	bptxmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func Test_NonceGapMonitor_CheckAll(t *testing.T) {
+	t.Parallel()
+
+	t.Run("does nothing if the chain nonce reflects the local nonce", func(t *testing.T) {
+		db := pgtest.NewGormDB(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+		config := new(bptxmmocks.Config)
+
+		_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return from == addr
+		})).Return(uint64(0), nil)
+
+		keyStates := cltest.MustSendingKeyStates(t, ethKeyStore)
+		m := bulletprooftxmanager.NewNonceGapMonitor(logger.TestLogger(t), db, ethClient, config, ethKeyStore, keyStates, time.Hour)
+
+		require.NoError(t, bulletprooftxmanager.CheckNonceGaps(m))
+
+		ethClient.AssertExpectations(t)
+		config.AssertExpectations(t)
+	})
+
+	t.Run("detects chain nonce ahead of local nonce without repairing when disabled", func(t *testing.T) {
+		db := pgtest.NewGormDB(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+		config := new(bptxmmocks.Config)
+		config.On("EvmNonceGapRepair").Return(false)
+
+		_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return from == addr
+		})).Return(uint64(5), nil)
+
+		keyStates := cltest.MustSendingKeyStates(t, ethKeyStore)
+		m := bulletprooftxmanager.NewNonceGapMonitor(logger.TestLogger(t), db, ethClient, config, ethKeyStore, keyStates, time.Hour)
+
+		require.NoError(t, bulletprooftxmanager.CheckNonceGaps(m))
+
+		assertDatabaseNonce(t, db, from, 0)
+
+		ethClient.AssertExpectations(t)
+		config.AssertExpectations(t)
+	})
+
+	t.Run("fastforwards the local nonce when chain nonce is ahead and repair is enabled", func(t *testing.T) {
+		db := pgtest.NewGormDB(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+		config := new(bptxmmocks.Config)
+		config.On("EvmNonceGapRepair").Return(true)
+
+		_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return from == addr
+		})).Return(uint64(5), nil)
+
+		keyStates := cltest.MustSendingKeyStates(t, ethKeyStore)
+		m := bulletprooftxmanager.NewNonceGapMonitor(logger.TestLogger(t), db, ethClient, config, ethKeyStore, keyStates, time.Hour)
+
+		require.NoError(t, bulletprooftxmanager.CheckNonceGaps(m))
+
+		assertDatabaseNonce(t, db, from, 5)
+
+		ethClient.AssertExpectations(t)
+		config.AssertExpectations(t)
+	})
+
+	t.Run("injects an empty transaction when a nonce below our unconfirmed transactions was never filled", func(t *testing.T) {
+		db := pgtest.NewGormDB(t)
+		ethClient := cltest.NewEthClientMockWithDefaultChain(t)
+		ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+		config := new(bptxmmocks.Config)
+		config.On("EvmNonceGapRepair").Return(true)
+		config.On("EvmGasLimitDefault").Return(uint64(21000))
+		config.On("EvmGasPriceDefault").Return(assets.GWei(20))
+
+		_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+		// Local nonce is ahead of chain, with unconfirmed transactions at
+		// nonces 3 and 4 but nothing at the on-chain nonce 2
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 3, from)
+		cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 4, from)
+		require.NoError(t, db.Exec(`UPDATE eth_key_states SET next_nonce = 5 WHERE address = ?`, from).Error)
+
+		ethClient.On("PendingNonceAt", mock.Anything, mock.MatchedBy(func(addr common.Address) bool {
+			return from == addr
+		})).Return(uint64(2), nil)
+		ethClient.On("SendTransaction", mock.Anything, mock.MatchedBy(func(tx *gethTypes.Transaction) bool {
+			return tx.Nonce() == uint64(2)
+		})).Return(nil).Once()
+
+		keyStates := cltest.MustSendingKeyStates(t, ethKeyStore)
+		m := bulletprooftxmanager.NewNonceGapMonitor(logger.TestLogger(t), db, ethClient, config, ethKeyStore, keyStates, time.Hour)
+
+		require.NoError(t, bulletprooftxmanager.CheckNonceGaps(m))
+
+		assertDatabaseNonce(t, db, from, 5)
+
+		ethClient.AssertExpectations(t)
+		config.AssertExpectations(t)
+	})
+}
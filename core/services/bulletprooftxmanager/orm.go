@@ -9,6 +9,7 @@ type ORM interface {
 	EthTransactionsWithAttempts(offset, limit int) ([]EthTx, int, error)
 	EthTxAttempts(offset, limit int) ([]EthTxAttempt, int, error)
 	FindEthTxAttempt(hash common.Hash) (*EthTxAttempt, error)
+	InflightEthTransactions() ([]EthTx, error)
 }
 
 type orm struct {
@@ -97,6 +98,18 @@ func (o *orm) EthTransactionsWithAttempts(offset, limit int) (txs []EthTx, count
 	return
 }
 
+// InflightEthTransactions returns all eth transactions that have not yet been
+// confirmed, in nonce order per address and chain. Attempts are sorted by
+// created_at.
+func (o *orm) InflightEthTransactions() (txs []EthTx, err error) {
+	sql := `SELECT * FROM eth_txes WHERE state IN ('in_progress', 'unconfirmed', 'confirmed_missing_receipt') ORDER BY from_address, evm_chain_id, nonce ASC`
+	if err = o.db.Select(&txs, sql); err != nil {
+		return
+	}
+	err = o.preloadTxAttempts(txs)
+	return
+}
+
 // EthTxAttempts returns the last tx attempts sorted by created_at descending.
 func (o *orm) EthTxAttempts(offset, limit int) (txs []EthTxAttempt, count int, err error) {
 	sql := `SELECT count(*) FROM eth_tx_attempts`
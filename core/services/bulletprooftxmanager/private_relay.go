@@ -0,0 +1,88 @@
+package bulletprooftxmanager
+
+import (
+	"context"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// PrivateRelayer submits signed transactions to a private relay endpoint
+// (e.g. Flashbots Protect) using eth_sendPrivateTransaction so that they skip
+// the public mempool. This is useful for MEV-sensitive transactions such as
+// VRF fulfillments.
+//
+// The relay connection is dialled lazily on first use and re-used afterwards.
+type PrivateRelayer struct {
+	url  string
+	lggr logger.Logger
+
+	mu  sync.Mutex
+	rpc *rpc.Client
+}
+
+// NewPrivateRelayer returns a relayer pointed at the given relay URL
+func NewPrivateRelayer(url string, lggr logger.Logger) *PrivateRelayer {
+	return &PrivateRelayer{
+		url:  url,
+		lggr: lggr.Named("PrivateRelayer"),
+	}
+}
+
+// SendTransaction submits the signed transaction to the relay using
+// eth_sendPrivateTransaction
+func (r *PrivateRelayer) SendTransaction(ctx context.Context, signedTx *gethTypes.Transaction) error {
+	client, err := r.client(ctx)
+	if err != nil {
+		return errors.Wrapf(err, "PrivateRelayer failed to dial %s", r.url)
+	}
+	rawTx, err := signedTx.MarshalBinary()
+	if err != nil {
+		return errors.Wrap(err, "PrivateRelayer failed to encode transaction")
+	}
+	arg := map[string]interface{}{
+		"tx": hexutil.Encode(rawTx),
+	}
+	var hash common.Hash
+	return errors.Wrap(client.CallContext(ctx, &hash, "eth_sendPrivateTransaction", arg), "eth_sendPrivateTransaction failed")
+}
+
+// Close tears down the relay connection (if one was established)
+func (r *PrivateRelayer) Close() {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.rpc != nil {
+		r.rpc.Close()
+		r.rpc = nil
+	}
+}
+
+func (r *PrivateRelayer) client(ctx context.Context) (*rpc.Client, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.rpc == nil {
+		client, err := rpc.DialContext(ctx, r.url)
+		if err != nil {
+			return nil, err
+		}
+		r.rpc = client
+	}
+	return r.rpc, nil
+}
+
+// newPrivateRelayerFromConfig returns a PrivateRelayer if a private relay URL
+// is configured for this chain, or nil if transactions should go to the
+// public mempool
+func newPrivateRelayerFromConfig(config Config, lggr logger.Logger) *PrivateRelayer {
+	url := config.EvmPrivateRelayURL()
+	if url == "" {
+		return nil
+	}
+	return NewPrivateRelayer(url, lggr)
+}
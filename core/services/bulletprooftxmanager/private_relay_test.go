@@ -0,0 +1,53 @@
+package bulletprooftxmanager_test
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	gethCommon "github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+)
+
+func TestPrivateRelayer_SendTransaction(t *testing.T) {
+	t.Parallel()
+
+	signedTx := gethTypes.NewTransaction(42, cltest.NewAddress(), big.NewInt(142), 21000, big.NewInt(1000000000), []byte{})
+
+	var gotMethod string
+	var gotTx string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var req struct {
+			ID     json.RawMessage          `json:"id"`
+			Method string                   `json:"method"`
+			Params []map[string]interface{} `json:"params"`
+		}
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&req))
+		gotMethod = req.Method
+		gotTx, _ = req.Params[0]["tx"].(string)
+		w.Header().Set("Content-Type", "application/json")
+		response := map[string]interface{}{"id": req.ID, "jsonrpc": "2.0", "result": gethCommon.Hash{}.Hex()}
+		require.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer server.Close()
+
+	relayer := bulletprooftxmanager.NewPrivateRelayer(server.URL, logger.TestLogger(t))
+	defer relayer.Close()
+
+	require.NoError(t, relayer.SendTransaction(context.Background(), signedTx))
+
+	assert.Equal(t, "eth_sendPrivateTransaction", gotMethod)
+	rawTx, err := signedTx.MarshalBinary()
+	require.NoError(t, err)
+	assert.Equal(t, hexutil.Encode(rawTx), gotTx)
+}
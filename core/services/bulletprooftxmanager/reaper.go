@@ -6,6 +6,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -13,10 +15,22 @@ import (
 	"gorm.io/gorm"
 )
 
+var promReapedEthTxes = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_reaped_eth_txes",
+	Help: "Number of old eth_txes removed (or archived) by the reaper",
+}, []string{"evmChainID", "state"})
+
+var promGCedEthTxAttempts = promauto.NewCounterVec(prometheus.CounterOpts{
+	Name: "tx_manager_gced_eth_tx_attempts",
+	Help: "Number of superseded eth_tx_attempts deleted by the reaper",
+}, []string{"evmChainID"})
+
 //go:generate mockery --name ReaperConfig --output ./mocks/ --case=underscore
 
 // ReaperConfig is the config subset used by the reaper
 type ReaperConfig interface {
+	EthTxAttemptGCKeepCount() uint16
+	EthTxReaperArchiveEnabled() bool
 	EthTxReaperInterval() time.Duration
 	EthTxReaperThreshold() time.Duration
 	EvmFinalityDepth() uint32
@@ -86,6 +100,10 @@ func (r *Reaper) work() {
 	if err != nil {
 		r.log.Error("BPTXMReaper: unable to reap old eth_txes: ", err)
 	}
+	err = r.GCEthTxAttempts()
+	if err != nil {
+		r.log.Error("BPTXMReaper: unable to GC superseded eth_tx_attempts: ", err)
+	}
 }
 
 // SetLatestBlockNum should be called on every new highest block number
@@ -116,8 +134,43 @@ func (r *Reaper) ReapEthTxes(headNum int64) error {
 	// Delete old confirmed eth_txes
 	// NOTE that this relies on foreign key triggers automatically removing
 	// the eth_tx_attempts and eth_receipts linked to every eth_tx
+	archive := r.config.EthTxReaperArchiveEnabled()
 	err := postgres.Batch(func(_, limit uint) (count uint, err error) {
-		res := r.db.Exec(`
+		var res *gorm.DB
+		if archive {
+			// Copy the batch (with its attempts and receipts) into the
+			// archive tables before removing it from the live tables
+			res = r.db.Exec(`
+WITH old_enough_receipts AS (
+	SELECT tx_hash FROM eth_receipts
+	WHERE block_number < ?
+	ORDER BY block_number ASC, id ASC
+	LIMIT ?
+), batch AS (
+	SELECT DISTINCT eth_txes.id FROM eth_txes
+	INNER JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+	INNER JOIN old_enough_receipts ON eth_tx_attempts.hash = old_enough_receipts.tx_hash
+	WHERE eth_txes.created_at < ?
+	AND eth_txes.state = 'confirmed'
+	AND evm_chain_id = ?
+), archived_attempts AS (
+	INSERT INTO eth_tx_attempts_archive
+	SELECT eth_tx_attempts.*, NOW() FROM eth_tx_attempts
+	WHERE eth_tx_attempts.eth_tx_id IN (SELECT id FROM batch)
+), archived_receipts AS (
+	INSERT INTO eth_receipts_archive
+	SELECT eth_receipts.*, NOW() FROM eth_receipts
+	INNER JOIN eth_tx_attempts ON eth_tx_attempts.hash = eth_receipts.tx_hash
+	WHERE eth_tx_attempts.eth_tx_id IN (SELECT id FROM batch)
+), archived_txes AS (
+	INSERT INTO eth_txes_archive
+	SELECT eth_txes.*, NOW() FROM eth_txes
+	WHERE eth_txes.id IN (SELECT id FROM batch)
+)
+DELETE FROM eth_txes
+WHERE id IN (SELECT id FROM batch)`, minBlockNumberToKeep, limit, timeThreshold, r.chainID)
+		} else {
+			res = r.db.Exec(`
 WITH old_enough_receipts AS (
 	SELECT tx_hash FROM eth_receipts
 	WHERE block_number < ?
@@ -131,9 +184,11 @@ AND eth_tx_attempts.hash = old_enough_receipts.tx_hash
 AND eth_txes.created_at < ?
 AND eth_txes.state = 'confirmed'
 AND evm_chain_id = ?`, minBlockNumberToKeep, limit, timeThreshold, r.chainID)
+		}
 		if res.Error != nil {
 			return count, res.Error
 		}
+		promReapedEthTxes.WithLabelValues(r.chainID.String(), "confirmed").Add(float64(res.RowsAffected))
 		return uint(res.RowsAffected), res.Error
 	})
 	if err != nil {
@@ -149,13 +204,78 @@ AND evm_chain_id = ?`, timeThreshold, r.chainID)
 		if res.Error != nil {
 			return count, res.Error
 		}
+		promReapedEthTxes.WithLabelValues(r.chainID.String(), "fatal_error").Add(float64(res.RowsAffected))
 		return uint(res.RowsAffected), res.Error
 	})
 	if err != nil {
 		return errors.Wrap(err, "BPTXMReaper#reapEthTxes batch delete of fatally errored eth_txes failed")
 	}
+	// Delete old 'expired' eth_txes
+	err = postgres.Batch(func(_, limit uint) (count uint, err error) {
+		res := r.db.Exec(`
+DELETE FROM eth_txes
+WHERE created_at < ?
+AND state = 'expired'
+AND evm_chain_id = ?`, timeThreshold, r.chainID)
+		if res.Error != nil {
+			return count, res.Error
+		}
+		promReapedEthTxes.WithLabelValues(r.chainID.String(), "expired").Add(float64(res.RowsAffected))
+		return uint(res.RowsAffected), res.Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "BPTXMReaper#reapEthTxes batch delete of expired eth_txes failed")
+	}
 
 	r.log.Debugf("BPTXMReaper: ReapEthTxes completed in %v", time.Since(mark))
 
 	return nil
 }
+
+// GCEthTxAttempts deletes superseded attempts of confirmed eth_txes, keeping
+// only the most recent ETH_TX_ATTEMPT_GC_KEEP_COUNT attempts per transaction.
+// The attempt that got the receipt is always kept. Keys that bump aggressively
+// can accumulate dozens of attempts per transaction, only one of which can
+// ever have been mined; the rest are dead weight once the transaction is
+// confirmed
+func (r *Reaper) GCEthTxAttempts() error {
+	keep := r.config.EthTxAttemptGCKeepCount()
+	if keep == 0 {
+		return nil
+	}
+	mark := time.Now()
+
+	r.log.Debugw("BPTXMReaper: GCing superseded attempts of confirmed eth_txes", "keepCount", keep)
+
+	err := postgres.Batch(func(_, limit uint) (count uint, err error) {
+		res := r.db.Exec(`
+WITH ranked AS (
+	SELECT eth_tx_attempts.id,
+		row_number() OVER (PARTITION BY eth_tx_attempts.eth_tx_id ORDER BY eth_tx_attempts.id DESC) AS rn,
+		EXISTS (SELECT 1 FROM eth_receipts WHERE eth_receipts.tx_hash = eth_tx_attempts.hash) AS has_receipt
+	FROM eth_tx_attempts
+	INNER JOIN eth_txes ON eth_txes.id = eth_tx_attempts.eth_tx_id
+	WHERE eth_txes.state = 'confirmed'
+	AND eth_txes.evm_chain_id = ?
+), batch AS (
+	SELECT id FROM ranked
+	WHERE rn > ?
+	AND NOT has_receipt
+	LIMIT ?
+)
+DELETE FROM eth_tx_attempts
+WHERE id IN (SELECT id FROM batch)`, r.chainID, keep, limit)
+		if res.Error != nil {
+			return count, res.Error
+		}
+		promGCedEthTxAttempts.WithLabelValues(r.chainID.String()).Add(float64(res.RowsAffected))
+		return uint(res.RowsAffected), res.Error
+	})
+	if err != nil {
+		return errors.Wrap(err, "BPTXMReaper#GCEthTxAttempts batch delete of superseded eth_tx_attempts failed")
+	}
+
+	r.log.Debugf("BPTXMReaper: GCEthTxAttempts completed in %v", time.Since(mark))
+
+	return nil
+}
@@ -11,7 +11,9 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 	"gorm.io/gorm"
 )
 
@@ -35,6 +37,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 
 	t.Run("with nothing in the database, doesn't error", func(t *testing.T) {
 		config := new(mocks.ReaperConfig)
+		config.On("EthTxReaperArchiveEnabled").Return(false)
 		config.On("EvmFinalityDepth").Return(uint32(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
@@ -51,6 +54,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 
 	t.Run("skips if threshold=0", func(t *testing.T) {
 		config := new(mocks.ReaperConfig)
+		config.On("EthTxReaperArchiveEnabled").Return(false)
 		config.On("EvmFinalityDepth").Return(uint32(10))
 		config.On("EthTxReaperThreshold").Return(0 * time.Second)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
@@ -65,6 +69,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 
 	t.Run("doesn't touch ethtxes with different chain ID", func(t *testing.T) {
 		config := new(mocks.ReaperConfig)
+		config.On("EthTxReaperArchiveEnabled").Return(false)
 		config.On("EvmFinalityDepth").Return(uint32(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
@@ -79,6 +84,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 
 	t.Run("deletes confirmed eth_txes that exceed the age threshold with at least ETH_FINALITY_DEPTH blocks above their receipt", func(t *testing.T) {
 		config := new(mocks.ReaperConfig)
+		config.On("EthTxReaperArchiveEnabled").Return(false)
 		config.On("EvmFinalityDepth").Return(uint32(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
@@ -107,6 +113,7 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 
 	t.Run("deletes errored eth_txes that exceed the age threshold", func(t *testing.T) {
 		config := new(mocks.ReaperConfig)
+		config.On("EthTxReaperArchiveEnabled").Return(false)
 		config.On("EvmFinalityDepth").Return(uint32(10))
 		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
 		config.On("EthTxReaperInterval").Return(1 * time.Hour)
@@ -125,4 +132,77 @@ func TestReaper_ReapEthTxes(t *testing.T) {
 		// Deleted because it is old enough now
 		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 0)
 	})
+
+	t.Run("archives confirmed eth_txes with their attempts and receipts if ETH_TX_REAPER_ARCHIVE_ENABLED is set", func(t *testing.T) {
+		config := new(mocks.ReaperConfig)
+		config.On("EthTxReaperArchiveEnabled").Return(true)
+		config.On("EvmFinalityDepth").Return(uint32(10))
+		config.On("EthTxReaperThreshold").Return(1 * time.Hour)
+		config.On("EthTxReaperInterval").Return(1 * time.Hour)
+
+		// Confirmed in block number 5
+		cltest.MustInsertConfirmedEthTxWithReceipt(t, db, from, nonce, 5)
+		nonce++
+		db.Exec(`UPDATE eth_txes SET created_at=?`, oneDayAgo)
+
+		r := newReaper(t, db, config)
+
+		err := r.ReapEthTxes(42)
+		assert.NoError(t, err)
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTx{}, 0)
+
+		var count int
+		require.NoError(t, db.Raw(`SELECT count(*) FROM eth_txes_archive`).Scan(&count).Error)
+		assert.Equal(t, 1, count)
+		require.NoError(t, db.Raw(`SELECT count(*) FROM eth_tx_attempts_archive`).Scan(&count).Error)
+		assert.Equal(t, 1, count)
+		require.NoError(t, db.Raw(`SELECT count(*) FROM eth_receipts_archive`).Scan(&count).Error)
+		assert.Equal(t, 1, count)
+	})
+}
+
+func TestReaper_GCEthTxAttempts(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	ethKeyStore := cltest.NewKeyStore(t, postgres.UnwrapGormDB(db)).Eth()
+
+	_, from := cltest.MustAddRandomKeyToKeystore(t, ethKeyStore)
+
+	// Confirmed in block number 5, with three superseded attempts that lost
+	// the bumping race
+	etx := cltest.MustInsertConfirmedEthTxWithReceipt(t, db, from, 0, 5)
+	for i := 0; i < 3; i++ {
+		attempt := cltest.NewLegacyEthTxAttempt(t, etx.ID)
+		attempt.State = bulletprooftxmanager.EthTxAttemptBroadcast
+		attempt.GasPrice = utils.NewBig(big.NewInt(int64(2 + i)))
+		require.NoError(t, db.Save(&attempt).Error)
+	}
+
+	t.Run("does nothing if ETH_TX_ATTEMPT_GC_KEEP_COUNT is 0", func(t *testing.T) {
+		config := new(mocks.ReaperConfig)
+		config.On("EthTxAttemptGCKeepCount").Return(uint16(0))
+
+		r := newReaper(t, db, config)
+
+		err := r.GCEthTxAttempts()
+		assert.NoError(t, err)
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTxAttempt{}, 4)
+	})
+
+	t.Run("deletes superseded attempts beyond the keep count but never the receipted attempt", func(t *testing.T) {
+		config := new(mocks.ReaperConfig)
+		config.On("EthTxAttemptGCKeepCount").Return(uint16(1))
+
+		r := newReaper(t, db, config)
+
+		err := r.GCEthTxAttempts()
+		assert.NoError(t, err)
+		// The newest attempt plus the one with the receipt survive
+		cltest.AssertCount(t, db, bulletprooftxmanager.EthTxAttempt{}, 2)
+
+		var count int
+		require.NoError(t, db.Raw(`SELECT count(*) FROM eth_tx_attempts WHERE hash = ?`, etx.EthTxAttempts[0].Hash).Scan(&count).Error)
+		assert.Equal(t, 1, count)
+	})
 }
@@ -0,0 +1,53 @@
+package bulletprooftxmanager
+
+import (
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/smartcontractkit/chainlink/core/chains"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+)
+
+// TxEncoder constructs the unsigned transaction for a new attempt, for chains
+// whose transaction format deviates from standard Ethereum - e.g. Celo's fee
+// currency fields, or zkSync's EIP-712 transactions. The returned transaction
+// may use any go-ethereum types.TxData implementation, so encoders can add
+// chain-specific fields without forking the txmanager
+type TxEncoder interface {
+	// NewLegacyTx returns the unsigned transaction to be signed for a legacy
+	// (type 0x0) attempt
+	NewLegacyTx(chainID *big.Int, etx EthTx, gasPrice *big.Int, gasLimit uint64) (*types.Transaction, error)
+	// NewDynamicFeeTx returns the unsigned transaction to be signed for an
+	// EIP-1559 (type 0x2) attempt
+	NewDynamicFeeTx(chainID *big.Int, etx EthTx, fee gas.DynamicFee, gasLimit uint64) (*types.Transaction, error)
+}
+
+var (
+	txEncodersMu sync.RWMutex
+	txEncoders   = map[chains.ChainType]TxEncoder{}
+)
+
+// RegisterTxEncoder makes a chain-specific transaction encoder available for
+// the given chain type, selected at runtime by CHAIN_TYPE (or the chain_type
+// column of evm_chains). External packages must register encoders before any
+// chain is started. It panics if the chain type already has an encoder.
+//
+// Chain types without a registered encoder use the standard Ethereum
+// transaction format
+func RegisterTxEncoder(chainType chains.ChainType, encoder TxEncoder) {
+	txEncodersMu.Lock()
+	defer txEncodersMu.Unlock()
+	if _, exists := txEncoders[chainType]; exists {
+		panic(fmt.Sprintf("tx encoder for chain type %q is already registered", chainType))
+	}
+	txEncoders[chainType] = encoder
+}
+
+func txEncoderFor(chainType chains.ChainType) TxEncoder {
+	txEncodersMu.RLock()
+	defer txEncodersMu.RUnlock()
+	return txEncoders[chainType]
+}
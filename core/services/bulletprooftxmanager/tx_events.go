@@ -0,0 +1,50 @@
+package bulletprooftxmanager
+
+import (
+	"encoding/json"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gorm.io/gorm"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// Tx lifecycle event types, emitted on postgres.ChannelTxLifecycle whenever a
+// transaction changes state
+const (
+	TxEventEnqueued       = "enqueued"
+	TxEventBroadcast      = "broadcast"
+	TxEventBumped         = "bumped"
+	TxEventConfirmed      = "confirmed"
+	TxEventReorgedOut     = "reorged_out"
+	TxEventExpired        = "expired"
+	TxEventFatallyErrored = "fatally_errored"
+)
+
+// TxLifecycleEvent is the JSON payload published on postgres.ChannelTxLifecycle.
+// Interested parties (pipeline tasks, the web SSE endpoint, or external
+// systems with their own LISTEN connection) can subscribe via the event
+// broadcaster instead of polling eth_txes
+type TxLifecycleEvent struct {
+	EthTxID     int64          `json:"ethTxId"`
+	Event       string         `json:"event"`
+	FromAddress common.Address `json:"fromAddress"`
+	Nonce       *int64         `json:"nonce,omitempty"`
+	TxHash      *common.Hash   `json:"txHash,omitempty"`
+	Error       string         `json:"error,omitempty"`
+}
+
+// notifyTxLifecycleEvent publishes the event with pg_notify. Delivery is best
+// effort: NOTIFY payloads are not persisted, and failure to publish must
+// never fail the state transition itself, so errors are only logged
+func notifyTxLifecycleEvent(db *gorm.DB, lggr logger.Logger, event TxLifecycleEvent) {
+	payload, err := json.Marshal(event)
+	if err != nil {
+		lggr.Errorw("Failed to marshal tx lifecycle event", "err", err, "ethTxID", event.EthTxID, "event", event.Event)
+		return
+	}
+	if err := db.Exec(`SELECT pg_notify(?, ?)`, postgres.ChannelTxLifecycle, string(payload)).Error; err != nil {
+		lggr.Warnw("Failed to publish tx lifecycle event", "err", err, "ethTxID", event.EthTxID, "event", event.Event)
+	}
+}
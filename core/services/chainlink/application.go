@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"math/big"
 	"os"
+	"path/filepath"
 	"reflect"
 	"sync"
 	"time"
@@ -20,6 +21,7 @@ import (
 	"go.uber.org/zap/zapcore"
 	"gorm.io/gorm"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	evmtypes "github.com/smartcontractkit/chainlink/core/chains/evm/types"
@@ -27,6 +29,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
 	"github.com/smartcontractkit/chainlink/core/services"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
 	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/cron"
 	"github.com/smartcontractkit/chainlink/core/services/directrequest"
@@ -36,16 +39,21 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
 	"github.com/smartcontractkit/chainlink/core/services/periodicbackup"
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
 	"github.com/smartcontractkit/chainlink/core/services/synchronization"
 	"github.com/smartcontractkit/chainlink/core/services/telemetry"
 	"github.com/smartcontractkit/chainlink/core/services/vrf"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/shutdown"
+	"github.com/smartcontractkit/chainlink/core/tracing"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -56,9 +64,12 @@ type Application interface {
 	Start() error
 	Stop() error
 	GetLogger() logger.Logger
+	GetAuditLogger() audit.AuditLogger
 	GetHealthChecker() health.Checker
 	GetDB() *gorm.DB
 	GetSqlxDB() *sqlx.DB
+	// GetReadReplicaDB returns nil when no read replica is configured
+	GetReadReplicaDB() *sqlx.DB
 	GetConfig() config.GeneralConfig
 	SetLogLevel(lvl zapcore.Level) error
 	GetKeyStore() keystore.Master
@@ -80,7 +91,8 @@ type Application interface {
 	BPTXMORM() bulletprooftxmanager.ORM
 	AddJobV2(ctx context.Context, job *job.Job) error
 	DeleteJob(ctx context.Context, jobID int32) error
-	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
+	ArchiveJob(ctx context.Context, jobID int32) error
+	RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable, callbackURL string) (int64, error)
 	ResumeJobV2(ctx context.Context, taskID uuid.UUID, result pipeline.Result) error
 	// Testing only
 	RunJobV2(ctx context.Context, jobID int32, meta map[string]interface{}) (int64, error)
@@ -119,13 +131,16 @@ type ChainlinkApplication struct {
 	shutdownOnce             sync.Once
 	shutdownSignal           shutdown.Signal
 	explorerClient           synchronization.ExplorerClient
+	auditLogger              audit.AuditLogger
 	subservices              []service.Service
 	HealthChecker            health.Checker
 	logger                   logger.Logger
 	sqlxDB                   *sqlx.DB
+	readReplicaDB            *sqlx.DB
 	gormDB                   *gorm.DB
 	advisoryLock             postgres.Locker
 	leaseLock                postgres.LeaseLock
+	tracingShutdown          func(ctx context.Context) error
 	id                       uuid.UUID
 
 	started     bool
@@ -138,6 +153,7 @@ type ApplicationOpts struct {
 	ShutdownSignal           shutdown.Signal
 	GormDB                   *gorm.DB
 	SqlxDB                   *sqlx.DB
+	ReadReplicaDB            *sqlx.DB
 	KeyStore                 keystore.Master
 	ChainSet                 evm.ChainSet
 	Logger                   logger.Logger
@@ -164,6 +180,13 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	globalLogger := opts.Logger
 	eventBroadcaster := opts.EventBroadcaster
 	externalInitiatorManager := opts.ExternalInitiatorManager
+	// The concrete manager runs a delivery loop for its notification outbox
+	if eim, ok := externalInitiatorManager.(service.Service); ok {
+		subservices = append(subservices, eim)
+	}
+
+	postgres.SetSlowQueryThreshold(cfg.DatabaseSlowQueryThreshold())
+	postgres.SetDefaultBatchSize(cfg.DatabaseDefaultBatchSize())
 
 	healthChecker := health.NewChecker()
 
@@ -177,12 +200,47 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	}
 
 	// Use Explorer over TelemetryIngress if both URLs are set
-	if cfg.ExplorerURL() == nil && cfg.TelemetryIngressURL() != nil {
-		telemetryIngressClient = synchronization.NewTelemetryIngressClient(cfg.TelemetryIngressURL(), cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging())
-		monitoringEndpointGen = telemetry.NewIngressAgentWrapper(telemetryIngressClient)
+	if cfg.ExplorerURL() == nil {
+		bufferDir := cfg.TelemetryIngressBufferDir()
+		if bufferDir != "" {
+			if err := os.MkdirAll(bufferDir, 0700); err != nil {
+				return nil, errors.Wrap(err, "failed to create telemetry ingress buffer dir")
+			}
+		}
+		// TELEMETRY_INGRESS_ENDPOINTS supports multiple servers with
+		// per-contract routing and takes precedence over the single
+		// TELEMETRY_INGRESS_URL
+		if cfg.TelemetryIngressEndpoints() != "" {
+			endpoints, err := synchronization.ParseTelemetryEndpoints(cfg.TelemetryIngressEndpoints())
+			if err != nil {
+				return nil, err
+			}
+			telemetryIngressClient, err = synchronization.NewTelemetryIngressRouter(endpoints, keyStore.CSA(), cfg.TelemetryIngressLogging(), bufferDir)
+			if err != nil {
+				return nil, err
+			}
+			monitoringEndpointGen = telemetry.NewIngressAgentWrapper(telemetryIngressClient)
+		} else if cfg.TelemetryIngressURL() != nil {
+			var bufferPath string
+			if bufferDir != "" {
+				bufferPath = filepath.Join(bufferDir, "telemetry.jsonl")
+			}
+			telemetryIngressClient = synchronization.NewTelemetryIngressClient(cfg.TelemetryIngressURL(), cfg.TelemetryIngressServerPubKey(), keyStore.CSA(), cfg.TelemetryIngressLogging(), bufferPath)
+			monitoringEndpointGen = telemetry.NewIngressAgentWrapper(telemetryIngressClient)
+		}
 	}
 	subservices = append(subservices, explorerClient, telemetryIngressClient)
 
+	auditLogger := audit.AuditLogger(&audit.NoopLogger{})
+	if cfg.AuditLogSink() != "" {
+		var err error
+		auditLogger, err = audit.NewLogger(cfg, globalLogger)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create audit logger")
+		}
+		subservices = append(subservices, auditLogger)
+	}
+
 	if cfg.DatabaseBackupMode() != config.DatabaseBackupModeNone && cfg.DatabaseBackupFrequency() > 0 {
 		globalLogger.Infow("DatabaseBackup: periodic database backups are enabled", "frequency", cfg.DatabaseBackupFrequency())
 
@@ -192,18 +250,80 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		globalLogger.Info("DatabaseBackup: periodic database backups are disabled. To enable automatic backups, set DATABASE_BACKUP_MODE=lite or DATABASE_BACKUP_MODE=full")
 	}
 
+	eventBus := postgres.NewEventBus(eventBroadcaster, globalLogger)
 	subservices = append(subservices, eventBroadcaster, chainSet)
 	promReporter := services.NewPromReporter(postgres.MustSQLDB(db), globalLogger)
 	subservices = append(subservices, promReporter)
 
+	dbStatsReporter := postgres.NewStatsReporter(globalLogger)
+	dbStatsReporter.RegisterDB("primary", sqlxDB.DB)
+	if opts.ReadReplicaDB != nil {
+		dbStatsReporter.RegisterDB("read_replica", opts.ReadReplicaDB.DB)
+	}
+	subservices = append(subservices, dbStatsReporter)
+
+	failoverDetector := postgres.NewFailoverDetector(sqlxDB, globalLogger)
+	subservices = append(subservices, failoverDetector)
+
+	if window := cfg.DatabaseMaintenanceWindow(); window != "" {
+		mw, err := postgres.ParseMaintenanceWindow(window)
+		if err != nil {
+			return nil, errors.Wrap(err, "invalid DATABASE_MAINTENANCE_WINDOW")
+		}
+		subservices = append(subservices, postgres.NewMaintenance(sqlxDB, mw, globalLogger))
+	}
+
+	// The session secret also encrypts users' TOTP secrets at rest
+	sessionSecret, err := cfg.SessionSecret()
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to obtain session secret")
+	}
+	logger.RegisterSecret(string(sessionSecret))
+
+	// Install the global tracer provider before any instrumented services
+	// start; a no-op shutdown is returned when tracing is disabled.
+	tracingShutdown, err := tracing.Start(cfg)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to start tracing")
+	}
+
 	var (
 		pipelineORM    = pipeline.NewORM(sqlxDB, globalLogger)
-		bridgeORM      = bridges.NewORM(sqlxDB)
-		sessionORM     = sessions.NewORM(sqlxDB, cfg.SessionTimeout().Duration(), globalLogger)
+		bridgeORM      = bridges.NewCachedORM(bridges.NewORM(sqlxDB), eventBus, globalLogger)
+		sessionORM     = sessions.NewORM(sqlxDB, cfg.SessionTimeout().Duration(), cfg.SessionAbsoluteTimeout().Duration(), string(sessionSecret), globalLogger)
 		pipelineRunner = pipeline.NewRunner(pipelineORM, cfg, chainSet, keyStore.Eth(), keyStore.VRF(), globalLogger)
 		jobORM         = job.NewORM(sqlxDB, chainSet, pipelineORM, keyStore, globalLogger)
 		bptxmORM       = bulletprooftxmanager.NewORM(sqlxDB)
 	)
+	if archiveURL := cfg.JobPipelineRunsArchiveURL(); archiveURL != "" {
+		archive, err := pipeline.NewRunArchive(archiveURL)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to initialize pipeline run archive")
+		}
+		pipelineORM.SetRunArchive(archive)
+	}
+	subservices = append(subservices, bridgeORM)
+	pipelineRunner.SetBridgeORM(bridgeORM)
+	pipelineRunner.SetResultCache(pipeline.NewResultCache(sqlxDB, globalLogger))
+	pipelineRunner.SetEventBus(eventBus)
+
+	failoverDetector.OnAvailabilityChange(func(available bool) {
+		if available {
+			// LISTEN/NOTIFY events may have been lost during the outage, so
+			// locally cached state can no longer be trusted
+			bridgeORM.Flush()
+			pipelineORM.FlushSpecCache()
+			// statements prepared against the old primary are useless after
+			// a failover
+			postgres.ClearStmtCaches()
+		}
+	})
+
+	if opts.ReadReplicaDB != nil {
+		readReplica := postgres.NewReadReplica(sqlxDB, opts.ReadReplicaDB, cfg.DatabaseReadReplicaMaxLag(), globalLogger)
+		pipelineORM.SetReadReplica(readReplica)
+		jobORM.SetReadReplica(readReplica)
+	}
 
 	for _, chain := range chainSet.Chains() {
 		chain.HeadBroadcaster().Subscribe(promReporter)
@@ -234,10 +354,16 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			job.Webhook: webhook.NewDelegate(
 				pipelineRunner,
 				externalInitiatorManager,
+				utils.Client,
 				globalLogger),
 			job.Cron: cron.NewDelegate(
 				pipelineRunner,
+				pipelineORM,
 				globalLogger),
+			job.BlockhashStore: blockhashstore.NewDelegate(
+				globalLogger,
+				chainSet,
+				keyStore.Eth()),
 		}
 		webhookJobRunner = delegates[job.Webhook].(*webhook.Delegate).WebhookJobRunner()
 	)
@@ -270,6 +396,21 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 			chainSet,
 			globalLogger,
 		)
+		relayers := map[relay.Network]relay.Relayer{
+			relay.EVM: evmrelay.NewRelayer(chainSet, globalLogger),
+		}
+		delegates[job.OffchainReporting2] = offchainreporting2.NewDelegate(
+			jobORM,
+			keyStore,
+			pipelineRunner,
+			relayers,
+			globalLogger,
+		)
+		delegates[job.Bootstrap] = ocrbootstrap.NewDelegate(
+			jobORM,
+			relayers,
+			globalLogger,
+		)
 	} else {
 		globalLogger.Debug("Off-chain reporting disabled")
 	}
@@ -278,7 +419,7 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 	for _, c := range chainSet.Chains() {
 		lbs = append(lbs, c.LogBroadcaster())
 	}
-	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, sqlxDB, globalLogger, lbs)
+	jobSpawner := job.NewSpawner(jobORM, cfg, delegates, sqlxDB, pipelineRunner, globalLogger, lbs)
 	subservices = append(subservices, jobSpawner, pipelineRunner)
 
 	feedsORM := feeds.NewORM(db)
@@ -312,16 +453,20 @@ func NewApplication(opts ApplicationOpts) (Application, error) {
 		ExternalInitiatorManager: externalInitiatorManager,
 		shutdownSignal:           shutdownSignal,
 		explorerClient:           explorerClient,
+		auditLogger:              auditLogger,
 		HealthChecker:            healthChecker,
 		logger:                   globalLogger,
 		id:                       opts.ID,
 
-		sqlxDB: sqlxDB,
-		gormDB: opts.GormDB,
+		sqlxDB:        sqlxDB,
+		readReplicaDB: opts.ReadReplicaDB,
+		gormDB:        opts.GormDB,
 
 		advisoryLock: opts.AdvisoryLock,
 		leaseLock:    opts.LeaseLock,
 
+		tracingShutdown: tracingShutdown,
+
 		// NOTE: Can keep things clean by putting more things in subservices
 		// instead of manually start/closing
 		subservices: subservices,
@@ -462,8 +607,18 @@ func (app *ChainlinkApplication) stop() (err error) {
 				app.leaseLock.Release()
 			}
 
+			if app.tracingShutdown != nil {
+				app.logger.Debug("Flushing traces...")
+				ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+				defer cancel()
+				merr = multierr.Append(merr, app.tracingShutdown(ctx))
+			}
+
 			// DB should pretty much always be closed last
 			app.logger.Debug("Closing DB...")
+			if app.readReplicaDB != nil {
+				merr = multierr.Append(merr, app.readReplicaDB.Close())
+			}
 			merr = multierr.Append(merr, app.sqlxDB.Close())
 
 			app.logger.Info("Exited all services")
@@ -493,6 +648,10 @@ func (app *ChainlinkApplication) GetLogger() logger.Logger {
 	return app.logger
 }
 
+func (app *ChainlinkApplication) GetAuditLogger() audit.AuditLogger {
+	return app.auditLogger
+}
+
 func (app *ChainlinkApplication) GetHealthChecker() health.Checker {
 	return app.HealthChecker
 }
@@ -552,8 +711,22 @@ func (app *ChainlinkApplication) DeleteJob(ctx context.Context, jobID int32) err
 	return app.jobSpawner.DeleteJob(ctx, jobID)
 }
 
-func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
-	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta)
+func (app *ChainlinkApplication) ArchiveJob(ctx context.Context, jobID int32) error {
+	// Do not allow the job to be archived if it is managed by the Feeds Manager
+	isManaged, err := app.FeedsService.IsJobManaged(ctx, int64(jobID))
+	if err != nil {
+		return err
+	}
+
+	if isManaged {
+		return errors.New("job must be deleted in the feeds manager")
+	}
+
+	return app.jobSpawner.ArchiveJob(ctx, jobID)
+}
+
+func (app *ChainlinkApplication) RunWebhookJobV2(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable, callbackURL string) (int64, error) {
+	return app.webhookJobRunner.RunJob(ctx, jobUUID, requestBody, meta, callbackURL)
 }
 
 // Only used for local testing, not supported by the UI.
@@ -598,6 +771,7 @@ func (app *ChainlinkApplication) RunJobV2(
 					"externalJobID": jb.ExternalJobID,
 					"name":          jb.Name.ValueOrZero(),
 					"publicKey":     jb.VRFSpec.PublicKey[:],
+					"params":        map[string]interface{}(jb.Params),
 				},
 				"jobRun": map[string]interface{}{
 					"meta":           meta,
@@ -663,6 +837,11 @@ func (app *ChainlinkApplication) GetSqlxDB() *sqlx.DB {
 	return app.sqlxDB
 }
 
+// GetReadReplicaDB returns nil when no read replica is configured
+func (app *ChainlinkApplication) GetReadReplicaDB() *sqlx.DB {
+	return app.readReplicaDB
+}
+
 // Returns the configuration to use for creating and authenticating
 // new WebAuthn credentials
 func (app *ChainlinkApplication) GetWebAuthnConfiguration() sessions.WebAuthnConfiguration {
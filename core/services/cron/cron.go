@@ -1,7 +1,10 @@
 package cron
 
 import (
+	"database/sql"
 	"fmt"
+	"math/rand"
+	"time"
 
 	"github.com/robfig/cron/v3"
 
@@ -17,6 +20,8 @@ type Cron struct {
 	logger         logger.Logger
 	jobSpec        job.Job
 	pipelineRunner pipeline.Runner
+	pipelineORM    pipeline.ORM
+	oneShotTimer   *time.Timer
 	chStop         chan struct{}
 }
 
@@ -24,6 +29,7 @@ type Cron struct {
 func NewCronFromJobSpec(
 	jobSpec job.Job,
 	pipelineRunner pipeline.Runner,
+	pipelineORM pipeline.ORM,
 	logger logger.Logger,
 ) (*Cron, error) {
 	cronLogger := logger.Named("Cron").With(
@@ -36,6 +42,7 @@ func NewCronFromJobSpec(
 		logger:         cronLogger,
 		jobSpec:        jobSpec,
 		pipelineRunner: pipelineRunner,
+		pipelineORM:    pipelineORM,
 		chStop:         make(chan struct{}),
 	}, nil
 }
@@ -44,24 +51,113 @@ func NewCronFromJobSpec(
 func (cr *Cron) Start() error {
 	cr.logger.Debug("Starting")
 
-	_, err := cr.cronRunner.AddFunc(cr.jobSpec.CronSpec.CronSchedule, cr.runPipeline)
-	if err != nil {
-		cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", cr.jobSpec.CronSpec.CronSchedule, "jobID", cr.jobSpec.ID)
-		return err
+	// One-shot jobs execute the pipeline exactly once at runAt; a runAt in
+	// the past (e.g. after a node restart before the run happened) fires
+	// immediately.
+	if runAt := cr.jobSpec.CronSpec.RunAt; runAt != nil {
+		cr.oneShotTimer = time.AfterFunc(time.Until(*runAt), func() {
+			cr.runPipeline("runAt")
+		})
+		return nil
+	}
+
+	for _, schedule := range cr.jobSpec.CronSpec.Schedules() {
+		schedule := schedule
+		_, err := cr.cronRunner.AddFunc(schedule.ScheduleWithTimezone(), func() {
+			cr.runSchedule(schedule)
+		})
+		if err != nil {
+			cr.logger.Errorw(fmt.Sprintf("Error running cron job %d", cr.jobSpec.ID), "error", err, "schedule", schedule.Schedule, "jobID", cr.jobSpec.ID)
+			return err
+		}
 	}
 	cr.cronRunner.Start()
+	go cr.catchUp()
 	return nil
 }
 
+// catchUp applies the spec's catch-up policy to schedule firings that were
+// missed while the node was down, based on the time of the last run.
+func (cr *Cron) catchUp() {
+	policy := cr.jobSpec.CronSpec.CatchUp
+	if policy == "" || policy == job.CronCatchUpSkip {
+		return
+	}
+	// pipelineORM is nil in some tests
+	if cr.pipelineORM == nil {
+		return
+	}
+	lastRun, err := cr.pipelineORM.GetLatestRunCreatedAt(cr.jobSpec.PipelineSpecID)
+	if err == sql.ErrNoRows {
+		// Never ran before; nothing was missed.
+		return
+	}
+	if err != nil {
+		cr.logger.Errorw("Error fetching last run for catch-up", "error", err)
+		return
+	}
+
+	parser := cron.NewParser(cron.Second | cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow | cron.Descriptor)
+	now := time.Now()
+	var missed int
+	for _, schedule := range cr.jobSpec.CronSpec.Schedules() {
+		sched, err := parser.Parse(schedule.ScheduleWithTimezone())
+		if err != nil {
+			cr.logger.Errorw("Error parsing schedule for catch-up", "error", err, "schedule", schedule.Schedule)
+			continue
+		}
+		for t := sched.Next(lastRun); t.Before(now); t = sched.Next(t) {
+			missed++
+		}
+	}
+	if missed == 0 {
+		return
+	}
+
+	runs := 1
+	if policy == job.CronCatchUpReplay {
+		runs = missed
+		if limit := int(cr.jobSpec.CronSpec.CatchUpLimit); limit > 0 && runs > limit {
+			runs = limit
+		}
+	}
+	cr.logger.Infow("Catching up on missed cron firings", "missed", missed, "runs", runs, "policy", policy)
+	for i := 0; i < runs; i++ {
+		select {
+		case <-cr.chStop:
+			return
+		default:
+		}
+		cr.runPipeline("catchUp")
+	}
+}
+
 // Close implements the job.Service interface. It stops this job from
 // running and cleans up resources.
 func (cr *Cron) Close() error {
 	cr.logger.Debug("Closing")
+	if cr.oneShotTimer != nil {
+		cr.oneShotTimer.Stop()
+	}
+	close(cr.chStop)
 	cr.cronRunner.Stop()
 	return nil
 }
 
-func (cr *Cron) runPipeline() {
+// runSchedule executes the pipeline for one schedule, first sleeping a random
+// duration up to the schedule's jitter
+func (cr *Cron) runSchedule(schedule job.CronSchedule) {
+	if jitter := schedule.Jitter.Duration(); jitter > 0 {
+		select {
+		case <-cr.chStop:
+			return
+		case <-time.After(time.Duration(rand.Int63n(int64(jitter)))):
+		}
+	}
+	cr.runPipeline(schedule.Tag())
+}
+
+func (cr *Cron) runPipeline(scheduleTag string) {
 	ctx, cancel := utils.ContextFromChan(cr.chStop)
 	defer cancel()
 
@@ -70,9 +166,12 @@ func (cr *Cron) runPipeline() {
 			"databaseID":    cr.jobSpec.ID,
 			"externalJobID": cr.jobSpec.ExternalJobID,
 			"name":          cr.jobSpec.Name.ValueOrZero(),
+			"params":        map[string]interface{}(cr.jobSpec.Params),
 		},
 		"jobRun": map[string]interface{}{
-			"meta": map[string]interface{}{},
+			"meta": map[string]interface{}{
+				"cronSchedule": scheduleTag,
+			},
 		},
 	})
 
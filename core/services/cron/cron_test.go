@@ -40,7 +40,7 @@ func TestCronV2Pipeline(t *testing.T) {
 		PipelineSpec:  &pipeline.Spec{},
 		ExternalJobID: uuid.NewV4(),
 	}
-	delegate := cron.NewDelegate(runner, lggr)
+	delegate := cron.NewDelegate(runner, orm, lggr)
 
 	err := jobORM.CreateJob(jb)
 	require.NoError(t, err)
@@ -68,7 +68,7 @@ func TestCronV2Schedule(t *testing.T) {
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Return(false, nil).Once()
 
-	service, err := cron.NewCronFromJobSpec(spec, runner, logger.TestLogger(t))
+	service, err := cron.NewCronFromJobSpec(spec, runner, nil, logger.TestLogger(t))
 	require.NoError(t, err)
 	err = service.Start()
 	require.NoError(t, err)
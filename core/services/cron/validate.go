@@ -34,8 +34,35 @@ func ValidatedCronSpec(tomlString string) (job.Job, error) {
 	if jb.Type != job.Cron {
 		return jb, errors.Errorf("unsupported type %s", jb.Type)
 	}
-	if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
-		return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
+	if spec.RunAt != nil {
+		if spec.CronSchedule != "" || len(spec.CronSchedules) != 0 {
+			return jb, errors.New("runAt and cron schedules are mutually exclusive")
+		}
+		if spec.RunAt.IsZero() {
+			return jb, errors.New("runAt must be a valid timestamp")
+		}
+		return jb, nil
+	}
+	if spec.CronSchedule == "" && len(spec.CronSchedules) == 0 {
+		return jb, errors.New("at least one cron schedule or runAt must be specified")
+	}
+	if spec.CronSchedule != "" {
+		if err := utils.ValidateCronSchedule(spec.CronSchedule); err != nil {
+			return jb, errors.Wrapf(err, "while validating cron schedule '%v'", spec.CronSchedule)
+		}
+	}
+	switch spec.CatchUp {
+	case "", job.CronCatchUpSkip, job.CronCatchUpRunOnce, job.CronCatchUpReplay:
+	default:
+		return jb, errors.Errorf("catchUp must be one of %q, %q or %q", job.CronCatchUpSkip, job.CronCatchUpRunOnce, job.CronCatchUpReplay)
+	}
+	for _, schedule := range spec.CronSchedules {
+		if err := utils.ValidateCronSchedule(schedule.ScheduleWithTimezone()); err != nil {
+			return jb, errors.Wrapf(err, "while validating cron schedule '%v'", schedule.Schedule)
+		}
+		if schedule.Jitter.Duration() < 0 {
+			return jb, errors.Errorf("cron schedule '%v' must not have a negative jitter", schedule.Schedule)
+		}
 	}
 
 	return jb, nil
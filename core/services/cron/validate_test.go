@@ -3,6 +3,7 @@ package cron_test
 import (
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/manyminds/api2go/jsonapi"
 	"github.com/stretchr/testify/assert"
@@ -53,6 +54,67 @@ ds_parse    [type=jsonparse path="data,price"];
 ds_multiply [type=multiply times=100];
 ds -> ds_parse -> ds_multiply;
 """
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), "cron schedule must specify a time zone using CRON_TZ"))
+			},
+		},
+		{
+			name: "multiple schedules",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedules       = [
+	{name = "frequent", schedule = "CRON_TZ=UTC */5 * * * *"},
+	{name = "daily", schedule = "0 0 0 * * *", timezone = "UTC", jitter = "30s"},
+]
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec)
+				require.Len(t, s.CronSpec.CronSchedules, 2)
+				assert.Equal(t, "CRON_TZ=UTC 0 0 0 * * *", s.CronSpec.CronSchedules[1].ScheduleWithTimezone())
+				assert.Equal(t, "daily", s.CronSpec.CronSchedules[1].Tag())
+			},
+		},
+		{
+			name: "no schedules at all",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), "at least one cron schedule or runAt must be specified"))
+			},
+		},
+		{
+			name: "schedule in schedules missing timezone",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedules       = [
+	{schedule = "0 0 0 * * *"},
+]
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
 `,
 			assertion: func(t *testing.T, s job.Job, err error) {
 				require.Error(t, err)
@@ -77,6 +139,44 @@ ds -> ds_parse -> ds_multiply;
 				assert.True(t, strings.Contains(err.Error(), "invalid cron schedule"))
 			},
 		},
+		{
+			name: "one-shot runAt",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+runAt           = 2030-01-01T00:00:00Z
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, s.CronSpec.RunAt)
+				assert.Equal(t, time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC), s.CronSpec.RunAt.UTC())
+			},
+		},
+		{
+			name: "runAt with schedule",
+			toml: `
+type            = "cron"
+schemaVersion   = 1
+schedule        = "CRON_TZ=UTC 0 0 1 1 *"
+runAt           = 2030-01-01T00:00:00Z
+observationSource   = """
+ds          [type=http method=GET url="https://chain.link/ETH-USD"];
+ds_parse    [type=jsonparse path="data,price"];
+ds_multiply [type=multiply times=100];
+ds -> ds_parse -> ds_multiply;
+"""
+`,
+			assertion: func(t *testing.T, s job.Job, err error) {
+				require.Error(t, err)
+				assert.True(t, strings.Contains(err.Error(), "runAt and cron schedules are mutually exclusive"))
+			},
+		},
 	}
 	for _, tc := range tt {
 		t.Run(tc.name, func(t *testing.T) {
@@ -12,6 +12,8 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/operator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -39,6 +41,13 @@ type (
 	}
 )
 
+var (
+	promRejectedRequests = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "direct_request_rejected_requests",
+		Help: "Number of oracle requests rejected before the pipeline was run, either because the requester was not on the allowlist or because the payment was below the minimum",
+	}, []string{"job_id", "job_name", "reason"})
+)
+
 var _ job.Delegate = (*Delegate)(nil)
 
 func NewDelegate(
@@ -300,6 +309,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 			"requester", request.Requester,
 			"allowedRequesters", l.requesters.ToStrings(),
 		)
+		promRejectedRequests.WithLabelValues(fmt.Sprintf("%d", l.job.ID), l.job.Name.ValueOrZero(), "requester_not_allowed").Inc()
 		l.markLogConsumed(lb)
 		return
 	}
@@ -317,6 +327,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 				"minContractPayment", minContractPayment.String(),
 				"requestPayment", requestPayment.String(),
 			)
+			promRejectedRequests.WithLabelValues(fmt.Sprintf("%d", l.job.ID), l.job.Name.ValueOrZero(), "insufficient_payment").Inc()
 			l.markLogConsumed(lb)
 			return
 		}
@@ -338,6 +349,7 @@ func (l *listener) handleOracleRequest(request *operator_wrapper.OperatorOracleR
 			"databaseID":    l.job.ID,
 			"externalJobID": l.job.ExternalJobID,
 			"name":          l.job.Name.ValueOrZero(),
+			"params":        map[string]interface{}(l.job.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"meta":           meta,
@@ -36,12 +36,24 @@ type Client interface {
 	Call(result interface{}, method string, args ...interface{}) error
 	CallContext(ctx context.Context, result interface{}, method string, args ...interface{}) error
 	BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
+	// BatchCallContextAll sends the same batch request to every node in the
+	// pool (including send-only nodes); the result from the primary is
+	// authoritative, all other responses are discarded
+	BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error
 
 	// HeadByNumber is a reimplemented version of HeaderByNumber due to a
 	// difference in how block header hashes are calculated by Parity nodes
 	// running on Kovan.  We have to return our own wrapper type to capture the
 	// correct hash from the RPC response.
 	HeadByNumber(ctx context.Context, n *big.Int) (*Head, error)
+	// HeadByNumberAllNodes fetches the head at the given height from every
+	// primary node in the pool, keyed by node name. Unlike the round-robin
+	// methods no single response is authoritative; callers can use it to
+	// cross-validate answers across providers
+	HeadByNumberAllNodes(ctx context.Context, n *big.Int) (map[string]*Head, error)
+	// HeadByTag is like HeadByNumber but takes a named block tag such as
+	// "finalized" or "safe", as introduced by post-merge clients
+	HeadByTag(ctx context.Context, tag string) (*Head, error)
 	SubscribeNewHead(ctx context.Context, ch chan<- *Head) (ethereum.Subscription, error)
 
 	// Wrapped Geth client methods
@@ -233,6 +245,30 @@ func (client *client) HeadByNumber(ctx context.Context, number *big.Int) (head *
 	return
 }
 
+func (client *client) HeadByTag(ctx context.Context, tag string) (head *Head, err error) {
+	err = client.pool.CallContext(ctx, &head, "eth_getBlockByNumber", tag, false)
+	if err != nil {
+		return nil, err
+	}
+	if head == nil {
+		err = ethereum.NotFound
+		return
+	}
+	head.EVMChainID = utils.NewBig(client.chainID)
+	return
+}
+
+func (client *client) HeadByNumberAllNodes(ctx context.Context, number *big.Int) (map[string]*Head, error) {
+	heads, err := client.pool.HeadByNumberAllNodes(ctx, number)
+	if err != nil {
+		return nil, err
+	}
+	for _, head := range heads {
+		head.EVMChainID = utils.NewBig(client.chainID)
+	}
+	return heads, nil
+}
+
 func ToBlockNumArg(number *big.Int) string {
 	if number == nil {
 		return "latest"
@@ -277,6 +313,10 @@ func (client *client) BatchCallContext(ctx context.Context, b []rpc.BatchElem) e
 	return client.pool.BatchCallContext(ctx, b)
 }
 
+func (client *client) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return client.pool.BatchCallContextAll(ctx, b)
+}
+
 func (client *client) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
 	return client.pool.SuggestGasTipCap(ctx)
 }
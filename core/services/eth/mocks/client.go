@@ -64,6 +64,20 @@ func (_m *Client) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error
 	return r0
 }
 
+// BatchCallContextAll provides a mock function with given fields: ctx, b
+func (_m *Client) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	ret := _m.Called(ctx, b)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, []rpc.BatchElem) error); ok {
+		r0 = rf(ctx, b)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // BlockByNumber provides a mock function with given fields: ctx, number
 func (_m *Client) BlockByNumber(ctx context.Context, number *big.Int) (*types.Block, error) {
 	ret := _m.Called(ctx, number)
@@ -338,6 +352,52 @@ func (_m *Client) HeadByNumber(ctx context.Context, n *big.Int) (*eth.Head, erro
 	return r0, r1
 }
 
+// HeadByNumberAllNodes provides a mock function with given fields: ctx, n
+func (_m *Client) HeadByNumberAllNodes(ctx context.Context, n *big.Int) (map[string]*eth.Head, error) {
+	ret := _m.Called(ctx, n)
+
+	var r0 map[string]*eth.Head
+	if rf, ok := ret.Get(0).(func(context.Context, *big.Int) map[string]*eth.Head); ok {
+		r0 = rf(ctx, n)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(map[string]*eth.Head)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, *big.Int) error); ok {
+		r1 = rf(ctx, n)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// HeadByTag provides a mock function with given fields: ctx, tag
+func (_m *Client) HeadByTag(ctx context.Context, tag string) (*eth.Head, error) {
+	ret := _m.Called(ctx, tag)
+
+	var r0 *eth.Head
+	if rf, ok := ret.Get(0).(func(context.Context, string) *eth.Head); ok {
+		r0 = rf(ctx, tag)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eth.Head)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(context.Context, string) error); ok {
+		r1 = rf(ctx, tag)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // HeaderByNumber provides a mock function with given fields: _a0, _a1
 func (_m *Client) HeaderByNumber(_a0 context.Context, _a1 *big.Int) (*types.Header, error) {
 	ret := _m.Called(_a0, _a1)
@@ -69,6 +69,16 @@ func (nc *NullClient) HeadByNumber(ctx context.Context, n *big.Int) (*Head, erro
 	return nil, nil
 }
 
+func (nc *NullClient) HeadByNumberAllNodes(ctx context.Context, n *big.Int) (map[string]*Head, error) {
+	nc.lggr.Debug("HeadByNumberAllNodes")
+	return nil, nil
+}
+
+func (nc *NullClient) HeadByTag(ctx context.Context, tag string) (*Head, error) {
+	nc.lggr.Debug("HeadByTag")
+	return nil, nil
+}
+
 type nullSubscription struct {
 	lggr logger.Logger
 }
@@ -177,6 +187,10 @@ func (nc *NullClient) BatchCallContext(ctx context.Context, b []rpc.BatchElem) e
 	return nil
 }
 
+func (nc *NullClient) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	return nil
+}
+
 func (nc *NullClient) SuggestGasTipCap(ctx context.Context) (tipCap *big.Int, err error) {
 	return nil, nil
 }
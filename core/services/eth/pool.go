@@ -2,6 +2,7 @@ package eth
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"math/big"
 	"sync"
@@ -87,6 +88,80 @@ func (p *Pool) BatchCallContext(ctx context.Context, b []rpc.BatchElem) error {
 	return p.getRoundRobin().BatchCallContext(ctx, b)
 }
 
+// BatchCallContextAll sends the same batch request to every node in the pool
+// (including send-only nodes). The response from the main node is
+// authoritative; responses from all other nodes are discarded. This is useful
+// for rebroadcasting transactions, since some providers silently drop txes
+// and a node that has dropped one may be paired with another that has not
+func (p *Pool) BatchCallContextAll(ctx context.Context, b []rpc.BatchElem) error {
+	var wg sync.WaitGroup
+	defer wg.Wait()
+
+	main := p.getRoundRobin()
+	var all []SendOnlyNode
+	for _, n := range p.nodes {
+		all = append(all, n)
+	}
+	all = append(all, p.sendonlys...)
+	for _, n := range all {
+		if n == main {
+			// main node is used at the end for the return value
+			continue
+		}
+		// Parallel call to all other nodes with ignored results. Each
+		// secondary node gets its own copy of the batch since responses are
+		// unmarshalled into the elements
+		secondary := make([]rpc.BatchElem, len(b))
+		copy(secondary, b)
+		for i := range secondary {
+			secondary[i].Result = new(json.RawMessage)
+		}
+		wg.Add(1)
+		go func(n SendOnlyNode) {
+			defer wg.Done()
+			err := n.BatchCallContext(ctx, secondary)
+			if err != nil {
+				p.logger.Warnw("secondary eth client returned error", "name", n.String(), "err", err)
+			}
+		}(n)
+	}
+
+	return main.BatchCallContext(ctx, b)
+}
+
+// HeadByNumberAllNodes fetches the head at the given height from every
+// primary node in parallel, keyed by node name. Nodes that error or return no
+// head are logged and omitted from the result; an error is only returned if
+// no node answered at all
+func (p *Pool) HeadByNumberAllNodes(ctx context.Context, number *big.Int) (map[string]*Head, error) {
+	hex := ToBlockNumArg(number)
+	var mu sync.Mutex
+	heads := make(map[string]*Head, len(p.nodes))
+	var wg sync.WaitGroup
+	for _, n := range p.nodes {
+		wg.Add(1)
+		go func(n Node) {
+			defer wg.Done()
+			var head *Head
+			if err := n.CallContext(ctx, &head, "eth_getBlockByNumber", hex, false); err != nil {
+				p.logger.Warnw("eth client returned error fetching head", "name", n.String(), "err", err)
+				return
+			}
+			if head == nil {
+				return
+			}
+			mu.Lock()
+			heads[n.String()] = head
+			mu.Unlock()
+		}(n)
+	}
+	wg.Wait()
+	if len(heads) == 0 {
+		return nil, fmt.Errorf("no node returned a head for block %s", hex)
+	}
+	return heads, nil
+}
+
 // Wrapped Geth client methods
 func (p *Pool) SendTransaction(ctx context.Context, tx *types.Transaction) error {
 	var wg sync.WaitGroup
@@ -11,6 +11,10 @@ import (
 	postgres "github.com/smartcontractkit/chainlink/core/services/postgres"
 
 	uuid "github.com/satori/go.uuid"
+
+	crypto "github.com/smartcontractkit/chainlink/core/utils/crypto"
+
+	null "gopkg.in/guregu/null.v4"
 )
 
 // ORM is an autogenerated mock type for the ORM type
@@ -315,6 +319,20 @@ func (_m *ORM) UpdateJobProposalStatus(id int64, status feeds.JobProposalStatus,
 	return r0
 }
 
+// UpdateJobProposalValidationError provides a mock function with given fields: ctx, id, validationError
+func (_m *ORM) UpdateJobProposalValidationError(ctx context.Context, id int64, validationError null.String) error {
+	ret := _m.Called(ctx, id, validationError)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, null.String) error); ok {
+		r0 = rf(ctx, id, validationError)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpdateManager provides a mock function with given fields: ctx, mgr
 func (_m *ORM) UpdateManager(ctx context.Context, mgr feeds.FeedsManager) error {
 	ret := _m.Called(ctx, mgr)
@@ -329,6 +347,20 @@ func (_m *ORM) UpdateManager(ctx context.Context, mgr feeds.FeedsManager) error
 	return r0
 }
 
+// UpdateManagerPublicKey provides a mock function with given fields: ctx, id, publicKey
+func (_m *ORM) UpdateManagerPublicKey(ctx context.Context, id int64, publicKey crypto.PublicKey) error {
+	ret := _m.Called(ctx, id, publicKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, crypto.PublicKey) error); ok {
+		r0 = rf(ctx, id, publicKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // UpsertJobProposal provides a mock function with given fields: ctx, jp
 func (_m *ORM) UpsertJobProposal(ctx context.Context, jp *feeds.JobProposal) (int64, error) {
 	ret := _m.Called(ctx, jp)
@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	crypto "github.com/smartcontractkit/chainlink/core/utils/crypto"
+
 	feeds "github.com/smartcontractkit/chainlink/core/services/feeds"
 	mock "github.com/stretchr/testify/mock"
 )
@@ -28,6 +30,38 @@ func (_m *Service) ApproveJobProposal(ctx context.Context, id int64) error {
 	return r0
 }
 
+// BulkApproveJobProposals provides a mock function with given fields: ctx, ids
+func (_m *Service) BulkApproveJobProposals(ctx context.Context, ids []int64) []feeds.JobProposalBulkResult {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []feeds.JobProposalBulkResult
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []feeds.JobProposalBulkResult); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalBulkResult)
+		}
+	}
+
+	return r0
+}
+
+// BulkRejectJobProposals provides a mock function with given fields: ctx, ids
+func (_m *Service) BulkRejectJobProposals(ctx context.Context, ids []int64) []feeds.JobProposalBulkResult {
+	ret := _m.Called(ctx, ids)
+
+	var r0 []feeds.JobProposalBulkResult
+	if rf, ok := ret.Get(0).(func(context.Context, []int64) []feeds.JobProposalBulkResult); ok {
+		r0 = rf(ctx, ids)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]feeds.JobProposalBulkResult)
+		}
+	}
+
+	return r0
+}
+
 // CancelJobProposal provides a mock function with given fields: ctx, id
 func (_m *Service) CancelJobProposal(ctx context.Context, id int64) error {
 	ret := _m.Called(ctx, id)
@@ -267,6 +301,20 @@ func (_m *Service) RejectJobProposal(ctx context.Context, id int64) error {
 	return r0
 }
 
+// RotateFeedsManagerKey provides a mock function with given fields: ctx, id, publicKey
+func (_m *Service) RotateFeedsManagerKey(ctx context.Context, id int64, publicKey crypto.PublicKey) error {
+	ret := _m.Called(ctx, id, publicKey)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int64, crypto.PublicKey) error); ok {
+		r0 = rf(ctx, id, publicKey)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Service) Start() error {
 	ret := _m.Called()
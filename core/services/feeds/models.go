@@ -24,6 +24,15 @@ type FeedsManager struct {
 	PublicKey crypto.PublicKey
 	JobTypes  pq.StringArray `gorm:"type:text[]"`
 
+	// ChainIDs restricts the EVM chains which the feeds manager may propose
+	// jobs for. An empty list permits all chains.
+	ChainIDs pq.Int64Array `gorm:"type:bigint[]"`
+
+	// PublicKeyFingerprint pins the SHA-256 fingerprint of the manager's
+	// public key at registration. Changing the public key afterwards requires
+	// an explicit key rotation.
+	PublicKeyFingerprint null.String
+
 	// Determines whether the node will be used as a bootstrap peer. If this is
 	// true, you must have both an OCRBootstrapAddr and OCRBootstrapPeerID.
 	IsOCRBootstrapPeer bool
@@ -43,6 +52,34 @@ func (FeedsManager) TableName() string {
 	return "feeds_managers"
 }
 
+// MatchesPinnedKey returns whether the given public key matches the pinned
+// fingerprint. Managers registered before pinning was introduced do not have
+// a fingerprint and match any key.
+func (m FeedsManager) MatchesPinnedKey(key crypto.PublicKey) bool {
+	if !m.PublicKeyFingerprint.Valid {
+		return true
+	}
+
+	return m.PublicKeyFingerprint.String == key.Fingerprint()
+}
+
+// IsChainPermitted returns whether the feeds manager may propose jobs for the
+// given EVM chain id. A manager without any chain ids configured is permitted
+// on all chains.
+func (m FeedsManager) IsChainPermitted(id int64) bool {
+	if len(m.ChainIDs) == 0 {
+		return true
+	}
+
+	for _, cid := range m.ChainIDs {
+		if cid == id {
+			return true
+		}
+	}
+
+	return false
+}
+
 // JobProposalStatus are the status codes that define the stage of a proposal
 type JobProposalStatus string
 
@@ -63,9 +100,16 @@ type JobProposal struct {
 	ExternalJobID  uuid.NullUUID
 	FeedsManagerID int64
 	Multiaddrs     pq.StringArray `gorm:"type:text[]"`
-	ProposedAt     time.Time
-	CreatedAt      time.Time
-	UpdatedAt      time.Time
+	// RejectionReason explains why the node rejected the proposal, when it
+	// was rejected automatically rather than by the operator.
+	RejectionReason null.String
+	// ValidationError records the result of the job validation dry run which
+	// is performed when the proposal arrives. It is empty when the spec would
+	// pass the node's job validation.
+	ValidationError null.String
+	ProposedAt      time.Time
+	CreatedAt       time.Time
+	UpdatedAt       time.Time
 }
 
 func (jp *JobProposal) CanEditSpec() bool {
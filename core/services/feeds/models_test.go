@@ -3,9 +3,47 @@ package feeds
 import (
 	"testing"
 
+	"github.com/lib/pq"
 	"github.com/stretchr/testify/assert"
 )
 
+func Test_FeedsManager_IsChainPermitted(t *testing.T) {
+	testCases := []struct {
+		name     string
+		chainIDs pq.Int64Array
+		chainID  int64
+		want     bool
+	}{
+		{
+			name:    "no chain scoping permits all chains",
+			chainID: 42,
+			want:    true,
+		},
+		{
+			name:     "chain id in scope",
+			chainIDs: pq.Int64Array{1, 42},
+			chainID:  42,
+			want:     true,
+		},
+		{
+			name:     "chain id not in scope",
+			chainIDs: pq.Int64Array{1, 42},
+			chainID:  1337,
+			want:     false,
+		},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			mgr := FeedsManager{ChainIDs: tc.chainIDs}
+			assert.Equal(t, tc.want, mgr.IsChainPermitted(tc.chainID))
+		})
+	}
+}
+
 func Test_JobProposal_CanEdit(t *testing.T) {
 	testCases := []struct {
 		name   string
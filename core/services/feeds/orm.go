@@ -6,9 +6,11 @@ import (
 	"time"
 
 	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -29,8 +31,10 @@ type ORM interface {
 	ListJobProposals(ctx context.Context) ([]JobProposal, error)
 	ListManagers(ctx context.Context) ([]FeedsManager, error)
 	UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error
+	UpdateJobProposalValidationError(ctx context.Context, id int64, validationError null.String) error
 	UpdateJobProposalStatus(id int64, status JobProposalStatus, qopts ...postgres.QOpt) error
 	UpdateManager(ctx context.Context, mgr FeedsManager) error
+	UpdateManagerPublicKey(ctx context.Context, id int64, publicKey crypto.PublicKey) error
 	UpsertJobProposal(ctx context.Context, jp *JobProposal) (int64, error)
 }
 
@@ -50,8 +54,8 @@ func (o *orm) CreateManager(ctx context.Context, ms *FeedsManager) (int64, error
 	now := time.Now()
 
 	stmt := `
-INSERT INTO feeds_managers (name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO feeds_managers (name, uri, public_key, public_key_fingerprint, job_types, chain_ids, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING id;
 `
 
@@ -59,7 +63,9 @@ RETURNING id;
 		ms.Name,
 		ms.URI,
 		ms.PublicKey,
+		ms.PublicKeyFingerprint,
 		ms.JobTypes,
+		ms.ChainIDs,
 		ms.IsOCRBootstrapPeer,
 		ms.OCRBootstrapPeerMultiaddr,
 		now,
@@ -81,7 +87,7 @@ RETURNING id;
 func (o *orm) ListManagers(ctx context.Context) ([]FeedsManager, error) {
 	mgrs := []FeedsManager{}
 	stmt := `
-SELECT id, name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at
+SELECT id, name, uri, public_key, public_key_fingerprint, job_types, chain_ids, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at
 FROM feeds_managers;
 `
 
@@ -96,7 +102,7 @@ FROM feeds_managers;
 // GetManager gets a feeds manager by id
 func (o *orm) GetManager(ctx context.Context, id int64) (*FeedsManager, error) {
 	stmt := `
-SELECT id, name, uri, public_key, job_types, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at
+SELECT id, name, uri, public_key, public_key_fingerprint, job_types, chain_ids, is_ocr_bootstrap_peer, ocr_bootstrap_peer_multiaddr, created_at, updated_at
 FROM feeds_managers
 WHERE id = ?;
 `
@@ -122,13 +128,14 @@ SET name = ?,
 	uri = ?,
 	public_key = ?,
 	job_types = ?,
+	chain_ids = ?,
 	is_ocr_bootstrap_peer = ?,
 	ocr_bootstrap_peer_multiaddr = ?,
 	updated_at = ?
 WHERE id = ?;
 `
 
-	result := o.db.WithContext(ctx).Exec(stmt, mgr.Name, mgr.URI, mgr.PublicKey, mgr.JobTypes, mgr.IsOCRBootstrapPeer, mgr.OCRBootstrapPeerMultiaddr, now, mgr.ID)
+	result := o.db.WithContext(ctx).Exec(stmt, mgr.Name, mgr.URI, mgr.PublicKey, mgr.JobTypes, mgr.ChainIDs, mgr.IsOCRBootstrapPeer, mgr.OCRBootstrapPeerMultiaddr, now, mgr.ID)
 	if result.RowsAffected == 0 {
 		return sql.ErrNoRows
 	}
@@ -140,6 +147,30 @@ WHERE id = ?;
 
 }
 
+// UpdateManagerPublicKey updates the public key of a feeds manager and
+// re-pins the key fingerprint. It is only used for explicit key rotations.
+func (o *orm) UpdateManagerPublicKey(ctx context.Context, id int64, publicKey crypto.PublicKey) error {
+	now := time.Now()
+
+	stmt := `
+UPDATE feeds_managers
+SET public_key = ?,
+	public_key_fingerprint = ?,
+	updated_at = ?
+WHERE id = ?;
+`
+
+	result := o.db.WithContext(ctx).Exec(stmt, publicKey, publicKey.Fingerprint(), now, id)
+	if result.RowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
 // Count counts the number of feeds manager records.
 func (o *orm) CountManagers(ctx context.Context) (int64, error) {
 	var count int64
@@ -162,12 +193,12 @@ func (o *orm) CreateJobProposal(ctx context.Context, jp *JobProposal) (int64, er
 	now := time.Now()
 
 	stmt := `
-INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, multiaddrs, rejection_reason, validation_error, proposed_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 RETURNING id;
 `
 
-	row := o.db.WithContext(ctx).Raw(stmt, jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.Multiaddrs, now, now, now).Row()
+	row := o.db.WithContext(ctx).Raw(stmt, jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.Multiaddrs, jp.RejectionReason, jp.ValidationError, now, now, now).Row()
 	if row.Err() != nil {
 		return id, row.Err()
 	}
@@ -188,21 +219,23 @@ func (o *orm) UpsertJobProposal(ctx context.Context, jp *JobProposal) (int64, er
 	now := time.Now()
 
 	stmt := `
-INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at)
-VALUES (?, ?, ?, ?, ?, ?, ?, ?)
+INSERT INTO job_proposals (remote_uuid, spec, status, feeds_manager_id, multiaddrs, rejection_reason, validation_error, proposed_at, created_at, updated_at)
+VALUES (?, ?, ?, ?, ?, ?, ?, ?, ?, ?)
 ON CONFLICT (remote_uuid)
 DO
 	UPDATE SET
 		spec = excluded.spec,
 		status = excluded.status,
 		multiaddrs = excluded.multiaddrs,
+		rejection_reason = excluded.rejection_reason,
+		validation_error = excluded.validation_error,
 		proposed_at = excluded.proposed_at,
 		updated_at = excluded.updated_at
 RETURNING id;
 `
 
 	row := o.db.WithContext(ctx).Raw(stmt,
-		jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.Multiaddrs, now, now, now,
+		jp.RemoteUUID, jp.Spec, jp.Status, jp.FeedsManagerID, jp.Multiaddrs, jp.RejectionReason, jp.ValidationError, now, now, now,
 	).Row()
 	if row.Err() != nil {
 		return id, row.Err()
@@ -216,7 +249,7 @@ RETURNING id;
 func (o *orm) ListJobProposals(ctx context.Context) ([]JobProposal, error) {
 	jps := []JobProposal{}
 	stmt := `
-SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at
+SELECT remote_uuid, id, spec, status, external_job_id, feeds_manager_id, multiaddrs, rejection_reason, validation_error, proposed_at, created_at, updated_at
 FROM job_proposals;
 `
 
@@ -231,7 +264,7 @@ FROM job_proposals;
 // GetJobProposal gets a job proposal by id
 func (o *orm) GetJobProposal(ctx context.Context, id int64) (*JobProposal, error) {
 	stmt := `
-SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at
+SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, rejection_reason, validation_error, proposed_at, created_at, updated_at
 FROM job_proposals
 WHERE id = ?;
 `
@@ -242,7 +275,7 @@ WHERE id = ?;
 // GetJobProposalByRemoteUUID gets a job proposal by the remote FMS uuid
 func (o *orm) GetJobProposalByRemoteUUID(ctx context.Context, id uuid.UUID) (*JobProposal, error) {
 	stmt := `
-SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, proposed_at, created_at, updated_at
+SELECT id, remote_uuid, spec, status, external_job_id, feeds_manager_id, multiaddrs, rejection_reason, validation_error, proposed_at, created_at, updated_at
 FROM job_proposals
 WHERE remote_uuid = ?;
 `
@@ -313,6 +346,29 @@ WHERE id = ?;
 	return nil
 }
 
+// UpdateJobProposalValidationError updates the stored job validation dry run
+// result of a job proposal by id.
+func (o *orm) UpdateJobProposalValidationError(ctx context.Context, id int64, validationError null.String) error {
+	now := time.Now()
+
+	stmt := `
+UPDATE job_proposals
+SET validation_error = ?,
+	updated_at = ?
+WHERE id = ?;
+`
+
+	result := o.db.WithContext(ctx).Exec(stmt, validationError, now, id)
+	if result.RowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	if result.Error != nil {
+		return result.Error
+	}
+
+	return nil
+}
+
 // ApproveJobProposal updates the job proposal as approved.
 func (o *orm) ApproveJobProposal(id int64, externalJobID uuid.UUID, status JobProposalStatus, qopts ...postgres.QOpt) error {
 	now := time.Now()
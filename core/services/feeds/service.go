@@ -2,11 +2,16 @@ package feeds
 
 import (
 	"context"
+	"crypto/ed25519"
 	"database/sql"
+	"fmt"
+	"math/big"
 
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
+	"google.golang.org/protobuf/proto"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -17,6 +22,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
+	"github.com/smartcontractkit/chainlink/core/utils/crypto"
 )
 
 //go:generate mockery --name Service --output ./mocks/ --case=underscore
@@ -25,6 +31,7 @@ import (
 var (
 	ErrOCRDisabled        = errors.New("ocr is disabled")
 	ErrSingleFeedsManager = errors.New("only a single feeds manager is supported")
+	ErrPublicKeyMismatch  = errors.New("public key does not match the pinned fingerprint, rotate the key instead")
 
 	promJobProposalRequest = promauto.NewCounter(prometheus.CounterOpts{
 		Name: "feeds_job_proposal_requests",
@@ -38,6 +45,8 @@ type Service interface {
 	Close() error
 
 	ApproveJobProposal(ctx context.Context, id int64) error
+	BulkApproveJobProposals(ctx context.Context, ids []int64) []JobProposalBulkResult
+	BulkRejectJobProposals(ctx context.Context, ids []int64) []JobProposalBulkResult
 	CountManagers() (int64, error)
 	CancelJobProposal(ctx context.Context, id int64) error
 	CreateJobProposal(jp *JobProposal) (int64, error)
@@ -48,6 +57,7 @@ type Service interface {
 	ProposeJob(jp *JobProposal) (int64, error)
 	RegisterManager(ms *FeedsManager) (int64, error)
 	RejectJobProposal(ctx context.Context, id int64) error
+	RotateFeedsManagerKey(ctx context.Context, id int64, publicKey crypto.PublicKey) error
 	SyncNodeInfo(id int64) error
 	UpdateJobProposalSpec(ctx context.Context, id int64, spec string) error
 	UpdateFeedsManager(ctx context.Context, mgr FeedsManager) error
@@ -64,6 +74,7 @@ type service struct {
 	queryer     postgres.Queryer
 	csaKeyStore keystore.CSA
 	ethKeyStore keystore.Eth
+	ocrKeyStore keystore.OCR
 	p2pKeyStore keystore.P2P
 	jobSpawner  job.Spawner
 	cfg         Config
@@ -94,6 +105,7 @@ func NewService(
 		p2pKeyStore: keyStore.P2P(),
 		csaKeyStore: keyStore.CSA(),
 		ethKeyStore: keyStore.Eth(),
+		ocrKeyStore: keyStore.OCR(),
 		cfg:         cfg,
 		connMgr:     newConnectionsManager(lggr),
 		chainSet:    chainSet,
@@ -117,6 +129,10 @@ func (s *service) RegisterManager(mgr *FeedsManager) (int64, error) {
 		return 0, ErrSingleFeedsManager
 	}
 
+	// Pin the manager's public key so that subsequent key changes require an
+	// explicit rotation.
+	mgr.PublicKeyFingerprint = null.StringFrom(mgr.PublicKey.Fingerprint())
+
 	id, err := s.orm.CreateManager(context.Background(), mgr)
 	if err != nil {
 		return 0, err
@@ -171,7 +187,7 @@ func (s *service) SyncNodeInfo(id int64) error {
 
 	// TODO: Update to support multiple chains
 	// See: https://app.clubhouse.io/chainlinklabs/story/14615/add-ability-to-set-chain-id-in-all-pipeline-tasks-that-interact-with-evm
-	_, err = fmsClient.UpdateNode(context.Background(), &pb.UpdateNodeRequest{
+	req := &pb.UpdateNodeRequest{
 		JobTypes: jobtypes,
 		// ChainID is deprecated but we still need to pass it in for backwards
 		// compatability. We now use ChainIds in order to support multichain.
@@ -184,8 +200,12 @@ func (s *service) SyncNodeInfo(id int64) error {
 		IsBootstrapPeer:    mgr.IsOCRBootstrapPeer,
 		BootstrapMultiaddr: mgr.OCRBootstrapPeerMultiaddr.ValueOrZero(),
 		Version:            s.version,
-	})
-	if err != nil {
+	}
+	if req.Signature, err = s.signRequest(req); err != nil {
+		return errors.Wrap(err, "could not sign request")
+	}
+
+	if _, err = fmsClient.UpdateNode(context.Background(), req); err != nil {
 		return err
 	}
 
@@ -195,8 +215,18 @@ func (s *service) SyncNodeInfo(id int64) error {
 // UpdateFeedsManager updates the feed manager details, takes down the
 // connection and reestablishes a new connection with the updated public key.
 func (s *service) UpdateFeedsManager(ctx context.Context, mgr FeedsManager) error {
-	err := s.orm.UpdateManager(ctx, mgr)
+	existing, err := s.orm.GetManager(ctx, mgr.ID)
 	if err != nil {
+		return errors.Wrap(err, "could not fetch manager")
+	}
+
+	// Verify the public key against the pinned fingerprint. A key change must
+	// go through RotateFeedsManagerKey so that it is an explicit operation.
+	if !existing.MatchesPinnedKey(mgr.PublicKey) {
+		return ErrPublicKeyMismatch
+	}
+
+	if err = s.orm.UpdateManager(ctx, mgr); err != nil {
 		return errors.Wrap(err, "could not update manager")
 	}
 
@@ -217,6 +247,36 @@ func (s *service) UpdateFeedsManager(ctx context.Context, mgr FeedsManager) erro
 	return nil
 }
 
+// RotateFeedsManagerKey rotates the pinned public key of a feeds manager and
+// performs a reconnection handshake against the new key.
+func (s *service) RotateFeedsManagerKey(ctx context.Context, id int64, publicKey crypto.PublicKey) error {
+	mgr, err := s.orm.GetManager(ctx, id)
+	if err != nil {
+		return errors.Wrap(err, "could not fetch manager")
+	}
+
+	if err = s.orm.UpdateManagerPublicKey(ctx, id, publicKey); err != nil {
+		return errors.Wrap(err, "could not rotate manager public key")
+	}
+
+	s.lggr.Infow("Rotated Feeds Manager public key", "feedsManagerID", id, "fingerprint", publicKey.Fingerprint())
+
+	if err = s.connMgr.Disconnect(id); err != nil {
+		s.lggr.Info("Feeds Manager not connected, attempting to connect")
+	}
+
+	privkey, err := s.getCSAPrivateKey()
+	if err != nil {
+		return err
+	}
+
+	mgr.PublicKey = publicKey
+	mgr.PublicKeyFingerprint = null.StringFrom(publicKey.Fingerprint())
+	s.connectFeedManager(*mgr, privkey)
+
+	return nil
+}
+
 // ListManagerServices lists all the manager services.
 func (s *service) ListManagers() ([]FeedsManager, error) {
 	managers, err := s.orm.ListManagers(context.Background())
@@ -303,6 +363,20 @@ func (s *service) ProposeJob(jp *JobProposal) (int64, error) {
 		}
 	}
 
+	// Auto-reject the proposal if it targets a chain which the operator has
+	// not approved this feeds manager for.
+	if err = s.checkChainPermitted(ctx, jp); err != nil {
+		return 0, err
+	}
+
+	// Attach the job validation dry run result so that the operator can see
+	// whether an approval would fail before attempting it.
+	if j, jerr := s.generateJob(jp.Spec); jerr != nil {
+		jp.ValidationError = null.StringFrom(jerr.Error())
+	} else {
+		jp.ValidationError = s.dryRunValidate(j)
+	}
+
 	// Reset the job proposal
 	jp.Status = JobProposalStatusPending
 
@@ -339,6 +413,17 @@ func (s *service) UpdateJobProposalSpec(ctx context.Context, id int64, spec stri
 		return errors.Wrap(err, "could not update job proposal")
 	}
 
+	// Refresh the job validation dry run result against the new spec.
+	var verr null.String
+	if j, jerr := s.generateJob(spec); jerr != nil {
+		verr = null.StringFrom(jerr.Error())
+	} else {
+		verr = s.dryRunValidate(j)
+	}
+	if err = s.orm.UpdateJobProposalValidationError(ctx, id, verr); err != nil {
+		return errors.Wrap(err, "could not update job proposal validation result")
+	}
+
 	return nil
 }
 
@@ -374,9 +459,14 @@ func (s *service) ApproveJobProposal(ctx context.Context, id int64) error {
 		}
 
 		// Send to FMS Client
-		if _, err = fmsClient.ApprovedJob(ctx, &pb.ApprovedJobRequest{
+		req := &pb.ApprovedJobRequest{
 			Uuid: jp.RemoteUUID.String(),
-		}); err != nil {
+		}
+		if req.Signature, err = s.signRequest(req); err != nil {
+			return err
+		}
+
+		if _, err = fmsClient.ApprovedJob(ctx, req); err != nil {
 			return err
 		}
 
@@ -389,6 +479,52 @@ func (s *service) ApproveJobProposal(ctx context.Context, id int64) error {
 	return nil
 }
 
+// JobProposalBulkResult is the outcome of a single proposal within a bulk
+// approve or reject operation.
+type JobProposalBulkResult struct {
+	ProposalID int64
+	Success    bool
+	Error      string
+}
+
+// BulkApproveJobProposals approves each of the given job proposals. Every
+// proposal is approved in its own transaction so a failure does not roll back
+// the proposals which have already been approved; the outcome for each id is
+// recorded in the returned results.
+func (s *service) BulkApproveJobProposals(ctx context.Context, ids []int64) []JobProposalBulkResult {
+	results := make([]JobProposalBulkResult, 0, len(ids))
+
+	for _, id := range ids {
+		result := JobProposalBulkResult{ProposalID: id, Success: true}
+		if err := s.ApproveJobProposal(ctx, id); err != nil {
+			result.Success = false
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// BulkRejectJobProposals rejects each of the given job proposals, recording
+// the outcome for each id in the returned results.
+func (s *service) BulkRejectJobProposals(ctx context.Context, ids []int64) []JobProposalBulkResult {
+	results := make([]JobProposalBulkResult, 0, len(ids))
+
+	for _, id := range ids {
+		result := JobProposalBulkResult{ProposalID: id, Success: true}
+		if err := s.RejectJobProposal(ctx, id); err != nil {
+			result.Success = false
+			result.Error = err.Error()
+		}
+
+		results = append(results, result)
+	}
+
+	return results
+}
+
 func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
 	jp, err := s.orm.GetJobProposal(ctx, id)
 	if err != nil {
@@ -409,9 +545,14 @@ func (s *service) RejectJobProposal(ctx context.Context, id int64) error {
 			return err
 		}
 
-		if _, err = fmsClient.RejectedJob(ctx, &pb.RejectedJobRequest{
+		req := &pb.RejectedJobRequest{
 			Uuid: jp.RemoteUUID.String(),
-		}); err != nil {
+		}
+		if req.Signature, err = s.signRequest(req); err != nil {
+			return err
+		}
+
+		if _, err = fmsClient.RejectedJob(ctx, req); err != nil {
 			return err
 		}
 
@@ -462,9 +603,14 @@ func (s *service) CancelJobProposal(ctx context.Context, id int64) error {
 		}
 
 		// Send to FMS Client
-		if _, err = fmsClient.CancelledJob(ctx, &pb.CancelledJobRequest{
+		req := &pb.CancelledJobRequest{
 			Uuid: jp.RemoteUUID.String(),
-		}); err != nil {
+		}
+		if req.Signature, err = s.signRequest(req); err != nil {
+			return err
+		}
+
+		if _, err = fmsClient.CancelledJob(ctx, req); err != nil {
 			return err
 		}
 
@@ -543,6 +689,23 @@ func (s *service) getCSAPrivateKey() (privkey []byte, err error) {
 	return keys[0].Raw(), nil
 }
 
+// signRequest signs the marshaled message with the node's CSA key so that
+// the feeds manager can authenticate node originated messages beyond the
+// transport credentials.
+func (s *service) signRequest(msg proto.Message) ([]byte, error) {
+	privkey, err := s.getCSAPrivateKey()
+	if err != nil {
+		return nil, err
+	}
+
+	payload, err := proto.Marshal(msg)
+	if err != nil {
+		return nil, errors.Wrap(err, "could not marshal message")
+	}
+
+	return ed25519.Sign(ed25519.PrivateKey(privkey), payload), nil
+}
+
 // Unsafe_SetConnectionsManager sets the ConnectionsManager on the service.
 //
 // We need to be able to inject a mock for the client to facilitate integration
@@ -575,10 +738,103 @@ func (s *service) generateJob(spec string) (*job.Job, error) {
 	if err != nil {
 		return nil, err
 	}
+	js.SpecTOML = spec
 
 	return &js, nil
 }
 
+// checkChainPermitted rejects a job proposal which targets a chain id that
+// the feeds manager is not scoped to. The rejected proposal is persisted with
+// the reason so that the operator can see why it was not accepted.
+func (s *service) checkChainPermitted(ctx context.Context, jp *JobProposal) error {
+	mgr, err := s.orm.GetManager(ctx, jp.FeedsManagerID)
+	if err != nil {
+		return errors.Wrap(err, "failed to get feeds manager")
+	}
+
+	if len(mgr.ChainIDs) == 0 {
+		return nil
+	}
+
+	j, err := s.generateJob(jp.Spec)
+	if err != nil {
+		return errors.Wrap(err, "failed to generate a job based on spec")
+	}
+
+	chainID := s.jobChainID(j)
+	if mgr.IsChainPermitted(chainID.Int64()) {
+		return nil
+	}
+
+	jp.Status = JobProposalStatusRejected
+	jp.RejectionReason = null.StringFrom(fmt.Sprintf("feeds manager is not permitted to propose jobs for chain id %s", chainID.String()))
+	if _, err = s.orm.UpsertJobProposal(ctx, jp); err != nil {
+		return errors.Wrap(err, "failed to persist the rejected job proposal")
+	}
+
+	return errors.Errorf("chain id %s is not permitted for this feeds manager", chainID.String())
+}
+
+// dryRunValidate runs the node's job validation against a generated job and
+// returns a description of the first problem found. The proposal is still
+// accepted either way; the result is only surfaced on the proposal resource.
+func (s *service) dryRunValidate(j *job.Job) null.String {
+	var chainID *utils.Big
+	switch j.Type {
+	case job.OffchainReporting:
+		chainID = j.OffchainreportingOracleSpec.EVMChainID
+	case job.FluxMonitor:
+		chainID = j.FluxMonitorSpec.EVMChainID
+	default:
+		// NOOP
+	}
+
+	// When the spec does not pin a chain the node's default chain is used,
+	// which always exists.
+	if chainID != nil {
+		if _, err := s.chainSet.Get(chainID.ToInt()); err != nil {
+			return null.StringFrom(fmt.Sprintf("chain not available: %s", err))
+		}
+	}
+
+	if j.Type == job.OffchainReporting && !j.OffchainreportingOracleSpec.IsBootstrapPeer {
+		spec := j.OffchainreportingOracleSpec
+
+		if spec.EncryptedOCRKeyBundleID != nil {
+			if _, err := s.ocrKeyStore.Get(spec.EncryptedOCRKeyBundleID.String()); err != nil {
+				return null.StringFrom(fmt.Sprintf("missing OCR key bundle: %s", spec.EncryptedOCRKeyBundleID))
+			}
+		}
+
+		if spec.TransmitterAddress != nil {
+			if _, err := s.ethKeyStore.Get(spec.TransmitterAddress.Hex()); err != nil {
+				return null.StringFrom(fmt.Sprintf("missing transmitter key: %s", spec.TransmitterAddress))
+			}
+		}
+	}
+
+	return null.String{}
+}
+
+// jobChainID returns the EVM chain id which the job will run against, falling
+// back to the default chain id when the spec does not set one.
+func (s *service) jobChainID(j *job.Job) *big.Int {
+	var chainID *utils.Big
+	switch j.Type {
+	case job.OffchainReporting:
+		chainID = j.OffchainreportingOracleSpec.EVMChainID
+	case job.FluxMonitor:
+		chainID = j.FluxMonitorSpec.EVMChainID
+	default:
+		// NOOP
+	}
+	if chainID == nil {
+		return s.cfg.ChainID()
+	}
+
+	return chainID.ToInt()
+}
+
 func (s *service) validateJobProposal(jp *JobProposal) error {
 	// Validate the job spec
 	j, err := s.generateJob(jp.Spec)
@@ -88,6 +88,7 @@ func setupTestService(t *testing.T) *TestService {
 		fmsClient   = &mocks.FeedsManagerClient{}
 		csaKeystore = &ksmocks.CSA{}
 		ethKeystore = &ksmocks.Eth{}
+		ocrKeystore = &ksmocks.OCR{}
 		p2pKeystore = &ksmocks.P2P{}
 		cfg         = &mocks.Config{}
 	)
@@ -112,6 +113,7 @@ func setupTestService(t *testing.T) *TestService {
 	keyStore := new(ksmocks.Master)
 	keyStore.On("CSA").Return(csaKeystore)
 	keyStore.On("Eth").Return(ethKeystore)
+	keyStore.On("OCR").Return(ocrKeystore)
 	keyStore.On("P2P").Return(p2pKeystore)
 	svc := feeds.NewService(orm, jobORM, queryer, spawner, keyStore, cfg, cc, logger.TestLogger(t), "1.0.0")
 	svc.SetConnectionsManager(connMgr)
@@ -253,6 +255,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 			before: func(svc *TestService) {
 				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
 				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(nil, sql.ErrNoRows)
+				svc.orm.On("GetManager", ctx, jp.FeedsManagerID).Return(&feeds.FeedsManager{ID: jp.FeedsManagerID}, nil)
 				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
 			},
 			wantID:   id,
@@ -269,6 +272,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 						RemoteUUID:     jp.RemoteUUID,
 						Status:         feeds.JobProposalStatusPending,
 					}, nil)
+				svc.orm.On("GetManager", ctx, jp.FeedsManagerID).Return(&feeds.FeedsManager{ID: jp.FeedsManagerID}, nil)
 				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
 			},
 			wantID:   id,
@@ -285,6 +289,7 @@ func Test_Service_ProposeJob(t *testing.T) {
 						RemoteUUID:     jp.RemoteUUID,
 						Status:         feeds.JobProposalStatusRejected,
 					}, nil)
+				svc.orm.On("GetManager", ctx, jp.FeedsManagerID).Return(&feeds.FeedsManager{ID: jp.FeedsManagerID}, nil)
 				svc.orm.On("UpsertJobProposal", ctx, &jp).Return(id, nil)
 			},
 			wantID:   id,
@@ -308,6 +313,25 @@ func Test_Service_ProposeJob(t *testing.T) {
 			},
 			wantErr: "only OCR job type supports multiaddr",
 		},
+		{
+			name:     "auto-rejects a proposal for a chain the manager is not permitted on",
+			proposal: jp,
+			before: func(svc *TestService) {
+				rejected := jp
+				rejected.Status = feeds.JobProposalStatusRejected
+				rejected.RejectionReason = null.StringFrom("feeds manager is not permitted to propose jobs for chain id 0")
+
+				svc.cfg.On("DefaultHTTPTimeout").Return(httpTimeout)
+				svc.cfg.On("ChainID").Return(big.NewInt(0))
+				svc.orm.On("GetJobProposalByRemoteUUID", ctx, jp.RemoteUUID).Return(nil, sql.ErrNoRows)
+				svc.orm.On("GetManager", ctx, jp.FeedsManagerID).Return(&feeds.FeedsManager{
+					ID:       jp.FeedsManagerID,
+					ChainIDs: pq.Int64Array{1337},
+				}, nil)
+				svc.orm.On("UpsertJobProposal", ctx, &rejected).Return(id, nil)
+			},
+			wantErr: "chain id 0 is not permitted for this feeds manager",
+		},
 		{
 			name:     "ensure an upsert validates the job propsal belongs to the feeds manager",
 			proposal: jp,
@@ -395,16 +419,20 @@ func Test_Service_SyncNodeInfo(t *testing.T) {
 	svc.connMgr.On("GetClient", feedsMgr.ID).Return(svc.fmsClient, nil)
 	svc.connMgr.On("IsConnected", feedsMgr.ID).Return(false, nil)
 
-	// Mock the send
-	svc.fmsClient.On("UpdateNode", ctx, &proto.UpdateNodeRequest{
-		JobTypes:           []proto.JobType{proto.JobType_JOB_TYPE_FLUX_MONITOR},
-		ChainId:            chainID.Int64(),
-		ChainIds:           []int64{chainID.Int64()},
-		AccountAddresses:   []string{sendingKey.Address.String()},
-		IsBootstrapPeer:    true,
-		BootstrapMultiaddr: multiaddr,
-		Version:            nodeVersion.Version,
-	}).Return(&proto.UpdateNodeResponse{}, nil)
+	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+
+	// Mock the send. The request is signed with the CSA key so we match on
+	// the fields and require a signature to be present.
+	svc.fmsClient.On("UpdateNode", ctx, mock.MatchedBy(func(req *proto.UpdateNodeRequest) bool {
+		return assert.ObjectsAreEqual(req.JobTypes, []proto.JobType{proto.JobType_JOB_TYPE_FLUX_MONITOR}) &&
+			req.ChainId == chainID.Int64() &&
+			assert.ObjectsAreEqual(req.ChainIds, []int64{chainID.Int64()}) &&
+			assert.ObjectsAreEqual(req.AccountAddresses, []string{sendingKey.Address.String()}) &&
+			req.IsBootstrapPeer &&
+			req.BootstrapMultiaddr == multiaddr &&
+			req.Version == nodeVersion.Version &&
+			len(req.Signature) > 0
+	})).Return(&proto.UpdateNodeResponse{}, nil)
 
 	err = svc.SyncNodeInfo(feedsMgr.ID)
 	require.NoError(t, err)
@@ -422,6 +450,7 @@ func Test_Service_UpdateFeedsManager(t *testing.T) {
 
 	svc := setupTestService(t)
 
+	svc.orm.On("GetManager", mock.Anything, mgr.ID).Return(&mgr, nil)
 	svc.orm.On("UpdateManager", mock.Anything, mgr).Return(nil)
 	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{key}, nil)
 	svc.connMgr.On("Disconnect", mgr.ID).Return(nil)
@@ -431,6 +460,51 @@ func Test_Service_UpdateFeedsManager(t *testing.T) {
 	require.NoError(t, err)
 }
 
+func Test_Service_UpdateFeedsManager_PinnedKeyMismatch(t *testing.T) {
+	var (
+		ctx      = context.Background()
+		existing = feeds.FeedsManager{
+			ID:                   1,
+			PublicKeyFingerprint: null.StringFrom("not the fingerprint"),
+		}
+		mgr = feeds.FeedsManager{
+			ID:        1,
+			PublicKey: crypto.PublicKey([]byte("rotated key")),
+		}
+	)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("GetManager", mock.Anything, mgr.ID).Return(&existing, nil)
+
+	err := svc.UpdateFeedsManager(ctx, mgr)
+	require.Error(t, err)
+	assert.ErrorIs(t, err, feeds.ErrPublicKeyMismatch)
+}
+
+func Test_Service_RotateFeedsManagerKey(t *testing.T) {
+	key := cltest.DefaultCSAKey
+
+	var (
+		ctx = context.Background()
+		mgr = feeds.FeedsManager{ID: 1}
+	)
+
+	pubKey, err := crypto.PublicKeyFromHex("0f17c3bf72de8beef6e2d17a14c0a972f5d7e0e66e70722373f12b88382d40f9")
+	require.NoError(t, err)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("GetManager", mock.Anything, mgr.ID).Return(&mgr, nil)
+	svc.orm.On("UpdateManagerPublicKey", mock.Anything, mgr.ID, *pubKey).Return(nil)
+	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{key}, nil)
+	svc.connMgr.On("Disconnect", mgr.ID).Return(nil)
+	svc.connMgr.On("Connect", mock.IsType(feeds.ConnectOpts{})).Return(nil)
+
+	err = svc.RotateFeedsManagerKey(ctx, mgr.ID, *pubKey)
+	require.NoError(t, err)
+}
+
 func Test_Service_ListJobProposals(t *testing.T) {
 	t.Parallel()
 
@@ -535,11 +609,12 @@ answer1 [type=median index=0];
 					feeds.JobProposalStatusApproved,
 					mock.Anything,
 				).Return(nil)
+				svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
 				svc.fmsClient.On("ApprovedJob",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					&proto.ApprovedJobRequest{
-						Uuid: pendingProposal.RemoteUUID.String(),
-					},
+					mock.MatchedBy(func(req *proto.ApprovedJobRequest) bool {
+						return req.Uuid == pendingProposal.RemoteUUID.String() && len(req.Signature) > 0
+					}),
 				).Return(&proto.ApprovedJobResponse{}, nil)
 			},
 		},
@@ -566,11 +641,12 @@ answer1 [type=median index=0];
 					feeds.JobProposalStatusApproved,
 					mock.Anything,
 				).Return(nil)
+				svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
 				svc.fmsClient.On("ApprovedJob",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					&proto.ApprovedJobRequest{
-						Uuid: cancelledProposal.RemoteUUID.String(),
-					},
+					mock.MatchedBy(func(req *proto.ApprovedJobRequest) bool {
+						return req.Uuid == cancelledProposal.RemoteUUID.String() && len(req.Signature) > 0
+					}),
 				).Return(&proto.ApprovedJobResponse{}, nil)
 			},
 		},
@@ -669,17 +745,57 @@ func Test_Service_RejectJobProposal(t *testing.T) {
 		mock.Anything,
 	).Return(nil)
 	svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
 	svc.fmsClient.On("RejectedJob",
 		mock.MatchedBy(func(ctx context.Context) bool { return true }),
-		&proto.RejectedJobRequest{
-			Uuid: jp.RemoteUUID.String(),
-		},
+		mock.MatchedBy(func(req *proto.RejectedJobRequest) bool {
+			return req.Uuid == jp.RemoteUUID.String() && len(req.Signature) > 0
+		}),
 	).Return(&proto.RejectedJobResponse{}, nil)
 
 	err := svc.RejectJobProposal(ctx, jp.ID)
 	require.NoError(t, err)
 }
 
+func Test_Service_BulkRejectJobProposals(t *testing.T) {
+	var (
+		ctx = context.Background()
+		jp  = &feeds.JobProposal{
+			ID:             1,
+			RemoteUUID:     uuid.NewV4(),
+			Status:         feeds.JobProposalStatusPending,
+			FeedsManagerID: 2,
+		}
+		missingID = int64(2)
+	)
+
+	svc := setupTestService(t)
+
+	svc.orm.On("GetJobProposal", ctx, jp.ID).Return(jp, nil)
+	svc.orm.On("UpdateJobProposalStatus",
+		jp.ID,
+		feeds.JobProposalStatusRejected,
+		mock.Anything,
+	).Return(nil)
+	svc.connMgr.On("GetClient", jp.FeedsManagerID).Return(svc.fmsClient, nil)
+	svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
+	svc.fmsClient.On("RejectedJob",
+		mock.MatchedBy(func(ctx context.Context) bool { return true }),
+		mock.MatchedBy(func(req *proto.RejectedJobRequest) bool {
+			return req.Uuid == jp.RemoteUUID.String() && len(req.Signature) > 0
+		}),
+	).Return(&proto.RejectedJobResponse{}, nil)
+	svc.orm.On("GetJobProposal", ctx, missingID).Return(nil, errors.New("Not Found"))
+
+	results := svc.BulkRejectJobProposals(ctx, []int64{jp.ID, missingID})
+	require.Len(t, results, 2)
+
+	assert.True(t, results[0].Success)
+	assert.Empty(t, results[0].Error)
+	assert.False(t, results[1].Success)
+	assert.Contains(t, results[1].Error, "Not Found")
+}
+
 func Test_Service_CancelJobProposal(t *testing.T) {
 	var (
 		externalJobID = uuid.NewV4()
@@ -715,11 +831,12 @@ func Test_Service_CancelJobProposal(t *testing.T) {
 				svc.jobORM.On("FindJobByExternalJobID", mock.AnythingOfType("*context.timerCtx"), externalJobID).Return(j, nil)
 				svc.spawner.On("DeleteJob", mock.AnythingOfType("*context.timerCtx"), j.ID).Return(nil)
 
+				svc.csaKeystore.On("GetAll").Return([]csakey.KeyV2{cltest.DefaultCSAKey}, nil)
 				svc.fmsClient.On("CancelledJob",
 					mock.MatchedBy(func(ctx context.Context) bool { return true }),
-					&proto.CancelledJobRequest{
-						Uuid: jp.RemoteUUID.String(),
-					},
+					mock.MatchedBy(func(req *proto.CancelledJobRequest) bool {
+						return req.Uuid == jp.RemoteUUID.String() && len(req.Signature) > 0
+					}),
 				).Return(&proto.CancelledJobResponse{}, nil)
 			},
 		},
@@ -814,6 +931,11 @@ func Test_Service_UpdateJobProposalSpec(t *testing.T) {
 					proposalID,
 					updatedSpec,
 				).Return(nil)
+				svc.orm.On("UpdateJobProposalValidationError",
+					mock.MatchedBy(func(ctx context.Context) bool { return true }),
+					proposalID,
+					mock.IsType(null.String{}),
+				).Return(nil)
 			},
 			proposalID: proposalID,
 		},
@@ -12,8 +12,11 @@ type Config interface {
 	DefaultHTTPTimeout() models.Duration
 	FlagsContractAddress() string
 	MinimumContractPayment() *assets.Link
+	MulticallContractAddress() string
 	EvmGasLimitDefault() uint64
 	EvmMaxQueuedTransactions() uint64
+	FMBatchFlushInterval() models.Duration
+	FMBatchSubmissions() bool
 	FMDefaultTransactionQueueDepth() uint32
 }
 
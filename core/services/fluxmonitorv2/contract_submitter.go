@@ -5,6 +5,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 )
 
@@ -24,6 +25,9 @@ type FluxAggregatorContractSubmitter struct {
 	orm      ORM
 	keyStore KeyStoreInterface
 	gasLimit uint64
+	// batcher, when set, aggregates submissions into Multicall transactions
+	// instead of creating one eth_tx per submission
+	batcher *bulletprooftxmanager.Batcher
 }
 
 // NewFluxAggregatorContractSubmitter constructs a new NewFluxAggregatorContractSubmitter
@@ -32,17 +36,19 @@ func NewFluxAggregatorContractSubmitter(
 	orm ORM,
 	keyStore KeyStoreInterface,
 	gasLimit uint64,
+	batcher *bulletprooftxmanager.Batcher,
 ) *FluxAggregatorContractSubmitter {
 	return &FluxAggregatorContractSubmitter{
 		FluxAggregatorInterface: contract,
 		orm:                     orm,
 		keyStore:                keyStore,
 		gasLimit:                gasLimit,
+		batcher:                 batcher,
 	}
 }
 
 // Submit submits the answer by writing a EthTx for the bulletprooftxmanager to
-// pick up
+// pick up, or by queueing it on the batcher when batching is enabled
 func (c *FluxAggregatorContractSubmitter) Submit(roundID *big.Int, submission *big.Int) error {
 	fromAddress, err := c.keyStore.GetRoundRobinAddress()
 	if err != nil {
@@ -54,6 +60,16 @@ func (c *FluxAggregatorContractSubmitter) Submit(roundID *big.Int, submission *b
 		return errors.Wrap(err, "abi.Pack failed")
 	}
 
+	if c.batcher != nil {
+		c.batcher.QueueCall(bulletprooftxmanager.BatchedCall{
+			FromAddress:    fromAddress,
+			ToAddress:      c.Address(),
+			EncodedPayload: payload,
+			GasLimit:       c.gasLimit,
+		})
+		return nil
+	}
+
 	return errors.Wrap(
 		c.orm.CreateEthTransaction(fromAddress, c.Address(), payload, c.gasLimit),
 		"failed to send Eth transaction",
@@ -3,11 +3,15 @@ package fluxmonitorv2_test
 import (
 	"math/big"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/mock"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/mocks"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	btmmocks "github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager/mocks"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	fmmocks "github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2/mocks"
 	"github.com/stretchr/testify/assert"
@@ -19,7 +23,7 @@ func TestFluxAggregatorContractSubmitter_Submit(t *testing.T) {
 		orm            = new(fmmocks.ORM)
 		keyStore       = new(fmmocks.KeyStoreInterface)
 		gasLimit       = uint64(2100)
-		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit)
+		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit, nil)
 
 		toAddress   = cltest.NewAddress()
 		fromAddress = cltest.NewAddress()
@@ -37,3 +41,30 @@ func TestFluxAggregatorContractSubmitter_Submit(t *testing.T) {
 	err = submitter.Submit(roundID, submission)
 	assert.NoError(t, err)
 }
+
+func TestFluxAggregatorContractSubmitter_SubmitBatched(t *testing.T) {
+	var (
+		fluxAggregator = new(mocks.FluxAggregator)
+		orm            = new(fmmocks.ORM)
+		keyStore       = new(fmmocks.KeyStoreInterface)
+		txm            = new(btmmocks.TxManager)
+		gasLimit       = uint64(2100)
+		batcher        = bulletprooftxmanager.NewBatcher(logger.TestLogger(t), txm, cltest.NewAddress(), time.Hour, bulletprooftxmanager.SendEveryStrategy{})
+		submitter      = fluxmonitorv2.NewFluxAggregatorContractSubmitter(fluxAggregator, orm, keyStore, gasLimit, batcher)
+
+		toAddress   = cltest.NewAddress()
+		fromAddress = cltest.NewAddress()
+		roundID     = big.NewInt(1)
+		submission  = big.NewInt(2)
+	)
+
+	keyStore.On("GetRoundRobinAddress", mock.Anything).Return(fromAddress, nil)
+	fluxAggregator.On("Address").Return(toAddress)
+
+	err := submitter.Submit(roundID, submission)
+	assert.NoError(t, err)
+
+	// The submission is queued on the batcher rather than written as its own
+	// eth_tx
+	orm.AssertNotCalled(t, "CreateEthTransaction", mock.Anything, mock.Anything, mock.Anything, mock.Anything)
+}
@@ -1,6 +1,9 @@
 package fluxmonitorv2
 
 import (
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -20,6 +23,12 @@ type Delegate struct {
 	pipelineRunner pipeline.Runner
 	chainSet       evm.ChainSet
 	lggr           logger.Logger
+
+	batchersMu sync.Mutex
+	// batchers holds one submission Batcher per chain, shared by every Flux
+	// Monitor job on that chain so that submissions across jobs are batched
+	// together
+	batchers map[string]*bulletprooftxmanager.Batcher
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -35,13 +44,14 @@ func NewDelegate(
 	lggr logger.Logger,
 ) *Delegate {
 	return &Delegate{
-		db,
-		ethKeyStore,
-		jobORM,
-		pipelineORM,
-		pipelineRunner,
-		chainSet,
-		lggr.Named("FluxMonitor"),
+		db:             db,
+		ethKeyStore:    ethKeyStore,
+		jobORM:         jobORM,
+		pipelineORM:    pipelineORM,
+		pipelineRunner: pipelineRunner,
+		chainSet:       chainSet,
+		lggr:           lggr.Named("FluxMonitor"),
+		batchers:       make(map[string]*bulletprooftxmanager.Batcher),
 	}
 }
 
@@ -50,8 +60,8 @@ func (d *Delegate) JobType() job.Type {
 	return job.FluxMonitor
 }
 
-func (Delegate) AfterJobCreated(spec job.Job)  {}
-func (Delegate) BeforeJobDeleted(spec job.Job) {}
+func (*Delegate) AfterJobCreated(spec job.Job)  {}
+func (*Delegate) BeforeJobDeleted(spec job.Job) {}
 
 // ServicesForSpec returns the flux monitor service for the job spec
 func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err error) {
@@ -64,6 +74,14 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err erro
 	}
 	strategy := bulletprooftxmanager.NewQueueingTxStrategy(jb.ExternalJobID, chain.Config().FMDefaultTransactionQueueDepth(), chain.Config().FMSimulateTransactions())
 
+	var batcher *bulletprooftxmanager.Batcher
+	if chain.Config().FMBatchSubmissions() {
+		batcher, err = d.batcherForChain(chain)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	fm, err := NewFromJobSpec(
 		jb,
 		d.db,
@@ -75,11 +93,46 @@ func (d *Delegate) ServicesForSpec(jb job.Job) (services []job.Service, err erro
 		chain.LogBroadcaster(),
 		d.pipelineRunner,
 		chain.Config(),
+		batcher,
 		d.lggr,
 	)
 	if err != nil {
 		return nil, err
 	}
 
+	if batcher != nil {
+		return []job.Service{batcher, fm}, nil
+	}
 	return []job.Service{fm}, nil
 }
+
+// batcherForChain returns the shared submission Batcher for the given chain,
+// creating it on first use. The Batcher's Start/Close are reference counted
+// so returning it as a service from every job using it is safe.
+func (d *Delegate) batcherForChain(chain evm.Chain) (*bulletprooftxmanager.Batcher, error) {
+	cfg := chain.Config()
+	addr := cfg.MulticallContractAddress()
+	if !common.IsHexAddress(addr) {
+		return nil, errors.Errorf("FM_BATCH_SUBMISSIONS requires MULTICALL_CONTRACT_ADDRESS to be set to a valid address, got %q", addr)
+	}
+	interval := cfg.FMBatchFlushInterval().Duration()
+	if interval <= 0 {
+		return nil, errors.Errorf("FM_BATCH_SUBMISSIONS requires a positive FM_BATCH_FLUSH_INTERVAL, got %s", interval)
+	}
+
+	d.batchersMu.Lock()
+	defer d.batchersMu.Unlock()
+	chainID := chain.ID().String()
+	if b, exists := d.batchers[chainID]; exists {
+		return b, nil
+	}
+	b := bulletprooftxmanager.NewBatcher(
+		d.lggr,
+		chain.TxManager(),
+		common.HexToAddress(addr),
+		interval,
+		bulletprooftxmanager.NewSendEveryStrategy(cfg.FMSimulateTransactions()),
+	)
+	d.batchers[chainID] = b
+	return b, nil
+}
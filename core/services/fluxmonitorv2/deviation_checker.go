@@ -1,8 +1,13 @@
 package fluxmonitorv2
 
 import (
+	"context"
+
 	"github.com/shopspring/decimal"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 // DeviationThresholds carries parameters used by the threshold-trigger logic
@@ -11,10 +16,60 @@ type DeviationThresholds struct {
 	Abs float64 // Absolute change required, i.e. |new-old| >= Abs
 }
 
+// ThresholdExpression computes the relative deviation threshold for a given
+// pair of answers by running a pipeline with curAnswer and nextAnswer
+// variables, so that e.g. the threshold can be tightened near zero or varied
+// by time of day. The pipeline is executed in-memory and its runs are never
+// persisted.
+type ThresholdExpression struct {
+	spec   pipeline.Spec
+	runner pipeline.Runner
+}
+
+// NewThresholdExpression constructs a ThresholdExpression from the DOT source
+// in the job spec's thresholdExpression field.
+func NewThresholdExpression(source string, jobSpec job.Job, runner pipeline.Runner) *ThresholdExpression {
+	return &ThresholdExpression{
+		spec: pipeline.Spec{
+			DotDagSource: source,
+			JobID:        jobSpec.ID,
+			JobName:      jobSpec.Name.ValueOrZero(),
+		},
+		runner: runner,
+	}
+}
+
+// Evaluate runs the expression pipeline and returns the relative threshold to
+// apply for the given answers.
+func (e *ThresholdExpression) Evaluate(curAnswer, nextAnswer decimal.Decimal, lggr logger.Logger) (float64, error) {
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"curAnswer":  curAnswer.String(),
+		"nextAnswer": nextAnswer.String(),
+	})
+	_, results, err := e.runner.ExecuteRun(context.Background(), e.spec, vars, lggr)
+	if err != nil {
+		return 0, err
+	}
+	result, err := results.FinalResult().SingularResult()
+	if err != nil {
+		return 0, err
+	}
+	if result.Error != nil {
+		return 0, result.Error
+	}
+	threshold, err := utils.ToDecimal(result.Value)
+	if err != nil {
+		return 0, err
+	}
+	f, _ := threshold.Float64()
+	return f, nil
+}
+
 // DeviationChecker checks the deviation of the next answer against the current
 // answer.
 type DeviationChecker struct {
 	Thresholds DeviationThresholds
+	expression *ThresholdExpression
 	lggr       logger.Logger
 }
 
@@ -29,6 +84,15 @@ func NewDeviationChecker(rel, abs float64, lggr logger.Logger) *DeviationChecker
 	}
 }
 
+// NewExpressionDeviationChecker constructs a new deviation checker that
+// computes the relative threshold from an expression pipeline, falling back to
+// rel if the expression fails to evaluate.
+func NewExpressionDeviationChecker(expression *ThresholdExpression, rel, abs float64, lggr logger.Logger) *DeviationChecker {
+	c := NewDeviationChecker(rel, abs, lggr)
+	c.expression = expression
+	return c
+}
+
 // NewZeroDeviationChecker constructs a new deviation checker with 0 as thresholds.
 func NewZeroDeviationChecker(lggr logger.Logger) *DeviationChecker {
 	return NewDeviationChecker(0, 0, lggr)
@@ -42,7 +106,7 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 		"nextAnswer", nextAnswer,
 	}
 
-	if c.Thresholds.Rel == 0 && c.Thresholds.Abs == 0 {
+	if c.expression == nil && c.Thresholds.Rel == 0 && c.Thresholds.Abs == 0 {
 		c.lggr.Debugw(
 			"Deviation thresholds both zero; short-circuiting deviation checker to "+
 				"true, regardless of feed values", loggerFields...)
@@ -65,12 +129,24 @@ func (c *DeviationChecker) OutsideDeviation(curAnswer, nextAnswer decimal.Decima
 		return true
 	}
 
+	rel := c.Thresholds.Rel
+	if c.expression != nil {
+		evaluated, err := c.expression.Evaluate(curAnswer, nextAnswer, c.lggr)
+		if err != nil {
+			c.lggr.Errorw("Error evaluating threshold expression; falling back to static threshold",
+				append(loggerFields, "err", err)...)
+		} else {
+			rel = evaluated
+			loggerFields = append(loggerFields, "evaluatedThreshold", rel)
+		}
+	}
+
 	// 100*|new-old|/|old|: Deviation (relative to curAnswer) as a percentage
 	percentage := diff.Div(curAnswer.Abs()).Mul(decimal.NewFromInt(100))
 
 	loggerFields = append(loggerFields, "percentage", percentage)
 
-	if percentage.LessThan(decimal.NewFromFloat(c.Thresholds.Rel)) {
+	if percentage.LessThan(decimal.NewFromFloat(rel)) {
 		c.lggr.Debugw("Relative deviation threshold not met", loggerFields...)
 		return false
 	}
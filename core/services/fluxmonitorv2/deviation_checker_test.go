@@ -6,9 +6,13 @@ import (
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	pipelinemocks "github.com/smartcontractkit/chainlink/core/services/pipeline/mocks"
 )
 
 type outsideDeviationRow struct {
@@ -81,3 +85,53 @@ func TestDeviationChecker_OutsideDeviation(t *testing.T) {
 		t.Run(tc.name+" max absolute threshold", func(t *testing.T) { c(test3) })
 	}
 }
+
+func TestDeviationChecker_ThresholdExpression(t *testing.T) {
+	t.Parallel()
+
+	i := decimal.NewFromInt
+
+	testCases := []struct {
+		name        string
+		threshold   interface{}
+		curPrice    decimal.Decimal
+		nextPrice   decimal.Decimal
+		expectation bool
+	}{
+		{"evaluated threshold met", decimal.NewFromInt(2), i(100), i(103), true},
+		{"evaluated threshold not met", decimal.NewFromInt(5), i(100), i(103), false},
+		{"expression errors, falls back to static threshold", nil, i(100), i(103), true},
+	}
+
+	for _, tc := range testCases {
+		tc := tc
+		t.Run(tc.name, func(t *testing.T) {
+			runner := new(pipelinemocks.Runner)
+			if tc.threshold != nil {
+				runner.
+					On("ExecuteRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(pipeline.Run{}, pipeline.TaskRunResults{
+						{
+							Result: pipeline.Result{Value: tc.threshold},
+							Task:   &pipeline.MemoTask{},
+						},
+					}, nil)
+			} else {
+				runner.
+					On("ExecuteRun", mock.Anything, mock.Anything, mock.Anything, mock.Anything).
+					Return(pipeline.Run{}, pipeline.TaskRunResults{}, fmt.Errorf("expression failed"))
+			}
+
+			checker := fluxmonitorv2.NewExpressionDeviationChecker(
+				fluxmonitorv2.NewThresholdExpression(`t [type=memo value="2"]`, job.Job{}, runner),
+				2, // static threshold, used as the fallback when the expression fails
+				0,
+				logger.TestLogger(t),
+			)
+
+			assert.Equal(t, tc.expectation,
+				checker.OutsideDeviation(tc.curPrice, tc.nextPrice))
+			runner.AssertExpectations(t)
+		})
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flags_wrapper"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/flux_aggregator_wrapper"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2/promfm"
 	"github.com/smartcontractkit/chainlink/core/services/job"
@@ -149,6 +150,7 @@ func NewFromJobSpec(
 	logBroadcaster log.Broadcaster,
 	pipelineRunner pipeline.Runner,
 	cfg Config,
+	batcher *bulletprooftxmanager.Batcher,
 	lggr logger.Logger,
 ) (*FluxMonitor, error) {
 	fmSpec := jobSpec.FluxMonitorSpec
@@ -175,6 +177,7 @@ func NewFromJobSpec(
 		orm,
 		keyStore,
 		cfg.EvmGasLimitDefault(),
+		batcher,
 	)
 
 	flags, err := NewFlags(cfg.FlagsContractAddress(), ethClient)
@@ -227,6 +230,20 @@ func NewFromJobSpec(
 		return nil, err
 	}
 
+	deviationChecker := NewDeviationChecker(
+		float64(fmSpec.Threshold),
+		float64(fmSpec.AbsoluteThreshold),
+		fmLogger,
+	)
+	if fmSpec.ThresholdExpression != "" {
+		deviationChecker = NewExpressionDeviationChecker(
+			NewThresholdExpression(fmSpec.ThresholdExpression, jobSpec, pipelineRunner),
+			float64(fmSpec.Threshold),
+			float64(fmSpec.AbsoluteThreshold),
+			fmLogger,
+		)
+	}
+
 	return NewFluxMonitor(
 		pipelineRunner,
 		jobSpec,
@@ -240,11 +257,7 @@ func NewFromJobSpec(
 		paymentChecker,
 		fmSpec.ContractAddress.Address(),
 		contractSubmitter,
-		NewDeviationChecker(
-			float64(fmSpec.Threshold),
-			float64(fmSpec.AbsoluteThreshold),
-			fmLogger,
-		),
+		deviationChecker,
 		NewSubmissionChecker(min, max),
 		flags,
 		fluxAggregator,
@@ -707,6 +720,7 @@ func (fm *FluxMonitor) respondToNewRoundLog(log flux_aggregator_wrapper.FluxAggr
 			"databaseID":    fm.jobSpec.ID,
 			"externalJobID": fm.jobSpec.ExternalJobID,
 			"name":          fm.jobSpec.Name.ValueOrZero(),
+			"params":        map[string]interface{}(fm.jobSpec.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"meta": metaDataForBridge,
@@ -914,6 +928,7 @@ func (fm *FluxMonitor) pollIfEligible(pollReq PollRequestType, deviationChecker
 			"databaseID":    fm.jobSpec.ID,
 			"externalJobID": fm.jobSpec.ExternalJobID,
 			"name":          fm.jobSpec.Name.ValueOrZero(),
+			"params":        map[string]interface{}(fm.jobSpec.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"meta": metaDataForBridge,
@@ -462,6 +462,7 @@ func TestFluxMonitor_PollIfEligible(t *testing.T) {
 								"databaseID":    int32(0),
 								"externalJobID": uuid.UUID{},
 								"name":          "",
+								"params":        map[string]interface{}(nil),
 							},
 						},
 					), mock.Anything).
@@ -1947,6 +1948,7 @@ func TestFluxMonitor_DrumbeatTicker(t *testing.T) {
 						"databaseID":    int32(0),
 						"externalJobID": uuid.UUID{},
 						"name":          "",
+						"params":        map[string]interface{}(nil),
 					},
 				},
 			), mock.Anything).
@@ -8,6 +8,7 @@ import (
 	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -40,6 +41,7 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 			ContractAddress:     specIntThreshold.ContractAddress,
 			Threshold:           float32(specIntThreshold.Threshold),
 			AbsoluteThreshold:   float32(specIntThreshold.AbsoluteThreshold),
+			ThresholdExpression: specIntThreshold.ThresholdExpression,
 			PollTimerPeriod:     specIntThreshold.PollTimerPeriod,
 			PollTimerDisabled:   specIntThreshold.PollTimerDisabled,
 			IdleTimerPeriod:     specIntThreshold.IdleTimerPeriod,
@@ -88,6 +90,12 @@ func ValidatedFluxMonitorSpec(config ValidationConfig, ts string) (job.Job, erro
 		}
 	}
 
+	if jb.FluxMonitorSpec.ThresholdExpression != "" {
+		if _, err := pipeline.Parse(jb.FluxMonitorSpec.ThresholdExpression); err != nil {
+			return jb, errors.Wrap(err, "while validating threshold expression")
+		}
+	}
+
 	if !validatePollTimer(jb.FluxMonitorSpec.PollTimerDisabled, minTimeout, jb.FluxMonitorSpec.PollTimerPeriod) {
 		return jb, errors.Errorf("PollTimerPeriod (%v) must be equal or greater than the smallest value of MaxTaskDuration param, DEFAULT_HTTP_TIMEOUT config var, or MinTimeout of all tasks (%v)", jb.FluxMonitorSpec.PollTimerPeriod, minTimeout)
 	}
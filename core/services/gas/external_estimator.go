@@ -0,0 +1,183 @@
+package gas
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// externalEstimatorTimeout bounds each request to the external estimation
+// service. Estimation is on the hot path for sending transactions so we would
+// rather fall back to defaults than block indefinitely
+const externalEstimatorTimeout = 10 * time.Second
+
+var _ Estimator = &externalEstimator{}
+
+// externalEstimator delegates gas estimation to an operator-supplied HTTP
+// service (GAS_ESTIMATOR_MODE=External, GAS_ESTIMATOR_EXTERNAL_URL=...) so
+// custom estimation logic can be plugged in per chain without forking the
+// node.
+//
+// The service must answer POST requests at the configured URL with a JSON
+// body of the form:
+//
+//	{"chainId":"1","calldata":"0x...","gasLimit":500000,"type":"legacy"}
+//
+// and respond with prices in wei:
+//
+//	{"gasPrice":"30000000000"}                                  for "legacy"
+//	{"tipCap":"2000000000","feeCap":"100000000000"}             for "dynamic"
+//
+// On any error the estimator falls back to the chain's fixed price defaults.
+type externalEstimator struct {
+	config   Config
+	chainID  big.Int
+	client   *http.Client
+	lggr     logger.Logger
+	fallback Estimator
+}
+
+// NewExternalEstimator returns a new Estimator backed by an external HTTP
+// estimation service
+func NewExternalEstimator(lggr logger.Logger, config Config, chainID big.Int) Estimator {
+	return &externalEstimator{
+		config:   config,
+		chainID:  chainID,
+		client:   &http.Client{Timeout: externalEstimatorTimeout},
+		lggr:     lggr.Named("ExternalEstimator"),
+		fallback: NewFixedPriceEstimator(config),
+	}
+}
+
+func (e *externalEstimator) Start() error                                    { return nil }
+func (e *externalEstimator) Close() error                                    { return nil }
+func (e *externalEstimator) OnNewLongestChain(_ context.Context, _ eth.Head) {}
+
+type externalEstimateRequest struct {
+	ChainID  string `json:"chainId"`
+	Calldata string `json:"calldata,omitempty"`
+	GasLimit uint64 `json:"gasLimit"`
+	Type     string `json:"type"`
+}
+
+type externalEstimateResponse struct {
+	GasPrice *utils.Big `json:"gasPrice"`
+	TipCap   *utils.Big `json:"tipCap"`
+	FeeCap   *utils.Big `json:"feeCap"`
+}
+
+func (e *externalEstimator) GetLegacyGas(calldata []byte, gasLimit uint64, _ ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	res, err := e.fetch(externalEstimateRequest{
+		ChainID:  e.chainID.String(),
+		Calldata: hexutil.Encode(calldata),
+		GasLimit: gasLimit,
+		Type:     "legacy",
+	})
+	if err != nil || res.GasPrice == nil {
+		e.lggr.Warnw("External estimation failed; falling back to fixed price", "err", err)
+		return e.fallback.GetLegacyGas(calldata, gasLimit)
+	}
+	gasPrice = e.capGasPrice(res.GasPrice.ToInt())
+	chainSpecificGasLimit = applyMultiplier(gasLimit, e.config.EvmGasLimitMultiplier())
+	return
+}
+
+func (e *externalEstimator) BumpLegacyGas(originalGasPrice *big.Int, originalGasLimit uint64) (gasPrice *big.Int, gasLimit uint64, err error) {
+	currentGasPrice := e.config.EvmGasPriceDefault()
+	res, err := e.fetch(externalEstimateRequest{
+		ChainID:  e.chainID.String(),
+		GasLimit: originalGasLimit,
+		Type:     "legacy",
+	})
+	if err != nil {
+		e.lggr.Warnw("External estimation failed; bumping from default price", "err", err)
+	} else if res.GasPrice != nil {
+		currentGasPrice = e.capGasPrice(res.GasPrice.ToInt())
+	}
+	return BumpLegacyGasPriceOnly(e.config, currentGasPrice, originalGasPrice, originalGasLimit)
+}
+
+func (e *externalEstimator) GetDynamicFee(originalGasLimit uint64) (d DynamicFee, chainSpecificGasLimit uint64, err error) {
+	res, err := e.fetch(externalEstimateRequest{
+		ChainID:  e.chainID.String(),
+		GasLimit: originalGasLimit,
+		Type:     "dynamic",
+	})
+	if err != nil || res.TipCap == nil {
+		e.lggr.Warnw("External estimation failed; falling back to fixed price", "err", err)
+		return e.fallback.GetDynamicFee(originalGasLimit)
+	}
+	var feeCap *big.Int
+	if res.FeeCap != nil {
+		feeCap = e.capGasPrice(res.FeeCap.ToInt())
+	} else {
+		feeCap = e.config.EvmGasFeeCap()
+	}
+	chainSpecificGasLimit = applyMultiplier(originalGasLimit, e.config.EvmGasLimitMultiplier())
+	return DynamicFee{
+		FeeCap: feeCap,
+		TipCap: res.TipCap.ToInt(),
+	}, chainSpecificGasLimit, nil
+}
+
+func (e *externalEstimator) BumpDynamicFee(originalFee DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	currentTipCap := e.config.EvmGasTipCapDefault()
+	res, err := e.fetch(externalEstimateRequest{
+		ChainID:  e.chainID.String(),
+		GasLimit: originalGasLimit,
+		Type:     "dynamic",
+	})
+	if err != nil {
+		e.lggr.Warnw("External estimation failed; bumping from default tip cap", "err", err)
+	} else if res.TipCap != nil {
+		currentTipCap = res.TipCap.ToInt()
+	}
+	return BumpDynamicFeeOnly(e.config, currentTipCap, originalFee, originalGasLimit)
+}
+
+// capGasPrice clamps prices returned by the external service to the
+// configured maximum; the service is operator-supplied but we never allow it
+// to exceed ETH_MAX_GAS_PRICE_WEI
+func (e *externalEstimator) capGasPrice(gasPrice *big.Int) *big.Int {
+	max := e.config.EvmMaxGasPriceWei()
+	if gasPrice.Cmp(max) > 0 {
+		e.lggr.Warnw("External estimation returned a gas price exceeding the configured maximum; capping", "gasPrice", gasPrice.String(), "maxGasPriceWei", max.String())
+		return max
+	}
+	return gasPrice
+}
+
+func (e *externalEstimator) fetch(request externalEstimateRequest) (response externalEstimateResponse, err error) {
+	url := e.config.GasEstimatorExternalURL()
+	if url == "" {
+		return response, errors.New("GAS_ESTIMATOR_EXTERNAL_URL is not set")
+	}
+	body, err := json.Marshal(request)
+	if err != nil {
+		return response, errors.Wrap(err, "failed to marshal estimate request")
+	}
+	resp, err := e.client.Post(url, "application/json", bytes.NewReader(body))
+	if err != nil {
+		return response, errors.Wrapf(err, "error fetching estimate from %s", url)
+	}
+	defer func() {
+		if cerr := resp.Body.Close(); cerr != nil {
+			e.lggr.Warnw("Error closing estimate response body", "err", cerr)
+		}
+	}()
+	if resp.StatusCode != http.StatusOK {
+		return response, errors.Errorf("external estimator at %s returned status %d", url, resp.StatusCode)
+	}
+	err = json.NewDecoder(resp.Body).Decode(&response)
+	return response, errors.Wrap(err, "failed to decode estimate response")
+}
@@ -0,0 +1,111 @@
+package gas_test
+
+import (
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_ExternalEstimator(t *testing.T) {
+	t.Parallel()
+
+	chainID := *big.NewInt(1)
+
+	t.Run("GetLegacyGas returns the price from the external service, with multiplier applied", func(t *testing.T) {
+		var gotRequest map[string]interface{}
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&gotRequest))
+			w.Header().Set("Content-Type", "application/json")
+			_, err := w.Write([]byte(`{"gasPrice":"30000000000"}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		config := new(mocks.Config)
+		config.On("GasEstimatorExternalURL").Return(server.URL)
+		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000000000))
+		config.On("EvmGasLimitMultiplier").Return(float32(1.1))
+
+		e := gas.NewExternalEstimator(logger.TestLogger(t), config, chainID)
+
+		gasPrice, gasLimit, err := e.GetLegacyGas([]byte{0x12, 0x34}, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(30000000000), gasPrice)
+		assert.Equal(t, 110000, int(gasLimit))
+
+		assert.Equal(t, "1", gotRequest["chainId"])
+		assert.Equal(t, "0x1234", gotRequest["calldata"])
+		assert.Equal(t, "legacy", gotRequest["type"])
+
+		config.AssertExpectations(t)
+	})
+
+	t.Run("GetLegacyGas caps the price at EvmMaxGasPriceWei", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write([]byte(`{"gasPrice":"999000000000000"}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		config := new(mocks.Config)
+		config.On("GasEstimatorExternalURL").Return(server.URL)
+		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000000000))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+
+		e := gas.NewExternalEstimator(logger.TestLogger(t), config, chainID)
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(1000000000000), gasPrice)
+	})
+
+	t.Run("GetLegacyGas falls back to fixed price if the service is unreachable", func(t *testing.T) {
+		config := new(mocks.Config)
+		config.On("GasEstimatorExternalURL").Return("http://127.0.0.1:1")
+		config.On("EvmGasPriceDefault").Return(big.NewInt(42))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+
+		e := gas.NewExternalEstimator(logger.TestLogger(t), config, chainID)
+
+		gasPrice, _, err := e.GetLegacyGas(nil, 100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(42), gasPrice)
+	})
+
+	t.Run("GetDynamicFee returns the fee from the external service", func(t *testing.T) {
+		server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_, err := w.Write([]byte(`{"tipCap":"2000000000","feeCap":"100000000000"}`))
+			require.NoError(t, err)
+		}))
+		defer server.Close()
+
+		config := new(mocks.Config)
+		config.On("GasEstimatorExternalURL").Return(server.URL)
+		config.On("EvmMaxGasPriceWei").Return(big.NewInt(1000000000000))
+		config.On("EvmGasLimitMultiplier").Return(float32(1))
+
+		e := gas.NewExternalEstimator(logger.TestLogger(t), config, chainID)
+
+		fee, gasLimit, err := e.GetDynamicFee(100000)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(2000000000), fee.TipCap)
+		assert.Equal(t, big.NewInt(100000000000), fee.FeeCap)
+		assert.Equal(t, 100000, int(gasLimit))
+	})
+}
+
+func Test_RegisterEstimator(t *testing.T) {
+	t.Parallel()
+
+	assert.PanicsWithValue(t, `gas estimator mode "FixedPrice" is already registered`, func() {
+		gas.RegisterEstimator("FixedPrice", nil)
+	})
+}
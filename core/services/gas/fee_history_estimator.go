@@ -0,0 +1,252 @@
+package gas
+
+import (
+	"context"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/shopspring/decimal"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var _ Estimator = &feeHistoryEstimator{}
+
+//go:generate mockery --name feeHistoryRPCClient --output ./mocks/ --case=underscore --structname FeeHistoryRPCClient
+type feeHistoryRPCClient interface {
+	Call(result interface{}, method string, args ...interface{}) error
+}
+
+// feeHistoryEstimator polls eth_feeHistory and sets the tip cap from a
+// configurable within-block reward percentile over a configurable window of
+// recent blocks. The fee cap is the next block's base fee multiplied by a
+// configurable headroom factor, plus the tip cap. All tunables are chain
+// scoped and may be edited at runtime via ChainCfg:
+//
+// FEE_HISTORY_ESTIMATOR_BLOCK_HISTORY_SIZE - number of blocks to look back
+// FEE_HISTORY_ESTIMATOR_REWARD_PERCENTILE - within-block priority fee percentile
+// FEE_HISTORY_ESTIMATOR_BASE_FEE_HEADROOM - base fee multiplier for the fee cap
+// FEE_HISTORY_ESTIMATOR_CAP_BEHAVIOR - "clamp" or "hold" when the computed fee
+// exceeds ETH_MAX_GAS_PRICE_WEI
+type feeHistoryEstimator struct {
+	utils.StartStopOnce
+
+	config     Config
+	client     feeHistoryRPCClient
+	pollPeriod time.Duration
+	logger     logger.Logger
+
+	feeMu   sync.RWMutex
+	tipCap  *big.Int
+	baseFee *big.Int
+
+	chForceRefetch chan (chan struct{})
+	chInitialised  chan struct{}
+	chStop         chan struct{}
+	chDone         chan struct{}
+}
+
+// NewFeeHistoryEstimator returns a new fee history estimator
+func NewFeeHistoryEstimator(lggr logger.Logger, config Config, client feeHistoryRPCClient) Estimator {
+	return &feeHistoryEstimator{
+		utils.StartStopOnce{},
+		config,
+		client,
+		10 * time.Second,
+		lggr,
+		sync.RWMutex{},
+		nil,
+		nil,
+		make(chan (chan struct{})),
+		make(chan struct{}),
+		make(chan struct{}),
+		make(chan struct{}),
+	}
+}
+
+func (f *feeHistoryEstimator) Start() error {
+	return f.StartOnce("FeeHistoryEstimator", func() error {
+		go f.run()
+		<-f.chInitialised
+		return nil
+	})
+}
+func (f *feeHistoryEstimator) Close() error {
+	return f.StopOnce("FeeHistoryEstimator", func() error {
+		close(f.chStop)
+		<-f.chDone
+		return nil
+	})
+}
+
+func (f *feeHistoryEstimator) run() {
+	defer close(f.chDone)
+
+	t := f.refreshFees()
+	close(f.chInitialised)
+
+	for {
+		select {
+		case <-f.chStop:
+			return
+		case ch := <-f.chForceRefetch:
+			t.Stop()
+			t = f.refreshFees()
+			close(ch)
+		case <-t.C:
+			t = f.refreshFees()
+		}
+	}
+}
+
+// FeeHistoryResponse is the shape of the response when calling eth_feeHistory
+type FeeHistoryResponse struct {
+	OldestBlock   *hexutil.Big     `json:"oldestBlock"`
+	Reward        [][]*hexutil.Big `json:"reward"`
+	BaseFeePerGas []*hexutil.Big   `json:"baseFeePerGas"`
+	GasUsedRatio  []float64        `json:"gasUsedRatio"`
+}
+
+func (f *feeHistoryEstimator) refreshFees() (t *time.Timer) {
+	t = time.NewTimer(utils.WithJitter(f.pollPeriod))
+
+	// Tunables are re-read on every poll so changes made via ChainCfg at
+	// runtime take effect without a restart
+	blocks := f.config.FeeHistoryEstimatorBlockHistorySize()
+	percentile := float64(f.config.FeeHistoryEstimatorRewardPercentile())
+	var res FeeHistoryResponse
+	if err := f.client.Call(&res, "eth_feeHistory", hexutil.Uint64(blocks), "latest", []float64{percentile}); err != nil {
+		f.logger.Warnf("FeeHistoryEstimator: Failed to refresh fees, got error: %s", err)
+		return
+	}
+	if len(res.BaseFeePerGas) == 0 || res.BaseFeePerGas[len(res.BaseFeePerGas)-1] == nil {
+		f.logger.Warn("FeeHistoryEstimator: eth_feeHistory returned no base fees; is the chain EIP-1559 enabled?")
+		return
+	}
+	// The last entry is the base fee of the next, not yet mined, block
+	baseFee := res.BaseFeePerGas[len(res.BaseFeePerGas)-1].ToInt()
+	// Take the highest percentile reward in the window so that a few blocks of
+	// cheap transactions do not drag the tip below what is needed for timely
+	// inclusion
+	tipCap := big.NewInt(0)
+	for _, rewards := range res.Reward {
+		if len(rewards) == 0 || rewards[0] == nil {
+			continue
+		}
+		if r := rewards[0].ToInt(); r.Cmp(tipCap) > 0 {
+			tipCap = r
+		}
+	}
+
+	f.logger.Debugw("FeeHistoryEstimator#refreshFees", "baseFee", baseFee, "tipCap", tipCap, "blocks", blocks, "percentile", percentile)
+
+	f.feeMu.Lock()
+	defer f.feeMu.Unlock()
+	f.tipCap = tipCap
+	f.baseFee = baseFee
+	return
+}
+
+func (f *feeHistoryEstimator) OnNewLongestChain(_ context.Context, _ eth.Head) {}
+
+func (f *feeHistoryEstimator) GetLegacyGas(_ []byte, gasLimit uint64, opts ...Opt) (gasPrice *big.Int, chainSpecificGasLimit uint64, err error) {
+	ok := f.IfStarted(func() {
+		if err = f.forceRefetchIfNecessary(opts); err != nil {
+			return
+		}
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		var fee DynamicFee
+		fee, err = f.calcFee()
+		if err != nil {
+			return
+		}
+		// For legacy transactions the entire fee cap is bid as the gas price
+		gasPrice = fee.FeeCap
+		if min := f.config.EvmMinGasPriceWei(); gasPrice.Cmp(min) < 0 {
+			gasPrice = min
+		}
+	})
+	if !ok {
+		return nil, 0, errors.New("estimator is not started")
+	}
+	return
+}
+
+func (f *feeHistoryEstimator) BumpLegacyGas(originalGasPrice *big.Int, originalGasLimit uint64) (gasPrice *big.Int, gasLimit uint64, err error) {
+	var currentGasPrice *big.Int
+	if fee, err := f.calcFee(); err == nil {
+		currentGasPrice = fee.FeeCap
+	}
+	return BumpLegacyGasPriceOnly(f.config, currentGasPrice, originalGasPrice, originalGasLimit)
+}
+
+func (f *feeHistoryEstimator) GetDynamicFee(gasLimit uint64) (fee DynamicFee, chainSpecificGasLimit uint64, err error) {
+	ok := f.IfStarted(func() {
+		chainSpecificGasLimit = applyMultiplier(gasLimit, f.config.EvmGasLimitMultiplier())
+		fee, err = f.calcFee()
+	})
+	if !ok {
+		return fee, 0, errors.New("estimator is not started")
+	}
+	return
+}
+
+func (f *feeHistoryEstimator) BumpDynamicFee(original DynamicFee, originalGasLimit uint64) (bumped DynamicFee, chainSpecificGasLimit uint64, err error) {
+	var currentTipCap *big.Int
+	if fee, err := f.calcFee(); err == nil {
+		currentTipCap = fee.TipCap
+	}
+	return BumpDynamicFeeOnly(f.config, currentTipCap, original, originalGasLimit)
+}
+
+// calcFee computes the current fee from the latest polled values, applying
+// the base fee headroom and the configured cap behavior
+func (f *feeHistoryEstimator) calcFee() (fee DynamicFee, err error) {
+	tipCap, baseFee := f.getFees()
+	if tipCap == nil || baseFee == nil {
+		return fee, errors.New("failed to estimate gas; fee history not fetched yet")
+	}
+	if min := f.config.EvmGasTipCapMinimum(); tipCap.Cmp(min) < 0 {
+		tipCap = min
+	}
+	headroom := decimal.NewFromBigInt(baseFee, 0).Mul(decimal.NewFromFloat32(f.config.FeeHistoryEstimatorBaseFeeHeadroom())).BigInt()
+	feeCap := new(big.Int).Add(headroom, tipCap)
+
+	max := f.config.EvmMaxGasPriceWei()
+	if feeCap.Cmp(max) > 0 {
+		if f.config.FeeHistoryEstimatorCapBehavior() == "hold" {
+			return fee, errors.Errorf("computed gas fee cap of %s exceeds maximum configured gas price of %s; holding transactions until fees fall (FEE_HISTORY_ESTIMATOR_CAP_BEHAVIOR=hold)", feeCap.String(), max.String())
+		}
+		feeCap = max
+	}
+	if tipCap.Cmp(feeCap) > 0 {
+		tipCap = feeCap
+	}
+	return DynamicFee{FeeCap: feeCap, TipCap: tipCap}, nil
+}
+
+func (f *feeHistoryEstimator) forceRefetchIfNecessary(opts []Opt) error {
+	for _, opt := range opts {
+		if opt == OptForceRefetch {
+			ch := make(chan struct{})
+			f.chForceRefetch <- ch
+			select {
+			case <-ch:
+			case <-f.chStop:
+				return errors.New("estimator stopped")
+			}
+		}
+	}
+	return nil
+}
+
+func (f *feeHistoryEstimator) getFees() (tipCap, baseFee *big.Int) {
+	f.feeMu.RLock()
+	defer f.feeMu.RUnlock()
+	return f.tipCap, f.baseFee
+}
@@ -0,0 +1,100 @@
+package gas_test
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/gas"
+	"github.com/smartcontractkit/chainlink/core/services/gas/mocks"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+)
+
+func newFeeHistoryConfig(capBehavior string, maxGasPrice *big.Int) *mocks.Config {
+	config := new(mocks.Config)
+	config.On("FeeHistoryEstimatorBlockHistorySize").Return(uint16(8))
+	config.On("FeeHistoryEstimatorRewardPercentile").Return(uint16(60))
+	config.On("FeeHistoryEstimatorBaseFeeHeadroom").Return(float32(2.0))
+	config.On("FeeHistoryEstimatorCapBehavior").Return(capBehavior)
+	config.On("EvmGasLimitMultiplier").Return(float32(1))
+	config.On("EvmMaxGasPriceWei").Return(maxGasPrice)
+	config.On("EvmMinGasPriceWei").Return(big.NewInt(0))
+	config.On("EvmGasTipCapMinimum").Return(big.NewInt(0))
+	return config
+}
+
+func newFeeHistoryClient(nextBaseFee, maxReward int64) *mocks.FeeHistoryRPCClient {
+	client := new(mocks.FeeHistoryRPCClient)
+	client.On("Call", mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(nil).Run(func(args mock.Arguments) {
+		res := args.Get(0).(*gas.FeeHistoryResponse)
+		res.BaseFeePerGas = []*hexutil.Big{(*hexutil.Big)(big.NewInt(90)), (*hexutil.Big)(big.NewInt(nextBaseFee))}
+		res.Reward = [][]*hexutil.Big{{(*hexutil.Big)(big.NewInt(maxReward))}, {(*hexutil.Big)(big.NewInt(30))}}
+	})
+	return client
+}
+
+func Test_FeeHistoryEstimator(t *testing.T) {
+	t.Parallel()
+
+	var gasLimit uint64 = 80000
+
+	t.Run("calling GetDynamicFee on unstarted estimator returns error", func(t *testing.T) {
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig("clamp", big.NewInt(5000)), newFeeHistoryClient(100, 60))
+		_, _, err := f.GetDynamicFee(gasLimit)
+		assert.EqualError(t, err, "estimator is not started")
+	})
+
+	t.Run("returns percentile tip and headroomed base fee", func(t *testing.T) {
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig("clamp", big.NewInt(5000)), newFeeHistoryClient(100, 60))
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		fee, chainSpecificGasLimit, err := f.GetDynamicFee(gasLimit)
+		require.NoError(t, err)
+		// tip cap is the highest reward in the window; fee cap is
+		// nextBaseFee*headroom + tip cap = 100*2 + 60
+		assert.Equal(t, big.NewInt(60), fee.TipCap)
+		assert.Equal(t, big.NewInt(260), fee.FeeCap)
+		assert.Equal(t, gasLimit, chainSpecificGasLimit)
+
+		gasPrice, _, err := f.GetLegacyGas(nil, gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(260), gasPrice)
+	})
+
+	t.Run("clamps the fee cap at the max gas price if cap behavior is clamp", func(t *testing.T) {
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig("clamp", big.NewInt(150)), newFeeHistoryClient(100, 60))
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		fee, _, err := f.GetDynamicFee(gasLimit)
+		require.NoError(t, err)
+		assert.Equal(t, big.NewInt(150), fee.FeeCap)
+		assert.Equal(t, big.NewInt(60), fee.TipCap)
+	})
+
+	t.Run("refuses to estimate if cap behavior is hold", func(t *testing.T) {
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig("hold", big.NewInt(150)), newFeeHistoryClient(100, 60))
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		_, _, err := f.GetDynamicFee(gasLimit)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "holding transactions until fees fall")
+	})
+
+	t.Run("returns error if initial call failed", func(t *testing.T) {
+		client := new(mocks.FeeHistoryRPCClient)
+		client.On("Call", mock.Anything, "eth_feeHistory", mock.Anything, mock.Anything, mock.Anything).Return(errors.New("kaboom"))
+		f := gas.NewFeeHistoryEstimator(logger.TestLogger(t), newFeeHistoryConfig("clamp", big.NewInt(5000)), client)
+		require.NoError(t, f.Start())
+		t.Cleanup(func() { require.NoError(t, f.Close()) })
+
+		_, _, err := f.GetDynamicFee(gasLimit)
+		assert.EqualError(t, err, "failed to estimate gas; fee history not fetched yet")
+	})
+}
@@ -253,6 +253,76 @@ func (_m *Config) EvmMinGasPriceWei() *big.Int {
 	return r0
 }
 
+// FeeHistoryEstimatorBaseFeeHeadroom provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBaseFeeHeadroom() float32 {
+	ret := _m.Called()
+
+	var r0 float32
+	if rf, ok := ret.Get(0).(func() float32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(float32)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorBlockHistorySize provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorBlockHistorySize() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorCapBehavior provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorCapBehavior() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
+// FeeHistoryEstimatorRewardPercentile provides a mock function with given fields:
+func (_m *Config) FeeHistoryEstimatorRewardPercentile() uint16 {
+	ret := _m.Called()
+
+	var r0 uint16
+	if rf, ok := ret.Get(0).(func() uint16); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint16)
+	}
+
+	return r0
+}
+
+// GasEstimatorExternalURL provides a mock function with given fields:
+func (_m *Config) GasEstimatorExternalURL() string {
+	ret := _m.Called()
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func() string); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	return r0
+}
+
 // GasEstimatorMode provides a mock function with given fields:
 func (_m *Config) GasEstimatorMode() string {
 	ret := _m.Called()
@@ -4,8 +4,10 @@ import (
 	"bytes"
 	"context"
 	"encoding/json"
+	"fmt"
 	"math"
 	"math/big"
+	"sync"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -27,21 +29,59 @@ func IsBumpErr(err error) bool {
 	return err != nil && (errors.Is(err, ErrBumpGasExceedsLimit) || errors.Is(err, ErrBump))
 }
 
-func NewEstimator(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
-	s := config.GasEstimatorMode()
-	switch s {
-	case "BlockHistory":
+// EstimatorFactory constructs an Estimator for a chain
+type EstimatorFactory func(lggr logger.Logger, ethClient eth.Client, config Config) Estimator
+
+var (
+	estimatorsMu sync.RWMutex
+	estimators   = map[string]EstimatorFactory{}
+)
+
+// RegisterEstimator makes a named estimation strategy available for use with
+// GAS_ESTIMATOR_MODE. Built-in strategies are registered on package init;
+// external packages may register additional strategies before any chain is
+// started. It panics if the name is already taken.
+func RegisterEstimator(name string, factory EstimatorFactory) {
+	estimatorsMu.Lock()
+	defer estimatorsMu.Unlock()
+	if _, exists := estimators[name]; exists {
+		panic(fmt.Sprintf("gas estimator mode %q is already registered", name))
+	}
+	estimators[name] = factory
+}
+
+func init() {
+	RegisterEstimator("BlockHistory", func(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
 		return NewBlockHistoryEstimator(lggr, ethClient, config, *ethClient.ChainID())
-	case "FixedPrice":
+	})
+	RegisterEstimator("FixedPrice", func(_ logger.Logger, _ eth.Client, config Config) Estimator {
 		return NewFixedPriceEstimator(config)
-	case "Optimism":
+	})
+	RegisterEstimator("Optimism", func(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
 		return NewOptimismEstimator(lggr, config, ethClient)
-	case "Optimism2":
+	})
+	RegisterEstimator("Optimism2", func(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
 		return NewOptimism2Estimator(lggr, config, ethClient)
-	default:
+	})
+	RegisterEstimator("External", func(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
+		return NewExternalEstimator(lggr, config, *ethClient.ChainID())
+	})
+	RegisterEstimator("FeeHistory", func(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
+		return NewFeeHistoryEstimator(lggr, config, ethClient)
+	})
+}
+
+// NewEstimator returns the estimation strategy selected by GAS_ESTIMATOR_MODE
+func NewEstimator(lggr logger.Logger, ethClient eth.Client, config Config) Estimator {
+	s := config.GasEstimatorMode()
+	estimatorsMu.RLock()
+	factory, exists := estimators[s]
+	estimatorsMu.RUnlock()
+	if !exists {
 		logger.Warnf("GasEstimator: unrecognised mode '%s', falling back to FixedPriceEstimator", s)
 		return NewFixedPriceEstimator(config)
 	}
+	return factory(lggr, ethClient, config)
 }
 
 // DynamicFee encompasses both FeeCap and TipCap for EIP1559 transactions
@@ -93,6 +133,11 @@ type Config interface {
 	EvmGasTipCapMinimum() *big.Int
 	EvmMaxGasPriceWei() *big.Int
 	EvmMinGasPriceWei() *big.Int
+	FeeHistoryEstimatorBaseFeeHeadroom() float32
+	FeeHistoryEstimatorBlockHistorySize() uint16
+	FeeHistoryEstimatorCapBehavior() string
+	FeeHistoryEstimatorRewardPercentile() uint16
+	GasEstimatorExternalURL() string
 	GasEstimatorMode() string
 }
 
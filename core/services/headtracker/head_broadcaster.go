@@ -5,25 +5,40 @@ import (
 	"crypto/rand"
 	"fmt"
 	"reflect"
+	"sort"
 	"sync"
 	"time"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	httypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+var promCallbackDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "head_broadcaster_callback_duration_seconds",
+	Help:    "How long each subscriber took to process a new head",
+	Buckets: prometheus.DefBuckets,
+}, []string{"callbackType"})
+
 const callbackTimeout = 2 * time.Second
 
 type callbackID [256]byte
 
-type callbackSet map[callbackID]httypes.HeadTrackable
+type subscriber struct {
+	callback httypes.HeadTrackable
+	priority httypes.SubscriptionPriority
+}
+
+type callbackSet map[callbackID]subscriber
 
 func (set callbackSet) clone() callbackSet {
 	cp := make(callbackSet)
-	for id, callback := range set {
-		cp[id] = callback
+	for id, s := range set {
+		cp[id] = s
 	}
 	return cp
 }
@@ -84,9 +99,19 @@ func (hb *headBroadcaster) OnNewLongestChain(ctx context.Context, head eth.Head)
 // Subscribe - Subscribes to OnNewLongestChain and Connect until HeadBroadcaster is closed,
 // or unsubscribe callback is called explicitly
 func (hb *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLongestChain *eth.Head, unsubscribe func()) {
+	return hb.SubscribeWithOpts(callback, httypes.SubscriptionOpts{})
+}
+
+// SubscribeWithOpts is Subscribe with per-subscriber head sampling. Heavy
+// consumers on fast chains can ask for every nth head and/or at most one head
+// per interval instead of running on every block
+func (hb *headBroadcaster) SubscribeWithOpts(callback httypes.HeadTrackable, opts httypes.SubscriptionOpts) (currentLongestChain *eth.Head, unsubscribe func()) {
 	if callback == nil {
 		panic("callback must be non-nil func")
 	}
+	if opts.EveryNth > 1 || opts.MinInterval > 0 {
+		callback = &sampledCallback{callback: callback, everyNth: opts.EveryNth, minInterval: opts.MinInterval}
+	}
 	hb.mutex.Lock()
 	defer hb.mutex.Unlock()
 	currentLongestChain = hb.latest
@@ -95,7 +120,7 @@ func (hb *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLon
 		hb.logger.Errorf("Unable to create ID for head relayble callback: %v", err)
 		return
 	}
-	hb.callbacks[id] = callback
+	hb.callbacks[id] = subscriber{callback, opts.Priority}
 	unsubscribe = func() {
 		hb.mutex.Lock()
 		defer hb.mutex.Unlock()
@@ -104,6 +129,34 @@ func (hb *headBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLon
 	return
 }
 
+// sampledCallback wraps a subscriber's callback, dropping heads according to
+// its SubscriptionOpts. The first head after subscribing is always delivered
+type sampledCallback struct {
+	callback    httypes.HeadTrackable
+	everyNth    uint
+	minInterval time.Duration
+
+	mu       sync.Mutex
+	seen     uint
+	lastSent time.Time
+}
+
+func (s *sampledCallback) OnNewLongestChain(ctx context.Context, head eth.Head) {
+	s.mu.Lock()
+	s.seen++
+	if s.everyNth > 1 && (s.seen-1)%s.everyNth != 0 {
+		s.mu.Unlock()
+		return
+	}
+	if s.minInterval > 0 && !s.lastSent.IsZero() && time.Since(s.lastSent) < s.minInterval {
+		s.mu.Unlock()
+		return
+	}
+	s.lastSent = time.Now()
+	s.mu.Unlock()
+	s.callback.OnNewLongestChain(ctx, head)
+}
+
 func (hb *headBroadcaster) run() {
 	defer hb.wgDone.Done()
 	for {
@@ -140,23 +193,50 @@ func (hb *headBroadcaster) executeCallbacks() {
 		"numCallbacks", len(callbacks),
 	)
 
-	wg := sync.WaitGroup{}
-	wg.Add(len(callbacks))
+	// Deliver one priority tier at a time, highest priority (lowest value)
+	// first, so critical consumers see the head before best-effort ones. Each
+	// tier runs in parallel and must finish (or time out) before the next
+	// tier starts
+	byPriority := make(map[httypes.SubscriptionPriority][]httypes.HeadTrackable)
+	for _, s := range callbacks {
+		byPriority[s.priority] = append(byPriority[s.priority], s.callback)
+	}
+	priorities := make([]httypes.SubscriptionPriority, 0, len(byPriority))
+	for priority := range byPriority {
+		priorities = append(priorities, priority)
+	}
+	sort.Slice(priorities, func(i, j int) bool { return priorities[i] < priorities[j] })
+
+	for _, priority := range priorities {
+		tier := byPriority[priority]
+		wg := sync.WaitGroup{}
+		wg.Add(len(tier))
 
-	for _, callback := range callbacks {
-		go func(trackable httypes.HeadTrackable) {
-			defer wg.Done()
-			start := time.Now()
-			ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
-			defer cancel()
-			trackable.OnNewLongestChain(ctx, head)
-			elapsed := time.Since(start)
-			hb.logger.Debugw(fmt.Sprintf("Finished callback in %s", elapsed),
-				"callbackType", reflect.TypeOf(trackable), "blockNumber", head.Number, "time", elapsed)
-		}(callback)
+		for _, callback := range tier {
+			go func(trackable httypes.HeadTrackable) {
+				defer wg.Done()
+				start := time.Now()
+				ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
+				defer cancel()
+				trackable.OnNewLongestChain(ctx, head)
+				elapsed := time.Since(start)
+				promCallbackDuration.WithLabelValues(callbackType(trackable).String()).Observe(elapsed.Seconds())
+				hb.logger.Debugw(fmt.Sprintf("Finished callback in %s", elapsed),
+					"callbackType", callbackType(trackable), "blockNumber", head.Number, "time", elapsed)
+			}(callback)
+		}
+
+		wg.Wait()
 	}
+}
 
-	wg.Wait()
+// callbackType reports the subscriber's own type, unwrapping any sampling
+// wrapper added by SubscribeWithOpts
+func callbackType(trackable httypes.HeadTrackable) reflect.Type {
+	if s, ok := trackable.(*sampledCallback); ok {
+		return reflect.TypeOf(s.callback)
+	}
+	return reflect.TypeOf(trackable)
 }
 
 func newID() (id callbackID, _ error) {
@@ -177,5 +257,8 @@ func (*NullBroadcaster) OnNewLongestChain(ctx context.Context, head eth.Head) {}
 func (*NullBroadcaster) Subscribe(callback httypes.HeadTrackable) (currentLongestChain *eth.Head, unsubscribe func()) {
 	return nil, func() {}
 }
+func (*NullBroadcaster) SubscribeWithOpts(callback httypes.HeadTrackable, opts httypes.SubscriptionOpts) (currentLongestChain *eth.Head, unsubscribe func()) {
+	return nil, func() {}
+}
 func (n *NullBroadcaster) Healthy() error { return nil }
 func (n *NullBroadcaster) Ready() error   { return nil }
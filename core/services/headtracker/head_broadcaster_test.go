@@ -1,9 +1,12 @@
 package headtracker_test
 
 import (
+	"context"
+	"sync"
 	"testing"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/onsi/gomega"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/mock"
@@ -16,6 +19,7 @@ import (
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
 	"github.com/smartcontractkit/chainlink/core/services/headtracker"
+	headtrackertypes "github.com/smartcontractkit/chainlink/core/services/headtracker/types"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -76,3 +80,80 @@ func TestHeadBroadcaster_Subscribe(t *testing.T) {
 
 	require.NoError(t, ht.Stop())
 }
+
+func TestHeadBroadcaster_SubscribeWithOpts(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	every := &cltest.MockHeadTrackable{}
+	everyNth := &cltest.MockHeadTrackable{}
+	throttled := &cltest.MockHeadTrackable{}
+
+	hr := headtracker.NewHeadBroadcaster(logger.TestLogger(t))
+	require.NoError(t, hr.Start())
+	defer hr.Close()
+
+	_, unsubscribe1 := hr.Subscribe(every)
+	defer unsubscribe1()
+	_, unsubscribe2 := hr.SubscribeWithOpts(everyNth, headtrackertypes.SubscriptionOpts{EveryNth: 2})
+	defer unsubscribe2()
+	_, unsubscribe3 := hr.SubscribeWithOpts(throttled, headtrackertypes.SubscriptionOpts{MinInterval: time.Hour})
+	defer unsubscribe3()
+
+	// Deliver one head at a time, waiting for the unsampled subscriber to see
+	// it, so the broadcaster's single-slot mailbox never skips any
+	var parentHash common.Hash
+	for i := int32(1); i <= 4; i++ {
+		h := eth.Head{Number: int64(i), Hash: utils.NewHash(), ParentHash: parentHash}
+		parentHash = h.Hash
+		hr.OnNewLongestChain(context.Background(), h)
+		g.Eventually(func() int32 { return every.OnNewLongestChainCount() }).Should(gomega.Equal(i))
+	}
+
+	// Heads 1 and 3 were delivered
+	assert.Equal(t, int32(2), everyNth.OnNewLongestChainCount())
+	// Only head 1 was delivered; all the rest fell within the interval
+	assert.Equal(t, int32(1), throttled.OnNewLongestChainCount())
+}
+
+type recordingHeadTrackable struct {
+	name  string
+	mu    *sync.Mutex
+	order *[]string
+}
+
+func (r *recordingHeadTrackable) OnNewLongestChain(ctx context.Context, head eth.Head) {
+	r.mu.Lock()
+	*r.order = append(*r.order, r.name)
+	r.mu.Unlock()
+}
+
+func TestHeadBroadcaster_SubscribeWithOpts_Priority(t *testing.T) {
+	t.Parallel()
+	g := gomega.NewGomegaWithT(t)
+
+	var mu sync.Mutex
+	var order []string
+
+	hr := headtracker.NewHeadBroadcaster(logger.TestLogger(t))
+	require.NoError(t, hr.Start())
+	defer hr.Close()
+
+	// Subscribe in reverse priority order to prove delivery order comes from
+	// the priority, not from subscription order
+	_, unsubscribe1 := hr.SubscribeWithOpts(&recordingHeadTrackable{"bestEffort", &mu, &order}, headtrackertypes.SubscriptionOpts{Priority: headtrackertypes.PriorityBestEffort})
+	defer unsubscribe1()
+	_, unsubscribe2 := hr.Subscribe(&recordingHeadTrackable{"normal", &mu, &order})
+	defer unsubscribe2()
+	_, unsubscribe3 := hr.SubscribeWithOpts(&recordingHeadTrackable{"critical", &mu, &order}, headtrackertypes.SubscriptionOpts{Priority: headtrackertypes.PriorityCritical})
+	defer unsubscribe3()
+
+	hr.OnNewLongestChain(context.Background(), eth.Head{Number: 1, Hash: utils.NewHash()})
+	g.Eventually(func() int {
+		mu.Lock()
+		defer mu.Unlock()
+		return len(order)
+	}).Should(gomega.Equal(3))
+
+	assert.Equal(t, []string{"critical", "normal", "bestEffort"}, order)
+}
@@ -32,9 +32,15 @@ var (
 type Config interface {
 	BlockEmissionIdleWarningThreshold() time.Duration
 	EvmFinalityDepth() uint32
+	EvmFinalityTagEnabled() bool
+	EvmHeadTrackerCrossValidationEnabled() bool
 	EvmHeadTrackerHistoryDepth() uint32
+	EvmHeadTrackerMaxBackfillDepth() uint32
+	EvmHeadTrackerMaxBatchSize() uint32
 	EvmHeadTrackerMaxBufferSize() uint32
+	EvmHeadTrackerMaxHeadLag() time.Duration
 	EvmHeadTrackerSamplingInterval() time.Duration
+	EvmRPCDefaultBatchSize() uint32
 }
 
 type HeadListener struct {
@@ -48,6 +54,11 @@ type HeadListener struct {
 	receivesHeads    atomic.Bool
 	sleeper          utils.Sleeper
 
+	pauseMutex sync.RWMutex
+	paused     bool
+	chPause    chan struct{}
+	chResume   chan struct{}
+
 	log logger.Logger
 
 	chStop chan struct{}
@@ -75,6 +86,8 @@ func NewHeadListener(l logger.Logger,
 		sleeper:   sleeper,
 		log:       l.Named("listener"),
 		chStop:    chStop,
+		chPause:   make(chan struct{}),
+		chResume:  make(chan struct{}),
 	}
 }
 
@@ -90,12 +103,28 @@ func (hl *HeadListener) ListenForNewHeads(handleNewHead func(ctx context.Context
 	defer cancel()
 
 	for {
+		if hl.Paused() {
+			select {
+			case <-hl.chStop:
+				return
+			case <-hl.resumeCh():
+				hl.log.Debugf("Head tracking resumed, resubscribing to heads on chain %s", hl.chainID.String())
+			}
+			continue
+		}
 		if !hl.subscribe() {
+			if hl.Paused() {
+				continue
+			}
 			break
 		}
 		err := hl.receiveHeaders(ctx, handleNewHead)
 		if ctx.Err() != nil {
 			break
+		} else if hl.Paused() {
+			hl.log.Info("Head tracking is paused, unsubscribed from heads")
+			hl.headers = nil
+			continue
 		} else if err != nil {
 			hl.log.Errorw(fmt.Sprintf("Error in new head subscription, unsubscribed: %s", err.Error()), "err", err)
 			hl.headers = nil
@@ -166,6 +195,10 @@ func (hl *HeadListener) subscribe() bool {
 		select {
 		case <-hl.chStop:
 			return false
+		case <-hl.pauseCh():
+			// tracking was paused while we were waiting to resubscribe; stop
+			// retrying until resumed
+			return false
 		case <-time.After(hl.sleeper.After()):
 			err := hl.subscribeToHead()
 			if err != nil {
@@ -223,3 +256,57 @@ func (hl *HeadListener) Connected() bool {
 
 	return hl.connected
 }
+
+// Pause closes the current head subscription and stops any attempt to
+// resubscribe until Resume is called. It is a no-op if already paused
+func (hl *HeadListener) Pause() {
+	hl.pauseMutex.Lock()
+	if hl.paused {
+		hl.pauseMutex.Unlock()
+		return
+	}
+	hl.paused = true
+	hl.chResume = make(chan struct{})
+	close(hl.chPause)
+	hl.pauseMutex.Unlock()
+
+	hl.log.Infof("Pausing head tracking on chain %s", hl.chainID.String())
+	if err := hl.unsubscribeFromHead(); err != nil {
+		hl.log.Warn(errors.Wrap(err, "HeadListener failed to unsubscribe from head on pause"))
+	}
+}
+
+// Resume lifts a Pause so the listener resubscribes to new heads. It is a
+// no-op if not paused
+func (hl *HeadListener) Resume() {
+	hl.pauseMutex.Lock()
+	if !hl.paused {
+		hl.pauseMutex.Unlock()
+		return
+	}
+	hl.paused = false
+	hl.chPause = make(chan struct{})
+	close(hl.chResume)
+	hl.pauseMutex.Unlock()
+
+	hl.log.Infof("Resuming head tracking on chain %s", hl.chainID.String())
+}
+
+// Paused returns whether head tracking is currently paused
+func (hl *HeadListener) Paused() bool {
+	hl.pauseMutex.RLock()
+	defer hl.pauseMutex.RUnlock()
+	return hl.paused
+}
+
+func (hl *HeadListener) pauseCh() chan struct{} {
+	hl.pauseMutex.RLock()
+	defer hl.pauseMutex.RUnlock()
+	return hl.chPause
+}
+
+func (hl *HeadListener) resumeCh() chan struct{} {
+	hl.pauseMutex.RLock()
+	defer hl.pauseMutex.RUnlock()
+	return hl.chResume
+}
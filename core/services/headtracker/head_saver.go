@@ -45,6 +45,10 @@ func (ht *HeadSaver) Save(ctx context.Context, h eth.Head) error {
 	return ht.orm.TrimOldHeads(ctx, uint(historyDepth))
 }
 
+// LoadFromDB loads the persisted chain of recent heads (everything within
+// ETH_HEAD_TRACKER_HISTORY_DEPTH of the highest seen block) into memory, so
+// that after a restart reorg detection resumes from where it left off instead
+// of having to re-fetch recent headers
 func (ht *HeadSaver) LoadFromDB(ctx context.Context) (chain *eth.Head, err error) {
 	historyDepth := int(ht.config.EvmHeadTrackerHistoryDepth())
 	heads, err := ht.orm.LatestHeads(ctx, historyDepth)
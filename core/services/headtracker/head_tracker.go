@@ -8,6 +8,8 @@ import (
 	"time"
 
 	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/pkg/errors"
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
@@ -29,6 +31,37 @@ var (
 		Name: "head_tracker_very_old_head",
 		Help: "Counter is incremented every time we get a head that is much lower than the highest seen head ('much lower' is defined as a block that is ETH_FINALITY_DEPTH or greater below the highest seen head)",
 	}, []string{"evmChainID"})
+
+	promHeadLag = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_head_lag_seconds",
+		Help: "Difference between wall-clock time and the timestamp of the latest received head",
+	}, []string{"evmChainID"})
+
+	promTimeBetweenHeads = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_time_between_heads_seconds",
+		Help: "Seconds elapsed between receipt of consecutive heads",
+	}, []string{"evmChainID"})
+
+	promHeadProcessingDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "head_tracker_head_processing_duration_seconds",
+		Help:    "How long it took to handle a new head, including persisting it",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"evmChainID"})
+
+	promHeadsDiverged = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_heads_diverged",
+		Help: "Counter is incremented every time cross-validation finds a node whose block hash at a finalized height disagrees with the majority of nodes",
+	}, []string{"evmChainID", "nodeName"})
+
+	promCallbackSkippedHeads = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "head_tracker_callback_skipped_heads",
+		Help: "The total number of queued heads skipped in favor of a newer head because the callback loop fell behind (only with ETH_HEAD_TRACKER_MAX_BATCH_SIZE > 1)",
+	}, []string{"evmChainID"})
+
+	promFinalizedHead = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "head_tracker_finalized_head",
+		Help: "The block number the chain reports as finalized, as returned by the 'finalized' block tag",
+	}, []string{"evmChainID"})
 )
 
 // HeadsBufferSize - The buffer is used when heads sampling is disabled, to ensure the callback is run for every head
@@ -44,13 +77,20 @@ type HeadTracker struct {
 	chainID         big.Int
 	config          Config
 
-	backfillMB   utils.Mailbox
-	callbackMB   utils.Mailbox
-	headListener *HeadListener
-	headSaver    *HeadSaver
-	chStop       chan struct{}
-	wgDone       sync.WaitGroup
+	backfillMB      utils.Mailbox
+	callbackMB      utils.Mailbox
+	crossValidateMB utils.Mailbox
+	finalizedMB     utils.Mailbox
+	headListener    *HeadListener
+	headSaver       *HeadSaver
+	chStop          chan struct{}
+	wgDone          sync.WaitGroup
 	utils.StartStopOnce
+
+	latestHeadMu        sync.RWMutex
+	lastHeadReceived    time.Time
+	latestHeadTimestamp time.Time
+	latestFinalizedHead *eth.Head
 }
 
 // NewHeadTracker instantiates a new HeadTracker using the orm to persist new block numbers.
@@ -74,6 +114,8 @@ func NewHeadTracker(
 		log:             l,
 		backfillMB:      *utils.NewMailbox(1),
 		callbackMB:      *utils.NewMailbox(HeadsBufferSize),
+		crossValidateMB: *utils.NewMailbox(1),
+		finalizedMB:     *utils.NewMailbox(1),
 		chStop:          chStop,
 		headListener:    NewHeadListener(l, ethClient, config, chStop, sleepers...),
 		headSaver:       NewHeadSaver(l, orm, config),
@@ -127,6 +169,14 @@ func (ht *HeadTracker) Start() error {
 		go ht.headListener.ListenForNewHeads(ht.handleNewHead, ht.wgDone.Done)
 		go ht.backfiller()
 		go ht.headCallbackLoop()
+		if ht.config.EvmHeadTrackerCrossValidationEnabled() {
+			ht.wgDone.Add(1)
+			go ht.crossValidationLoop()
+		}
+		if ht.config.EvmFinalityTagEnabled() {
+			ht.wgDone.Add(1)
+			go ht.finalizedHeadLoop()
+		}
 
 		return nil
 	})
@@ -171,11 +221,37 @@ func (ht *HeadTracker) HighestSeenHeadFromDB(ctx context.Context) (*eth.Head, er
 	return ht.headSaver.orm.LatestHead(ctx)
 }
 
+// HeadsFromDB returns all persisted heads with block numbers in the inclusive
+// range [from, to], newest first
+func (ht *HeadTracker) HeadsFromDB(ctx context.Context, from, to int64) ([]*eth.Head, error) {
+	return ht.headSaver.orm.HeadsByNumberRange(ctx, from, to)
+}
+
 // Connected returns whether or not this HeadTracker is connected.
 func (ht *HeadTracker) Connected() bool {
 	return ht.headListener.Connected()
 }
 
+// Pause closes the head subscription and suspends head processing without
+// shutting the tracker down, e.g. while the chain's RPC provider is down for
+// maintenance. Dependent services simply see no new heads for the duration
+// instead of a stream of connection errors
+func (ht *HeadTracker) Pause() {
+	ht.headListener.Pause()
+}
+
+// Resume resubscribes to new heads after a Pause. Blocks missed while paused
+// are backfilled over HTTP when the first new head arrives, up to
+// ETH_HEAD_TRACKER_MAX_BACKFILL_DEPTH
+func (ht *HeadTracker) Resume() {
+	ht.headListener.Resume()
+}
+
+// Paused returns whether head tracking is currently paused
+func (ht *HeadTracker) Paused() bool {
+	return ht.headListener.Paused()
+}
+
 func (ht *HeadTracker) headCallbackLoop() {
 	defer ht.wgDone.Done()
 
@@ -197,7 +273,12 @@ func (ht *HeadTracker) headCallbackLoop() {
 			}
 		}
 	} else {
-		ht.log.Info("Head sampling is disabled - callback will be called on every head")
+		maxBatchSize := int(ht.config.EvmHeadTrackerMaxBatchSize())
+		if maxBatchSize > 1 {
+			ht.log.Debugf("Head sampling is disabled - up to %d queued heads are collapsed into one callback if the callback falls behind", maxBatchSize)
+		} else {
+			ht.log.Info("Head sampling is disabled - callback will be called on every head")
+		}
 		for {
 			select {
 			case <-ht.chStop:
@@ -208,6 +289,25 @@ func (ht *HeadTracker) headCallbackLoop() {
 					if !exists {
 						break
 					}
+					if maxBatchSize > 1 {
+						// Collapse queued heads to the newest in the batch so
+						// that on chains producing blocks faster than the
+						// callbacks can process them we do not fall ever
+						// further behind
+						skipped := 0
+						for skipped < maxBatchSize-1 {
+							next, more := ht.callbackMB.Retrieve()
+							if !more {
+								break
+							}
+							item = next
+							skipped++
+						}
+						if skipped > 0 {
+							promCallbackSkippedHeads.WithLabelValues(ht.chainID.String()).Add(float64(skipped))
+							ht.log.Debugw(fmt.Sprintf("HeadTracker: callback fell behind; skipped %d older heads to deliver only the latest of the batch", skipped), "skipped", skipped)
+						}
+					}
 					ht.callbackOnLatestHead(item)
 				}
 			}
@@ -339,6 +439,21 @@ func (ht *HeadTracker) fetchAndSaveHead(ctx context.Context, n int64) (eth.Head,
 }
 
 func (ht *HeadTracker) handleNewHead(ctx context.Context, head eth.Head) error {
+	now := time.Now()
+	defer func() {
+		promHeadProcessingDuration.WithLabelValues(ht.chainID.String()).Observe(time.Since(now).Seconds())
+	}()
+	if !head.Timestamp.IsZero() {
+		promHeadLag.WithLabelValues(ht.chainID.String()).Set(now.Sub(head.Timestamp).Seconds())
+	}
+	ht.latestHeadMu.Lock()
+	if !ht.lastHeadReceived.IsZero() {
+		promTimeBetweenHeads.WithLabelValues(ht.chainID.String()).Set(now.Sub(ht.lastHeadReceived).Seconds())
+	}
+	ht.lastHeadReceived = now
+	ht.latestHeadTimestamp = head.Timestamp
+	ht.latestHeadMu.Unlock()
+
 	prevHead := ht.LatestChain()
 
 	ht.log.Debugw(fmt.Sprintf("Received new head %v", config.FriendlyBigInt(head.ToInt())),
@@ -347,6 +462,12 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head eth.Head) error {
 		"parentHeadHash", head.ParentHash,
 	)
 
+	if prevHead != nil && head.Number > prevHead.Number+1 {
+		if err := ht.backfillGap(ctx, prevHead.Number, head); err != nil {
+			ht.log.Warnw("HeadTracker: failed to backfill gap in head sequence", "err", err)
+		}
+	}
+
 	err := ht.Save(ctx, head)
 	if ctx.Err() != nil {
 		return nil
@@ -364,6 +485,12 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head eth.Head) error {
 
 		ht.backfillMB.Deliver(*headWithChain)
 		ht.callbackMB.Deliver(*headWithChain)
+		if ht.config.EvmHeadTrackerCrossValidationEnabled() {
+			ht.crossValidateMB.Deliver(*headWithChain)
+		}
+		if ht.config.EvmFinalityTagEnabled() {
+			ht.finalizedMB.Deliver(*headWithChain)
+		}
 		return nil
 	}
 	if head.Number == prevHead.Number {
@@ -382,13 +509,214 @@ func (ht *HeadTracker) handleNewHead(ctx context.Context, head eth.Head) error {
 	return nil
 }
 
+func (ht *HeadTracker) crossValidationLoop() {
+	defer ht.wgDone.Done()
+	for {
+		select {
+		case <-ht.chStop:
+			return
+		case <-ht.crossValidateMB.Notify():
+			for {
+				item, exists := ht.crossValidateMB.Retrieve()
+				if !exists {
+					break
+				}
+				head, is := item.(eth.Head)
+				if !is {
+					panic(fmt.Sprintf("expected `eth.Head`, got %T", item))
+				}
+				ht.crossValidateHead(head)
+			}
+		}
+	}
+}
+
+// crossValidateHead compares the block hash our canonical chain has at a
+// finalized height against what every node in the pool reports for the same
+// height. Honest providers may briefly disagree about the tip, but a mismatch
+// ETH_FINALITY_DEPTH blocks deep is beyond normal reorg behavior and
+// indicates a badly-forked or malicious provider
+func (ht *HeadTracker) crossValidateHead(head eth.Head) {
+	height := head.Number - int64(ht.config.EvmFinalityDepth())
+	if height < 0 {
+		return
+	}
+	var localHash common.Hash
+	for h := ht.headSaver.Chain(head.Hash); h != nil; h = h.Parent {
+		if h.Number == height {
+			localHash = h.Hash
+			break
+		}
+	}
+	if localHash == (common.Hash{}) {
+		// our in-memory chain does not reach back to the validation height yet
+		return
+	}
+
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+	heads, err := ht.ethClient.HeadByNumberAllNodes(ctx, big.NewInt(height))
+	if err != nil {
+		ht.log.Warnw("HeadTracker: cross-validation failed to fetch heads from all nodes", "err", err, "blockHeight", height)
+		return
+	}
+	if len(heads) < 2 {
+		// nothing to compare against
+		return
+	}
+
+	// the hash most nodes agree on is taken as canonical
+	counts := make(map[common.Hash]int)
+	for _, h := range heads {
+		counts[h.Hash]++
+	}
+	var majorityHash common.Hash
+	var majorityCount int
+	for hash, count := range counts {
+		if count > majorityCount {
+			majorityHash, majorityCount = hash, count
+		}
+	}
+
+	for name, h := range heads {
+		if h.Hash != majorityHash {
+			promHeadsDiverged.WithLabelValues(ht.chainID.String(), name).Inc()
+			ht.log.Errorw(fmt.Sprintf("HeadTracker: node %s reports block hash %s at height %v but the majority of nodes report %s. This is beyond normal reorg behavior; the provider may be badly forked or malicious and its responses should not be trusted", name, h.Hash.Hex(), height, majorityHash.Hex()),
+				"nodeName", name, "blockHeight", height, "nodeHash", h.Hash, "majorityHash", majorityHash)
+		}
+	}
+	if localHash != majorityHash {
+		ht.log.Errorw(fmt.Sprintf("HeadTracker: local chain has block hash %s at height %v but the majority of nodes report %s. The node serving our head subscription may be badly forked; prefer the majority", localHash.Hex(), height, majorityHash.Hex()),
+			"blockHeight", height, "localHash", localHash, "majorityHash", majorityHash)
+	}
+}
+
+func (ht *HeadTracker) finalizedHeadLoop() {
+	defer ht.wgDone.Done()
+	for {
+		select {
+		case <-ht.chStop:
+			return
+		case <-ht.finalizedMB.Notify():
+			if item := ht.finalizedMB.RetrieveLatestAndClear(); item != nil {
+				ht.refetchFinalizedHead()
+			}
+		}
+	}
+}
+
+// refetchFinalizedHead asks the chain which block it considers finalized and
+// caches the result. It is triggered once per new longest chain rather than on
+// a timer so the finalized height moves in lockstep with head arrival
+func (ht *HeadTracker) refetchFinalizedHead() {
+	ctx, cancel := eth.DefaultQueryCtx()
+	defer cancel()
+	head, err := ht.ethClient.HeadByTag(ctx, "finalized")
+	if err != nil {
+		ht.log.Warnw("HeadTracker: failed to fetch finalized head; the RPC node may not support the 'finalized' block tag", "err", err)
+		return
+	}
+	if head == nil {
+		return
+	}
+	head.EVMChainID = utils.NewBig(&ht.chainID)
+	ht.latestHeadMu.Lock()
+	ht.latestFinalizedHead = head
+	ht.latestHeadMu.Unlock()
+	promFinalizedHead.WithLabelValues(ht.chainID.String()).Set(float64(head.Number))
+	ht.log.Debugw("HeadTracker: updated finalized head", "blockNumber", head.Number, "blockHash", head.Hash)
+}
+
+// LatestFinalizedHead returns the most recently fetched finalized head, or nil
+// if ETH_FINALITY_TAG_ENABLED is off or no finalized head has been seen yet.
+// When set, services can use this instead of subtracting a fixed
+// ETH_FINALITY_DEPTH number of blocks from the latest head
+func (ht *HeadTracker) LatestFinalizedHead() *eth.Head {
+	ht.latestHeadMu.RLock()
+	defer ht.latestHeadMu.RUnlock()
+	return ht.latestFinalizedHead
+}
+
+// backfillGap fetches the missed header range over HTTP after the websocket
+// subscription has skipped ahead, e.g. on reconnect after an outage, so that
+// downstream consumers see a contiguous head sequence rather than a silent
+// jump. Gaps larger than ETH_HEAD_TRACKER_MAX_BACKFILL_DEPTH are treated like
+// a node restart: only the new head is processed and older blocks are left to
+// the regular finality-depth backfill
+func (ht *HeadTracker) backfillGap(ctx context.Context, prevHeadNumber int64, head eth.Head) error {
+	gap := head.Number - prevHeadNumber - 1
+	maxDepth := int64(ht.config.EvmHeadTrackerMaxBackfillDepth())
+	if maxDepth == 0 || gap > maxDepth {
+		ht.log.Warnw(fmt.Sprintf("HeadTracker: missed %d heads, exceeding ETH_HEAD_TRACKER_MAX_BACKFILL_DEPTH of %d; resyncing from block %v as if restarted", gap, maxDepth, head.Number),
+			"gap", gap, "previousHeadNumber", prevHeadNumber, "blockNumber", head.Number)
+		return nil
+	}
+	ht.log.Infow(fmt.Sprintf("HeadTracker: missed %d heads, backfilling blocks %v to %v over HTTP", gap, prevHeadNumber+1, head.Number-1),
+		"gap", gap, "fromBlockHeight", prevHeadNumber+1, "toBlockHeight", head.Number-1)
+
+	reqs := make([]rpc.BatchElem, 0, gap)
+	for i := prevHeadNumber + 1; i < head.Number; i++ {
+		reqs = append(reqs, rpc.BatchElem{
+			Method: "eth_getBlockByNumber",
+			Args:   []interface{}{hexutil.EncodeUint64(uint64(i)), false},
+			Result: &eth.Head{},
+		})
+	}
+
+	batchSize := int(ht.config.EvmRPCDefaultBatchSize())
+	if batchSize == 0 {
+		batchSize = len(reqs)
+	}
+	for i := 0; i < len(reqs); i += batchSize {
+		j := i + batchSize
+		if j > len(reqs) {
+			j = len(reqs)
+		}
+		if err := ht.ethClient.BatchCallContext(ctx, reqs[i:j]); err != nil {
+			return errors.Wrap(err, "BatchCallContext failed while backfilling gap")
+		}
+	}
+
+	for _, req := range reqs {
+		if req.Error != nil {
+			return errors.Wrap(req.Error, "failed to fetch missed head")
+		}
+		h, is := req.Result.(*eth.Head)
+		if !is {
+			return errors.Errorf("expected *eth.Head, got %T", req.Result)
+		}
+		if h == nil || h.Hash == (common.Hash{}) {
+			return errors.New("got nil or missing head while backfilling gap")
+		}
+		h.EVMChainID = utils.NewBig(&ht.chainID)
+		if err := ht.handleNewHead(ctx, *h); err != nil {
+			return errors.Wrapf(err, "failed to handle missed head %v", h.Number)
+		}
+	}
+	return nil
+}
+
 func (ht *HeadTracker) Healthy() error {
+	if ht.headListener.Paused() {
+		// deliberately paused by an operator; not an error condition
+		return nil
+	}
 	if !ht.headListener.receivesHeads.Load() {
 		return errors.New("Heads are not being received")
 	}
 	if !ht.headListener.Connected() {
 		return errors.New("Not connected")
 	}
+	if maxHeadLag := ht.config.EvmHeadTrackerMaxHeadLag(); maxHeadLag > 0 {
+		ht.latestHeadMu.RLock()
+		latestHeadTimestamp := ht.latestHeadTimestamp
+		ht.latestHeadMu.RUnlock()
+		if !latestHeadTimestamp.IsZero() {
+			if lag := time.Since(latestHeadTimestamp); lag > maxHeadLag {
+				return errors.Errorf("Latest received head is %s behind wall-clock time, exceeding ETH_HEAD_TRACKER_MAX_HEAD_LAG of %s; the RPC provider may be stalling", lag.Truncate(time.Second), maxHeadLag)
+			}
+		}
+	}
 	return nil
 }
 
@@ -399,6 +727,16 @@ type NullTracker struct{}
 func (n *NullTracker) HighestSeenHeadFromDB(context.Context) (*eth.Head, error) {
 	return nil, nil
 }
+
+func (n *NullTracker) HeadsFromDB(context.Context, int64, int64) ([]*eth.Head, error) {
+	return nil, nil
+}
+func (*NullTracker) LatestFinalizedHead() *eth.Head { return nil }
+
+func (*NullTracker) Pause()       {}
+func (*NullTracker) Resume()      {}
+func (*NullTracker) Paused() bool { return false }
+
 func (*NullTracker) Start() error   { return nil }
 func (*NullTracker) Stop() error    { return nil }
 func (*NullTracker) Ready() error   { return nil }
@@ -14,6 +14,7 @@ import (
 	"github.com/ethereum/go-ethereum"
 	gethCommon "github.com/ethereum/go-ethereum/common"
 	gethTypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
 	"github.com/onsi/gomega"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/cltest/heavyweight"
@@ -880,6 +881,54 @@ func TestHeadTracker_Backfill(t *testing.T) {
 	})
 }
 
+func TestHeadTracker_BackfillsGapOverHTTP(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	cfg := cltest.NewTestGeneralConfig(t)
+	orm := headtracker.NewORM(db, cltest.FixtureChainID)
+
+	ethClient := cltest.NewEthClientMock(t)
+	ethClient.On("ChainID", mock.Anything).Return(cfg.DefaultChainID(), nil)
+
+	ht := createHeadTrackerWithNeverSleeper(t, ethClient, cfg, orm)
+
+	h1 := *cltest.Head(1)
+	require.NoError(t, headtracker.HandleNewHead(ht.headTracker, context.Background(), h1))
+
+	h2 := *cltest.Head(2)
+	h2.ParentHash = h1.Hash
+	h3 := *cltest.Head(3)
+	h3.ParentHash = h2.Hash
+	h4 := *cltest.Head(4)
+	h4.ParentHash = h3.Hash
+
+	ethClient.On("BatchCallContext", mock.Anything, mock.MatchedBy(func(b []rpc.BatchElem) bool {
+		return len(b) == 2
+	})).Run(func(args mock.Arguments) {
+		elems := args.Get(1).([]rpc.BatchElem)
+		*(elems[0].Result.(*eth.Head)) = h2
+		*(elems[1].Result.(*eth.Head)) = h3
+	}).Return(nil).Once()
+
+	require.NoError(t, headtracker.HandleNewHead(ht.headTracker, context.Background(), h4))
+
+	// The missed heads were fetched over HTTP and the in-memory chain is contiguous
+	chain := ht.headTracker.LatestChain()
+	require.NotNil(t, chain)
+	assert.Equal(t, int64(4), chain.Number)
+	assert.Equal(t, uint32(4), chain.ChainLength())
+
+	// A gap exceeding ETH_HEAD_TRACKER_MAX_BACKFILL_DEPTH is not backfilled
+	h400 := *cltest.Head(400)
+	require.NoError(t, headtracker.HandleNewHead(ht.headTracker, context.Background(), h400))
+	chain = ht.headTracker.LatestChain()
+	require.NotNil(t, chain)
+	assert.Equal(t, int64(400), chain.Number)
+
+	ethClient.AssertExpectations(t)
+}
+
 func createHeadTracker(t *testing.T, ethClient eth.Client, config headtracker.Config, orm *headtracker.ORM) *headTrackerUniverse {
 	lggr := logger.TestLogger(t)
 	hb := headtracker.NewHeadBroadcaster(lggr)
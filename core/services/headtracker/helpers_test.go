@@ -19,6 +19,10 @@ func LoadFromDB(ht *HeadTracker) (*eth.Head, error) {
 	return ht.headSaver.LoadFromDB(context.Background())
 }
 
+func HandleNewHead(ht *HeadTracker, ctx context.Context, head eth.Head) error {
+	return ht.handleNewHead(ctx, head)
+}
+
 func Heads(hs *HeadSaver) []*eth.Head {
 	hs.mu.RLock()
 	defer hs.mu.RUnlock()
@@ -41,6 +41,34 @@ func (_m *Config) EvmFinalityDepth() uint32 {
 	return r0
 }
 
+// EvmFinalityTagEnabled provides a mock function with given fields:
+func (_m *Config) EvmFinalityTagEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerCrossValidationEnabled provides a mock function with given fields:
+func (_m *Config) EvmHeadTrackerCrossValidationEnabled() bool {
+	ret := _m.Called()
+
+	var r0 bool
+	if rf, ok := ret.Get(0).(func() bool); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(bool)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerHistoryDepth provides a mock function with given fields:
 func (_m *Config) EvmHeadTrackerHistoryDepth() uint32 {
 	ret := _m.Called()
@@ -55,6 +83,20 @@ func (_m *Config) EvmHeadTrackerHistoryDepth() uint32 {
 	return r0
 }
 
+// EvmHeadTrackerMaxBatchSize provides a mock function with given fields:
+func (_m *Config) EvmHeadTrackerMaxBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerMaxBufferSize provides a mock function with given fields:
 func (_m *Config) EvmHeadTrackerMaxBufferSize() uint32 {
 	ret := _m.Called()
@@ -69,6 +111,34 @@ func (_m *Config) EvmHeadTrackerMaxBufferSize() uint32 {
 	return r0
 }
 
+// EvmHeadTrackerMaxBackfillDepth provides a mock function with given fields:
+func (_m *Config) EvmHeadTrackerMaxBackfillDepth() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
+
+// EvmHeadTrackerMaxHeadLag provides a mock function with given fields:
+func (_m *Config) EvmHeadTrackerMaxHeadLag() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // EvmHeadTrackerSamplingInterval provides a mock function with given fields:
 func (_m *Config) EvmHeadTrackerSamplingInterval() time.Duration {
 	ret := _m.Called()
@@ -82,3 +152,17 @@ func (_m *Config) EvmHeadTrackerSamplingInterval() time.Duration {
 
 	return r0
 }
+
+// EvmRPCDefaultBatchSize provides a mock function with given fields:
+func (_m *Config) EvmRPCDefaultBatchSize() uint32 {
+	ret := _m.Called()
+
+	var r0 uint32
+	if rf, ok := ret.Get(0).(func() uint32); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(uint32)
+	}
+
+	return r0
+}
@@ -101,3 +101,28 @@ func (_m *HeadBroadcaster) Subscribe(callback types.HeadTrackable) (*eth.Head, f
 
 	return r0, r1
 }
+
+// SubscribeWithOpts provides a mock function with given fields: callback, opts
+func (_m *HeadBroadcaster) SubscribeWithOpts(callback types.HeadTrackable, opts types.SubscriptionOpts) (*eth.Head, func()) {
+	ret := _m.Called(callback, opts)
+
+	var r0 *eth.Head
+	if rf, ok := ret.Get(0).(func(types.HeadTrackable, types.SubscriptionOpts) *eth.Head); ok {
+		r0 = rf(callback, opts)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).(*eth.Head)
+		}
+	}
+
+	var r1 func()
+	if rf, ok := ret.Get(1).(func(types.HeadTrackable, types.SubscriptionOpts) func()); ok {
+		r1 = rf(callback, opts)
+	} else {
+		if ret.Get(1) != nil {
+			r1 = ret.Get(1).(func())
+		}
+	}
+
+	return r0, r1
+}
@@ -7,21 +7,23 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"gorm.io/gorm"
-	"gorm.io/gorm/clause"
 )
 
 type ORM struct {
-	db      *gorm.DB
-	chainID utils.Big
+	db        *gorm.DB
+	stmtCache *postgres.StmtCache
+	chainID   utils.Big
 }
 
 func NewORM(db *gorm.DB, chainID big.Int) *ORM {
 	if db == nil {
 		panic("db may not be nil")
 	}
-	return &ORM{db, utils.Big(chainID)}
+	sqlxDB := postgres.UnwrapGormDB(db)
+	return &ORM{db, postgres.NewStmtCache(sqlxDB), utils.Big(chainID)}
 }
 
 // IdempotentInsertHead inserts a head only if the hash is new. Will do nothing if hash exists already.
@@ -32,17 +34,17 @@ func (orm *ORM) IdempotentInsertHead(ctx context.Context, h eth.Head) error {
 	} else if ((*big.Int)(h.EVMChainID)).Cmp((*big.Int)(&orm.chainID)) != 0 {
 		return errors.Errorf("head chain ID %s does not match orm chain ID %s", h.EVMChainID.String(), orm.chainID.String())
 	}
-	err := orm.db.
-		WithContext(ctx).
-		Clauses(clause.OnConflict{
-			Columns:   []clause.Column{{Name: "evm_chain_id"}, {Name: "hash"}},
-			DoNothing: true,
-		}).Create(&h).Error
+	// issued for every new head, so it goes through the prepared statement
+	// cache
+	query := `
+	INSERT INTO heads (hash, number, parent_hash, created_at, timestamp, l1_block_number, evm_chain_id, base_fee_per_gas)
+	VALUES ($1, $2, $3, now(), $4, $5, $6, $7)
+	ON CONFLICT (evm_chain_id, hash) DO NOTHING`
+	_, err := orm.stmtCache.ExecContext(ctx, orm.stmtCache.DB(), query,
+		h.Hash, h.Number, h.ParentHash, h.Timestamp, h.L1BlockNumber, h.EVMChainID, h.BaseFeePerGas)
 
 	if ctx.Err() != nil {
 		return nil
-	} else if err != nil && err.Error() == "sql: no rows in result set" {
-		return nil
 	}
 	return err
 }
@@ -73,13 +75,31 @@ func (orm *ORM) LatestHead(ctx context.Context) (head *eth.Head, err error) {
 	return
 }
 
-// LatestHeads returns the latest heads up to given limit
-func (orm *ORM) LatestHeads(ctx context.Context, limit int) (heads []*eth.Head, err error) {
-	err = orm.db.WithContext(ctx).Where("evm_chain_id = ?", orm.chainID).Order("number DESC, created_at DESC, id DESC").Limit(limit).Find(&heads).Error
+// LatestHeads returns all persisted heads (including uncles) within the given
+// block-number depth of the highest seen head. Loading every head in the
+// window, rather than a fixed number of rows, means sibling blocks from a
+// reorg are always included and a reorg spanning a restart can be detected
+// without re-fetching recent headers from the node
+func (orm *ORM) LatestHeads(ctx context.Context, depth int) (heads []*eth.Head, err error) {
+	err = orm.db.WithContext(ctx).
+		Where("evm_chain_id = ? AND number > (SELECT coalesce(max(number), 0) FROM heads WHERE evm_chain_id = ?) - ?", orm.chainID, orm.chainID, depth).
+		Order("number DESC, created_at DESC, id DESC").
+		Find(&heads).Error
 	err = errors.Wrap(err, "LatestHeads failed")
 	return
 }
 
+// HeadsByNumberRange returns all persisted heads (including uncles) with
+// block numbers in the inclusive range [from, to], newest first
+func (orm *ORM) HeadsByNumberRange(ctx context.Context, from, to int64) (heads []*eth.Head, err error) {
+	err = orm.db.WithContext(ctx).
+		Where("evm_chain_id = ? AND number >= ? AND number <= ?", orm.chainID, from, to).
+		Order("number DESC, created_at DESC, id DESC").
+		Find(&heads).Error
+	err = errors.Wrap(err, "HeadsByNumberRange failed")
+	return
+}
+
 // HeadByHash fetches the head with the given hash from the db, returns nil if none exists
 func (orm *ORM) HeadByHash(ctx context.Context, hash common.Hash) (*eth.Head, error) {
 	head := &eth.Head{}
@@ -34,3 +34,25 @@ func TestORM_Heads_IdempotentInsertHead(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, head.Hash, foundHead.Hash)
 }
+
+func TestORM_LatestHeads(t *testing.T) {
+	t.Parallel()
+
+	db := pgtest.NewGormDB(t)
+	orm := headtracker.NewORM(db, cltest.FixtureChainID)
+
+	for i := 0; i < 10; i++ {
+		require.NoError(t, orm.IdempotentInsertHead(context.TODO(), *cltest.Head(i)))
+	}
+	// A competing head at the tip must also be returned, even though it makes
+	// the window contain more rows than its block-number depth
+	uncle := *cltest.Head(9)
+	require.NoError(t, orm.IdempotentInsertHead(context.TODO(), uncle))
+
+	heads, err := orm.LatestHeads(context.TODO(), 3)
+	require.NoError(t, err)
+	// Blocks 7, 8 and 9 plus the uncle at 9
+	require.Len(t, heads, 4)
+	assert.Equal(t, int64(9), heads[0].Number)
+	assert.Equal(t, int64(7), heads[3].Number)
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"context"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/service"
 	"github.com/smartcontractkit/chainlink/core/services/eth"
@@ -10,8 +11,20 @@ import (
 
 type Tracker interface {
 	HighestSeenHeadFromDB(context.Context) (*eth.Head, error)
+	// HeadsFromDB returns all persisted heads with block numbers in the
+	// inclusive range [from, to], newest first
+	HeadsFromDB(ctx context.Context, from, to int64) ([]*eth.Head, error)
+	// LatestFinalizedHead returns the head the chain reports as finalized, or
+	// nil if finality tag support is disabled or no finalized head has been
+	// fetched yet
+	LatestFinalizedHead() *eth.Head
 	Start() error
 	Stop() error
+	// Pause and Resume temporarily suspend head tracking without shutting the
+	// service down, e.g. during RPC provider maintenance
+	Pause()
+	Resume()
+	Paused() bool
 	SetLogLevel(lvl zapcore.Level)
 	Ready() error
 	Healthy() error
@@ -30,10 +43,45 @@ type HeadBroadcasterRegistry interface {
 	Subscribe(callback HeadTrackable) (currentLongestChain *eth.Head, unsubscribe func())
 }
 
+// SubscriptionPriority orders head delivery across subscribers. Subscribers
+// with a lower priority value receive each new head before those with a
+// higher one
+type SubscriptionPriority int
+
+const (
+	// PriorityCritical is for consumers that must act on every head as fast
+	// as possible, e.g. the txmanager confirmer or OCR
+	PriorityCritical SubscriptionPriority = -1
+	// PriorityNormal is the default for plain Subscribe calls
+	PriorityNormal SubscriptionPriority = 0
+	// PriorityBestEffort is for consumers that merely observe heads, e.g.
+	// balance monitoring and metrics
+	PriorityBestEffort SubscriptionPriority = 1
+)
+
+// SubscriptionOpts allows individual subscribers to sample heads rather than
+// receiving every single one, and to be prioritized relative to other
+// subscribers. On sub-second chains heavy consumers can use the sampling
+// options to avoid running on every block. The zero value delivers every head
+// at PriorityNormal. If both sampling options are set a head must pass both
+// filters to be delivered
+type SubscriptionOpts struct {
+	// EveryNth delivers only every nth head seen since subscribing (0 or 1
+	// delivers every head)
+	EveryNth uint
+	// MinInterval delivers at most one head per interval (0 delivers every
+	// head)
+	MinInterval time.Duration
+	// Priority determines how early in the fan-out this subscriber receives
+	// each head
+	Priority SubscriptionPriority
+}
+
 // HeadBroadcaster is the external interface of headBroadcaster
 //go:generate mockery --name HeadBroadcaster --output ../mocks/ --case=underscore
 type HeadBroadcaster interface {
 	service.Service
 	HeadTrackable
 	Subscribe(callback HeadTrackable) (currentLongestChain *eth.Head, unsubscribe func())
+	SubscribeWithOpts(callback HeadTrackable, opts SubscriptionOpts) (currentLongestChain *eth.Head, unsubscribe func())
 }
@@ -15,5 +15,6 @@ type Service interface {
 type Config interface {
 	DatabaseMaximumTxDuration() time.Duration
 	DatabaseURL() url.URL
+	JobQuarantineFailureThreshold() uint32
 	TriggerFallbackDBPollInterval() time.Duration
 }
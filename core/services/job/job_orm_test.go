@@ -72,6 +72,23 @@ func TestORM(t *testing.T) {
 		assert.NotEqual(t, uuid.UUID{}, returnedSpec.ExternalJobID)
 	})
 
+	t.Run("rejects a job chained to itself", func(t *testing.T) {
+		jbSelf := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		jbSelf.OnSuccess = &jbSelf.ExternalJobID
+		err := orm.CreateJob(jbSelf)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), job.ErrSelfChainedJob.Error())
+	})
+
+	t.Run("rejects a job chained to a non-existent job", func(t *testing.T) {
+		jbDangling := makeOCRJobSpec(t, address, bridge.Name.String(), bridge2.Name.String())
+		missing := uuid.NewV4()
+		jbDangling.OnFailure = &missing
+		err := orm.CreateJob(jbDangling)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), job.ErrNoSuchChainedJob.Error())
+	})
+
 	t.Run("it deletes jobs from the DB", func(t *testing.T) {
 		var dbSpecs []job.Job
 		err := gdb.Find(&dbSpecs).Error
@@ -139,7 +156,7 @@ func TestORM(t *testing.T) {
 			{Name: eiFoo.Name, Spec: cltest.JSONFromString(t, `{}`)},
 			{Name: eiBar.Name, Spec: cltest.JSONFromString(t, `{"bar": 1}`)},
 		}
-		eim := webhook.NewExternalInitiatorManager(gdb, nil)
+		eim := webhook.NewExternalInitiatorManager(gdb, nil, logger.TestLogger(t))
 		jb, err := webhook.ValidatedWebhookSpec(testspecs.GenerateWebhookSpec(testspecs.WebhookSpecParams{ExternalInitiators: eiWS}).Toml(), eim)
 		require.NoError(t, err)
 
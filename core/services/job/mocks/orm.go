@@ -5,6 +5,8 @@ package mocks
 import (
 	context "context"
 
+	common "github.com/ethereum/go-ethereum/common"
+
 	job "github.com/smartcontractkit/chainlink/core/services/job"
 	mock "github.com/stretchr/testify/mock"
 
@@ -12,6 +14,8 @@ import (
 
 	postgres "github.com/smartcontractkit/chainlink/core/services/postgres"
 
+	time "time"
+
 	uuid "github.com/satori/go.uuid"
 )
 
@@ -20,6 +24,27 @@ type ORM struct {
 	mock.Mock
 }
 
+// ArchiveJob provides a mock function with given fields: id, qopts
+func (_m *ORM) ArchiveJob(id int32, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...postgres.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *ORM) Close() error {
 	ret := _m.Called()
@@ -206,6 +231,64 @@ func (_m *ORM) FindJobs(offset int, limit int) ([]job.Job, int, error) {
 	return r0, r1, r2
 }
 
+// FindJobsByGroupOrLabels provides a mock function with given fields: group, labels, offset, limit
+func (_m *ORM) FindJobsByGroupOrLabels(group string, labels []string, offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(group, labels, offset, limit)
+
+	var r0 []job.Job
+	if rf, ok := ret.Get(0).(func(string, []string, int, int) []job.Job); ok {
+		r0 = rf(group, labels, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(string, []string, int, int) int); ok {
+		r1 = rf(group, labels, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(string, []string, int, int) error); ok {
+		r2 = rf(group, labels, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// JobSpend provides a mock function with given fields: id, since, qopts
+func (_m *ORM) JobSpend(id int32, since time.Time, qopts ...postgres.QOpt) (job.SpendReport, error) {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, since)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 job.SpendReport
+	if rf, ok := ret.Get(0).(func(int32, time.Time, ...postgres.QOpt) job.SpendReport); ok {
+		r0 = rf(id, since, qopts...)
+	} else {
+		r0 = ret.Get(0).(job.SpendReport)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32, time.Time, ...postgres.QOpt) error); ok {
+		r1 = rf(id, since, qopts...)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // PipelineRuns provides a mock function with given fields: jobID, offset, size
 func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run, int, error) {
 	ret := _m.Called(jobID, offset, size)
@@ -236,7 +319,121 @@ func (_m *ORM) PipelineRuns(jobID *int32, offset int, size int) ([]pipeline.Run,
 	return r0, r1, r2
 }
 
+// QuarantineJob provides a mock function with given fields: id, reason, qopts
+func (_m *ORM) QuarantineJob(id int32, reason string, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, reason)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, string, ...postgres.QOpt) error); ok {
+		r0 = rf(id, reason, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // RecordError provides a mock function with given fields: ctx, jobID, description
 func (_m *ORM) RecordError(ctx context.Context, jobID int32, description string) {
 	_m.Called(ctx, jobID, description)
 }
+
+// ResumeJob provides a mock function with given fields: id, qopts
+func (_m *ORM) ResumeJob(id int32, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...postgres.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// RestoreJob provides a mock function with given fields: id, qopts
+func (_m *ORM) RestoreJob(id int32, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, ...postgres.QOpt) error); ok {
+		r0 = rf(id, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// SearchJobs provides a mock function with given fields: contract, transmitter, offset, limit
+func (_m *ORM) SearchJobs(contract *common.Address, transmitter *common.Address, offset int, limit int) ([]job.Job, int, error) {
+	ret := _m.Called(contract, transmitter, offset, limit)
+
+	var r0 []job.Job
+	if rf, ok := ret.Get(0).(func(*common.Address, *common.Address, int, int) []job.Job); ok {
+		r0 = rf(contract, transmitter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]job.Job)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(*common.Address, *common.Address, int, int) int); ok {
+		r1 = rf(contract, transmitter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(*common.Address, *common.Address, int, int) error); ok {
+		r2 = rf(contract, transmitter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
+// SetJobParams provides a mock function with given fields: id, params, qopts
+func (_m *ORM) SetJobParams(id int32, params job.JSONConfig, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, id, params)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int32, job.JSONConfig, ...postgres.QOpt) error); ok {
+		r0 = rf(id, params, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
@@ -32,6 +32,20 @@ func (_m *Spawner) ActiveJobs() map[int32]job.Job {
 	return r0
 }
 
+// ArchiveJob provides a mock function with given fields: ctx, jobID
+func (_m *Spawner) ArchiveJob(ctx context.Context, jobID int32) error {
+	ret := _m.Called(ctx, jobID)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32) error); ok {
+		r0 = rf(ctx, jobID)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Close provides a mock function with given fields:
 func (_m *Spawner) Close() error {
 	ret := _m.Called()
@@ -95,6 +109,20 @@ func (_m *Spawner) Healthy() error {
 	return r0
 }
 
+// PauseJob provides a mock function with given fields: ctx, jobID, reason
+func (_m *Spawner) PauseJob(ctx context.Context, jobID int32, reason string) error {
+	ret := _m.Called(ctx, jobID, reason)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(context.Context, int32, string) error); ok {
+		r0 = rf(ctx, jobID, reason)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Ready provides a mock function with given fields:
 func (_m *Spawner) Ready() error {
 	ret := _m.Called()
@@ -109,6 +137,20 @@ func (_m *Spawner) Ready() error {
 	return r0
 }
 
+// RestartService provides a mock function with given fields: spec
+func (_m *Spawner) RestartService(spec job.Job) error {
+	ret := _m.Called(spec)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(job.Job) error); ok {
+		r0 = rf(spec)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // Start provides a mock function with given fields:
 func (_m *Spawner) Start() error {
 	ret := _m.Called()
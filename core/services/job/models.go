@@ -1,6 +1,8 @@
 package job
 
 import (
+	"database/sql/driver"
+	"encoding/json"
 	"fmt"
 	"strconv"
 	"strings"
@@ -8,6 +10,7 @@ import (
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/lib/pq"
+	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"gopkg.in/guregu/null.v4"
 	"gorm.io/gorm"
@@ -24,13 +27,16 @@ import (
 )
 
 const (
-	Cron              Type = "cron"
-	DirectRequest     Type = "directrequest"
-	FluxMonitor       Type = "fluxmonitor"
-	OffchainReporting Type = "offchainreporting"
-	Keeper            Type = "keeper"
-	VRF               Type = "vrf"
-	Webhook           Type = "webhook"
+	Cron               Type = "cron"
+	DirectRequest      Type = "directrequest"
+	FluxMonitor        Type = "fluxmonitor"
+	OffchainReporting  Type = "offchainreporting"
+	OffchainReporting2 Type = "offchainreporting2"
+	Bootstrap          Type = "bootstrap"
+	Keeper             Type = "keeper"
+	VRF                Type = "vrf"
+	Webhook            Type = "webhook"
+	BlockhashStore     Type = "blockhashstore"
 )
 
 //revive:disable:redefines-builtin-id
@@ -54,60 +60,107 @@ func (t Type) SchemaVersion() uint32 {
 
 var (
 	requiresPipelineSpec = map[Type]bool{
-		Cron:              true,
-		DirectRequest:     true,
-		FluxMonitor:       true,
-		OffchainReporting: false, // bootstrap jobs do not require it
-		Keeper:            true,
-		VRF:               true,
-		Webhook:           true,
+		Cron:               true,
+		DirectRequest:      true,
+		FluxMonitor:        true,
+		OffchainReporting:  false, // bootstrap jobs do not require it
+		OffchainReporting2: false, // bootstrap jobs do not require it
+		Bootstrap:          false,
+		Keeper:             true,
+		VRF:                true,
+		Webhook:            true,
+		BlockhashStore:     false,
 	}
 	supportsAsync = map[Type]bool{
-		Cron:              true,
-		DirectRequest:     true,
-		FluxMonitor:       false,
-		OffchainReporting: false,
-		Keeper:            true,
-		VRF:               true,
-		Webhook:           true,
+		Cron:               true,
+		DirectRequest:      true,
+		FluxMonitor:        false,
+		OffchainReporting:  false,
+		OffchainReporting2: false,
+		Bootstrap:          false,
+		Keeper:             true,
+		VRF:                true,
+		Webhook:            true,
+		BlockhashStore:     false,
 	}
 	schemaVersions = map[Type]uint32{
-		Cron:              1,
-		DirectRequest:     1,
-		FluxMonitor:       1,
-		OffchainReporting: 1,
-		Keeper:            2,
-		VRF:               1,
-		Webhook:           1,
+		Cron:               1,
+		DirectRequest:      1,
+		FluxMonitor:        1,
+		OffchainReporting:  1,
+		OffchainReporting2: 1,
+		Bootstrap:          1,
+		Keeper:             3,
+		VRF:                1,
+		Webhook:            1,
+		BlockhashStore:     1,
 	}
 )
 
 type Job struct {
-	ID                            int32     `toml:"-" gorm:"primary_key"`
-	ExternalJobID                 uuid.UUID `toml:"externalJobID"`
-	OffchainreportingOracleSpecID *int32
-	OffchainreportingOracleSpec   *OffchainReportingOracleSpec
-	CronSpecID                    *int32
-	CronSpec                      *CronSpec
-	DirectRequestSpecID           *int32
-	DirectRequestSpec             *DirectRequestSpec
-	FluxMonitorSpecID             *int32
-	FluxMonitorSpec               *FluxMonitorSpec
-	KeeperSpecID                  *int32
-	KeeperSpec                    *KeeperSpec
-	VRFSpecID                     *int32
-	VRFSpec                       *VRFSpec
-	WebhookSpecID                 *int32
-	WebhookSpec                   *WebhookSpec
-	PipelineSpecID                int32
-	PipelineSpec                  *pipeline.Spec
-	JobSpecErrors                 []SpecError `gorm:"foreignKey:JobID"`
-	Type                          Type
-	SchemaVersion                 uint32
-	Name                          null.String
-	MaxTaskDuration               models.Interval
-	Pipeline                      pipeline.Pipeline `toml:"observationSource" gorm:"-"`
-	CreatedAt                     time.Time
+	ID                             int32     `toml:"-" gorm:"primary_key"`
+	ExternalJobID                  uuid.UUID `toml:"externalJobID"`
+	OffchainreportingOracleSpecID  *int32
+	OffchainreportingOracleSpec    *OffchainReportingOracleSpec
+	Offchainreporting2OracleSpecID *int32
+	Offchainreporting2OracleSpec   *OffchainReporting2OracleSpec
+	BootstrapSpecID                *int32
+	BootstrapSpec                  *BootstrapSpec
+	CronSpecID                     *int32
+	CronSpec                       *CronSpec
+	DirectRequestSpecID            *int32
+	DirectRequestSpec              *DirectRequestSpec
+	FluxMonitorSpecID              *int32
+	FluxMonitorSpec                *FluxMonitorSpec
+	KeeperSpecID                   *int32
+	KeeperSpec                     *KeeperSpec
+	VRFSpecID                      *int32
+	VRFSpec                        *VRFSpec
+	WebhookSpecID                  *int32
+	WebhookSpec                    *WebhookSpec
+	BlockhashStoreSpecID           *int32
+	BlockhashStoreSpec             *BlockhashStoreSpec
+	PipelineSpecID                 int32
+	PipelineSpec                   *pipeline.Spec
+	JobSpecErrors                  []SpecError `gorm:"foreignKey:JobID"`
+	Type                           Type
+	SchemaVersion                  uint32
+	Name                           null.String
+	MaxTaskDuration                models.Interval
+	// Params are operator-editable runtime parameters, exposed to the job's
+	// pipelines as $(jobSpec.params.X). They can be changed via the API
+	// without recreating the job.
+	Params JSONConfig `toml:"params" gorm:"type:jsonb"`
+	// OnSuccess / OnFailure optionally chain another job: when a pipeline run
+	// for this job finishes, a run of the referenced job (by external job ID)
+	// is enqueued with this run's outputs as $(jobRun.inputs).
+	OnSuccess *uuid.UUID `toml:"onSuccess"`
+	OnFailure *uuid.UUID `toml:"onFailure"`
+	// Labels and GroupName organize fleets of related jobs: list endpoints
+	// can filter on them and job group endpoints operate on every job in a
+	// group at once.
+	Labels    pq.StringArray `toml:"labels" gorm:"type:text[]"`
+	GroupName null.String    `toml:"group"`
+	// GasBudget (wei) and LinkBudget (juels) optionally cap the job's on-chain
+	// spend over the rolling BudgetWindow (default 24h); when a cap is
+	// exceeded the job is quarantined. See Spawner.
+	GasBudget    *utils.Big      `toml:"gasBudget" gorm:"type:numeric(78,0);default:null"`
+	LinkBudget   *assets.Link    `toml:"linkBudget" gorm:"type:numeric(78,0);default:null"`
+	BudgetWindow models.Interval `toml:"budgetWindow" gorm:"type:bigint;default:null"`
+	// QuarantinedAt / QuarantineReason are set when the job is automatically
+	// paused after too many consecutive failed runs. See Spawner.
+	QuarantinedAt    null.Time   `toml:"-"`
+	QuarantineReason null.String `toml:"-"`
+	// ArchivedAt is set when the job is archived (soft-deleted): its services
+	// are stopped and it is hidden from default listings, but its spec, runs
+	// and transactions are preserved until it is purged or restored.
+	ArchivedAt null.Time `toml:"-"`
+	// SpecTOML is the original TOML source the job was created from, kept
+	// verbatim so specs can be exported and re-imported on another node.
+	// Empty for jobs created before it was stored.
+	SpecTOML  string            `toml:"-"`
+	Pipeline  pipeline.Pipeline `toml:"observationSource" gorm:"-"`
+	CreatedAt time.Time
 }
 
 func ExternalJobIDEncodeStringToTopic(id uuid.UUID) common.Hash {
@@ -232,6 +285,108 @@ func (OffchainReportingOracleSpec) TableName() string {
 	return "offchainreporting_oracle_specs"
 }
 
+// JSONConfig is a map for arbitrary relay-specific configuration, stored as
+// jsonb
+type JSONConfig map[string]interface{}
+
+func (r JSONConfig) Bytes() []byte {
+	b, _ := json.Marshal(r)
+	return b
+}
+
+func (r JSONConfig) Value() (driver.Value, error) {
+	return json.Marshal(r)
+}
+
+func (r *JSONConfig) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.Errorf("expected bytes got %T", value)
+	}
+	return json.Unmarshal(b, &r)
+}
+
+// OffchainReporting2OracleSpec defines the job spec for OCR2 jobs. Unlike the
+// v1 spec, onchain interactions go through the relayer for the configured
+// network, so the contract is identified by an opaque contract ID and the
+// transmitter by an opaque transmitter ID rather than EVM addresses.
+type OffchainReporting2OracleSpec struct {
+	ID int32 `toml:"-" gorm:"primary_key"`
+	// Relay is the network the job's onchain interactions go through, e.g. "evm"
+	Relay string `toml:"relay"`
+	// RelayConfig carries relay-specific configuration, e.g. the EVM chain ID
+	RelayConfig JSONConfig `toml:"relayConfig" gorm:"type:jsonb"`
+	ContractID  string     `toml:"contractID"`
+	// P2PBootstrapPeers are the V2 networking bootstrappers, as multiaddrs
+	P2PBootstrapPeers pq.StringArray `toml:"p2pBootstrapPeers" gorm:"column:p2p_bootstrap_peers;type:text[]" db:"p2p_bootstrap_peers"`
+	IsBootstrapPeer   bool           `toml:"isBootstrapPeer"`
+	// EncryptedOCRKeyBundleID identifies the key bundle for the relay's key
+	// type; its format is relay-specific
+	EncryptedOCRKeyBundleID null.String `toml:"keyBundleID"`
+	// TransmitterID identifies the account transmissions are sent from; its
+	// format is relay-specific
+	TransmitterID                     null.String     `toml:"transmitterID"`
+	BlockchainTimeout                 models.Interval `toml:"blockchainTimeout" gorm:"type:bigint;default:null"`
+	ContractConfigTrackerPollInterval models.Interval `toml:"contractConfigTrackerPollInterval" gorm:"type:bigint;default:null"`
+	ContractConfigConfirmations       uint16          `toml:"contractConfigConfirmations"`
+	CreatedAt                         time.Time       `toml:"-"`
+	UpdatedAt                         time.Time       `toml:"-"`
+}
+
+func (s OffchainReporting2OracleSpec) GetID() string {
+	return fmt.Sprintf("%v", s.ID)
+}
+
+func (s *OffchainReporting2OracleSpec) SetID(value string) error {
+	ID, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return err
+	}
+	s.ID = int32(ID)
+	return nil
+}
+
+func (OffchainReporting2OracleSpec) TableName() string {
+	return "offchainreporting2_oracle_specs"
+}
+
+// BootstrapSpec defines the job spec for bootstrap jobs, which run only the
+// OCR bootstrapper (config tracking and peer discovery) for a contract.
+// Dedicated bootstrap nodes therefore need neither oracle key bundles nor
+// transmitter keys.
+type BootstrapSpec struct {
+	ID int32 `toml:"-" gorm:"primary_key"`
+	// ContractID identifies the contract to track config for; its format is
+	// relay-specific
+	ContractID string `toml:"contractID"`
+	// Relay is the network the config tracking goes through, e.g. "evm"
+	Relay string `toml:"relay"`
+	// RelayConfig carries relay-specific configuration, e.g. the EVM chain ID
+	RelayConfig                       JSONConfig      `toml:"relayConfig" gorm:"type:jsonb"`
+	BlockchainTimeout                 models.Interval `toml:"blockchainTimeout" gorm:"type:bigint;default:null"`
+	ContractConfigTrackerPollInterval models.Interval `toml:"contractConfigTrackerPollInterval" gorm:"type:bigint;default:null"`
+	ContractConfigConfirmations       uint16          `toml:"contractConfigConfirmations"`
+	CreatedAt                         time.Time       `toml:"-"`
+	UpdatedAt                         time.Time       `toml:"-"`
+}
+
+func (s BootstrapSpec) GetID() string {
+	return fmt.Sprintf("%v", s.ID)
+}
+
+func (s *BootstrapSpec) SetID(value string) error {
+	ID, err := strconv.ParseInt(value, 10, 32)
+	if err != nil {
+		return err
+	}
+	s.ID = int32(ID)
+	return nil
+}
+
+func (BootstrapSpec) TableName() string {
+	return "bootstrap_specs"
+}
+
 type ExternalInitiatorWebhookSpec struct {
 	ExternalInitiatorID int64
 	ExternalInitiator   bridges.ExternalInitiator `gorm:"foreignkey:ExternalInitiatorID;->"`
@@ -243,8 +398,11 @@ type ExternalInitiatorWebhookSpec struct {
 type WebhookSpec struct {
 	ID                            int32 `toml:"-" gorm:"primary_key"`
 	ExternalInitiatorWebhookSpecs []ExternalInitiatorWebhookSpec
-	CreatedAt                     time.Time `json:"createdAt" toml:"-"`
-	UpdatedAt                     time.Time `json:"updatedAt" toml:"-"`
+	// SharedSecret, when set, requires incoming webhook triggers to carry a
+	// valid HMAC signature header. It is never rendered in API responses
+	SharedSecret string    `toml:"sharedSecret" json:"-"`
+	CreatedAt    time.Time `json:"createdAt" toml:"-"`
+	UpdatedAt    time.Time `json:"updatedAt" toml:"-"`
 }
 
 func (w WebhookSpec) GetID() string {
@@ -280,11 +438,96 @@ func (DirectRequestSpec) TableName() string {
 	return "direct_request_specs"
 }
 
+// Catch-up policies controlling what happens when schedule firings were
+// missed while the node was down
+const (
+	// CronCatchUpSkip ignores missed firings (the default)
+	CronCatchUpSkip = "skip"
+	// CronCatchUpRunOnce runs the pipeline once on startup if any firing was
+	// missed
+	CronCatchUpRunOnce = "runOnce"
+	// CronCatchUpReplay runs the pipeline once per missed firing, up to
+	// CatchUpLimit
+	CronCatchUpReplay = "replay"
+)
+
 type CronSpec struct {
-	ID           int32     `toml:"-" gorm:"primary_key"`
-	CronSchedule string    `toml:"schedule"`
-	CreatedAt    time.Time `toml:"-"`
-	UpdatedAt    time.Time `toml:"-"`
+	ID            int32         `toml:"-" gorm:"primary_key"`
+	CronSchedule  string        `toml:"schedule"`
+	CronSchedules CronSchedules `toml:"schedules" gorm:"type:jsonb"`
+	// CatchUp selects what to do about firings missed while the node was
+	// down: skip them, run once, or replay each one up to CatchUpLimit
+	CatchUp      string `toml:"catchUp"`
+	CatchUpLimit uint32 `toml:"catchUpLimit"`
+	// RunAt makes the job one-shot: instead of a recurring schedule, the
+	// pipeline executes exactly once at the given timestamp, after which the
+	// job is archived automatically
+	RunAt     *time.Time `toml:"runAt"`
+	CreatedAt time.Time  `toml:"-"`
+	UpdatedAt time.Time  `toml:"-"`
+}
+
+// CronSchedule is one of potentially several schedules a cron job runs on, so
+// that a single spec can implement e.g. "every 5 minutes plus daily at 00:00
+// UTC" patterns
+type CronSchedule struct {
+	// Name tags the runs created when this schedule fires; it defaults to the
+	// schedule expression itself
+	Name     string `toml:"name" json:"name,omitempty"`
+	Schedule string `toml:"schedule" json:"schedule"`
+	// Timezone, when set, is applied to Schedule as a CRON_TZ prefix. A
+	// schedule may instead embed its own CRON_TZ
+	Timezone string `toml:"timezone" json:"timezone,omitempty"`
+	// Jitter delays each firing by a random duration up to this value, so
+	// that many schedules (or many nodes) do not all fire at the same instant
+	Jitter models.Interval `toml:"jitter" json:"jitter,omitempty"`
+}
+
+// ScheduleWithTimezone returns the cron expression with any Timezone applied
+// as a CRON_TZ prefix
+func (s CronSchedule) ScheduleWithTimezone() string {
+	if s.Timezone != "" && !strings.HasPrefix(s.Schedule, "CRON_TZ=") {
+		return fmt.Sprintf("CRON_TZ=%s %s", s.Timezone, s.Schedule)
+	}
+	return s.Schedule
+}
+
+// Tag identifies which schedule fired on the runs it creates
+func (s CronSchedule) Tag() string {
+	if s.Name != "" {
+		return s.Name
+	}
+	return s.Schedule
+}
+
+type CronSchedules []CronSchedule
+
+func (s *CronSchedules) Scan(value interface{}) error {
+	if value == nil {
+		*s = nil
+		return nil
+	}
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, s)
+}
+
+func (s CronSchedules) Value() (driver.Value, error) {
+	if len(s) == 0 {
+		return nil, nil
+	}
+	return json.Marshal(s)
+}
+
+// Schedules returns every schedule this spec runs on, including the legacy
+// single CronSchedule field
+func (s CronSpec) Schedules() (schedules []CronSchedule) {
+	if s.CronSchedule != "" {
+		schedules = append(schedules, CronSchedule{Schedule: s.CronSchedule})
+	}
+	return append(schedules, s.CronSchedules...)
 }
 
 func (s CronSpec) GetID() string {
@@ -324,6 +567,7 @@ type FluxMonitorSpecIntThreshold struct {
 	ContractAddress     ethkey.EIP55Address `toml:"contractAddress"`
 	Threshold           int                 `toml:"threshold"`
 	AbsoluteThreshold   int                 `toml:"absoluteThreshold"`
+	ThresholdExpression string              `toml:"thresholdExpression"`
 	PollTimerPeriod     time.Duration
 	PollTimerDisabled   bool
 	IdleTimerPeriod     time.Duration
@@ -342,7 +586,11 @@ type FluxMonitorSpec struct {
 	// AbsoluteThreshold is the maximum absolute change allowed in a fluxmonitored
 	// value before a new round should be kicked off, so that the current value
 	// can be reported on-chain.
-	AbsoluteThreshold   float32 `toml:"absoluteThreshold,float" gorm:"type:float;not null"`
+	AbsoluteThreshold float32 `toml:"absoluteThreshold,float" gorm:"type:float;not null"`
+	// ThresholdExpression is an optional pipeline evaluated on every deviation
+	// check with curAnswer and nextAnswer variables; its final output replaces
+	// Threshold, allowing e.g. tighter thresholds near zero.
+	ThresholdExpression string `toml:"thresholdExpression"`
 	PollTimerPeriod     time.Duration
 	PollTimerDisabled   bool
 	IdleTimerPeriod     time.Duration
@@ -361,8 +609,17 @@ type KeeperSpec struct {
 	ContractAddress ethkey.EIP55Address `toml:"contractAddress"`
 	FromAddress     ethkey.EIP55Address `toml:"fromAddress"`
 	EVMChainID      *utils.Big          `toml:"evmChainID" gorm:"column:evm_chain_id" db:"evm_chain_id"`
-	CreatedAt       time.Time           `toml:"-"`
-	UpdatedAt       time.Time           `toml:"-"`
+	// TurnBlockCount overrides the registry contract's blockCountPerTurn for
+	// this job if set to a non-zero value
+	TurnBlockCount int32 `toml:"turnBlockCount"`
+	// TurnBufferBlocks is how many blocks behind the latest head eligibility
+	// is evaluated, protecting against re-orgs near turn boundaries
+	TurnBufferBlocks int32 `toml:"turnBufferBlocks"`
+	// GasPriceBufferPercent overrides KEEPER_GAS_PRICE_BUFFER_PERCENT and
+	// KEEPER_GAS_TIP_CAP_BUFFER_PERCENT for this job if set to a non-zero value
+	GasPriceBufferPercent uint32    `toml:"gasPriceBufferPercent"`
+	CreatedAt             time.Time `toml:"-"`
+	UpdatedAt             time.Time `toml:"-"`
 }
 
 type VRFSpec struct {
@@ -375,6 +632,62 @@ type VRFSpec struct {
 	FromAddress        *ethkey.EIP55Address `toml:"fromAddress"`
 	PollPeriod         time.Duration        `toml:"pollPeriod"` // For v2 jobs
 	PollPeriodEnv      bool                 `gorm:"-"`
-	CreatedAt          time.Time            `toml:"-"`
-	UpdatedAt          time.Time            `toml:"-"`
+	// BatchFulfillmentEnabled bundles multiple pending fulfillments into a
+	// single transaction against BatchCoordinatorAddress. For v2 jobs
+	BatchFulfillmentEnabled bool `toml:"batchFulfillmentEnabled"`
+	// BatchCoordinatorAddress is the address of the batch coordinator
+	// contract that proxies batched fulfillments to the coordinator
+	BatchCoordinatorAddress *ethkey.EIP55Address `toml:"batchCoordinatorAddress"`
+	// MaxBatchSize caps how many fulfillments may be bundled into one batch
+	MaxBatchSize uint32 `toml:"maxBatchSize"`
+	// BatchGasBudget caps the total gas limit of a single batch transaction
+	BatchGasBudget uint64 `toml:"batchGasBudget"`
+	// MinSubscriptionBalance is the balance below which a subscription is
+	// considered underfunded and its requests are left unprocessed until the
+	// subscription is topped up. For v2 jobs
+	MinSubscriptionBalance *assets.Link `toml:"minSubscriptionBalanceLinkJuels"`
+	CreatedAt              time.Time    `toml:"-"`
+	UpdatedAt              time.Time    `toml:"-"`
+}
+
+// BlockhashStoreSpec defines the job parameters for the blockhash store
+// feeder job, which stores the blockhashes of blocks with unfulfilled VRF
+// requests before they leave the 256-block blockhash(n) horizon
+type BlockhashStoreSpec struct {
+	ID int32
+
+	// CoordinatorV1Address is the VRF V1 coordinator to watch for unfulfilled
+	// requests; at least one of the coordinator addresses must be set
+	CoordinatorV1Address *ethkey.EIP55Address `toml:"coordinatorV1Address"`
+
+	// CoordinatorV2Address is the VRF V2 coordinator to watch for unfulfilled
+	// requests
+	CoordinatorV2Address *ethkey.EIP55Address `toml:"coordinatorV2Address"`
+
+	// WaitBlocks is the minimum age in blocks of requests before their
+	// blockhash is stored
+	WaitBlocks int32 `toml:"waitBlocks"`
+
+	// LookbackBlocks is how far back unfulfilled requests are searched for;
+	// must be greater than WaitBlocks and less than 256
+	LookbackBlocks int32 `toml:"lookbackBlocks"`
+
+	// BlockhashStoreAddress is the address of the BlockhashStore contract to
+	// store blockhashes into
+	BlockhashStoreAddress ethkey.EIP55Address `toml:"blockhashStoreAddress"`
+
+	// PollPeriod defines how often the feeder checks for unfulfilled requests
+	PollPeriod time.Duration `toml:"pollPeriod"`
+
+	// RunTimeout bounds how long a single feeder run may take
+	RunTimeout time.Duration `toml:"runTimeout"`
+
+	EVMChainID *utils.Big `toml:"evmChainID" gorm:"column:evm_chain_id" db:"evm_chain_id"`
+
+	// FromAddress optionally pins the key used to send store transactions; if
+	// unset a key is chosen round-robin
+	FromAddress *ethkey.EIP55Address `toml:"fromAddress"`
+
+	CreatedAt time.Time `toml:"-"`
+	UpdatedAt time.Time `toml:"-"`
 }
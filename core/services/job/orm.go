@@ -3,10 +3,16 @@ package job
 import (
 	"context"
 	"database/sql"
+	"encoding/json"
 	"fmt"
+	"math/big"
 	"reflect"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
@@ -17,10 +23,12 @@ import (
 	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
 	"go.uber.org/multierr"
 
 	"github.com/jackc/pgconn"
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 )
@@ -29,6 +37,8 @@ var (
 	ErrNoSuchKeyBundle          = errors.New("no such key bundle exists")
 	ErrNoSuchTransmitterAddress = errors.New("no such transmitter address exists")
 	ErrNoSuchPublicKey          = errors.New("no such public key exists")
+	ErrNoSuchChainedJob         = errors.New("no such chained job exists")
+	ErrSelfChainedJob           = errors.New("job cannot chain to itself")
 )
 
 //go:generate mockery --name ORM --output ./mocks/ --case=underscore
@@ -41,6 +51,14 @@ type ORM interface {
 	FindJobByExternalJobID(ctx context.Context, uuid uuid.UUID) (Job, error)
 	FindJobIDsWithBridge(name string) ([]int32, error)
 	DeleteJob(id int32, qopts ...postgres.QOpt) error
+	ArchiveJob(id int32, qopts ...postgres.QOpt) error
+	RestoreJob(id int32, qopts ...postgres.QOpt) error
+	SearchJobs(contract, transmitter *common.Address, offset, limit int) ([]Job, int, error)
+	FindJobsByGroupOrLabels(group string, labels []string, offset, limit int) ([]Job, int, error)
+	SetJobParams(id int32, params JSONConfig, qopts ...postgres.QOpt) error
+	JobSpend(id int32, since time.Time, qopts ...postgres.QOpt) (SpendReport, error)
+	QuarantineJob(id int32, reason string, qopts ...postgres.QOpt) error
+	ResumeJob(id int32, qopts ...postgres.QOpt) error
 	RecordError(ctx context.Context, jobID int32, description string)
 	DismissError(ctx context.Context, errorID int32) error
 	Close() error
@@ -49,6 +67,7 @@ type ORM interface {
 
 type orm struct {
 	db          *sqlx.DB
+	readReplica *postgres.ReadReplica
 	chainSet    evm.ChainSet
 	keyStore    keystore.Master
 	pipelineORM pipeline.ORM
@@ -76,6 +95,21 @@ func (o *orm) Close() error {
 	return nil
 }
 
+// SetReadReplica routes this ORM's heavy read-only queries (job and run
+// listings) through the given read replica; writes always use the primary.
+func (o *orm) SetReadReplica(rr *postgres.ReadReplica) {
+	o.readReplica = rr
+}
+
+// readQueryer returns the connection heavy read-only queries should use: the
+// read replica when one is configured and healthy, the primary otherwise.
+func (o *orm) readQueryer() postgres.Queryer {
+	if o.readReplica != nil {
+		return o.readReplica.Queryer()
+	}
+	return o.db
+}
+
 // CreateJob creates the job and it's associated spec record.
 // Expects an unmarshaled job spec as the jb argument i.e. output from ValidatedXX.
 // Scans all persisted records back into jb
@@ -106,6 +140,13 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 			jb.ExternalJobID = uuid.NewV4()
 		}
 
+		if err := o.validateChainedJob(tx, "onSuccess", jb.OnSuccess, jb.ExternalJobID); err != nil {
+			return err
+		}
+		if err := o.validateChainedJob(tx, "onFailure", jb.OnFailure, jb.ExternalJobID); err != nil {
+			return err
+		}
+
 		switch jb.Type {
 		case DirectRequest:
 			var specID int32
@@ -118,9 +159,9 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 			jb.DirectRequestSpecID = &specID
 		case FluxMonitor:
 			var specID int32
-			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
+			sql := `INSERT INTO flux_monitor_specs (contract_address, threshold, absolute_threshold, threshold_expression, poll_timer_period, poll_timer_disabled, idle_timer_period, idle_timer_disabled,
 					drumbeat_schedule, drumbeat_random_delay, drumbeat_enabled, min_payment, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :threshold, :absolute_threshold, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
+			VALUES (:contract_address, :threshold, :absolute_threshold, :threshold_expression, :poll_timer_period, :poll_timer_disabled, :idle_timer_period, :idle_timer_disabled,
 					:drumbeat_schedule, :drumbeat_random_delay, :drumbeat_enabled, :min_payment, :evm_chain_id, NOW(), NOW())
 			RETURNING id;`
 			if err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.FluxMonitorSpec); err != nil {
@@ -154,10 +195,42 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 				return errors.Wrap(err, "failed to create OffchainreportingOracleSpec")
 			}
 			jb.OffchainreportingOracleSpecID = &specID
+		case OffchainReporting2:
+			var specID int32
+			if jb.Offchainreporting2OracleSpec.EncryptedOCRKeyBundleID.Valid {
+				_, err := o.keyStore.OCR().Get(jb.Offchainreporting2OracleSpec.EncryptedOCRKeyBundleID.String)
+				if err != nil {
+					return errors.Wrapf(ErrNoSuchKeyBundle, "%v", jb.Offchainreporting2OracleSpec.EncryptedOCRKeyBundleID)
+				}
+			}
+
+			sql := `INSERT INTO offchainreporting2_oracle_specs (relay, relay_config, contract_id, p2p_bootstrap_peers, is_bootstrap_peer, encrypted_ocr_key_bundle_id, transmitter_id,
+					blockchain_timeout, contract_config_tracker_poll_interval, contract_config_confirmations,
+					created_at, updated_at)
+			VALUES (:relay, :relay_config, :contract_id, :p2p_bootstrap_peers, :is_bootstrap_peer, :encrypted_ocr_key_bundle_id, :transmitter_id,
+					:blockchain_timeout, :contract_config_tracker_poll_interval, :contract_config_confirmations,
+					NOW(), NOW())
+			RETURNING id;`
+			err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.Offchainreporting2OracleSpec)
+			if err != nil {
+				return errors.Wrap(err, "failed to create Offchainreporting2OracleSpec")
+			}
+			jb.Offchainreporting2OracleSpecID = &specID
+		case Bootstrap:
+			var specID int32
+			sql := `INSERT INTO bootstrap_specs (contract_id, relay, relay_config, blockchain_timeout,
+					contract_config_tracker_poll_interval, contract_config_confirmations, created_at, updated_at)
+			VALUES (:contract_id, :relay, :relay_config, :blockchain_timeout,
+					:contract_config_tracker_poll_interval, :contract_config_confirmations, NOW(), NOW())
+			RETURNING id;`
+			if err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.BootstrapSpec); err != nil {
+				return errors.Wrap(err, "failed to create BootstrapSpec")
+			}
+			jb.BootstrapSpecID = &specID
 		case Keeper:
 			var specID int32
-			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, created_at, updated_at)
-			VALUES (:contract_address, :from_address, :evm_chain_id, NOW(), NOW())
+			sql := `INSERT INTO keeper_specs (contract_address, from_address, evm_chain_id, turn_block_count, turn_buffer_blocks, gas_price_buffer_percent, created_at, updated_at)
+			VALUES (:contract_address, :from_address, :evm_chain_id, :turn_block_count, :turn_buffer_blocks, :gas_price_buffer_percent, NOW(), NOW())
 			RETURNING id;`
 			if err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.KeeperSpec); err != nil {
 				return errors.Wrap(err, "failed to create KeeperSpec")
@@ -165,8 +238,8 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 			jb.KeeperSpecID = &specID
 		case Cron:
 			var specID int32
-			sql := `INSERT INTO cron_specs (cron_schedule, created_at, updated_at)
-			VALUES (:cron_schedule, NOW(), NOW())
+			sql := `INSERT INTO cron_specs (cron_schedule, cron_schedules, run_at, catch_up, catch_up_limit, created_at, updated_at)
+			VALUES (:cron_schedule, :cron_schedules, :run_at, :catch_up, :catch_up_limit, NOW(), NOW())
 			RETURNING id;`
 			if err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.CronSpec); err != nil {
 				return errors.Wrap(err, "failed to create CronSpec")
@@ -174,8 +247,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 			jb.CronSpecID = &specID
 		case VRF:
 			var specID int32
-			sql := `INSERT INTO vrf_specs (coordinator_address, public_key, confirmations, evm_chain_id, from_address, poll_period, created_at, updated_at)
-			VALUES (:coordinator_address, :public_key, :confirmations, :evm_chain_id, :from_address, :poll_period, NOW(), NOW())
+			sql := `INSERT INTO vrf_specs (coordinator_address, public_key, confirmations, evm_chain_id, from_address, poll_period,
+					batch_fulfillment_enabled, batch_coordinator_address, max_batch_size, batch_gas_budget, min_subscription_balance, created_at, updated_at)
+			VALUES (:coordinator_address, :public_key, :confirmations, :evm_chain_id, :from_address, :poll_period,
+					:batch_fulfillment_enabled, :batch_coordinator_address, :max_batch_size, :batch_gas_budget, :min_subscription_balance, NOW(), NOW())
 			RETURNING id;`
 			err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.VRFSpec)
 			pqErr, ok := err.(*pgconn.PgError)
@@ -212,6 +287,15 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 					return errors.Wrap(err, "failed to create ExternalInitiatorWebhookSpecs")
 				}
 			}
+		case BlockhashStore:
+			var specID int32
+			sql := `INSERT INTO blockhash_store_specs (coordinator_v1_address, coordinator_v2_address, wait_blocks, lookback_blocks, blockhash_store_address, poll_period, run_timeout, evm_chain_id, from_address, created_at, updated_at)
+			VALUES (:coordinator_v1_address, :coordinator_v2_address, :wait_blocks, :lookback_blocks, :blockhash_store_address, :poll_period, :run_timeout, :evm_chain_id, :from_address, NOW(), NOW())
+			RETURNING id;`
+			if err := postgres.PrepareQueryRowx(tx, sql, &specID, jb.BlockhashStoreSpec); err != nil {
+				return errors.Wrap(err, "failed to create BlockhashStoreSpec")
+			}
+			jb.BlockhashStoreSpecID = &specID
 		default:
 			o.lggr.Fatalf("Unsupported jb.Type: %v", jb.Type)
 		}
@@ -222,10 +306,10 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 		}
 		jb.PipelineSpecID = pipelineSpecID
 
-		sql := `INSERT INTO jobs (pipeline_spec_id, offchainreporting_oracle_spec_id, name, schema_version, type, max_task_duration, direct_request_spec_id, flux_monitor_spec_id,
-				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, external_job_id, created_at)
-		VALUES (:pipeline_spec_id, :offchainreporting_oracle_spec_id, :name, :schema_version, :type, :max_task_duration, :direct_request_spec_id, :flux_monitor_spec_id,
-				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :external_job_id, NOW())
+		sql := `INSERT INTO jobs (pipeline_spec_id, offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, bootstrap_spec_id, name, schema_version, type, max_task_duration, params, on_success, on_failure, labels, group_name, gas_budget, link_budget, budget_window, direct_request_spec_id, flux_monitor_spec_id,
+				keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id, blockhash_store_spec_id, external_job_id, spec_toml, created_at)
+		VALUES (:pipeline_spec_id, :offchainreporting_oracle_spec_id, :offchainreporting2_oracle_spec_id, :bootstrap_spec_id, :name, :schema_version, :type, :max_task_duration, :params, :on_success, :on_failure, :labels, :group_name, :gas_budget, :link_budget, :budget_window, :direct_request_spec_id, :flux_monitor_spec_id,
+				:keeper_spec_id, :cron_spec_id, :vrf_spec_id, :webhook_spec_id, :blockhash_store_spec_id, :external_job_id, :spec_toml, NOW())
 		RETURNING id;`
 		err = postgres.PrepareQueryRowx(tx, sql, &jobID, jb)
 		return errors.Wrap(err, "failed to insert job")
@@ -237,6 +321,28 @@ func (o *orm) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 	return o.findJob(jb, "id", jobID, qopts...)
 }
 
+// validateChainedJob rejects an onSuccess/onFailure value which references
+// the job itself or which does not resolve to an existing job. This cannot
+// catch every chaining cycle (jobs may be deleted and recreated), so the
+// spawner additionally bounds the chain depth at run time.
+func (o *orm) validateChainedJob(tx postgres.Queryer, field string, next *uuid.UUID, self uuid.UUID) error {
+	if next == nil {
+		return nil
+	}
+	if *next == self {
+		return errors.Wrap(ErrSelfChainedJob, field)
+	}
+	sql := `SELECT EXISTS(SELECT 1 FROM jobs WHERE external_job_id = $1);`
+	var exists bool
+	if err := tx.QueryRowx(sql, *next).Scan(&exists); err != nil {
+		return errors.Wrapf(err, "CreateJob failed to check %s job", field)
+	}
+	if !exists {
+		return errors.Wrapf(ErrNoSuchChainedJob, "%s: %s", field, *next)
+	}
+	return nil
+}
+
 // DeleteJob removes a job
 func (o *orm) DeleteJob(id int32, qopts ...postgres.QOpt) error {
 	q := postgres.NewQ(o.db, qopts...)
@@ -245,16 +351,25 @@ func (o *orm) DeleteJob(id int32, qopts ...postgres.QOpt) error {
 			DELETE FROM jobs WHERE id = $1 RETURNING
 				pipeline_spec_id,
 				offchainreporting_oracle_spec_id,
+				offchainreporting2_oracle_spec_id,
+				bootstrap_spec_id,
 				keeper_spec_id,
 				cron_spec_id,
 				flux_monitor_spec_id,
 				vrf_spec_id,
 				webhook_spec_id,
-				direct_request_spec_id
+				direct_request_spec_id,
+				blockhash_store_spec_id
 		),
 		deleted_oracle_specs AS (
 			DELETE FROM offchainreporting_oracle_specs WHERE id IN (SELECT offchainreporting_oracle_spec_id FROM deleted_jobs)
 		),
+		deleted_oracle2_specs AS (
+			DELETE FROM offchainreporting2_oracle_specs WHERE id IN (SELECT offchainreporting2_oracle_spec_id FROM deleted_jobs)
+		),
+		deleted_bootstrap_specs AS (
+			DELETE FROM bootstrap_specs WHERE id IN (SELECT bootstrap_spec_id FROM deleted_jobs)
+		),
 		deleted_keeper_specs AS (
 			DELETE FROM keeper_specs WHERE id IN (SELECT keeper_spec_id FROM deleted_jobs)
 		),
@@ -272,6 +387,16 @@ func (o *orm) DeleteJob(id int32, qopts ...postgres.QOpt) error {
 		),
 		deleted_dr_specs AS (
 			DELETE FROM direct_request_specs WHERE id IN (SELECT direct_request_spec_id FROM deleted_jobs)
+		),
+		deleted_bhs_specs AS (
+			DELETE FROM blockhash_store_specs WHERE id IN (SELECT blockhash_store_spec_id FROM deleted_jobs)
+		),
+		-- pipeline_task_runs no longer cascades from pipeline_runs (the run
+		-- tables are partitioned), so it must be cleared explicitly
+		deleted_task_runs AS (
+			DELETE FROM pipeline_task_runs WHERE pipeline_run_id IN (
+				SELECT id FROM pipeline_runs WHERE pipeline_spec_id IN (SELECT pipeline_spec_id FROM deleted_jobs)
+			)
 		)
 		DELETE FROM pipeline_specs WHERE id IN (SELECT pipeline_spec_id FROM deleted_jobs)`
 	res, err := q.Exec(query, id)
@@ -288,6 +413,153 @@ func (o *orm) DeleteJob(id int32, qopts ...postgres.QOpt) error {
 	return nil
 }
 
+// ArchiveJob marks the job as archived, hiding it from default listings
+// while preserving its spec, runs and transactions.
+func (o *orm) ArchiveJob(id int32, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	res, err := q.Exec(`UPDATE jobs SET archived_at = now() WHERE id = $1 AND archived_at IS NULL`, id)
+	if err != nil {
+		return errors.Wrap(err, "ArchiveJob failed to update job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "ArchiveJob failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// RestoreJob clears the job's archived state.
+func (o *orm) RestoreJob(id int32, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	res, err := q.Exec(`UPDATE jobs SET archived_at = NULL WHERE id = $1 AND archived_at IS NOT NULL`, id)
+	if err != nil {
+		return errors.Wrap(err, "RestoreJob failed to update job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "RestoreJob failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// SetJobParams replaces the job's runtime params. Services running for the
+// job only pick up the new values once restarted; see Spawner.RestartService.
+func (o *orm) SetJobParams(id int32, params JSONConfig, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	res, err := q.Exec(`UPDATE jobs SET params = $1 WHERE id = $2`, params, id)
+	if err != nil {
+		return errors.Wrap(err, "SetJobParams failed to update job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "SetJobParams failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// SpendReport aggregates a job's on-chain spend over some window.
+type SpendReport struct {
+	// GasSpent is the total gas cost in wei of the job's confirmed transactions.
+	GasSpent *utils.Big
+	// LinkSpent is the total LINK in juels billed for transactions that record
+	// a max LINK amount in their metadata (e.g. VRF fulfillments).
+	LinkSpent *assets.Link
+}
+
+// JobSpend aggregates spend attributable to the job since the given time,
+// derived from eth_txes tagged with the job ID in their metadata.
+func (o *orm) JobSpend(id int32, since time.Time, qopts ...postgres.QOpt) (SpendReport, error) {
+	q := postgres.NewQ(o.db, qopts...)
+	report := SpendReport{GasSpent: utils.NewBigI(0), LinkSpent: assets.NewLinkFromJuels(0)}
+
+	var rows []struct {
+		GasPrice  *utils.Big
+		GasFeeCap *utils.Big
+		Receipt   []byte
+	}
+	err := q.Select(&rows, `
+SELECT eth_tx_attempts.gas_price, eth_tx_attempts.gas_fee_cap, eth_receipts.receipt
+FROM eth_txes
+JOIN eth_tx_attempts ON eth_tx_attempts.eth_tx_id = eth_txes.id
+JOIN eth_receipts ON eth_receipts.tx_hash = eth_tx_attempts.hash
+WHERE (eth_txes.meta->>'JobID')::int = $1 AND eth_receipts.created_at >= $2`, id, since)
+	if err != nil {
+		return report, errors.Wrap(err, "JobSpend failed to query receipts")
+	}
+	for _, row := range rows {
+		var receipt struct {
+			GasUsed hexutil.Uint64 `json:"gasUsed"`
+		}
+		if err = json.Unmarshal(row.Receipt, &receipt); err != nil {
+			return report, errors.Wrap(err, "JobSpend failed to unmarshal receipt")
+		}
+		gasPrice := row.GasPrice
+		if gasPrice == nil {
+			gasPrice = row.GasFeeCap
+		}
+		if gasPrice == nil {
+			continue
+		}
+		cost := new(big.Int).Mul(gasPrice.ToInt(), new(big.Int).SetUint64(uint64(receipt.GasUsed)))
+		report.GasSpent = utils.NewBig(new(big.Int).Add(report.GasSpent.ToInt(), cost))
+	}
+
+	var linkSpent utils.Big
+	err = q.Get(&linkSpent, `
+SELECT coalesce(sum((meta->>'MaxLink')::numeric), 0)
+FROM eth_txes
+WHERE (meta->>'JobID')::int = $1 AND meta->>'MaxLink' IS NOT NULL AND created_at >= $2`, id, since)
+	if err != nil {
+		return report, errors.Wrap(err, "JobSpend failed to query link spend")
+	}
+	report.LinkSpent = (*assets.Link)(linkSpent.ToInt())
+
+	return report, nil
+}
+
+// QuarantineJob marks the job as quarantined with the given reason.
+func (o *orm) QuarantineJob(id int32, reason string, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	res, err := q.Exec(`UPDATE jobs SET quarantined_at = now(), quarantine_reason = $1 WHERE id = $2`, reason, id)
+	if err != nil {
+		return errors.Wrap(err, "QuarantineJob failed to update job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "QuarantineJob failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ResumeJob clears the job's quarantine state.
+func (o *orm) ResumeJob(id int32, qopts ...postgres.QOpt) error {
+	q := postgres.NewQ(o.db, qopts...)
+	res, err := q.Exec(`UPDATE jobs SET quarantined_at = NULL, quarantine_reason = NULL WHERE id = $1`, id)
+	if err != nil {
+		return errors.Wrap(err, "ResumeJob failed to update job")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "ResumeJob failed getting RowsAffected")
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
 func (o *orm) RecordError(ctx context.Context, jobID int32, description string) {
 	q := postgres.NewQ(o.db, postgres.WithParentCtx(ctx))
 	sql := `INSERT INTO job_spec_errors (job_id, description, occurrences, created_at, updated_at)
@@ -323,14 +595,14 @@ func (o *orm) DismissError(ctx context.Context, ID int32) error {
 }
 
 func (o *orm) FindJobs(offset, limit int) (jobs []Job, count int, err error) {
-	err = postgres.SqlxTransactionWithDefaultCtx(o.db, o.lggr, func(tx postgres.Queryer) error {
-		sql := `SELECT count(*) FROM jobs;`
+	err = postgres.SqlxTransactionWithDefaultCtx(o.readQueryer(), o.lggr, func(tx postgres.Queryer) error {
+		sql := `SELECT count(*) FROM jobs WHERE archived_at IS NULL;`
 		err = tx.QueryRowx(sql).Scan(&count)
 		if err != nil {
 			return err
 		}
 
-		sql = `SELECT * FROM jobs ORDER BY id ASC OFFSET $1 LIMIT $2;`
+		sql = `SELECT * FROM jobs WHERE archived_at IS NULL ORDER BY id ASC OFFSET $1 LIMIT $2;`
 		err = tx.Select(&jobs, sql, offset, limit)
 		if err != nil {
 			return err
@@ -347,10 +619,113 @@ func (o *orm) FindJobs(offset, limit int) (jobs []Job, count int, err error) {
 			}
 		}
 		return nil
-	})
+	}, postgres.QueryClassInteractive.TxOptions())
 	return jobs, int(count), err
 }
 
+// FindJobsByGroupOrLabels returns non-archived jobs in the named group
+// and/or carrying all the given labels. Empty filters match all jobs.
+func (o *orm) FindJobsByGroupOrLabels(group string, labels []string, offset, limit int) (jobs []Job, count int, err error) {
+	err = postgres.SqlxTransactionWithDefaultCtx(o.readQueryer(), o.lggr, func(tx postgres.Queryer) error {
+		where := `FROM jobs
+		WHERE archived_at IS NULL
+		AND ($1 = '' OR group_name = $1)
+		AND ($2::text[] IS NULL OR labels @> $2)`
+		var labelsArg interface{}
+		if len(labels) > 0 {
+			labelsArg = pq.StringArray(labels)
+		}
+
+		err = tx.QueryRowx(`SELECT count(*) `+where, group, labelsArg).Scan(&count)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Select(&jobs, `SELECT * `+where+` ORDER BY id ASC OFFSET $3 LIMIT $4`, group, labelsArg, offset, limit)
+		if err != nil {
+			return err
+		}
+
+		err = LoadAllJobsTypes(tx, jobs)
+		if err != nil {
+			return err
+		}
+		for i := range jobs {
+			err = o.LoadEnvConfigVars(&jobs[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, postgres.QueryClassInteractive.TxOptions())
+	return jobs, count, err
+}
+
+// SearchJobs returns jobs filtered by on-chain contract address and/or
+// transmitter (key) address, matching against the relevant column of every
+// spec type. A nil filter matches all jobs.
+func (o *orm) SearchJobs(contract, transmitter *common.Address, offset, limit int) (jobs []Job, count int, err error) {
+	var contractBytes, transmitterBytes []byte
+	var contractHex, transmitterHex *string
+	if contract != nil {
+		contractBytes = contract.Bytes()
+		s := contract.Hex()
+		contractHex = &s
+	}
+	if transmitter != nil {
+		transmitterBytes = transmitter.Bytes()
+		s := transmitter.Hex()
+		transmitterHex = &s
+	}
+	err = postgres.SqlxTransactionWithDefaultCtx(o.readQueryer(), o.lggr, func(tx postgres.Queryer) error {
+		from := `FROM jobs
+		LEFT JOIN offchainreporting_oracle_specs ocr ON ocr.id = jobs.offchainreporting_oracle_spec_id
+		LEFT JOIN offchainreporting2_oracle_specs ocr2 ON ocr2.id = jobs.offchainreporting2_oracle_spec_id
+		LEFT JOIN bootstrap_specs bs ON bs.id = jobs.bootstrap_spec_id
+		LEFT JOIN direct_request_specs drs ON drs.id = jobs.direct_request_spec_id
+		LEFT JOIN flux_monitor_specs fms ON fms.id = jobs.flux_monitor_spec_id
+		LEFT JOIN keeper_specs ks ON ks.id = jobs.keeper_spec_id
+		LEFT JOIN vrf_specs vs ON vs.id = jobs.vrf_spec_id
+		WHERE jobs.archived_at IS NULL
+		AND ($1::bytea IS NULL
+			OR ocr.contract_address = $1
+			OR drs.contract_address = $1
+			OR fms.contract_address = $1
+			OR ks.contract_address = $1
+			OR vs.coordinator_address = $1
+			OR lower(ocr2.contract_id) = lower($2)
+			OR lower(bs.contract_id) = lower($2))
+		AND ($3::bytea IS NULL
+			OR ocr.transmitter_address = $3
+			OR ks.from_address = $3
+			OR lower(ocr2.transmitter_id) = lower($4))`
+		args := []interface{}{contractBytes, contractHex, transmitterBytes, transmitterHex}
+
+		err = tx.QueryRowx(`SELECT count(*) `+from, args...).Scan(&count)
+		if err != nil {
+			return err
+		}
+
+		err = tx.Select(&jobs, `SELECT jobs.* `+from+` ORDER BY jobs.id ASC OFFSET $5 LIMIT $6`, append(args, offset, limit)...)
+		if err != nil {
+			return err
+		}
+
+		err = LoadAllJobsTypes(tx, jobs)
+		if err != nil {
+			return err
+		}
+		for i := range jobs {
+			err = o.LoadEnvConfigVars(&jobs[i])
+			if err != nil {
+				return err
+			}
+		}
+		return nil
+	}, postgres.QueryClassInteractive.TxOptions())
+	return jobs, count, err
+}
+
 func (o *orm) LoadEnvConfigVars(jb *Job) error {
 	if jb.OffchainreportingOracleSpec != nil {
 		ch, err := o.chainSet.Get(jb.OffchainreportingOracleSpec.EVMChainID.ToInt())
@@ -571,7 +946,7 @@ func (o *orm) FindJobIDsWithBridge(name string) (jids []int32, err error) {
 // PipelineRuns returns pipeline runs for a job, with spec and taskruns loaded, latest first
 // If jobID is nil, returns all pipeline runs
 func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run, count int, err error) {
-	err = postgres.SqlxTransactionWithDefaultCtx(o.db, o.lggr, func(tx postgres.Queryer) error {
+	err = postgres.SqlxTransactionWithDefaultCtx(o.readQueryer(), o.lggr, func(tx postgres.Queryer) error {
 		var args []interface{}
 		var where string
 		if jobID != nil {
@@ -633,7 +1008,7 @@ func (o *orm) PipelineRuns(jobID *int32, offset, size int) (runs []pipeline.Run,
 			run.PipelineTaskRuns = append(run.PipelineTaskRuns, taskRun)
 		}
 		return nil
-	})
+	}, postgres.QueryClassInteractive.TxOptions())
 
 	return runs, count, errors.Wrap(err, "PipelineRuns failed")
 }
@@ -657,10 +1032,13 @@ func LoadAllJobTypes(tx postgres.Queryer, job *Job) error {
 		loadJobType(tx, job, "FluxMonitorSpec", "flux_monitor_specs", job.FluxMonitorSpecID),
 		loadJobType(tx, job, "DirectRequestSpec", "direct_request_specs", job.DirectRequestSpecID),
 		loadJobType(tx, job, "OffchainreportingOracleSpec", "offchainreporting_oracle_specs", job.OffchainreportingOracleSpecID),
+		loadJobType(tx, job, "Offchainreporting2OracleSpec", "offchainreporting2_oracle_specs", job.Offchainreporting2OracleSpecID),
+		loadJobType(tx, job, "BootstrapSpec", "bootstrap_specs", job.BootstrapSpecID),
 		loadJobType(tx, job, "KeeperSpec", "keeper_specs", job.KeeperSpecID),
 		loadJobType(tx, job, "CronSpec", "cron_specs", job.CronSpecID),
 		loadJobType(tx, job, "WebhookSpec", "webhook_specs", job.WebhookSpecID),
 		loadJobType(tx, job, "VRFSpec", "vrf_specs", job.VRFSpecID),
+		loadJobType(tx, job, "BlockhashStoreSpec", "blockhash_store_specs", job.BlockhashStoreSpecID),
 	)
 }
 
@@ -699,6 +699,7 @@ func TestRunner_AsyncJob(t *testing.T) {
 
 	// Create the EI record on the Core node
 	var eia *auth.Token
+	var eiSigningKey string
 	{
 		eiCreate := map[string]string{
 			"name": eiName,
@@ -711,6 +712,7 @@ func TestRunner_AsyncJob(t *testing.T) {
 			AccessKey: eip.AccessKey,
 			Secret:    eip.Secret,
 		}
+		eiSigningKey = eip.SigningKey
 	}
 
 	var responseURL string
@@ -776,7 +778,7 @@ observationSource   = """
 	t.Run("simulate request from EI -> Core node with successful callback", func(t *testing.T) {
 		cltest.AwaitJobActive(t, app.JobSpawner(), jobID, 3*time.Second)
 
-		_ = cltest.CreateJobRunViaExternalInitiatorV2(t, app, jobUUID, *eia, cltest.MustJSONMarshal(t, eiRequest))
+		_ = cltest.CreateJobRunViaExternalInitiatorV2(t, app, jobUUID, *eia, eiSigningKey, cltest.MustJSONMarshal(t, eiRequest))
 
 		pipelineORM := pipeline.NewORM(app.GetSqlxDB(), logger.TestLogger(t))
 		jobORM := job.NewTestORM(t, app.GetSqlxDB(), cc, pipelineORM, app.KeyStore)
@@ -811,7 +813,7 @@ observationSource   = """
 	})
 
 	t.Run("simulate request from EI -> Core node with erroring callback", func(t *testing.T) {
-		_ = cltest.CreateJobRunViaExternalInitiatorV2(t, app, jobUUID, *eia, cltest.MustJSONMarshal(t, eiRequest))
+		_ = cltest.CreateJobRunViaExternalInitiatorV2(t, app, jobUUID, *eia, eiSigningKey, cltest.MustJSONMarshal(t, eiRequest))
 
 		pipelineORM := pipeline.NewORM(app.GetSqlxDB(), logger.TestLogger(t))
 		jobORM := job.NewTestORM(t, app.GetSqlxDB(), cc, pipelineORM, app.KeyStore)
@@ -2,14 +2,17 @@ package job
 
 import (
 	"context"
+	"fmt"
 	"math"
 	"reflect"
 	"sync"
+	"time"
 
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
@@ -26,11 +29,14 @@ type (
 		service.Service
 		CreateJob(jb *Job, qopts ...postgres.QOpt) error
 		DeleteJob(ctx context.Context, jobID int32) error
+		ArchiveJob(ctx context.Context, jobID int32) error
+		PauseJob(ctx context.Context, jobID int32, reason string) error
 		ActiveJobs() map[int32]Job
 
 		// NOTE: Prefer to use CreateJob, this is only publicly exposed for use in tests
 		// to start a job that was previously manually inserted into DB
 		StartService(spec Job) error
+		RestartService(spec Job) error
 	}
 
 	spawner struct {
@@ -40,8 +46,14 @@ type (
 		activeJobs       map[int32]activeJob
 		activeJobsMu     sync.RWMutex
 		db               *sqlx.DB
+		pipelineRunner   pipeline.Runner
 		lggr             logger.Logger
 
+		// consecutiveFailures tracks, per job ID, how many runs in a row have
+		// failed, for quarantining. See maybeQuarantine.
+		consecutiveFailures   map[int32]uint32
+		consecutiveFailuresMu sync.Mutex
+
 		utils.StartStopOnce
 		chStop              chan struct{}
 		lbDependentAwaiters []utils.DependentAwaiter
@@ -68,14 +80,20 @@ type (
 
 var _ Spawner = (*spawner)(nil)
 
-func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *sqlx.DB, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter) *spawner {
+// DefaultBudgetWindow is the rolling window over which job spend budgets are
+// enforced when the spec does not set budgetWindow.
+const DefaultBudgetWindow = 24 * time.Hour
+
+func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *sqlx.DB, pipelineRunner pipeline.Runner, lggr logger.Logger, lbDependentAwaiters []utils.DependentAwaiter) *spawner {
 	s := &spawner{
 		orm:                 orm,
 		config:              config,
 		jobTypeDelegates:    jobTypeDelegates,
 		db:                  db,
+		pipelineRunner:      pipelineRunner,
 		lggr:                lggr.Named("JobSpawner"),
 		activeJobs:          make(map[int32]activeJob),
+		consecutiveFailures: make(map[int32]uint32),
 		chStop:              make(chan struct{}),
 		lbDependentAwaiters: lbDependentAwaiters,
 	}
@@ -84,12 +102,233 @@ func NewSpawner(orm ORM, config Config, jobTypeDelegates map[Type]Delegate, db *
 
 func (js *spawner) Start() error {
 	return js.StartOnce("JobSpawner", func() error {
+		// pipelineRunner is nil in some tests
+		if js.pipelineRunner != nil {
+			js.pipelineRunner.OnRunFinished(js.onRunFinished)
+		}
 		js.startAllServices()
 		return nil
 
 	})
 }
 
+// onRunFinished is registered with the pipeline runner and dispatches to the
+// features that react to finished runs: quarantining and job chaining.
+func (js *spawner) onRunFinished(run *pipeline.Run) {
+	js.activeJobsMu.RLock()
+	var spec Job
+	var found bool
+	for _, aj := range js.activeJobs {
+		if aj.spec.PipelineSpecID == run.PipelineSpecID {
+			spec, found = aj.spec, true
+			break
+		}
+	}
+	js.activeJobsMu.RUnlock()
+	if !found {
+		return
+	}
+	if js.maybeQuarantine(spec, run) {
+		return
+	}
+	if js.maybeEnforceBudget(spec) {
+		return
+	}
+	// One-shot jobs (runAt) transition to archived once their run has
+	// finished.
+	if spec.CronSpec != nil && spec.CronSpec.RunAt != nil {
+		go func() {
+			ctx, cancel := utils.ContextFromChan(js.chStop)
+			defer cancel()
+			if err := js.ArchiveJob(ctx, spec.ID); err != nil {
+				js.lggr.Errorw("JobSpawner: error archiving one-shot job", "jobID", spec.ID, "error", err)
+			}
+		}()
+	}
+	js.chainRun(spec, run)
+}
+
+// maybeEnforceBudget checks the job's spend over its budget window against
+// the optional gas/LINK budget caps and quarantines the job if a cap is
+// exceeded. Returns true if the job was quarantined.
+func (js *spawner) maybeEnforceBudget(spec Job) bool {
+	if spec.GasBudget == nil && spec.LinkBudget == nil {
+		return false
+	}
+	window := spec.BudgetWindow.Duration()
+	if window == 0 {
+		window = DefaultBudgetWindow
+	}
+	report, err := js.orm.JobSpend(spec.ID, time.Now().Add(-window))
+	if err != nil {
+		js.lggr.Errorw("JobSpawner: error fetching job spend", "jobID", spec.ID, "error", err)
+		return false
+	}
+
+	var reason string
+	if spec.GasBudget != nil && report.GasSpent.Cmp(spec.GasBudget) > 0 {
+		reason = fmt.Sprintf("gas budget exceeded: spent %s wei of %s wei over %s", report.GasSpent.String(), spec.GasBudget.String(), window)
+	} else if spec.LinkBudget != nil && report.LinkSpent.Cmp(spec.LinkBudget) > 0 {
+		reason = fmt.Sprintf("LINK budget exceeded: spent %s juels of %s juels over %s", report.LinkSpent.String(), spec.LinkBudget.String(), window)
+	}
+	if reason == "" {
+		return false
+	}
+
+	js.lggr.Errorw("JobSpawner: quarantining job over budget", "jobID", spec.ID, "reason", reason)
+	if err := js.orm.QuarantineJob(spec.ID, reason); err != nil {
+		js.lggr.Errorw("JobSpawner: error quarantining job", "jobID", spec.ID, "error", err)
+	}
+	ctx, cancel := utils.ContextFromChan(js.chStop)
+	defer cancel()
+	js.orm.RecordError(ctx, spec.ID, reason)
+
+	// Stop on a fresh goroutine since onRunFinished is invoked from within
+	// the run's execution, which the job's services may be blocked on.
+	go js.stopService(spec.ID)
+	return true
+}
+
+// maybeQuarantine tracks consecutive run failures for the job and, once the
+// configured threshold is reached, pauses the job's services, records the
+// quarantine on the job row and emits an alert. Returns true if the job was
+// quarantined. The job can be resumed via the API.
+func (js *spawner) maybeQuarantine(spec Job, run *pipeline.Run) bool {
+	threshold := js.config.JobQuarantineFailureThreshold()
+	if threshold == 0 {
+		return false
+	}
+
+	js.consecutiveFailuresMu.Lock()
+	if !run.HasErrors() {
+		delete(js.consecutiveFailures, spec.ID)
+		js.consecutiveFailuresMu.Unlock()
+		return false
+	}
+	js.consecutiveFailures[spec.ID]++
+	failures := js.consecutiveFailures[spec.ID]
+	if failures < threshold {
+		js.consecutiveFailuresMu.Unlock()
+		return false
+	}
+	delete(js.consecutiveFailures, spec.ID)
+	js.consecutiveFailuresMu.Unlock()
+
+	reason := fmt.Sprintf("quarantined after %d consecutive failed runs", failures)
+	js.lggr.Errorw("JobSpawner: quarantining job after repeated run failures", "jobID", spec.ID, "consecutiveFailures", failures)
+	if err := js.orm.QuarantineJob(spec.ID, reason); err != nil {
+		js.lggr.Errorw("JobSpawner: error quarantining job", "jobID", spec.ID, "error", err)
+	}
+	ctx, cancel := utils.ContextFromChan(js.chStop)
+	defer cancel()
+	js.orm.RecordError(ctx, spec.ID, reason)
+
+	// Stop on a fresh goroutine since onRunFinished is invoked from within
+	// the run's execution, which the job's services may be blocked on.
+	go js.stopService(spec.ID)
+	return true
+}
+
+// MaxChainDepth bounds how many chained runs a single originating run can
+// trigger. Creation-time validation rejects self-references and dangling
+// targets, but cycles can still be formed (e.g. by deleting and recreating a
+// job), so the depth is tracked through $(jobRun.meta.chainDepth) and chains
+// are cut off here rather than looping forever.
+const MaxChainDepth = 10
+
+// chainRun implements job chaining: when a run finishes for a job with
+// onSuccess/onFailure set, a run of the referenced job is enqueued with the
+// finished run's outputs available as $(jobRun.inputs).
+func (js *spawner) chainRun(spec Job, run *pipeline.Run) {
+	next := spec.OnSuccess
+	if run.HasErrors() {
+		next = spec.OnFailure
+	}
+	if next == nil {
+		return
+	}
+
+	depth := chainDepth(run) + 1
+	if depth > MaxChainDepth {
+		js.lggr.Errorw("JobSpawner: not triggering chained run, max chain depth exceeded; check the jobs' onSuccess/onFailure for a cycle",
+			"jobID", spec.ID, "chainedExternalJobID", *next, "maxChainDepth", MaxChainDepth)
+		ctx, cancel := utils.ContextFromChan(js.chStop)
+		defer cancel()
+		js.orm.RecordError(ctx, spec.ID, fmt.Sprintf("chained run not triggered: chain depth exceeded %d, onSuccess/onFailure may form a cycle", MaxChainDepth))
+		return
+	}
+
+	js.activeJobsMu.RLock()
+	var target Job
+	var found bool
+	for _, aj := range js.activeJobs {
+		if aj.spec.ExternalJobID == *next {
+			target, found = aj.spec, true
+			break
+		}
+	}
+	js.activeJobsMu.RUnlock()
+	if !found {
+		js.lggr.Warnw("JobSpawner: chained job is not active", "jobID", spec.ID, "chainedExternalJobID", *next)
+		return
+	}
+	if target.PipelineSpec == nil {
+		js.lggr.Warnw("JobSpawner: chained job has no pipeline spec", "jobID", spec.ID, "chainedJobID", target.ID)
+		return
+	}
+
+	vars := pipeline.NewVarsFrom(map[string]interface{}{
+		"jobSpec": map[string]interface{}{
+			"databaseID":    target.ID,
+			"externalJobID": target.ExternalJobID,
+			"name":          target.Name.ValueOrZero(),
+			"params":        map[string]interface{}(target.Params),
+		},
+		"jobRun": map[string]interface{}{
+			"inputs": run.Outputs.Val,
+			"meta": map[string]interface{}{
+				"chainedFromJobID": spec.ID,
+				"chainDepth":       depth,
+			},
+		},
+	})
+	newRun := pipeline.NewRun(*target.PipelineSpec, vars)
+
+	// Run on a fresh goroutine since chainRun is invoked from within the
+	// upstream run's execution.
+	go func() {
+		if _, err := js.pipelineRunner.Run(context.Background(), &newRun, js.lggr, true, nil); err != nil {
+			js.lggr.Errorw("JobSpawner: error running chained job", "jobID", target.ID, "error", err)
+		}
+	}()
+}
+
+// chainDepth returns how many chained runs preceded the given run, as
+// recorded in $(jobRun.meta.chainDepth) by chainRun. Runs which were not
+// triggered by a chain have depth 0.
+func chainDepth(run *pipeline.Run) int {
+	vars, ok := run.Inputs.Val.(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	jobRun, ok := vars["jobRun"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	meta, ok := jobRun["meta"].(map[string]interface{})
+	if !ok {
+		return 0
+	}
+	switch depth := meta["chainDepth"].(type) {
+	case int:
+		return depth
+	case float64:
+		// Inputs which have round-tripped through the DB decode as float64
+		return int(depth)
+	}
+	return 0
+}
+
 func (js *spawner) Close() error {
 	return js.StopOnce("JobSpawner", func() error {
 		close(js.chStop)
@@ -108,6 +347,10 @@ func (js *spawner) startAllServices() {
 	}
 
 	for _, spec := range specs {
+		if spec.QuarantinedAt.Valid {
+			js.lggr.Infow("JobSpawner: not starting quarantined job", "jobID", spec.ID, "reason", spec.QuarantineReason.ValueOrZero())
+			continue
+		}
 		if err = js.StartService(spec); err != nil {
 			js.lggr.Errorf("Couldn't start service %v: %v", spec.Name, err)
 		}
@@ -184,6 +427,14 @@ func (js *spawner) StartService(spec Job) error {
 	return nil
 }
 
+// RestartService stops and restarts the services for the given job spec,
+// picking up any changes made to the job since the services were started
+// (e.g. new params).
+func (js *spawner) RestartService(spec Job) error {
+	js.stopService(spec.ID)
+	return js.StartService(spec)
+}
+
 // Should not get called before Start()
 func (js *spawner) CreateJob(jb *Job, qopts ...postgres.QOpt) error {
 	delegate, exists := js.jobTypeDelegates[jb.Type]
@@ -239,14 +490,14 @@ func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
 		defer js.activeJobsMu.RUnlock()
 		aj, exists = js.activeJobs[jobID]
 	}()
-	if !exists {
-		return errors.Errorf("job not found (id: %v)", jobID)
-	}
-
-	// Stop the service if we own the job.
-	js.stopService(jobID)
+	// Archived or quarantined jobs have no running services but can still be
+	// deleted from the DB.
+	if exists {
+		// Stop the service if we own the job.
+		js.stopService(jobID)
 
-	aj.delegate.BeforeJobDeleted(aj.spec)
+		aj.delegate.BeforeJobDeleted(aj.spec)
+	}
 
 	combctx, cancel := utils.CombinedContext(js.chStop, ctx)
 	defer cancel()
@@ -262,6 +513,55 @@ func (js *spawner) DeleteJob(ctx context.Context, jobID int32) error {
 	return nil
 }
 
+// ArchiveJob stops the job's services and marks it archived. Unlike
+// DeleteJob, the job's spec, runs and transactions are preserved and the job
+// can be restored later.
+func (js *spawner) ArchiveJob(ctx context.Context, jobID int32) error {
+	if jobID == 0 {
+		return errors.New("will not archive job with 0 ID")
+	}
+
+	// Stop the service if we own the job. Quarantined or otherwise stopped
+	// jobs can still be archived.
+	js.stopService(jobID)
+
+	combctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	err := js.orm.ArchiveJob(jobID, postgres.WithParentCtx(combctx))
+	if err != nil {
+		js.lggr.Errorw("Error archiving job", "jobID", jobID, "error", err)
+		return err
+	}
+
+	js.lggr.Infow("Archived job", "jobID", jobID)
+
+	return nil
+}
+
+// PauseJob stops the job's services and marks it quarantined with the given
+// reason. The job can be resumed via the API like any quarantined job.
+func (js *spawner) PauseJob(ctx context.Context, jobID int32, reason string) error {
+	if jobID == 0 {
+		return errors.New("will not pause job with 0 ID")
+	}
+
+	js.stopService(jobID)
+
+	combctx, cancel := utils.CombinedContext(js.chStop, ctx)
+	defer cancel()
+
+	err := js.orm.QuarantineJob(jobID, reason, postgres.WithParentCtx(combctx))
+	if err != nil {
+		js.lggr.Errorw("Error pausing job", "jobID", jobID, "error", err)
+		return err
+	}
+
+	js.lggr.Infow("Paused job", "jobID", jobID, "reason", reason)
+
+	return nil
+}
+
 func (js *spawner) ActiveJobs() map[int32]Job {
 	js.activeJobsMu.RLock()
 	defer js.activeJobsMu.RUnlock()
@@ -76,7 +76,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		orm := job.NewTestORM(t, db, cc, pipeline.NewORM(db, lggr), keyStore)
 		a := utils.NewDependentAwaiter()
 		a.AddDependents(1)
-		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{}, db, lggr, []utils.DependentAwaiter{a})
+		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{}, db, nil, lggr, []utils.DependentAwaiter{a})
 		// Starting the spawner should signal to the dependents
 		result := make(chan bool)
 		go func() {
@@ -115,7 +115,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
 			jobB.Type: delegateB,
-		}, db, lggr, nil)
+		}, db, nil, lggr, nil)
 		spawner.Start()
 		err := spawner.CreateJob(jobA)
 		require.NoError(t, err)
@@ -172,7 +172,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, d}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
-		}, db, lggr, nil)
+		}, db, nil, lggr, nil)
 
 		err := orm.CreateJob(jobA)
 		require.NoError(t, err)
@@ -208,7 +208,7 @@ func TestSpawner_CreateJobDeleteJob(t *testing.T) {
 		delegateA := &delegate{jobA.Type, []job.Service{serviceA1, serviceA2}, 0, nil, d}
 		spawner := job.NewSpawner(orm, config, map[job.Type]job.Delegate{
 			jobA.Type: delegateA,
-		}, db, lggr, nil)
+		}, db, nil, lggr, nil)
 
 		err := orm.CreateJob(jobA)
 		require.NoError(t, err)
@@ -12,13 +12,15 @@ var (
 	ErrInvalidJobType       = errors.New("invalid job type")
 	ErrInvalidSchemaVersion = errors.New("invalid schema version")
 	jobTypes                = map[Type]struct{}{
-		Cron:              {},
-		DirectRequest:     {},
-		FluxMonitor:       {},
-		OffchainReporting: {},
-		Keeper:            {},
-		VRF:               {},
-		Webhook:           {},
+		Cron:               {},
+		DirectRequest:      {},
+		FluxMonitor:        {},
+		OffchainReporting:  {},
+		OffchainReporting2: {},
+		Bootstrap:          {},
+		Keeper:             {},
+		VRF:                {},
+		Webhook:            {},
 	}
 )
 
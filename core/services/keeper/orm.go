@@ -83,10 +83,13 @@ func (korm ORM) BatchDeleteUpkeepsForJob(ctx context.Context, jobID int32, upkee
 	return exec.RowsAffected, exec.Error
 }
 
+// EligibleUpkeepsForRegistry returns all upkeeps on the given registry whose
+// turn it is at the given block number. A non-zero turnBlockCount overrides
+// the registry's block_count_per_turn.
 func (korm ORM) EligibleUpkeepsForRegistry(
 	ctx context.Context,
 	registryAddress ethkey.EIP55Address,
-	blockNumber, gracePeriod int64,
+	blockNumber, gracePeriod, turnBlockCount int64,
 ) ([]UpkeepRegistration, error) {
 	var upkeeps []UpkeepRegistration
 	err := korm.getDB(ctx).
@@ -99,13 +102,13 @@ func (korm ORM) EligibleUpkeepsForRegistry(
 			(
 				upkeep_registrations.last_run_block_height = 0 OR (
 					upkeep_registrations.last_run_block_height + ? < ? AND
-					upkeep_registrations.last_run_block_height < (? - (? % keeper_registries.block_count_per_turn))
+					upkeep_registrations.last_run_block_height < (? - (? % coalesce(nullif(?, 0), keeper_registries.block_count_per_turn)))
 				)
 			) AND
 			keeper_registries.keeper_index = (
-				upkeep_registrations.positioning_constant + ((? - (? % keeper_registries.block_count_per_turn)) / keeper_registries.block_count_per_turn)
+				upkeep_registrations.positioning_constant + ((? - (? % coalesce(nullif(?, 0), keeper_registries.block_count_per_turn))) / coalesce(nullif(?, 0), keeper_registries.block_count_per_turn))
 			) % keeper_registries.num_keepers
-		`, registryAddress, gracePeriod, blockNumber, blockNumber, blockNumber, blockNumber, blockNumber).
+		`, registryAddress, gracePeriod, blockNumber, blockNumber, blockNumber, turnBlockCount, blockNumber, blockNumber, turnBlockCount, turnBlockCount).
 		Find(&upkeeps).
 		Error
 
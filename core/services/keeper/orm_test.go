@@ -167,7 +167,7 @@ func TestKeeperDB_EligibleUpkeeps_BlockCountPerTurn(t *testing.T) {
 
 	cltest.AssertCount(t, db, &keeper.UpkeepRegistration{}, 5)
 
-	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, blockheight, gracePeriod)
+	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, blockheight, gracePeriod, 0)
 	assert.NoError(t, err)
 
 	require.Len(t, eligibleUpkeeps, 3)
@@ -210,7 +210,7 @@ func TestKeeperDB_EligibleUpkeeps_GracePeriod(t *testing.T) {
 
 	cltest.AssertCount(t, db, &keeper.UpkeepRegistration{}, 3)
 
-	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, blockheight, gracePeriod)
+	eligibleUpkeeps, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, blockheight, gracePeriod, 0)
 	assert.NoError(t, err)
 	assert.Len(t, eligibleUpkeeps, 2)
 	assert.Equal(t, int64(0), eligibleUpkeeps[0].UpkeepID)
@@ -232,15 +232,15 @@ func TestKeeperDB_EligibleUpkeeps_KeepersRotate(t *testing.T) {
 
 	// out of 5 valid block ranges, with 5 keepers, we are eligible
 	// to submit on exactly 1 of them
-	list1, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 20, 0)
+	list1, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 20, 0, 0)
 	require.NoError(t, err)
-	list2, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 41, 0)
+	list2, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 41, 0, 0)
 	require.NoError(t, err)
-	list3, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 62, 0)
+	list3, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 62, 0, 0)
 	require.NoError(t, err)
-	list4, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 83, 0)
+	list4, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 83, 0, 0)
 	require.NoError(t, err)
-	list5, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 104, 0)
+	list5, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 104, 0, 0)
 	require.NoError(t, err)
 
 	totalEligible := len(list1) + len(list2) + len(list3) + len(list4) + len(list5)
@@ -265,15 +265,15 @@ func TestKeeperDB_EligibleUpkeeps_KeepersCycleAllUpkeeps(t *testing.T) {
 	cltest.AssertCount(t, db, &keeper.UpkeepRegistration{}, 1000)
 
 	// in a full cycle, each node should be responsible for each upkeep exactly once
-	list1, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 20, 0) // someone eligible
+	list1, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 20, 0, 0) // someone eligible
 	require.NoError(t, err)
-	list2, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 40, 0) // someone eligible
+	list2, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 40, 0, 0) // someone eligible
 	require.NoError(t, err)
-	list3, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 60, 0) // someone eligible
+	list3, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 60, 0, 0) // someone eligible
 	require.NoError(t, err)
-	list4, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 80, 0) // someone eligible
+	list4, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 80, 0, 0) // someone eligible
 	require.NoError(t, err)
-	list5, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 100, 0) // someone eligible
+	list5, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry.ContractAddress, 100, 0, 0) // someone eligible
 	require.NoError(t, err)
 
 	totalEligible := len(list1) + len(list2) + len(list3) + len(list4) + len(list5)
@@ -294,9 +294,9 @@ func TestKeeperDB_EligibleUpkeeps_FiltersByRegistry(t *testing.T) {
 	cltest.AssertCount(t, db, keeper.Registry{}, 2)
 	cltest.AssertCount(t, db, &keeper.UpkeepRegistration{}, 2)
 
-	list1, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry1.ContractAddress, 20, 0)
+	list1, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry1.ContractAddress, 20, 0, 0)
 	require.NoError(t, err)
-	list2, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry2.ContractAddress, 20, 0)
+	list2, err := orm.EligibleUpkeepsForRegistry(context.Background(), registry2.ContractAddress, 20, 0, 0)
 	require.NoError(t, err)
 
 	assert.Equal(t, 1, len(list1))
@@ -40,6 +40,7 @@ type RegistrySynchronizerOptions struct {
 }
 
 type RegistrySynchronizer struct {
+	chSignal            chan struct{}
 	chStop              chan struct{}
 	contract            *keeper_registry_wrapper.KeeperRegistry
 	interval            time.Duration
@@ -64,6 +65,7 @@ func NewRegistrySynchronizer(opts RegistrySynchronizerOptions) *RegistrySynchron
 		mbUpkeepRegistered: utils.NewMailbox(50),
 	}
 	return &RegistrySynchronizer{
+		chSignal:            make(chan struct{}, 1),
 		chStop:              make(chan struct{}),
 		contract:            opts.Contract,
 		interval:            opts.SyncInterval,
@@ -129,12 +131,18 @@ func (rs *RegistrySynchronizer) run() {
 
 	rs.fullSync()
 
+	// Logs are processed as soon as HandleLog signals their arrival, so the
+	// upkeep set tracks UpkeepRegistered/UpkeepCanceled/ConfigSet within a
+	// block. The tickers remain as a fallback: logTicker catches anything the
+	// signal missed and syncTicker reconciles against the full registry.
 	for {
 		select {
 		case <-rs.chStop:
 			return
 		case <-syncTicker.C:
 			rs.fullSync()
+		case <-rs.chSignal:
+			rs.processLogs()
 		case <-logTicker.C:
 			rs.processLogs()
 		}
@@ -50,4 +50,11 @@ func (rs *RegistrySynchronizer) HandleLog(broadcast log.Broadcast) {
 	if wasOverCapacity {
 		svcLogger.With("mailboxName", mailboxName).Errorf("mailbox is over capacity - dropped the oldest unprocessed item")
 	}
+
+	// Wake the run loop so the log is processed immediately rather than on
+	// the next tick.
+	select {
+	case rs.chSignal <- struct{}{}:
+	default:
+	}
 }
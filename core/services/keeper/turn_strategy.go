@@ -0,0 +1,37 @@
+package keeper
+
+import (
+	"github.com/smartcontractkit/chainlink/core/services/job"
+)
+
+// TurnStrategy determines how upkeep work is divided between the keepers on a
+// registry. The default strategy follows the registry contract's
+// blockCountPerTurn; operators on fast chains can override the tunables per
+// job via the keeper spec.
+type TurnStrategy interface {
+	// TurnBlockCount is the number of blocks in one keeper turn. Zero means
+	// follow the registry contract's blockCountPerTurn.
+	TurnBlockCount() int64
+	// BufferBlocks is how many blocks behind the latest head eligibility is
+	// evaluated, protecting against re-orgs near turn boundaries. Zero means
+	// evaluate at the latest head.
+	BufferBlocks() int64
+}
+
+// NewSpecTurnStrategy returns the turn strategy for the given keeper spec,
+// applying any per-job overrides set on the spec.
+func NewSpecTurnStrategy(spec job.KeeperSpec) TurnStrategy {
+	return specTurnStrategy{spec}
+}
+
+type specTurnStrategy struct {
+	spec job.KeeperSpec
+}
+
+func (s specTurnStrategy) TurnBlockCount() int64 {
+	return int64(s.spec.TurnBlockCount)
+}
+
+func (s specTurnStrategy) BufferBlocks() int64 {
+	return int64(s.spec.TurnBufferBlocks)
+}
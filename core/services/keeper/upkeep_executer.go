@@ -40,6 +40,12 @@ var (
 	},
 		[]string{"upkeepID"},
 	)
+	promUpkeepsSkipped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "keeper_upkeeps_skipped",
+		Help: "Number of upkeeps skipped before broadcast because checkUpkeep or the performUpkeep simulation reverted",
+	},
+		[]string{"upkeepID", "task"},
+	)
 )
 
 // UpkeepExecuter implements the logic to communicate with KeeperRegistry
@@ -54,6 +60,7 @@ type UpkeepExecuter struct {
 	mailbox         *utils.Mailbox
 	orm             ORM
 	pr              pipeline.Runner
+	turnStrategy    TurnStrategy
 	logger          logger.Logger
 	wgDone          sync.WaitGroup
 	utils.StartStopOnce
@@ -81,6 +88,7 @@ func NewUpkeepExecuter(
 		config:          config,
 		orm:             orm,
 		pr:              pr,
+		turnStrategy:    NewSpecTurnStrategy(*job.KeeperSpec),
 		logger:          logger.Named("UpkeepExecuter"),
 	}
 }
@@ -149,11 +157,13 @@ func (ex *UpkeepExecuter) processActiveUpkeeps() {
 	ctx, cancel := postgres.DefaultQueryCtx()
 	defer cancel()
 
+	blockNumber := head.Number - ex.turnStrategy.BufferBlocks()
 	activeUpkeeps, err := ex.orm.EligibleUpkeepsForRegistry(
 		ctx,
 		ex.job.KeeperSpec.ContractAddress,
-		head.Number,
+		blockNumber,
 		ex.config.KeeperMaximumGracePeriod(),
+		ex.turnStrategy.TurnBlockCount(),
 	)
 	if err != nil {
 		ex.logger.With("error", err).Error("unable to load active registrations")
@@ -203,6 +213,7 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 			"gasPrice":  gasPrice,
 			"gasTipCap": fee.TipCap,
 			"gasFeeCap": fee.FeeCap,
+			"params":    map[string]interface{}(ex.job.Params),
 		},
 	})
 
@@ -212,6 +223,24 @@ func (ex *UpkeepExecuter) execute(upkeep UpkeepRegistration, headNumber int64, d
 		return
 	}
 
+	// If checkUpkeep or the performUpkeep simulation reverted, another keeper
+	// most likely already serviced the upkeep; skip the submission entirely
+	// rather than waste gas on a transaction that would revert
+	if run.FailEarly {
+		task, reason := "unknown", ""
+		for _, tr := range run.PipelineTaskRuns {
+			if tr.Error.Valid {
+				task, reason = tr.DotID, tr.Error.String
+				break
+			}
+		}
+		svcLogger.Infow("skipping upkeep; pipeline reverted before broadcast", "task", task, "reason", reason)
+		promUpkeepsSkipped.
+			WithLabelValues(strconv.Itoa(int(upkeep.UpkeepID)), task).
+			Inc()
+		return
+	}
+
 	// Only after task runs where a tx was broadcast
 	if run.State == pipeline.RunStatusCompleted {
 		err := ex.orm.SetLastRunHeightForUpkeepOnJob(ctxService, ex.job.ID, upkeep.UpkeepID, headNumber)
@@ -236,12 +265,18 @@ func (ex *UpkeepExecuter) estimateGasPrice(upkeep UpkeepRegistration) (gasPrice
 	if err != nil {
 		return nil, fee, errors.Wrap(err, "unable to construct performUpkeep data")
 	}
+	gasPriceBufferPercent := ex.config.KeeperGasPriceBufferPercent()
+	gasTipCapBufferPercent := ex.config.KeeperGasTipCapBufferPercent()
+	if prct := ex.job.KeeperSpec.GasPriceBufferPercent; prct > 0 {
+		gasPriceBufferPercent = prct
+		gasTipCapBufferPercent = prct
+	}
 	if ex.config.EvmEIP1559DynamicFees() {
 		fee, _, err = ex.gasEstimator.GetDynamicFee(upkeep.ExecuteGas)
-		fee.TipCap = addBuffer(fee.TipCap, ex.config.KeeperGasTipCapBufferPercent())
+		fee.TipCap = addBuffer(fee.TipCap, gasTipCapBufferPercent)
 	} else {
 		gasPrice, _, err = ex.gasEstimator.GetLegacyGas(performTxData, upkeep.ExecuteGas)
-		gasPrice = addBuffer(gasPrice, ex.config.KeeperGasPriceBufferPercent())
+		gasPrice = addBuffer(gasPrice, gasPriceBufferPercent)
 	}
 	if err != nil {
 		return nil, fee, errors.Wrap(err, "unable to estimate gas")
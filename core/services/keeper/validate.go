@@ -32,13 +32,23 @@ decode_check_upkeep_tx   [type=ethabidecode
 encode_perform_upkeep_tx [type=ethabiencode
                           abi="performUpkeep(uint256 id, bytes calldata performData)"
                           data="{\"id\": $(jobSpec.upkeepID),\"performData\":$(decode_check_upkeep_tx.performData)}"]
+simulate_perform_upkeep_tx [type=ethcall
+                          failEarly=true
+                          extractRevertReason=true
+                          from="$(jobSpec.fromAddress)"
+                          contract="$(jobSpec.contractAddress)"
+                          gas="$(jobSpec.performUpkeepGasLimit)"
+                          gasPrice="$(jobSpec.gasPrice)"
+                          gasTipCap="$(jobSpec.gasTipCap)"
+                          gasFeeCap="$(jobSpec.gasFeeCap)"
+                          data="$(encode_perform_upkeep_tx)"]
 perform_upkeep_tx        [type=ethtx
                           minConfirmations=0
                           to="$(jobSpec.contractAddress)"
                           data="$(encode_perform_upkeep_tx)"
                           gasLimit="$(jobSpec.performUpkeepGasLimit)"
                           txMeta="{\"jobID\":$(jobSpec.jobID)}"]
-encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_perform_upkeep_tx -> perform_upkeep_tx`
+encode_check_upkeep_tx -> check_upkeep_tx -> decode_check_upkeep_tx -> encode_perform_upkeep_tx -> simulate_perform_upkeep_tx -> perform_upkeep_tx`
 )
 
 // expectedPipeline it is basically parsed expectedObservationSourceRaw value
@@ -76,6 +86,13 @@ func ValidatedKeeperSpec(tomlString string) (job.Job, error) {
 		return j, errors.Errorf("unsupported type %s", j.Type)
 	}
 
+	if spec.TurnBlockCount < 0 {
+		return j, errors.New("turnBlockCount must not be negative")
+	}
+	if spec.TurnBufferBlocks < 0 {
+		return j, errors.New("turnBufferBlocks must not be negative")
+	}
+
 	if !reflect.DeepEqual(j.Pipeline.Tasks, expectedPipeline.Tasks) {
 		return j, errors.New("invalid observation source provided")
 	}
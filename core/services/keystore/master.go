@@ -208,6 +208,8 @@ func (km *keyManager) Unlock(password string) error {
 	km.keyStates = ks
 
 	km.password = password
+	// Make sure the keystore password never shows up in log output
+	logger.RegisterSecret(password)
 	return nil
 }
 
@@ -43,13 +43,14 @@ type ORM interface {
 
 type orm struct {
 	db         *sqlx.DB
+	stmtCache  *postgres.StmtCache
 	evmChainID utils.Big
 }
 
 var _ ORM = (*orm)(nil)
 
 func NewORM(db *sqlx.DB, evmChainID big.Int) *orm {
-	return &orm{db, *utils.NewBig(&evmChainID)}
+	return &orm{db, postgres.NewStmtCache(db), *utils.NewBig(&evmChainID)}
 }
 
 func (o *orm) WasBroadcastConsumed(blockHash common.Hash, logIndex uint, jobID int32, qopts ...postgres.QOpt) (consumed bool, err error) {
@@ -67,7 +68,10 @@ func (o *orm) WasBroadcastConsumed(blockHash common.Hash, logIndex uint, jobID i
 		o.evmChainID,
 	}
 	q := postgres.NewQ(o.db, qopts...)
-	err = q.QueryRowx(query, args...).Scan(&consumed)
+	ctx, cancel := q.Context()
+	defer cancel()
+	// checked for every log broadcast, so worth a prepared statement
+	err = o.stmtCache.GetContext(ctx, q.Queryer, &consumed, query, args...)
 	if errors.Is(err, sql.ErrNoRows) {
 		return false, nil
 	}
@@ -0,0 +1,100 @@
+package ocrbootstrap
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
+)
+
+// Delegate creates the services for bootstrap jobs. A bootstrap job runs only
+// the config tracking and peer discovery side of OCR, so no oracle key bundle
+// or transmitter key is required: dedicated bootstrap nodes can run with an
+// otherwise empty keystore.
+type Delegate struct {
+	jobORM   job.ORM
+	relayers map[relay.Network]relay.Relayer
+	lggr     logger.Logger
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(
+	jobORM job.ORM,
+	relayers map[relay.Network]relay.Relayer,
+	lggr logger.Logger,
+) *Delegate {
+	return &Delegate{
+		jobORM:   jobORM,
+		relayers: relayers,
+		lggr:     lggr.Named("OCRBootstrap"),
+	}
+}
+
+func (d Delegate) JobType() job.Type {
+	return job.Bootstrap
+}
+
+func (Delegate) AfterJobCreated(spec job.Job)  {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {}
+
+func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.Service, error) {
+	spec := jobSpec.BootstrapSpec
+	if spec == nil {
+		return nil, errors.Errorf("ocrbootstrap.Delegate expects a *job.BootstrapSpec to be present, got %v", jobSpec)
+	}
+	relayer, exists := d.relayers[spec.Relay]
+	if !exists {
+		return nil, errors.Errorf("no relayer exists for network %s", spec.Relay)
+	}
+
+	relaySpec, err := relaySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := relayer.NewOCR2Provider(jobSpec.ExternalJobID, relaySpec)
+	if err != nil {
+		return nil, errors.Wrap(err, "error calling NewOCR2Provider")
+	}
+
+	return []job.Service{provider}, nil
+}
+
+// relaySpec builds the relayer-specific spec. Bootstrap jobs never transmit,
+// so no transmitter address is ever set.
+func relaySpec(spec *job.BootstrapSpec) (interface{}, error) {
+	switch spec.Relay {
+	case relay.EVM:
+		if !common.IsHexAddress(spec.ContractID) {
+			return nil, errors.Errorf("invalid contractID, expected EVM address, got %s", spec.ContractID)
+		}
+		return evmrelay.OCR2Spec{
+			ID:              spec.ID,
+			ContractAddress: common.HexToAddress(spec.ContractID),
+			ChainID:         evmChainID(spec.RelayConfig),
+		}, nil
+	default:
+		return nil, errors.Errorf("unsupported relay network %s", spec.Relay)
+	}
+}
+
+// evmChainID extracts the chain ID from the relay config, if present. A nil
+// return selects the default chain.
+func evmChainID(config job.JSONConfig) *big.Int {
+	v, exists := config["chainID"]
+	if !exists {
+		return nil
+	}
+	switch n := v.(type) {
+	case int64:
+		return big.NewInt(n)
+	case float64:
+		return big.NewInt(int64(n))
+	}
+	return nil
+}
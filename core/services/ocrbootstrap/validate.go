@@ -0,0 +1,39 @@
+package ocrbootstrap
+
+import (
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// ValidatedBootstrapSpecToml validates a bootstrap spec that came from TOML
+func ValidatedBootstrapSpecToml(tomlString string) (job.Job, error) {
+	var jb = job.Job{}
+	var spec job.BootstrapSpec
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on job")
+	}
+	jb.BootstrapSpec = &spec
+
+	if jb.Type != job.Bootstrap {
+		return jb, errors.Errorf("the only supported type is currently 'bootstrap', got %s", jb.Type)
+	}
+	if _, exists := relay.SupportedRelays[spec.Relay]; !exists {
+		return jb, errors.Errorf("no relayer exists for network %s", spec.Relay)
+	}
+	if spec.ContractID == "" {
+		return jb, errors.New("contractID must be set")
+	}
+	return jb, nil
+}
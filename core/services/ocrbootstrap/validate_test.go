@@ -0,0 +1,67 @@
+package ocrbootstrap
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateBootstrapSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, os job.Job, err error)
+	}{
+		{
+			name: "decodes valid bootstrap spec toml",
+			toml: `
+type          = "bootstrap"
+schemaVersion = 1
+relay         = "evm"
+contractID    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+[relayConfig]
+chainID       = 1337
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, os.BootstrapSpec)
+				assert.Equal(t, "evm", os.BootstrapSpec.Relay)
+				assert.Equal(t, int64(1337), os.BootstrapSpec.RelayConfig["chainID"])
+			},
+		},
+		{
+			name: "unsupported relay network",
+			toml: `
+type          = "bootstrap"
+schemaVersion = 1
+relay         = "untron"
+contractID    = "0x613a38AC1659769640aaE063C651F48E0250454C"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "no relayer exists for network")
+			},
+		},
+		{
+			name: "missing contract ID",
+			toml: `
+type          = "bootstrap"
+schemaVersion = 1
+relay         = "evm"
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "contractID must be set")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := ValidatedBootstrapSpecToml(tc.toml)
+			tc.assertion(t, s, err)
+		})
+	}
+}
@@ -155,7 +155,7 @@ func (t *OCRContractTracker) Start() error {
 		})
 
 		var latestHead *eth.Head
-		latestHead, t.unsubscribeHeads = t.headBroadcaster.Subscribe(t)
+		latestHead, t.unsubscribeHeads = t.headBroadcaster.SubscribeWithOpts(t, httypes.SubscriptionOpts{Priority: httypes.PriorityCritical})
 		if latestHead != nil {
 			t.setLatestBlockHeight(*latestHead)
 		}
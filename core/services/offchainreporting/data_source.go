@@ -43,6 +43,7 @@ func (ds *dataSource) Observe(ctx context.Context) (ocrtypes.Observation, error)
 			"databaseID":    ds.jobSpec.ID,
 			"externalJobID": ds.jobSpec.ExternalJobID,
 			"name":          ds.jobSpec.Name.ValueOrZero(),
+			"params":        map[string]interface{}(ds.jobSpec.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"meta": md,
@@ -18,6 +18,15 @@ import (
 	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
 )
 
+// Database is the abstraction over the OCR protocol's persistent state: the
+// oracle state, contract config, pending transmissions and the latest round
+// requested. The default implementation is backed by Postgres; alternative
+// backends can be substituted via Delegate.SetDatabaseFactory.
+type Database interface {
+	ocrtypes.Database
+	OCRContractTrackerDB
+}
+
 type db struct {
 	*sql.DB
 	oracleSpecID int32
@@ -26,6 +35,7 @@ type db struct {
 var (
 	_ ocrtypes.Database    = &db{}
 	_ OCRContractTrackerDB = &db{}
+	_ Database             = &db{}
 )
 
 // NewDB returns a new DB scoped to this oracleSpecID
@@ -0,0 +1,114 @@
+package offchainreporting
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+)
+
+// inMemoryDB is a Database implementation that keeps all OCR protocol state
+// in memory. It avoids any contention on the shared Postgres tables, which
+// matters for nodes running hundreds of OCR jobs, at the cost of losing state
+// across restarts: the protocol recovers the config and oracle state from the
+// network, but pending transmissions queued at shutdown are dropped.
+type inMemoryDB struct {
+	mu                   sync.RWMutex
+	states               map[ocrtypes.ConfigDigest]ocrtypes.PersistentState
+	config               *ocrtypes.ContractConfig
+	transmissions        map[ocrtypes.PendingTransmissionKey]ocrtypes.PendingTransmission
+	latestRoundRequested offchainaggregator.OffchainAggregatorRoundRequested
+}
+
+var _ Database = &inMemoryDB{}
+
+// NewInMemoryDB returns a Database that holds all state in memory
+func NewInMemoryDB() Database {
+	return &inMemoryDB{
+		states:        make(map[ocrtypes.ConfigDigest]ocrtypes.PersistentState),
+		transmissions: make(map[ocrtypes.PendingTransmissionKey]ocrtypes.PendingTransmission),
+	}
+}
+
+func (d *inMemoryDB) ReadState(_ context.Context, cd ocrtypes.ConfigDigest) (*ocrtypes.PersistentState, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	state, exists := d.states[cd]
+	if !exists {
+		return nil, nil
+	}
+	return &state, nil
+}
+
+func (d *inMemoryDB) WriteState(_ context.Context, cd ocrtypes.ConfigDigest, state ocrtypes.PersistentState) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.states[cd] = state
+	return nil
+}
+
+func (d *inMemoryDB) ReadConfig(_ context.Context) (*ocrtypes.ContractConfig, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.config, nil
+}
+
+func (d *inMemoryDB) WriteConfig(_ context.Context, c ocrtypes.ContractConfig) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.config = &c
+	return nil
+}
+
+func (d *inMemoryDB) StorePendingTransmission(_ context.Context, k ocrtypes.PendingTransmissionKey, p ocrtypes.PendingTransmission) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.transmissions[k] = p
+	return nil
+}
+
+func (d *inMemoryDB) PendingTransmissionsWithConfigDigest(_ context.Context, cd ocrtypes.ConfigDigest) (map[ocrtypes.PendingTransmissionKey]ocrtypes.PendingTransmission, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	m := make(map[ocrtypes.PendingTransmissionKey]ocrtypes.PendingTransmission)
+	for k, p := range d.transmissions {
+		if k.ConfigDigest == cd {
+			m[k] = p
+		}
+	}
+	return m, nil
+}
+
+func (d *inMemoryDB) DeletePendingTransmission(_ context.Context, k ocrtypes.PendingTransmissionKey) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	delete(d.transmissions, k)
+	return nil
+}
+
+func (d *inMemoryDB) DeletePendingTransmissionsOlderThan(_ context.Context, t time.Time) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	for k, p := range d.transmissions {
+		if p.Time.Before(t) {
+			delete(d.transmissions, k)
+		}
+	}
+	return nil
+}
+
+func (d *inMemoryDB) SaveLatestRoundRequested(_ postgres.Queryer, rr offchainaggregator.OffchainAggregatorRoundRequested) error {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.latestRoundRequested = rr
+	return nil
+}
+
+func (d *inMemoryDB) LoadLatestRoundRequested() (offchainaggregator.OffchainAggregatorRoundRequested, error) {
+	d.mu.RLock()
+	defer d.mu.RUnlock()
+	return d.latestRoundRequested, nil
+}
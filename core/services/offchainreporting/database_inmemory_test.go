@@ -0,0 +1,123 @@
+package offchainreporting_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/libocr/gethwrappers/offchainaggregator"
+	ocrtypes "github.com/smartcontractkit/libocr/offchainreporting/types"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func Test_InMemoryDB_ReadWriteState(t *testing.T) {
+	db := offchainreporting.NewInMemoryDB()
+	configDigest := cltest.MakeConfigDigest(t)
+
+	state, err := db.ReadState(context.Background(), configDigest)
+	require.NoError(t, err)
+	require.Nil(t, state)
+
+	expected := ocrtypes.PersistentState{
+		Epoch:                1,
+		HighestSentEpoch:     2,
+		HighestReceivedEpoch: []uint32{3},
+	}
+	require.NoError(t, db.WriteState(context.Background(), configDigest, expected))
+
+	state, err = db.ReadState(context.Background(), configDigest)
+	require.NoError(t, err)
+	require.NotNil(t, state)
+	assert.Equal(t, expected, *state)
+
+	// A different config digest reads nothing
+	state, err = db.ReadState(context.Background(), cltest.MakeConfigDigest(t))
+	require.NoError(t, err)
+	require.Nil(t, state)
+}
+
+func Test_InMemoryDB_ReadWriteConfig(t *testing.T) {
+	db := offchainreporting.NewInMemoryDB()
+
+	config, err := db.ReadConfig(context.Background())
+	require.NoError(t, err)
+	require.Nil(t, config)
+
+	expected := ocrtypes.ContractConfig{
+		ConfigDigest:         cltest.MakeConfigDigest(t),
+		Signers:              []common.Address{cltest.NewAddress()},
+		Transmitters:         []common.Address{cltest.NewAddress()},
+		Threshold:            1,
+		EncodedConfigVersion: 1,
+		Encoded:              []byte{1, 2, 3},
+	}
+	require.NoError(t, db.WriteConfig(context.Background(), expected))
+
+	config, err = db.ReadConfig(context.Background())
+	require.NoError(t, err)
+	require.NotNil(t, config)
+	assert.Equal(t, expected, *config)
+}
+
+func Test_InMemoryDB_PendingTransmissions(t *testing.T) {
+	db := offchainreporting.NewInMemoryDB()
+	configDigest := cltest.MakeConfigDigest(t)
+
+	k := ocrtypes.PendingTransmissionKey{
+		ConfigDigest: configDigest,
+		Epoch:        0,
+		Round:        1,
+	}
+	p := ocrtypes.PendingTransmission{
+		Time:             time.Now().Round(time.Second).UTC(),
+		Median:           ocrtypes.Observation(big.NewInt(41)),
+		SerializedReport: []byte{0, 2, 3},
+	}
+	require.NoError(t, db.StorePendingTransmission(context.Background(), k, p))
+
+	m, err := db.PendingTransmissionsWithConfigDigest(context.Background(), configDigest)
+	require.NoError(t, err)
+	require.Len(t, m, 1)
+	assert.Equal(t, p, m[k])
+
+	// Unrelated config digests are not returned
+	m, err = db.PendingTransmissionsWithConfigDigest(context.Background(), cltest.MakeConfigDigest(t))
+	require.NoError(t, err)
+	require.Len(t, m, 0)
+
+	// DeletePendingTransmissionsOlderThan leaves newer transmissions intact
+	require.NoError(t, db.DeletePendingTransmissionsOlderThan(context.Background(), p.Time.Add(-time.Hour)))
+	m, err = db.PendingTransmissionsWithConfigDigest(context.Background(), configDigest)
+	require.NoError(t, err)
+	require.Len(t, m, 1)
+
+	require.NoError(t, db.DeletePendingTransmission(context.Background(), k))
+	m, err = db.PendingTransmissionsWithConfigDigest(context.Background(), configDigest)
+	require.NoError(t, err)
+	require.Len(t, m, 0)
+}
+
+func Test_InMemoryDB_LatestRoundRequested(t *testing.T) {
+	db := offchainreporting.NewInMemoryDB()
+
+	rr, err := db.LoadLatestRoundRequested()
+	require.NoError(t, err)
+	assert.Equal(t, offchainaggregator.OffchainAggregatorRoundRequested{}, rr)
+
+	expected := offchainaggregator.OffchainAggregatorRoundRequested{
+		Requester:    cltest.NewAddress(),
+		ConfigDigest: cltest.MakeConfigDigest(t),
+		Epoch:        42,
+		Round:        9,
+	}
+	require.NoError(t, db.SaveLatestRoundRequested(nil, expected))
+
+	rr, err = db.LoadLatestRoundRequested()
+	require.NoError(t, err)
+	assert.Equal(t, expected, rr)
+}
@@ -52,6 +52,10 @@ type Config interface {
 	ChainType() chains.ChainType
 }
 
+// DatabaseFactory constructs the Database backing the OCR protocol state for
+// a single oracle spec
+type DatabaseFactory func(oracleSpecID int32) Database
+
 type Delegate struct {
 	db                    *gorm.DB
 	jobORM                job.ORM
@@ -61,6 +65,7 @@ type Delegate struct {
 	monitoringEndpointGen telemetry.MonitoringEndpointGenerator
 	chainSet              evm.ChainSet
 	lggr                  logger.Logger
+	databaseFactory       DatabaseFactory
 }
 
 var _ job.Delegate = (*Delegate)(nil)
@@ -86,9 +91,16 @@ func NewDelegate(
 		monitoringEndpointGen,
 		chainSet,
 		lggr.Named("OCR"),
+		nil,
 	}
 }
 
+// SetDatabaseFactory overrides the default Postgres-backed OCR database with
+// an alternative backend. It must be called before any jobs are serviced.
+func (d *Delegate) SetDatabaseFactory(f DatabaseFactory) {
+	d.databaseFactory = f
+}
+
 func (d Delegate) JobType() job.Type {
 	return job.OffchainReporting
 }
@@ -128,7 +140,12 @@ func (d Delegate) ServicesForSpec(jobSpec job.Job) (services []job.Service, err
 	if errdb != nil {
 		return nil, errors.Wrap(errdb, "unable to open sql db")
 	}
-	ocrdb := NewDB(gormdb, concreteSpec.ID)
+	var ocrdb Database
+	if d.databaseFactory != nil {
+		ocrdb = d.databaseFactory(concreteSpec.ID)
+	} else {
+		ocrdb = NewDB(gormdb, concreteSpec.ID)
+	}
 
 	tracker := NewOCRContractTracker(
 		contract,
@@ -0,0 +1,125 @@
+package offchainreporting2
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+	evmrelay "github.com/smartcontractkit/chainlink/core/services/relay/evm"
+)
+
+// Delegate creates the services for OCR2 jobs. All onchain interactions go
+// through the relayer registered for the spec's network, so adding support
+// for a new chain is a matter of registering another relayer.
+//
+// NOTE: the OCR2 protocol engine itself is attached here once libocr's
+// offchainreporting2 package is vendored; until then only the relayer-built
+// config tracking and transmission plumbing runs.
+type Delegate struct {
+	jobORM         job.ORM
+	keyStore       keystore.Master
+	pipelineRunner pipeline.Runner
+	relayers       map[relay.Network]relay.Relayer
+	lggr           logger.Logger
+}
+
+var _ job.Delegate = (*Delegate)(nil)
+
+func NewDelegate(
+	jobORM job.ORM,
+	keyStore keystore.Master,
+	pipelineRunner pipeline.Runner,
+	relayers map[relay.Network]relay.Relayer,
+	lggr logger.Logger,
+) *Delegate {
+	return &Delegate{
+		jobORM:         jobORM,
+		keyStore:       keyStore,
+		pipelineRunner: pipelineRunner,
+		relayers:       relayers,
+		lggr:           lggr.Named("OCR2"),
+	}
+}
+
+func (d Delegate) JobType() job.Type {
+	return job.OffchainReporting2
+}
+
+func (Delegate) AfterJobCreated(spec job.Job)  {}
+func (Delegate) BeforeJobDeleted(spec job.Job) {}
+
+func (d Delegate) ServicesForSpec(jobSpec job.Job) ([]job.Service, error) {
+	spec := jobSpec.Offchainreporting2OracleSpec
+	if spec == nil {
+		return nil, errors.Errorf("offchainreporting2.Delegate expects an *job.Offchainreporting2OracleSpec to be present, got %v", jobSpec)
+	}
+	relayer, exists := d.relayers[spec.Relay]
+	if !exists {
+		return nil, errors.Errorf("no relayer exists for network %s", spec.Relay)
+	}
+
+	relaySpec, err := d.relaySpec(spec)
+	if err != nil {
+		return nil, err
+	}
+	provider, err := relayer.NewOCR2Provider(jobSpec.ExternalJobID, relaySpec)
+	if err != nil {
+		return nil, errors.Wrap(err, "error calling NewOCR2Provider")
+	}
+
+	return []job.Service{provider}, nil
+}
+
+// relaySpec builds the relayer-specific spec, selecting and checking the key
+// bundle and transmitter key for the spec's network
+func (d Delegate) relaySpec(spec *job.OffchainReporting2OracleSpec) (interface{}, error) {
+	switch spec.Relay {
+	case relay.EVM:
+		if !common.IsHexAddress(spec.ContractID) {
+			return nil, errors.Errorf("invalid contractID, expected EVM address, got %s", spec.ContractID)
+		}
+		relaySpec := evmrelay.OCR2Spec{
+			ID:              spec.ID,
+			ContractAddress: common.HexToAddress(spec.ContractID),
+			ChainID:         evmChainID(spec.RelayConfig),
+		}
+		if !spec.IsBootstrapPeer {
+			if _, err := d.keyStore.OCR().Get(spec.EncryptedOCRKeyBundleID.String); err != nil {
+				return nil, errors.Wrapf(err, "no key bundle with ID %s", spec.EncryptedOCRKeyBundleID.String)
+			}
+			if !common.IsHexAddress(spec.TransmitterID.String) {
+				return nil, errors.Errorf("invalid transmitterID, expected EVM address, got %s", spec.TransmitterID.String)
+			}
+			if _, err := d.keyStore.Eth().Get(spec.TransmitterID.String); err != nil {
+				return nil, errors.Wrapf(err, "no eth key with ID %s", spec.TransmitterID.String)
+			}
+			transmitterAddress := common.HexToAddress(spec.TransmitterID.String)
+			relaySpec.TransmitterAddress = &transmitterAddress
+		}
+		return relaySpec, nil
+	default:
+		return nil, errors.Errorf("unsupported relay network %s", spec.Relay)
+	}
+}
+
+// evmChainID extracts the chain ID from the relay config, if present. A nil
+// return selects the default chain.
+func evmChainID(config job.JSONConfig) *big.Int {
+	v, exists := config["chainID"]
+	if !exists {
+		return nil
+	}
+	switch n := v.(type) {
+	case int64:
+		return big.NewInt(n)
+	case float64:
+		return big.NewInt(int64(n))
+	}
+	return nil
+}
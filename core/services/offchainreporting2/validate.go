@@ -0,0 +1,59 @@
+package offchainreporting2
+
+import (
+	"github.com/multiformats/go-multiaddr"
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// ValidatedOracleSpecToml validates an OCR2 oracle spec that came from TOML
+func ValidatedOracleSpecToml(tomlString string) (job.Job, error) {
+	var jb = job.Job{}
+	var spec job.OffchainReporting2OracleSpec
+	tree, err := toml.Load(tomlString)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml error on load")
+	}
+	err = tree.Unmarshal(&spec)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on spec")
+	}
+	err = tree.Unmarshal(&jb)
+	if err != nil {
+		return jb, errors.Wrap(err, "toml unmarshal error on job")
+	}
+	jb.Offchainreporting2OracleSpec = &spec
+
+	if jb.Type != job.OffchainReporting2 {
+		return jb, errors.Errorf("the only supported type is currently 'offchainreporting2', got %s", jb.Type)
+	}
+	if _, exists := relay.SupportedRelays[spec.Relay]; !exists {
+		return jb, errors.Errorf("no relayer exists for network %s", spec.Relay)
+	}
+	if spec.ContractID == "" {
+		return jb, errors.New("contractID must be set")
+	}
+	if !tree.Has("isBootstrapPeer") {
+		return jb, errors.New("isBootstrapPeer is not defined")
+	}
+	for i := range spec.P2PBootstrapPeers {
+		if _, err = multiaddr.NewMultiaddr(spec.P2PBootstrapPeers[i]); err != nil {
+			return jb, errors.Wrapf(err, "p2p bootstrap peer %v is invalid", spec.P2PBootstrapPeers[i])
+		}
+	}
+	if !spec.IsBootstrapPeer {
+		if jb.Pipeline.Source == "" {
+			return jb, errors.New("no pipeline specified")
+		}
+		if !spec.EncryptedOCRKeyBundleID.Valid {
+			return jb, errors.New("keyBundleID must be set for non-bootstrap peers")
+		}
+		if !spec.TransmitterID.Valid {
+			return jb, errors.New("transmitterID must be set for non-bootstrap peers")
+		}
+	}
+	return jb, nil
+}
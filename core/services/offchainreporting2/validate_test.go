@@ -0,0 +1,126 @@
+package offchainreporting2
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestValidateOracleSpec(t *testing.T) {
+	var tt = []struct {
+		name      string
+		toml      string
+		assertion func(t *testing.T, os job.Job, err error)
+	}{
+		{
+			name: "minimal bootstrap oracle spec",
+			toml: `
+type            = "offchainreporting2"
+schemaVersion   = 1
+relay           = "evm"
+contractID      = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer = true
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, os.Offchainreporting2OracleSpec)
+				assert.Equal(t, "evm", os.Offchainreporting2OracleSpec.Relay)
+				assert.True(t, os.Offchainreporting2OracleSpec.IsBootstrapPeer)
+			},
+		},
+		{
+			name: "decodes valid non-bootstrap oracle spec toml",
+			toml: `
+type            = "offchainreporting2"
+schemaVersion   = 1
+relay           = "evm"
+contractID      = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer = false
+keyBundleID     = "f5bf259689b26f1374efb3c9a9868796953a0f814bb2d39b968d0e61b58620a5"
+transmitterID   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource = """
+ds1          [type=bridge name=voter_turnout];
+ds1_parse    [type=jsonparse path="one,two"];
+ds1 -> ds1_parse;
+"""
+[relayConfig]
+chainID         = 1337
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.NoError(t, err)
+				require.NotNil(t, os.Offchainreporting2OracleSpec)
+				assert.Equal(t, int64(1337), os.Offchainreporting2OracleSpec.RelayConfig["chainID"])
+				assert.Equal(t, "0x613a38AC1659769640aaE063C651F48E0250454C", os.Offchainreporting2OracleSpec.TransmitterID.String)
+			},
+		},
+		{
+			name: "unsupported relay network",
+			toml: `
+type            = "offchainreporting2"
+schemaVersion   = 1
+relay           = "untron"
+contractID      = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer = true
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "no relayer exists for network")
+			},
+		},
+		{
+			name: "missing contract ID",
+			toml: `
+type            = "offchainreporting2"
+schemaVersion   = 1
+relay           = "evm"
+isBootstrapPeer = true
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "contractID must be set")
+			},
+		},
+		{
+			name: "non-bootstrap without key bundle",
+			toml: `
+type            = "offchainreporting2"
+schemaVersion   = 1
+relay           = "evm"
+contractID      = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer = false
+transmitterID   = "0x613a38AC1659769640aaE063C651F48E0250454C"
+observationSource = """
+ds1 [type=bridge name=voter_turnout];
+"""
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "keyBundleID must be set")
+			},
+		},
+		{
+			name: "invalid bootstrap peer",
+			toml: `
+type              = "offchainreporting2"
+schemaVersion     = 1
+relay             = "evm"
+contractID        = "0x613a38AC1659769640aaE063C651F48E0250454C"
+isBootstrapPeer   = true
+p2pBootstrapPeers = ["not a multiaddr"]
+`,
+			assertion: func(t *testing.T, os job.Job, err error) {
+				require.Error(t, err)
+				assert.Contains(t, err.Error(), "is invalid")
+			},
+		},
+	}
+
+	for _, tc := range tt {
+		t.Run(tc.name, func(t *testing.T) {
+			s, err := ValidatedOracleSpecToml(tc.toml)
+			tc.assertion(t, s, err)
+		})
+	}
+}
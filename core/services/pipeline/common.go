@@ -39,6 +39,7 @@ type (
 		Inputs() []Task
 		OutputIndex() int32
 		TaskTimeout() (time.Duration, bool)
+		TaskCacheTTL() time.Duration
 		TaskRetries() uint32
 		TaskMinBackoff() time.Duration
 		TaskMaxBackoff() time.Duration
@@ -319,6 +320,7 @@ const (
 	TaskTypeETHABIDecode     TaskType = "ethabidecode"
 	TaskTypeETHABIDecodeLog  TaskType = "ethabidecodelog"
 	TaskTypeMerge            TaskType = "merge"
+	TaskTypeWebSocket        TaskType = "websocket"
 
 	// Testing only.
 	TaskTypePanic TaskType = "panic"
@@ -395,6 +397,8 @@ func UnmarshalTaskFromMap(taskType TaskType, taskMap interface{}, ID int, dotID
 		task = &FailTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	case TaskTypeMerge:
 		task = &MergeTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
+	case TaskTypeWebSocket:
+		task = &WebSocketTask{BaseTask: BaseTask{id: ID, dotID: dotID}}
 	default:
 		return nil, errors.Errorf(`unknown task type: "%v"`, taskType)
 	}
@@ -9,6 +9,8 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
 
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
@@ -39,6 +41,9 @@ func makeHTTPRequest(
 		return nil, 0, nil, 0, errors.Wrap(err, "failed to create http.Request")
 	}
 	request.Header.Set("Content-Type", "application/json")
+	// Propagate the trace context so bridges and adapters can join the
+	// pipeline run's trace
+	otel.GetTextMapPropagator().Inject(timeoutCtx, propagation.HeaderCarrier(request.Header))
 
 	httpRequest := utils.HTTPRequest{
 		Request: request,
@@ -9,6 +9,7 @@ import (
 
 var (
 	NewKeypathFromString = newKeypathFromString
+	ResultCacheInputHash = resultCacheInputHash
 )
 
 const (
@@ -41,6 +42,10 @@ func (t *HTTPTask) HelperSetDependencies(config Config) {
 	t.config = config
 }
 
+func (t *WebSocketTask) HelperSetDependencies(config Config) {
+	t.config = config
+}
+
 func (t *ETHCallTask) HelperSetDependencies(cc evm.ChainSet, config Config) {
 	t.chainSet = cc
 	t.config = config
@@ -117,6 +117,20 @@ func (_m *ORM) DeleteRunsOlderThan(_a0 context.Context, _a1 time.Duration) error
 	return r0
 }
 
+// EnsureRunPartitions provides a mock function with given fields: monthsAhead
+func (_m *ORM) EnsureRunPartitions(monthsAhead int) error {
+	ret := _m.Called(monthsAhead)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int) error); ok {
+		r0 = rf(monthsAhead)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindRun provides a mock function with given fields: id
 func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	ret := _m.Called(id)
@@ -138,6 +152,11 @@ func (_m *ORM) FindRun(id int64) (pipeline.Run, error) {
 	return r0, r1
 }
 
+// FlushSpecCache provides a mock function with given fields:
+func (_m *ORM) FlushSpecCache() {
+	_m.Called()
+}
+
 // GetAllRuns provides a mock function with given fields:
 func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	ret := _m.Called()
@@ -161,6 +180,57 @@ func (_m *ORM) GetAllRuns() ([]pipeline.Run, error) {
 	return r0, r1
 }
 
+// GetLatestRunCreatedAt provides a mock function with given fields: pipelineSpecID
+func (_m *ORM) GetLatestRunCreatedAt(pipelineSpecID int32) (time.Time, error) {
+	ret := _m.Called(pipelineSpecID)
+
+	var r0 time.Time
+	if rf, ok := ret.Get(0).(func(int32) time.Time); ok {
+		r0 = rf(pipelineSpecID)
+	} else {
+		r0 = ret.Get(0).(time.Time)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(int32) error); ok {
+		r1 = rf(pipelineSpecID)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// GetRuns provides a mock function with given fields: filter, offset, limit
+func (_m *ORM) GetRuns(filter pipeline.RunFilter, offset int, limit int) ([]pipeline.Run, int, error) {
+	ret := _m.Called(filter, offset, limit)
+
+	var r0 []pipeline.Run
+	if rf, ok := ret.Get(0).(func(pipeline.RunFilter, int, int) []pipeline.Run); ok {
+		r0 = rf(filter, offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]pipeline.Run)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(pipeline.RunFilter, int, int) int); ok {
+		r1 = rf(filter, offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(pipeline.RunFilter, int, int) error); ok {
+		r2 = rf(filter, offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // GetUnfinishedRuns provides a mock function with given fields: _a0, _a1, _a2
 func (_m *ORM) GetUnfinishedRuns(_a0 context.Context, _a1 time.Time, _a2 func(pipeline.Run) error) error {
 	ret := _m.Called(_a0, _a1, _a2)
@@ -196,6 +266,27 @@ func (_m *ORM) InsertFinishedRun(run *pipeline.Run, saveSuccessfulTaskRuns bool,
 	return r0
 }
 
+// InsertFinishedRuns provides a mock function with given fields: runs, saveSuccessfulTaskRuns, qopts
+func (_m *ORM) InsertFinishedRuns(runs []*pipeline.Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) error {
+	_va := make([]interface{}, len(qopts))
+	for _i := range qopts {
+		_va[_i] = qopts[_i]
+	}
+	var _ca []interface{}
+	_ca = append(_ca, runs, saveSuccessfulTaskRuns)
+	_ca = append(_ca, _va...)
+	ret := _m.Called(_ca...)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func([]*pipeline.Run, bool, ...postgres.QOpt) error); ok {
+		r0 = rf(runs, saveSuccessfulTaskRuns, qopts...)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // StoreRun provides a mock function with given fields: run, qopts
 func (_m *ORM) StoreRun(run *pipeline.Run, qopts ...postgres.QOpt) (bool, error) {
 	_va := make([]interface{}, len(qopts))
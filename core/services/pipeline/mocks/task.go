@@ -129,6 +129,20 @@ func (_m *Task) Run(ctx context.Context, lggr logger.Logger, vars pipeline.Vars,
 	return r0, r1
 }
 
+// TaskCacheTTL provides a mock function with given fields:
+func (_m *Task) TaskCacheTTL() time.Duration {
+	ret := _m.Called()
+
+	var r0 time.Duration
+	if rf, ok := ret.Get(0).(func() time.Duration); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(time.Duration)
+	}
+
+	return r0
+}
+
 // TaskMaxBackoff provides a mock function with given fields:
 func (_m *Task) TaskMaxBackoff() time.Duration {
 	ret := _m.Called()
@@ -168,6 +168,9 @@ type TaskRun struct {
 	FinishedAt    null.Time        `json:"finishedAt"`
 	Index         int32            `json:"index"`
 	DotID         string           `json:"dotId"`
+	// Attempts is how many times the task actually ran; > 1 when a retry
+	// policy is configured and the task errored transiently
+	Attempts uint32 `json:"attempts"`
 
 	// Used internally for sorting completed results
 	task Task
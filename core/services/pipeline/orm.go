@@ -1,10 +1,16 @@
 package pipeline
 
 import (
+	"compress/gzip"
 	"context"
 	"database/sql"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
 	"time"
 
+	"github.com/lib/pq"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/sqlx"
@@ -27,22 +33,42 @@ type ORM interface {
 	StoreRun(run *Run, qopts ...postgres.QOpt) (restart bool, err error)
 	UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error)
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) (err error)
+	InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) (err error)
 	DeleteRunsOlderThan(context.Context, time.Duration) error
+	EnsureRunPartitions(monthsAhead int) error
 	FindRun(id int64) (Run, error)
 	GetAllRuns() ([]Run, error)
+	GetRuns(filter RunFilter, offset, limit int) ([]Run, int, error)
+	GetLatestRunCreatedAt(pipelineSpecID int32) (time.Time, error)
 	GetUnfinishedRuns(context.Context, time.Time, func(run Run) error) error
+	FlushSpecCache()
 	DB() *sqlx.DB
 }
 
 type orm struct {
-	db   *sqlx.DB
-	lggr logger.Logger
+	db          *sqlx.DB
+	stmtCache   *postgres.StmtCache
+	readReplica *postgres.ReadReplica
+	lggr        logger.Logger
+	// specCache caches pipeline spec rows by ID. Spec rows are immutable
+	// (they are only ever inserted, and deleted together with their job) so
+	// entries never go stale; the cache is flushed when a job is deleted.
+	specCache sync.Map
+	// archive, when set, receives reaped runs as gzipped JSONL before they
+	// are deleted
+	archive RunArchive
 }
 
 var _ ORM = (*orm)(nil)
 
 func NewORM(db *sqlx.DB, lggr logger.Logger) *orm {
-	return &orm{db, lggr}
+	return &orm{db: db, stmtCache: postgres.NewStmtCache(db), lggr: lggr}
+}
+
+// SetRunArchive enables archival of reaped runs to cold storage. It must be
+// called before the run reaper starts.
+func (o *orm) SetRunArchive(archive RunArchive) {
+	o.archive = archive
 }
 
 func (o *orm) CreateSpec(pipeline Pipeline, maxTaskDuration models.Interval, qopts ...postgres.QOpt) (id int32, err error) {
@@ -83,11 +109,7 @@ func (o *orm) CreateRun(run *Run, qopts ...postgres.QOpt) (err error) {
 			run.PipelineTaskRuns[i].PipelineRunID = run.ID
 		}
 
-		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at);`
-		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
-		return err
+		return o.insertTaskRuns(tx, run.PipelineTaskRuns)
 	})
 
 	return errors.Wrap(err, "CreateRun failed")
@@ -150,10 +172,10 @@ func (o *orm) StoreRun(run *Run, qopts ...postgres.QOpt) (restart bool, err erro
 		}
 
 		sql := `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at)
-		ON CONFLICT (pipeline_run_id, dot_id) DO UPDATE SET
-		output = EXCLUDED.output, error = EXCLUDED.error, finished_at = EXCLUDED.finished_at
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, attempts, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :attempts, :created_at, :finished_at)
+		ON CONFLICT (pipeline_run_id, dot_id, created_at) DO UPDATE SET
+		output = EXCLUDED.output, error = EXCLUDED.error, attempts = EXCLUDED.attempts, finished_at = EXCLUDED.finished_at
 		RETURNING *;
 		`
 
@@ -177,13 +199,24 @@ func (o *orm) StoreRun(run *Run, qopts ...postgres.QOpt) (restart bool, err erro
 
 // DeleteRun cleans up a run that failed and is marked failEarly (should leave no trace of the run)
 func (o *orm) DeleteRun(id int64) error {
-	// NOTE: this will cascade and wipe pipeline_task_runs too
-	_, err := postgres.NewQ(o.db).Exec(`DELETE FROM pipeline_runs WHERE id = $1`, id)
-	return err
+	q := postgres.NewQ(o.db)
+	return q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		// NOTE: the partitioned run tables carry no cascading foreign key, so
+		// task runs must be wiped explicitly
+		if _, err := tx.Exec(`DELETE FROM pipeline_task_runs WHERE pipeline_run_id = $1`, id); err != nil {
+			return errors.Wrap(err, "DeleteRun failed to delete task runs")
+		}
+		_, err := tx.Exec(`DELETE FROM pipeline_runs WHERE id = $1`, id)
+		return errors.Wrap(err, "DeleteRun failed to delete run")
+	})
 }
 
 func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, start bool, err error) {
 	q := postgres.NewQ(o.db)
+	// runs once per asynchronous task callback, so the statements are served
+	// from the prepared statement cache
+	ctx, cancel := q.Context()
+	defer cancel()
 	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
 		sql := `
 		SELECT pipeline_runs.*, pipeline_specs.dot_dag_source "pipeline_spec.dot_dag_source"
@@ -192,13 +225,13 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 		JOIN pipeline_specs ON (pipeline_specs.id = pipeline_runs.pipeline_spec_id)
 		WHERE pipeline_task_runs.id = $1 AND pipeline_runs.state in ('running', 'suspended')
 		FOR UPDATE`
-		if err = tx.Get(&run, sql, taskID); err != nil {
+		if err = o.stmtCache.GetContext(ctx, tx, &run, sql, taskID); err != nil {
 			return err
 		}
 
 		// Update the task with result
 		sql = `UPDATE pipeline_task_runs SET output = $2, error = $3, finished_at = $4 WHERE id = $1`
-		if _, err = tx.Exec(sql, taskID, result.OutputDB(), result.ErrorDB(), time.Now()); err != nil {
+		if _, err = o.stmtCache.ExecContext(ctx, tx, sql, taskID, result.OutputDB(), result.ErrorDB(), time.Now()); err != nil {
 			return errors.Wrap(err, "UpdateTaskRunResult")
 		}
 
@@ -208,14 +241,14 @@ func (o *orm) UpdateTaskRunResult(taskID uuid.UUID, result Result) (run Run, sta
 
 			// We're going to restart the run, so set it back to "in progress"
 			sql = `UPDATE pipeline_runs SET state = $2 WHERE id = $1`
-			if _, err = tx.Exec(sql, run.ID, run.State); err != nil {
+			if _, err = o.stmtCache.ExecContext(ctx, tx, sql, run.ID, run.State); err != nil {
 				return errors.Wrap(err, "UpdateTaskRunResult")
 			}
 
 			// NOTE: can't join and preload in a single query unless explicitly listing all the struct fields...
 			// https://snippets.aktagon.com/snippets/757-how-to-join-two-tables-with-jmoiron-sqlx
 			sql = `SELECT * FROM pipeline_task_runs WHERE pipeline_run_id = $1`
-			return tx.Select(&run.PipelineTaskRuns, sql, run.ID)
+			return o.stmtCache.SelectContext(ctx, tx, &run.PipelineTaskRuns, sql, run.ID)
 		}
 
 		return nil
@@ -265,19 +298,316 @@ func (o *orm) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...
 			return nil
 		}
 
-		sql = `
-		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, created_at, finished_at)
-		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :created_at, :finished_at);`
-		_, err = tx.NamedExec(sql, run.PipelineTaskRuns)
-		return errors.Wrap(err, "failed to insert pipeline_task_runs")
+		return errors.Wrap(o.insertTaskRuns(tx, run.PipelineTaskRuns), "failed to insert pipeline_task_runs")
 	})
 	return errors.Wrap(err, "InsertFinishedRun failed")
 }
 
+// InsertFinishedRuns inserts all the given runs and their task runs in a
+// single transaction using multi-row inserts, cutting write amplification for
+// high-frequency jobs that buffer finished runs before persisting them.
+func (o *orm) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) (err error) {
+	if len(runs) == 0 {
+		return nil
+	}
+	for _, run := range runs {
+		if run.CreatedAt.IsZero() {
+			return errors.New("run.CreatedAt must be set")
+		}
+		if run.FinishedAt.IsZero() {
+			return errors.New("run.FinishedAt must be set")
+		}
+		if run.Outputs.Val == nil || len(run.FatalErrors) == 0 {
+			return errors.Errorf("run must have both Outputs and Errors, got Outputs: %#v, Errors: %#v", run.Outputs.Val, run.FatalErrors)
+		}
+		if len(run.PipelineTaskRuns) == 0 && (saveSuccessfulTaskRuns || run.HasErrors()) {
+			return errors.New("must provide task run results")
+		}
+	}
+
+	q := postgres.NewQ(o.db, qopts...)
+	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		sql := `INSERT INTO pipeline_runs (pipeline_spec_id, meta, all_errors, fatal_errors, inputs, outputs, created_at, finished_at, state)
+		VALUES (:pipeline_spec_id, :meta, :all_errors, :fatal_errors, :inputs, :outputs, :created_at, :finished_at, :state)
+		RETURNING id;`
+
+		rows, e := tx.NamedQuery(sql, runs)
+		if e != nil {
+			return errors.Wrap(e, "error inserting finished pipeline_runs")
+		}
+		defer rows.Close()
+
+		var runIDs []int64
+		for rows.Next() {
+			var runID int64
+			if e = rows.Scan(&runID); e != nil {
+				return errors.Wrap(e, "error scanning pipeline_run id")
+			}
+			runIDs = append(runIDs, runID)
+		}
+		if len(runIDs) != len(runs) {
+			return errors.Errorf("expected %v inserted pipeline_runs, got %v", len(runs), len(runIDs))
+		}
+
+		var taskRuns []TaskRun
+		for i, run := range runs {
+			run.ID = runIDs[i]
+			for j := range run.PipelineTaskRuns {
+				run.PipelineTaskRuns[j].PipelineRunID = run.ID
+			}
+			if !saveSuccessfulTaskRuns && !run.HasErrors() {
+				continue
+			}
+			taskRuns = append(taskRuns, run.PipelineTaskRuns...)
+		}
+
+		return errors.Wrap(o.insertTaskRuns(tx, taskRuns), "failed to insert pipeline_task_runs")
+	})
+	return errors.Wrap(err, "InsertFinishedRuns failed")
+}
+
+// copyTaskRunThreshold is the number of task runs above which inserts switch
+// from a multi-row INSERT to the COPY protocol, which scales far better for
+// large DAGs
+const copyTaskRunThreshold = 10
+
+// insertTaskRuns inserts the given task runs inside tx. Small batches use a
+// single multi-row INSERT; large batches stream through COPY.
+func (o *orm) insertTaskRuns(tx postgres.Queryer, taskRuns []TaskRun) error {
+	if len(taskRuns) == 0 {
+		return nil
+	}
+	if len(taskRuns) < copyTaskRunThreshold {
+		sql := `
+		INSERT INTO pipeline_task_runs (pipeline_run_id, id, type, index, output, error, dot_id, attempts, created_at, finished_at)
+		VALUES (:pipeline_run_id, :id, :type, :index, :output, :error, :dot_id, :attempts, :created_at, :finished_at);`
+		_, err := tx.NamedExec(sql, taskRuns)
+		return err
+	}
+
+	stmt, err := tx.Prepare(pq.CopyIn("pipeline_task_runs",
+		"pipeline_run_id", "id", "type", "index", "output", "error", "dot_id", "attempts", "created_at", "finished_at"))
+	if err != nil {
+		return errors.Wrap(err, "failed to prepare COPY of task runs")
+	}
+	defer stmt.Close()
+	for _, tr := range taskRuns {
+		// pq encodes []byte as bytea in the COPY text format, which the jsonb
+		// output column would reject, so serialize the output up front
+		output, err := tr.Output.Value()
+		if err != nil {
+			return errors.Wrap(err, "failed to serialize task run output")
+		}
+		if b, ok := output.([]byte); ok {
+			output = string(b)
+		}
+		if _, err = stmt.Exec(tr.PipelineRunID, tr.ID, tr.Type, tr.Index, output, tr.Error, tr.DotID, tr.Attempts, tr.CreatedAt, tr.FinishedAt); err != nil {
+			return errors.Wrap(err, "failed to COPY task run")
+		}
+	}
+	// an Exec with no arguments flushes the stream
+	_, err = stmt.Exec()
+	return errors.Wrap(err, "failed to complete COPY of task runs")
+}
+
+// runPartitionTables are partitioned by month on created_at
+var runPartitionTables = []string{"pipeline_runs", "pipeline_task_runs"}
+
+// runPartitionSuffixFormat is the YYYYMM suffix identifying the month a
+// partition covers, e.g. pipeline_runs_202608
+const runPartitionSuffixFormat = "200601"
+
+const partitionBoundFormat = "2006-01-02 15:04:05+00:00"
+
+// EnsureRunPartitions creates the monthly partitions for the run tables
+// covering the current month through monthsAhead months ahead. Any rows that
+// already landed in the default partition for a covered month (e.g. right
+// after the partitioning migration) are moved into the new partition.
+func (o *orm) EnsureRunPartitions(monthsAhead int) error {
+	q := postgres.NewQ(o.db)
+	now := time.Now().UTC()
+	month := time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+	for i := 0; i <= monthsAhead; i++ {
+		from := month.AddDate(0, i, 0)
+		to := from.AddDate(0, 1, 0)
+		for _, table := range runPartitionTables {
+			name := fmt.Sprintf("%s_%s", table, from.Format(runPartitionSuffixFormat))
+			var exists bool
+			if err := q.Get(&exists, `SELECT EXISTS (SELECT 1 FROM pg_class WHERE relname = $1)`, name); err != nil {
+				return errors.Wrapf(err, "EnsureRunPartitions failed to check for %s", name)
+			}
+			if exists {
+				continue
+			}
+			err := q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+				stmts := []string{
+					fmt.Sprintf(`CREATE TABLE %s (LIKE %s INCLUDING DEFAULTS INCLUDING CONSTRAINTS)`, name, table),
+					fmt.Sprintf(`INSERT INTO %s SELECT * FROM %s_default WHERE created_at >= '%s' AND created_at < '%s'`, name, table, from.Format(partitionBoundFormat), to.Format(partitionBoundFormat)),
+					fmt.Sprintf(`DELETE FROM %s_default WHERE created_at >= '%s' AND created_at < '%s'`, table, from.Format(partitionBoundFormat), to.Format(partitionBoundFormat)),
+					fmt.Sprintf(`ALTER TABLE %s ATTACH PARTITION %s FOR VALUES FROM ('%s') TO ('%s')`, table, name, from.Format(partitionBoundFormat), to.Format(partitionBoundFormat)),
+				}
+				for _, stmt := range stmts {
+					if _, err := tx.Exec(stmt); err != nil {
+						return err
+					}
+				}
+				return nil
+			})
+			if err != nil {
+				return errors.Wrapf(err, "EnsureRunPartitions failed to create %s", name)
+			}
+		}
+	}
+	return nil
+}
+
+// DeleteRunsOlderThan drops whole monthly partitions that ended before the
+// threshold - a metadata operation - and falls back to row-level deletion for
+// the default partition and any months it could not drop. When a run archive
+// is configured, every partition and row is streamed to it as gzipped JSONL
+// first, and nothing is deleted if archival fails.
 func (o *orm) DeleteRunsOlderThan(ctx context.Context, threshold time.Duration) error {
 	q := postgres.NewQ(o.db, postgres.WithParentCtx(ctx))
-	_, err := q.Exec(`DELETE FROM pipeline_runs WHERE finished_at < $1`, time.Now().Add(-threshold))
-	return errors.Wrap(err, "DeleteRunsOlderThan failed")
+	cutoff := time.Now().Add(-threshold)
+
+	partitions := make(map[string][]time.Time)
+	for _, table := range runPartitionTables {
+		var names []string
+		if err := q.Select(&names, `
+SELECT c.relname FROM pg_inherits i
+JOIN pg_class c ON c.oid = i.inhrelid
+JOIN pg_class p ON p.oid = i.inhparent
+WHERE p.relname = $1
+ORDER BY c.relname ASC`, table); err != nil {
+			return errors.Wrap(err, "DeleteRunsOlderThan failed to list partitions")
+		}
+		for _, name := range names {
+			from, err := time.Parse(runPartitionSuffixFormat, strings.TrimPrefix(name, table+"_"))
+			if err != nil {
+				// the default partition; reaped row-wise below
+				continue
+			}
+			partitions[table] = append(partitions[table], from)
+		}
+	}
+
+	// A pipeline_runs partition is only dropped once every run in it finished
+	// before the cutoff; kept months are tracked so the corresponding task run
+	// partitions survive too.
+	kept := make(map[time.Time]bool)
+	for _, from := range partitions["pipeline_runs"] {
+		if from.AddDate(0, 1, 0).After(cutoff) {
+			kept[from] = true
+			continue
+		}
+		name := fmt.Sprintf("pipeline_runs_%s", from.Format(runPartitionSuffixFormat))
+		var keep bool
+		if err := q.Get(&keep, fmt.Sprintf(`SELECT EXISTS (SELECT 1 FROM %s WHERE finished_at IS NULL OR finished_at >= $1)`, name), cutoff); err != nil {
+			return errors.Wrapf(err, "DeleteRunsOlderThan failed to check %s", name)
+		}
+		if keep {
+			kept[from] = true
+			continue
+		}
+		if err := o.archiveTable(ctx, name); err != nil {
+			return errors.Wrap(err, "DeleteRunsOlderThan failed")
+		}
+		if _, err := q.Exec(fmt.Sprintf(`DROP TABLE IF EXISTS %s`, name)); err != nil {
+			return errors.Wrapf(err, "DeleteRunsOlderThan failed to drop %s", name)
+		}
+	}
+	for _, from := range partitions["pipeline_task_runs"] {
+		// NOTE: a run created at the end of one month may have task runs in
+		// the next, so a task run partition is only dropped once both its own
+		// month and the previous one are gone
+		if from.AddDate(0, 1, 0).After(cutoff) || kept[from] || kept[from.AddDate(0, -1, 0)] {
+			continue
+		}
+		name := fmt.Sprintf("pipeline_task_runs_%s", from.Format(runPartitionSuffixFormat))
+		if err := o.archiveTable(ctx, name); err != nil {
+			return errors.Wrap(err, "DeleteRunsOlderThan failed")
+		}
+		if _, err := q.Exec(fmt.Sprintf(`DROP TABLE IF EXISTS %s`, name)); err != nil {
+			return errors.Wrapf(err, "DeleteRunsOlderThan failed to drop %s", name)
+		}
+	}
+
+	if o.archive != nil {
+		// NOTE: new runs finishing concurrently are not at risk here; they
+		// finish after the cutoff and are neither archived nor deleted
+		var count int
+		if err := q.Get(&count, `SELECT count(*) FROM pipeline_runs WHERE finished_at < $1`, cutoff); err != nil {
+			return errors.Wrap(err, "DeleteRunsOlderThan failed to count reapable runs")
+		}
+		if count > 0 {
+			stamp := time.Now().UTC().Format("20060102T150405")
+			err := o.archiveRows(ctx, fmt.Sprintf("pipeline_runs_reaped_%s.jsonl.gz", stamp),
+				`SELECT row_to_json(t) FROM pipeline_runs t WHERE finished_at < $1`, cutoff)
+			if err != nil {
+				return errors.Wrap(err, "DeleteRunsOlderThan failed")
+			}
+			err = o.archiveRows(ctx, fmt.Sprintf("pipeline_task_runs_reaped_%s.jsonl.gz", stamp),
+				`SELECT row_to_json(t) FROM pipeline_task_runs t WHERE pipeline_run_id IN (SELECT id FROM pipeline_runs WHERE finished_at < $1)`, cutoff)
+			if err != nil {
+				return errors.Wrap(err, "DeleteRunsOlderThan failed")
+			}
+		}
+	}
+
+	return errors.Wrap(q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		var ids []int64
+		if err := tx.Select(&ids, `DELETE FROM pipeline_runs WHERE finished_at < $1 RETURNING id`, cutoff); err != nil {
+			return err
+		}
+		if len(ids) == 0 {
+			return nil
+		}
+		_, err := tx.Exec(`DELETE FROM pipeline_task_runs WHERE pipeline_run_id = ANY($1)`, pq.Array(ids))
+		return err
+	}), "DeleteRunsOlderThan failed")
+}
+
+// archiveTable streams the entire contents of table to the run archive as
+// one gzipped JSONL object named after the table. No-op when no archive is
+// configured.
+func (o *orm) archiveTable(ctx context.Context, table string) error {
+	if o.archive == nil {
+		return nil
+	}
+	return o.archiveRows(ctx, table+".jsonl.gz", fmt.Sprintf(`SELECT row_to_json(t) FROM %s t`, table))
+}
+
+// archiveRows streams the JSON rows selected by query to the run archive
+// under key, gzip compressed, one object per line.
+func (o *orm) archiveRows(ctx context.Context, key, query string, args ...interface{}) error {
+	rows, err := o.db.QueryContext(ctx, query, args...)
+	if err != nil {
+		return errors.Wrapf(err, "failed to select rows for archival to %q", key)
+	}
+	defer rows.Close()
+
+	pr, pw := io.Pipe()
+	go func() {
+		gz := gzip.NewWriter(pw)
+		var err error
+		for rows.Next() {
+			var line []byte
+			if err = rows.Scan(&line); err != nil {
+				break
+			}
+			if _, err = gz.Write(append(line, '\n')); err != nil {
+				break
+			}
+		}
+		if err == nil {
+			err = rows.Err()
+		}
+		if cerr := gz.Close(); err == nil {
+			err = cerr
+		}
+		pw.CloseWithError(err)
+	}()
+	return errors.Wrapf(o.archive.Store(ctx, key, pr), "failed to archive rows to %q", key)
 }
 
 func (o *orm) FindRun(id int64) (r Run, err error) {
@@ -287,7 +617,7 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 		if err = tx.Select(&runs, `SELECT * from pipeline_runs WHERE id = $1 LIMIT 1`, id); err != nil {
 			return errors.Wrap(err, "failed to load runs")
 		}
-		return loadAssociations(tx, runs)
+		return o.loadAssociations(tx, runs)
 	})
 	if len(runs) == 0 {
 		return r, sql.ErrNoRows
@@ -295,37 +625,146 @@ func (o *orm) FindRun(id int64) (r Run, err error) {
 	return runs[0], err
 }
 
+// SetReadReplica routes this ORM's heavy read-only queries (run listings)
+// through the given read replica; writes always use the primary.
+func (o *orm) SetReadReplica(rr *postgres.ReadReplica) {
+	o.readReplica = rr
+}
+
+// readQueryer returns the connection heavy read-only queries should use: the
+// read replica when one is configured and healthy, the primary otherwise.
+func (o *orm) readQueryer() postgres.Queryer {
+	if o.readReplica != nil {
+		return o.readReplica.Queryer()
+	}
+	return o.db
+}
+
 func (o *orm) GetAllRuns() (runs []Run, err error) {
-	q := postgres.NewQ(o.db)
+	q := postgres.NewQ(o.readQueryer(), postgres.WithQueryClass(postgres.QueryClassBatch))
 	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
 		err = tx.Select(&runs, `SELECT * from pipeline_runs ORDER BY created_at ASC, id ASC`)
 		if err != nil {
 			return errors.Wrap(err, "failed to load runs")
 		}
 
-		return loadAssociations(tx, runs)
+		return o.loadAssociations(tx, runs)
 	})
 	return runs, err
 }
 
+// RunFilter restricts the runs returned by GetRuns; zero-valued fields do
+// not filter.
+type RunFilter struct {
+	PipelineSpecID int32
+	State          *RunStatus
+	CreatedAfter   time.Time
+	CreatedBefore  time.Time
+	// Errored selects runs with (true) or without (false) any run error,
+	// fatal or not
+	Errored *bool
+}
+
+// hasErrorsSQL is true for runs whose all_errors jsonb array holds at least
+// one non-null entry; jsonb_typeof guards are needed because the column can
+// hold SQL NULL or the json literal null
+const hasErrorsSQL = `(all_errors IS NOT NULL AND jsonb_typeof(all_errors) = 'array' AND EXISTS (SELECT 1 FROM jsonb_array_elements(all_errors) e WHERE jsonb_typeof(e) <> 'null'))`
+
+func (f RunFilter) sql() (where string, args []interface{}) {
+	var conds []string
+	add := func(cond string, arg interface{}) {
+		args = append(args, arg)
+		conds = append(conds, fmt.Sprintf(cond, len(args)))
+	}
+	if f.PipelineSpecID != 0 {
+		add("pipeline_spec_id = $%d", f.PipelineSpecID)
+	}
+	if f.State != nil {
+		add("state = $%d", *f.State)
+	}
+	if !f.CreatedAfter.IsZero() {
+		add("created_at >= $%d", f.CreatedAfter)
+	}
+	if !f.CreatedBefore.IsZero() {
+		add("created_at < $%d", f.CreatedBefore)
+	}
+	if f.Errored != nil {
+		if *f.Errored {
+			conds = append(conds, hasErrorsSQL)
+		} else {
+			conds = append(conds, "NOT "+hasErrorsSQL)
+		}
+	}
+	if len(conds) == 0 {
+		return "", nil
+	}
+	return " WHERE " + strings.Join(conds, " AND "), args
+}
+
+// GetRuns returns one page of the runs matching filter, newest first, along
+// with the total count of matching runs. Unlike GetAllRuns it never loads
+// more than limit runs into memory.
+func (o *orm) GetRuns(filter RunFilter, offset, limit int) (runs []Run, count int, err error) {
+	where, args := filter.sql()
+	q := postgres.NewQ(o.readQueryer(), postgres.WithQueryClass(postgres.QueryClassBatch))
+	err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
+		if err = tx.Get(&count, `SELECT count(*) FROM pipeline_runs`+where, args...); err != nil {
+			return errors.Wrap(err, "failed to count runs")
+		}
+		sql := fmt.Sprintf(`SELECT * FROM pipeline_runs%s ORDER BY created_at DESC, id DESC LIMIT $%d OFFSET $%d`, where, len(args)+1, len(args)+2)
+		if err = tx.Select(&runs, sql, append(args, limit, offset)...); err != nil {
+			return errors.Wrap(err, "failed to load runs")
+		}
+		return o.loadAssociations(tx, runs)
+	})
+	return runs, count, err
+}
+
+// GetLatestRunCreatedAt returns the creation time of the most recent run for
+// the given pipeline spec, or sql.ErrNoRows if the spec has never run.
+func (o *orm) GetLatestRunCreatedAt(pipelineSpecID int32) (createdAt time.Time, err error) {
+	q := postgres.NewQ(o.db)
+	err = q.Get(&createdAt, `SELECT created_at FROM pipeline_runs WHERE pipeline_spec_id = $1 ORDER BY created_at DESC LIMIT 1`, pipelineSpecID)
+	return createdAt, err
+}
+
+// ErrRunSkipped can be returned by the GetUnfinishedRuns callback to skip
+// the given run and carry on with the rest of the stream.
+var ErrRunSkipped = errors.New("run skipped")
+
+// GetUnfinishedRuns streams every run still running that was created before
+// now to fn, runs closest to their max_task_duration deadline first so that
+// resumption is not head-of-line blocked by younger runs. fn may return
+// ErrRunSkipped to skip a run without aborting the stream; any other error,
+// or cancellation of ctx, aborts it.
 func (o *orm) GetUnfinishedRuns(ctx context.Context, now time.Time, fn func(run Run) error) error {
 	q := postgres.NewQ(o.db, postgres.WithParentCtx(ctx))
 	return postgres.Batch(func(offset, limit uint) (count uint, err error) {
 		var runs []Run
 
 		err = q.Transaction(o.lggr, func(tx postgres.Queryer) error {
-			err = tx.Select(&runs, `SELECT * from pipeline_runs WHERE state = $1 AND created_at < $2 ORDER BY created_at ASC, id ASC OFFSET $3 LIMIT $4`, RunStatusRunning, now, offset, limit)
+			err = tx.Select(&runs, `
+SELECT pr.* FROM pipeline_runs pr
+JOIN pipeline_specs ps ON ps.id = pr.pipeline_spec_id
+WHERE pr.state = $1 AND pr.created_at < $2
+ORDER BY pr.created_at + make_interval(secs => COALESCE(ps.max_task_duration, 0) / 1e9) ASC, pr.id ASC
+OFFSET $3 LIMIT $4`, RunStatusRunning, now, offset, limit)
 			if err != nil {
 				return errors.Wrap(err, "failed to load runs")
 			}
 
-			err = loadAssociations(tx, runs)
+			err = o.loadAssociations(tx, runs)
 			if err != nil {
 				return err
 			}
 
 			for _, run := range runs {
-				if err = fn(run); err != nil {
+				if err = ctx.Err(); err != nil {
+					return err
+				}
+				if err = fn(run); errors.Is(err, ErrRunSkipped) {
+					continue
+				} else if err != nil {
 					return err
 				}
 			}
@@ -336,27 +775,44 @@ func (o *orm) GetUnfinishedRuns(ctx context.Context, now time.Time, fn func(run
 	})
 }
 
-// loads PipelineSpec and PipelineTaskRuns for Runs in exactly 2 queries
-func loadAssociations(q postgres.Queryer, runs []Run) error {
+// FlushSpecCache drops all cached pipeline specs, e.g. after a job (and
+// hence its spec) has been deleted
+func (o *orm) FlushSpecCache() {
+	o.specCache.Range(func(key, _ interface{}) bool {
+		o.specCache.Delete(key)
+		return true
+	})
+}
+
+// loads PipelineSpec and PipelineTaskRuns for Runs in at most 2 queries,
+// serving specs from the cache where possible
+func (o *orm) loadAssociations(q postgres.Queryer, runs []Run) error {
 	if len(runs) == 0 {
 		return nil
 	}
 	var specs []Spec
 	pipelineSpecIDM := make(map[int32]Spec)
-	var pipelineSpecIDs []int32 // keyed by pipelineSpecID
+	var pipelineSpecIDs []int32 // pipelineSpecIDs not found in the cache
 	pipelineRunIDs := make([]int64, len(runs))
 	for i, run := range runs {
 		pipelineRunIDs[i] = run.ID
 		if _, exists := pipelineSpecIDM[run.PipelineSpecID]; !exists {
+			if v, ok := o.specCache.Load(run.PipelineSpecID); ok {
+				pipelineSpecIDM[run.PipelineSpecID] = v.(Spec)
+				continue
+			}
 			pipelineSpecIDs = append(pipelineSpecIDs, run.PipelineSpecID)
 			pipelineSpecIDM[run.PipelineSpecID] = Spec{}
 		}
 	}
-	if err := q.Select(&specs, `SELECT * FROM pipeline_specs WHERE id = ANY($1)`, pipelineSpecIDs); err != nil {
-		return errors.Wrap(err, "failed to postload pipeline_specs for runs")
+	if len(pipelineSpecIDs) > 0 {
+		if err := q.Select(&specs, `SELECT * FROM pipeline_specs WHERE id = ANY($1)`, pipelineSpecIDs); err != nil {
+			return errors.Wrap(err, "failed to postload pipeline_specs for runs")
+		}
 	}
 	for _, spec := range specs {
 		pipelineSpecIDM[spec.ID] = spec
+		o.specCache.Store(spec.ID, spec)
 	}
 
 	var taskRuns []TaskRun
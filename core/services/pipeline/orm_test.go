@@ -1,6 +1,7 @@
 package pipeline_test
 
 import (
+	"context"
 	"testing"
 	"time"
 
@@ -51,6 +52,141 @@ func Test_PipelineORM_FindRun(t *testing.T) {
 	require.Equal(t, expected.ID, run.ID)
 }
 
+func Test_PipelineORM_GetRuns(t *testing.T) {
+	db, orm := setupORM(t)
+
+	require.NoError(t, db.Exec(`SET CONSTRAINTS pipeline_runs_pipeline_spec_id_fkey DEFERRED`).Error)
+	running := mustInsertPipelineRun(t, db)
+	errored := pipeline.Run{
+		State:       pipeline.RunStatusErrored,
+		Outputs:     pipeline.JSONSerializable{},
+		AllErrors:   pipeline.RunErrors{null.StringFrom("pipeline run failed")},
+		FatalErrors: pipeline.RunErrors{null.StringFrom("pipeline run failed")},
+		FinishedAt:  null.TimeFrom(time.Now()),
+	}
+	require.NoError(t, db.Create(&errored).Error)
+
+	runs, count, err := orm.GetRuns(pipeline.RunFilter{}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, runs, 2)
+
+	// pagination
+	runs, count, err = orm.GetRuns(pipeline.RunFilter{}, 1, 1)
+	require.NoError(t, err)
+	require.Equal(t, 2, count)
+	require.Len(t, runs, 1)
+
+	state := pipeline.RunStatusRunning
+	runs, count, err = orm.GetRuns(pipeline.RunFilter{State: &state}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, runs, 1)
+	assert.Equal(t, running.ID, runs[0].ID)
+
+	hasErrors := true
+	runs, count, err = orm.GetRuns(pipeline.RunFilter{Errored: &hasErrors}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, runs, 1)
+	assert.Equal(t, errored.ID, runs[0].ID)
+
+	hasErrors = false
+	runs, count, err = orm.GetRuns(pipeline.RunFilter{Errored: &hasErrors}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.Len(t, runs, 1)
+	assert.Equal(t, running.ID, runs[0].ID)
+
+	_, count, err = orm.GetRuns(pipeline.RunFilter{CreatedBefore: time.Now().Add(-time.Hour)}, 0, 10)
+	require.NoError(t, err)
+	require.Equal(t, 0, count)
+}
+
+func Test_PipelineORM_GetUnfinishedRuns(t *testing.T) {
+	_, orm := setupORM(t)
+
+	shortSpecID, err := orm.CreateSpec(pipeline.Pipeline{}, models.Interval(time.Minute))
+	require.NoError(t, err)
+	longSpecID, err := orm.CreateSpec(pipeline.Pipeline{}, models.Interval(time.Hour))
+	require.NoError(t, err)
+
+	now := time.Now()
+	mkRun := func(specID int32, createdAt time.Time) *pipeline.Run {
+		run := &pipeline.Run{
+			PipelineSpecID: specID,
+			State:          pipeline.RunStatusRunning,
+			Outputs:        pipeline.JSONSerializable{},
+			CreatedAt:      createdAt,
+		}
+		require.NoError(t, orm.CreateRun(run))
+		return run
+	}
+	// the older run has the later deadline, so the younger one comes first
+	longRun := mkRun(longSpecID, now.Add(-2*time.Minute))
+	shortRun := mkRun(shortSpecID, now.Add(-time.Minute))
+
+	var got []int64
+	require.NoError(t, orm.GetUnfinishedRuns(context.Background(), now, func(run pipeline.Run) error {
+		got = append(got, run.ID)
+		return nil
+	}))
+	require.Equal(t, []int64{shortRun.ID, longRun.ID}, got)
+
+	// ErrRunSkipped skips a run without aborting the stream
+	got = nil
+	require.NoError(t, orm.GetUnfinishedRuns(context.Background(), now, func(run pipeline.Run) error {
+		if run.ID == shortRun.ID {
+			return pipeline.ErrRunSkipped
+		}
+		got = append(got, run.ID)
+		return nil
+	}))
+	require.Equal(t, []int64{longRun.ID}, got)
+
+	// a cancelled context aborts the stream
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	require.Error(t, orm.GetUnfinishedRuns(ctx, now, func(run pipeline.Run) error { return nil }))
+}
+
+func Test_PipelineORM_InsertFinishedRuns(t *testing.T) {
+	_, orm := setupORM(t)
+
+	specID, err := orm.CreateSpec(pipeline.Pipeline{}, models.Interval(time.Minute))
+	require.NoError(t, err)
+
+	now := time.Now()
+	var runs []*pipeline.Run
+	for i := 0; i < 3; i++ {
+		runs = append(runs, &pipeline.Run{
+			PipelineSpecID: specID,
+			State:          pipeline.RunStatusCompleted,
+			AllErrors:      pipeline.RunErrors{},
+			FatalErrors:    pipeline.RunErrors{null.String{}},
+			Outputs:        pipeline.JSONSerializable{Val: []interface{}{"9700.1"}, Valid: true},
+			CreatedAt:      now,
+			FinishedAt:     null.TimeFrom(now),
+			PipelineTaskRuns: []pipeline.TaskRun{{
+				ID:         uuid.NewV4(),
+				Type:       pipeline.TaskTypeHTTP,
+				DotID:      "ds1",
+				CreatedAt:  now,
+				FinishedAt: null.TimeFrom(now),
+			}},
+		})
+	}
+	require.NoError(t, orm.InsertFinishedRuns(runs, true))
+
+	for _, run := range runs {
+		require.NotZero(t, run.ID)
+		r, err2 := orm.FindRun(run.ID)
+		require.NoError(t, err2)
+		require.Len(t, r.PipelineTaskRuns, 1)
+		assert.Equal(t, run.ID, r.PipelineTaskRuns[0].PipelineRunID)
+	}
+}
+
 func mustInsertPipelineRun(t *testing.T, db *gorm.DB) pipeline.Run {
 	t.Helper()
 
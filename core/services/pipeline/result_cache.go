@@ -0,0 +1,152 @@
+package pipeline
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/sqlx"
+)
+
+var (
+	promPipelineTaskCacheHits = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_cache_hits_total",
+		Help: "The total number of pipeline task results served from the result cache",
+	},
+		[]string{"task_type"},
+	)
+	promPipelineTaskCacheMisses = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_cache_misses_total",
+		Help: "The total number of pipeline task result cache lookups that missed",
+	},
+		[]string{"task_type"},
+	)
+)
+
+// ResultCache memoizes task results keyed on (pipeline spec ID, task dot ID,
+// input hash) so that identical fetches executed by many concurrent runs
+// within a short window can reuse the result instead of hitting the upstream
+// again. Tasks opt in by setting cacheTTL in the pipeline spec.
+type ResultCache interface {
+	// Get returns the cached value for the key, if present and not expired.
+	Get(ctx context.Context, specID int32, dotID string, inputHash string) (value interface{}, ok bool)
+	// Put stores value under the key for ttl. Failures are logged, not
+	// returned: caching is best-effort.
+	Put(ctx context.Context, specID int32, dotID string, inputHash string, value interface{}, ttl time.Duration)
+}
+
+// resultCache layers an in-memory map over the pipeline_task_result_cache
+// table, so that hits within a process avoid the database round trip while
+// other nodes sharing the database still benefit.
+type resultCache struct {
+	db   *sqlx.DB
+	lggr logger.Logger
+
+	mu  sync.Mutex
+	mem map[resultCacheKey]resultCacheEntry
+}
+
+type resultCacheKey struct {
+	specID    int32
+	dotID     string
+	inputHash string
+}
+
+type resultCacheEntry struct {
+	value     interface{}
+	expiresAt time.Time
+}
+
+// memory entries beyond this count trigger a prune of expired entries on Put
+const resultCachePruneThreshold = 1000
+
+func NewResultCache(db *sqlx.DB, lggr logger.Logger) ResultCache {
+	return &resultCache{
+		db:   db,
+		lggr: lggr.Named("PipelineResultCache"),
+		mem:  make(map[resultCacheKey]resultCacheEntry),
+	}
+}
+
+func (c *resultCache) Get(ctx context.Context, specID int32, dotID string, inputHash string) (interface{}, bool) {
+	key := resultCacheKey{specID, dotID, inputHash}
+	now := time.Now()
+
+	c.mu.Lock()
+	entry, exists := c.mem[key]
+	if exists && now.Before(entry.expiresAt) {
+		c.mu.Unlock()
+		return entry.value, true
+	}
+	if exists {
+		delete(c.mem, key)
+	}
+	c.mu.Unlock()
+
+	var js JSONSerializable
+	var expiresAt time.Time
+	err := c.db.QueryRowxContext(ctx, `
+SELECT value, expires_at FROM pipeline_task_result_cache
+WHERE pipeline_spec_id = $1 AND task_dot_id = $2 AND input_hash = $3 AND expires_at > now()`,
+		specID, dotID, inputHash).Scan(&js, &expiresAt)
+	if err != nil {
+		return nil, false
+	}
+
+	c.mu.Lock()
+	c.mem[key] = resultCacheEntry{value: js.Val, expiresAt: expiresAt}
+	c.mu.Unlock()
+	return js.Val, true
+}
+
+func (c *resultCache) Put(ctx context.Context, specID int32, dotID string, inputHash string, value interface{}, ttl time.Duration) {
+	expiresAt := time.Now().Add(ttl)
+
+	c.mu.Lock()
+	if len(c.mem) > resultCachePruneThreshold {
+		now := time.Now()
+		for key, entry := range c.mem {
+			if now.After(entry.expiresAt) {
+				delete(c.mem, key)
+			}
+		}
+	}
+	c.mem[resultCacheKey{specID, dotID, inputHash}] = resultCacheEntry{value: value, expiresAt: expiresAt}
+	c.mu.Unlock()
+
+	js := JSONSerializable{Val: value, Valid: true}
+	_, err := c.db.ExecContext(ctx, `
+INSERT INTO pipeline_task_result_cache (pipeline_spec_id, task_dot_id, input_hash, value, expires_at)
+VALUES ($1, $2, $3, $4, $5)
+ON CONFLICT (pipeline_spec_id, task_dot_id, input_hash) DO UPDATE SET
+value = EXCLUDED.value, expires_at = EXCLUDED.expires_at, created_at = now()`,
+		specID, dotID, inputHash, js, expiresAt)
+	if err != nil {
+		c.lggr.Warnw("Failed to store task result in cache", "err", err, "specID", specID, "dotID", dotID)
+	}
+}
+
+// resultCacheInputHash derives the cache key hash from the task's inputs.
+// Tasks with errored or unserializable inputs are not cacheable.
+func resultCacheInputHash(inputs []Result) (string, bool) {
+	values := make([]interface{}, len(inputs))
+	for i, input := range inputs {
+		if input.Error != nil {
+			return "", false
+		}
+		values[i] = input.Value
+	}
+	b, err := json.Marshal(values)
+	if err != nil {
+		return "", false
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:]), true
+}
@@ -0,0 +1,69 @@
+package pipeline_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/store/models"
+)
+
+func TestResultCache_PutGet(t *testing.T) {
+	db, orm := setupORM(t)
+	ctx := context.Background()
+
+	specID, err := orm.CreateSpec(pipeline.Pipeline{}, models.Interval(time.Minute))
+	require.NoError(t, err)
+
+	sqlxDB := postgres.UnwrapGormDB(db)
+	cache := pipeline.NewResultCache(sqlxDB, logger.TestLogger(t))
+
+	_, ok := cache.Get(ctx, specID, "ds1", "somehash")
+	require.False(t, ok)
+
+	cache.Put(ctx, specID, "ds1", "somehash", "9700.1", time.Minute)
+
+	value, ok := cache.Get(ctx, specID, "ds1", "somehash")
+	require.True(t, ok)
+	assert.Equal(t, "9700.1", value)
+
+	// a second cache sharing the database sees the entry too
+	other := pipeline.NewResultCache(sqlxDB, logger.TestLogger(t))
+	value, ok = other.Get(ctx, specID, "ds1", "somehash")
+	require.True(t, ok)
+	assert.Equal(t, "9700.1", value)
+
+	// different key misses
+	_, ok = cache.Get(ctx, specID, "ds2", "somehash")
+	require.False(t, ok)
+
+	// expired entries miss
+	cache.Put(ctx, specID, "ds1", "expiredhash", "9700.1", -time.Second)
+	_, ok = cache.Get(ctx, specID, "ds1", "expiredhash")
+	require.False(t, ok)
+}
+
+func TestResultCacheInputHash(t *testing.T) {
+	t.Parallel()
+
+	hash, ok := pipeline.ResultCacheInputHash([]pipeline.Result{{Value: "foo"}, {Value: float64(42)}})
+	require.True(t, ok)
+	hash2, ok := pipeline.ResultCacheInputHash([]pipeline.Result{{Value: "foo"}, {Value: float64(42)}})
+	require.True(t, ok)
+	assert.Equal(t, hash, hash2)
+
+	hash3, ok := pipeline.ResultCacheInputHash([]pipeline.Result{{Value: "bar"}, {Value: float64(42)}})
+	require.True(t, ok)
+	assert.NotEqual(t, hash, hash3)
+
+	// errored inputs are not cacheable
+	_, ok = pipeline.ResultCacheInputHash([]pipeline.Result{{Error: errors.New("boom")}})
+	require.False(t, ok)
+}
@@ -0,0 +1,95 @@
+package pipeline
+
+import (
+	"context"
+	"io"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/aws/aws-sdk-go/aws"
+	"github.com/aws/aws-sdk-go/aws/session"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
+	"github.com/pkg/errors"
+)
+
+// RunArchive is a cold storage destination for reaped pipeline runs. Each
+// Store call receives one gzipped JSONL object; contents is only readable
+// once, so implementations must stream it out rather than seek.
+type RunArchive interface {
+	Store(ctx context.Context, key string, contents io.Reader) error
+}
+
+// NewRunArchive constructs a run archive from archiveURL. Supported schemes
+// are s3://bucket/prefix and file:///path (a plain path also works); GCS is
+// not supported because the node does not carry a GCS client dependency.
+func NewRunArchive(archiveURL string) (RunArchive, error) {
+	u, err := url.Parse(archiveURL)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid run archive URL %q", archiveURL)
+	}
+	switch u.Scheme {
+	case "s3":
+		sess, err := session.NewSession(aws.NewConfig())
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to create AWS session")
+		}
+		return &s3RunArchive{
+			bucket:   u.Host,
+			prefix:   strings.Trim(u.Path, "/"),
+			uploader: s3manager.NewUploader(sess),
+		}, nil
+	case "file", "":
+		dir := u.Path
+		if u.Scheme == "" {
+			dir = archiveURL
+		}
+		if err := os.MkdirAll(dir, 0700); err != nil {
+			return nil, errors.Wrapf(err, "failed to create run archive directory %q", dir)
+		}
+		return &fileRunArchive{dir: dir}, nil
+	default:
+		return nil, errors.Errorf("unsupported run archive URL scheme %q (only s3:// and file:// are supported)", u.Scheme)
+	}
+}
+
+// fileRunArchive writes each object to a file under dir.
+type fileRunArchive struct {
+	dir string
+}
+
+func (a *fileRunArchive) Store(ctx context.Context, key string, contents io.Reader) error {
+	path := filepath.Join(a.dir, key)
+	f, err := os.OpenFile(path, os.O_WRONLY|os.O_CREATE|os.O_TRUNC, 0600)
+	if err != nil {
+		return errors.Wrapf(err, "failed to create run archive file %q", path)
+	}
+	if _, err := io.Copy(f, contents); err != nil {
+		f.Close()
+		return errors.Wrapf(err, "failed to write run archive file %q", path)
+	}
+	return errors.Wrapf(f.Close(), "failed to close run archive file %q", path)
+}
+
+// s3RunArchive streams each object to bucket under prefix. Credentials come
+// from the standard AWS environment/instance profile chain, same as the
+// audit log S3 sink.
+type s3RunArchive struct {
+	bucket   string
+	prefix   string
+	uploader *s3manager.Uploader
+}
+
+func (a *s3RunArchive) Store(ctx context.Context, key string, contents io.Reader) error {
+	if a.prefix != "" {
+		key = a.prefix + "/" + key
+	}
+	_, err := a.uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket:      aws.String(a.bucket),
+		Key:         aws.String(key),
+		Body:        contents,
+		ContentType: aws.String("application/gzip"),
+	})
+	return errors.Wrapf(err, "failed to upload run archive object %q to S3", key)
+}
@@ -0,0 +1,40 @@
+package pipeline_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+func TestNewRunArchive(t *testing.T) {
+	t.Parallel()
+
+	_, err := pipeline.NewRunArchive("gs://some-bucket")
+	require.Error(t, err)
+	assert.Contains(t, err.Error(), "unsupported run archive URL scheme")
+
+	_, err = pipeline.NewRunArchive("s3://some-bucket/runs")
+	require.NoError(t, err)
+}
+
+func TestFileRunArchive_Store(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join(t.TempDir(), "archive")
+	archive, err := pipeline.NewRunArchive("file://" + dir)
+	require.NoError(t, err)
+
+	contents := `{"id":1}` + "\n" + `{"id":2}` + "\n"
+	require.NoError(t, archive.Store(context.Background(), "pipeline_runs_2021_09.jsonl.gz", strings.NewReader(contents)))
+
+	b, err := os.ReadFile(filepath.Join(dir, "pipeline_runs_2021_09.jsonl.gz"))
+	require.NoError(t, err)
+	assert.Equal(t, contents, string(b))
+}
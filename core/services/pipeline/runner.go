@@ -12,13 +12,16 @@ import (
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
+	"go.opentelemetry.io/otel/attribute"
 	"gopkg.in/guregu/null.v4"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/chains/evm"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/service"
 	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/tracing"
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
@@ -38,6 +41,9 @@ type Runner interface {
 	ExecuteRun(ctx context.Context, spec Spec, vars Vars, l logger.Logger) (run Run, trrs TaskRunResults, err error)
 	// InsertFinishedRun saves the run results in the database.
 	InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) error
+	// InsertFinishedRuns saves a batch of finished runs in a single
+	// multi-row insert.
+	InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) error
 
 	// ExecuteAndInsertFinishedRun executes a new run in-memory according to a spec, persists and saves the results.
 	// It is a combination of ExecuteRun and InsertFinishedRun.
@@ -49,10 +55,13 @@ type Runner interface {
 
 type runner struct {
 	orm             ORM
+	btORM           bridges.ORM
+	resultCache     ResultCache
 	config          Config
 	chainSet        evm.ChainSet
 	ethKeyStore     ETHKeyStore
 	vrfKeyStore     VRFKeyStore
+	eventBus        *postgres.EventBus
 	runReaperWorker utils.SleeperTask
 	lggr            logger.Logger
 
@@ -60,8 +69,9 @@ type runner struct {
 	runFinished func(*Run)
 
 	utils.StartStopOnce
-	chStop chan struct{}
-	wgDone sync.WaitGroup
+	chStop          chan struct{}
+	wgDone          sync.WaitGroup
+	unsubJobDeleted func()
 }
 
 var (
@@ -92,6 +102,26 @@ var (
 	},
 		[]string{"job_id", "job_name", "task_id", "task_type", "status"},
 	)
+	promPipelineTaskExecutionDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_execution_duration_seconds",
+		Help:    "How long tasks of each type take to execute, by bridge where applicable",
+		Buckets: prometheus.DefBuckets,
+	},
+		[]string{"task_type", "bridge"},
+	)
+	promPipelineTaskQueuedDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "pipeline_task_queued_duration_seconds",
+		Help:    "How long tasks of each type wait between becoming schedulable and starting to execute",
+		Buckets: prometheus.DefBuckets,
+	},
+		[]string{"task_type"},
+	)
+	promPipelineTaskErrors = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pipeline_task_errors_total",
+		Help: "The total number of pipeline task errors, by error class",
+	},
+		[]string{"task_type", "bridge", "error_class"},
+	)
 )
 
 func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore, vrfks VRFKeyStore, lggr logger.Logger) *runner {
@@ -112,11 +142,39 @@ func NewRunner(orm ORM, config Config, chainSet evm.ChainSet, ethks ETHKeyStore,
 	return r
 }
 
+// SetBridgeORM routes bridge task lookups through the given ORM (typically a
+// caching one) instead of querying the database directly.
+func (r *runner) SetBridgeORM(orm bridges.ORM) {
+	r.btORM = orm
+}
+
+// SetResultCache enables memoization of task results for tasks that set
+// cacheTTL in their pipeline spec.
+func (r *runner) SetResultCache(cache ResultCache) {
+	r.resultCache = cache
+}
+
+// SetEventBus enables flushing the ORM's pipeline spec cache when a job (and
+// hence its spec) is deleted, including by another process. The event bus
+// must be started before this runner.
+func (r *runner) SetEventBus(bus *postgres.EventBus) {
+	r.eventBus = bus
+}
+
 func (r *runner) Start() error {
 	return r.StartOnce("PipelineRunner", func() error {
 		r.wgDone.Add(2)
 		go r.scheduleUnfinishedRuns()
 		go r.runReaperLoop()
+		if r.eventBus != nil {
+			var err error
+			r.unsubJobDeleted, err = r.eventBus.SubscribeFunc(postgres.ChannelJobDeleted, "", func(postgres.Event) {
+				r.orm.FlushSpecCache()
+			})
+			if err != nil {
+				return errors.Wrap(err, "failed to subscribe to job deletions")
+			}
+		}
 		return nil
 	})
 }
@@ -124,6 +182,9 @@ func (r *runner) Start() error {
 func (r *runner) Close() error {
 	return r.StopOnce("PipelineRunner", func() error {
 		close(r.chStop)
+		if r.unsubJobDeleted != nil {
+			r.unsubJobDeleted()
+		}
 		r.wgDone.Wait()
 		return nil
 	})
@@ -157,6 +218,9 @@ type memoryTaskRun struct {
 	inputs   []Result // sorted by input index
 	vars     Vars
 	attempts uint
+	// scheduledAt is when the task became schedulable, for reporting how
+	// long it spent queued before a worker picked it up
+	scheduledAt time.Time
 }
 
 // When a task panics, we catch the panic and wrap it in an error for reporting to the scheduler.
@@ -222,9 +286,12 @@ func (r *runner) initializePipeline(run *Run) (*Pipeline, error) {
 		switch task.Type() {
 		case TaskTypeHTTP:
 			task.(*HTTPTask).config = r.config
+		case TaskTypeWebSocket:
+			task.(*WebSocketTask).config = r.config
 		case TaskTypeBridge:
 			task.(*BridgeTask).config = r.config
 			task.(*BridgeTask).queryer = r.orm.DB()
+			task.(*BridgeTask).orm = r.btORM
 		case TaskTypeETHCall:
 			task.(*ETHCallTask).chainSet = r.chainSet
 			task.(*ETHCallTask).config = r.config
@@ -259,6 +326,12 @@ func (r *runner) run(
 ) (TaskRunResults, error) {
 	l.Debugw("Initiating tasks for pipeline run of spec", "job ID", run.PipelineSpec.JobID, "job name", run.PipelineSpec.JobName)
 
+	ctx, span := tracing.StartSpan(ctx, "pipeline", "pipeline.run",
+		attribute.Int64("job.id", int64(run.PipelineSpec.JobID)),
+		attribute.String("job.name", run.PipelineSpec.JobName),
+	)
+	defer span.End()
+
 	todo := context.TODO()
 	scheduler := newScheduler(todo, pipeline, run, vars)
 	go scheduler.Run()
@@ -314,6 +387,7 @@ func (r *runner) run(
 			Output:        output,
 			Error:         result.Result.ErrorDB(),
 			DotID:         result.Task.DotID(),
+			Attempts:      uint32(result.Attempts),
 			CreatedAt:     result.CreatedAt,
 			FinishedAt:    result.FinishedAt,
 			task:          result.Task,
@@ -363,10 +437,38 @@ func (r *runner) run(
 
 func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryTaskRun, l logger.Logger) TaskRunResult {
 	start := time.Now()
+	ctx, span := tracing.StartSpan(ctx, "pipeline", "pipeline.task."+string(taskRun.task.Type()),
+		attribute.String("task.id", taskRun.task.DotID()),
+		attribute.Int("task.attempt", int(taskRun.attempts)),
+	)
+	defer span.End()
+	if !taskRun.scheduledAt.IsZero() {
+		promPipelineTaskQueuedDuration.WithLabelValues(string(taskRun.task.Type())).Observe(start.Sub(taskRun.scheduledAt).Seconds())
+	}
 	l = l.With("taskName", taskRun.task.DotID(),
 		"taskType", taskRun.task.Type(),
 		"attempt", taskRun.attempts)
 
+	var cacheInputHash string
+	cacheTTL := taskRun.task.TaskCacheTTL()
+	if cacheTTL > 0 && r.resultCache != nil {
+		if hash, cacheable := resultCacheInputHash(taskRun.inputs); cacheable {
+			cacheInputHash = hash
+			if value, hit := r.resultCache.Get(ctx, spec.ID, taskRun.task.DotID(), hash); hit {
+				promPipelineTaskCacheHits.WithLabelValues(string(taskRun.task.Type())).Inc()
+				l.Debugw("Pipeline task result served from cache")
+				return TaskRunResult{
+					ID:         taskRun.task.Base().uuid,
+					Task:       taskRun.task,
+					Result:     Result{Value: value},
+					CreatedAt:  start,
+					FinishedAt: null.TimeFrom(time.Now()),
+				}
+			}
+			promPipelineTaskCacheMisses.WithLabelValues(string(taskRun.task.Type())).Inc()
+		}
+	}
+
 	// Order of precedence for task timeout:
 	// - Specific task timeout (task.TaskTimeout)
 	// - Job level task timeout (spec.MaxTaskDuration)
@@ -383,6 +485,9 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 
 	result, runInfo := taskRun.task.Run(ctx, l, taskRun.vars, taskRun.inputs)
+	if result.Error != nil {
+		span.RecordError(result.Error)
+	}
 	loggerFields := []interface{}{"runInfo", runInfo,
 		"resultValue", result.Value,
 		"resultError", result.Error,
@@ -395,6 +500,10 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 	}
 	l.Debugw("Pipeline task completed", loggerFields...)
 
+	if cacheInputHash != "" && result.Error == nil && !runInfo.IsPending {
+		r.resultCache.Put(ctx, spec.ID, taskRun.task.DotID(), cacheInputHash, result.Value, cacheTTL)
+	}
+
 	now := time.Now()
 
 	var finishedAt null.Time
@@ -413,15 +522,41 @@ func (r *runner) executeTaskRun(ctx context.Context, spec Spec, taskRun *memoryT
 
 func logTaskRunToPrometheus(trr TaskRunResult, spec Spec) {
 	elapsed := trr.FinishedAt.Time.Sub(trr.CreatedAt)
+	taskType := string(trr.Task.Type())
+	bridge := taskBridgeName(trr.Task)
 
-	PromPipelineTaskExecutionTime.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type())).Set(float64(elapsed))
+	PromPipelineTaskExecutionTime.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), taskType).Set(float64(elapsed))
+	promPipelineTaskExecutionDuration.WithLabelValues(taskType, bridge).Observe(elapsed.Seconds())
 	var status string
 	if trr.Result.Error != nil {
 		status = "error"
+		promPipelineTaskErrors.WithLabelValues(taskType, bridge, taskErrorClass(trr.Result.Error)).Inc()
 	} else {
 		status = "completed"
 	}
-	PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), string(trr.Task.Type()), status).Inc()
+	PromPipelineTasksTotalFinished.WithLabelValues(fmt.Sprintf("%d", spec.JobID), spec.JobName, trr.Task.DotID(), taskType, status).Inc()
+}
+
+// taskBridgeName returns the bridge a task calls out to, or empty for tasks
+// which are not bridge tasks.
+func taskBridgeName(task Task) string {
+	if bt, ok := task.(*BridgeTask); ok {
+		return bt.Name
+	}
+	return ""
+}
+
+// taskErrorClass buckets task errors into a bounded set of classes suitable
+// for use as a metric label.
+func taskErrorClass(err error) string {
+	switch {
+	case errors.Is(err, ErrTimeout) || errors.Is(err, context.DeadlineExceeded):
+		return "timeout"
+	case errors.Is(err, ErrCancelled) || errors.Is(err, context.Canceled):
+		return "cancelled"
+	default:
+		return "error"
+	}
 }
 
 // ExecuteAndInsertFinishedRun executes a run in memory then inserts the finished run/task run records, returning the final result
@@ -558,10 +693,25 @@ func (r *runner) InsertFinishedRun(run *Run, saveSuccessfulTaskRuns bool, qopts
 	return r.orm.InsertFinishedRun(run, saveSuccessfulTaskRuns, qopts...)
 }
 
+func (r *runner) InsertFinishedRuns(runs []*Run, saveSuccessfulTaskRuns bool, qopts ...postgres.QOpt) error {
+	return r.orm.InsertFinishedRuns(runs, saveSuccessfulTaskRuns, qopts...)
+}
+
+// runPartitionsAhead is how many months of run table partitions are kept
+// created in advance of the current month
+const runPartitionsAhead = 2
+
 func (r *runner) runReaper() {
+	if !postgres.DBAvailable() {
+		r.lggr.Warn("Skipping run reaper; database is unavailable")
+		return
+	}
 	ctx, cancel := utils.CombinedContext(context.Background(), r.chStop)
 	defer cancel()
 
+	if err := r.orm.EnsureRunPartitions(runPartitionsAhead); err != nil {
+		r.lggr.Errorw("Pipeline run partition maintenance failed", "error", err)
+	}
 	err := r.orm.DeleteRunsOlderThan(ctx, r.config.JobPipelineReaperThreshold())
 	if ctx.Err() != nil {
 		return
@@ -13,7 +13,7 @@ import (
 )
 
 func (s *scheduler) newMemoryTaskRun(task Task) *memoryTaskRun {
-	run := &memoryTaskRun{task: task, vars: s.vars.Copy()}
+	run := &memoryTaskRun{task: task, vars: s.vars.Copy(), scheduledAt: time.Now()}
 
 	// fill in the inputs, fast path for no inputs
 	if len(task.Inputs()) != 0 {
@@ -21,6 +21,10 @@ type BaseTask struct {
 	MinBackoff time.Duration `mapstructure:"minBackoff"`
 	MaxBackoff time.Duration `mapstructure:"maxBackoff"`
 
+	// CacheTTL, when non-zero, memoizes the task's result keyed on (spec ID,
+	// dot ID, input hash) so that identical fetches within the TTL reuse it.
+	CacheTTL time.Duration `mapstructure:"cacheTTL"`
+
 	uuid uuid.UUID
 }
 
@@ -63,6 +67,10 @@ func (t BaseTask) TaskRetries() uint32 {
 	return t.Retries.Uint32
 }
 
+func (t BaseTask) TaskCacheTTL() time.Duration {
+	return t.CacheTTL
+}
+
 func (t BaseTask) TaskMinBackoff() time.Duration {
 	if t.MinBackoff > 0 {
 		return t.MinBackoff
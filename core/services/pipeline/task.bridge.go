@@ -27,6 +27,7 @@ type BridgeTask struct {
 	Async             string `json:"async"`
 
 	queryer postgres.Queryer
+	orm     bridges.ORM
 	config  Config
 }
 
@@ -143,7 +144,13 @@ func (t *BridgeTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inp
 
 func (t BridgeTask) getBridgeURLFromName(name StringParam) (URLParam, error) {
 	var bt bridges.BridgeType
-	err := t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+	var err error
+	if t.orm != nil {
+		// the ORM is preferred since it may serve the bridge from cache
+		bt, err = t.orm.FindBridge(bridges.TaskType(name))
+	} else {
+		err = t.queryer.Get(&bt, "SELECT * FROM bridge_types WHERE name = $1", string(name))
+	}
 	if err != nil {
 		return URLParam{}, errors.Wrapf(err, "could not find bridge with name '%s'", name)
 	}
@@ -23,6 +23,7 @@ import (
 type ETHCallTask struct {
 	BaseTask            `mapstructure:",squash"`
 	Contract            string `json:"contract"`
+	From                string `json:"from"`
 	Data                string `json:"data"`
 	Gas                 string `json:"gas"`
 	GasPrice            string `json:"gasPrice"`
@@ -58,6 +59,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 
 	var (
 		contractAddr AddressParam
+		fromAddr     AddressParam
 		data         BytesParam
 		gas          Uint64Param
 		gasPrice     MaybeBigIntParam
@@ -73,6 +75,9 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 		errors.Wrap(ResolveParam(&gasTipCap, From(VarExpr(t.GasTipCap, vars), t.GasTipCap)), "gasTipCap"),
 		errors.Wrap(ResolveParam(&gasFeeCap, From(VarExpr(t.GasFeeCap, vars), t.GasFeeCap)), "gasFeeCap"),
 	)
+	if err == nil && t.From != "" {
+		err = errors.Wrap(ResolveParam(&fromAddr, From(VarExpr(t.From, vars), NonemptyString(t.From))), "from")
+	}
 	if err != nil {
 		return Result{Error: err}, runInfo
 	} else if len(data) == 0 {
@@ -80,6 +85,7 @@ func (t *ETHCallTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, in
 	}
 
 	call := ethereum.CallMsg{
+		From:      common.Address(fromAddr),
 		To:        (*common.Address)(&contractAddr),
 		Data:      []byte(data),
 		Gas:       uint64(gas),
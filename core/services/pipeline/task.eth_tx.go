@@ -4,6 +4,7 @@ import (
 	"context"
 	"reflect"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/mitchellh/mapstructure"
@@ -22,15 +23,19 @@ import (
 //     nil
 //
 type ETHTxTask struct {
-	BaseTask         `mapstructure:",squash"`
-	From             string `json:"from"`
-	To               string `json:"to"`
-	Data             string `json:"data"`
-	GasLimit         string `json:"gasLimit"`
-	TxMeta           string `json:"txMeta"`
-	MinConfirmations string `json:"minConfirmations"`
-	EVMChainID       string `json:"evmChainID" mapstructure:"evmChainID"`
-	Simulate         string `json:"simulate" mapstructure:"simulate"`
+	BaseTask           `mapstructure:",squash"`
+	From               string `json:"from"`
+	To                 string `json:"to"`
+	Data               string `json:"data"`
+	GasLimit           string `json:"gasLimit"`
+	GasPriceMultiplier string `json:"gasPriceMultiplier"`
+	Priority           string `json:"priority"`
+	TTL                string `json:"ttl"`
+	TxMeta             string `json:"txMeta"`
+	MinConfirmations   string `json:"minConfirmations"`
+	EVMChainID         string `json:"evmChainID" mapstructure:"evmChainID"`
+	ForwardingAllowed  string `json:"forwardingAllowed"`
+	Simulate           string `json:"simulate" mapstructure:"simulate"`
 
 	keyStore ETHKeyStore
 	chainSet evm.ChainSet
@@ -73,6 +78,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		txMetaMap             MapParam
 		maybeMinConfirmations MaybeUint64Param
 		simulate              BoolParam
+		forwardingAllowed     BoolParam
 	)
 	err = multierr.Combine(
 		errors.Wrap(ResolveParam(&fromAddrs, From(VarExpr(t.From, vars), JSONWithVarExprs(t.From, vars, false), NonemptyString(t.From), nil)), "from"),
@@ -82,6 +88,7 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		errors.Wrap(ResolveParam(&txMetaMap, From(VarExpr(t.TxMeta, vars), JSONWithVarExprs(t.TxMeta, vars, false), MapParam{})), "txMeta"),
 		errors.Wrap(ResolveParam(&maybeMinConfirmations, From(t.MinConfirmations)), "minConfirmations"),
 		errors.Wrap(ResolveParam(&simulate, From(VarExpr(t.Simulate, vars), NonemptyString(t.Simulate), false)), "simulate"),
+		errors.Wrap(ResolveParam(&forwardingAllowed, From(VarExpr(t.ForwardingAllowed, vars), NonemptyString(t.ForwardingAllowed), false)), "forwardingAllowed"),
 	)
 	if err != nil {
 		return Result{Error: err}, runInfo
@@ -139,6 +146,48 @@ func (t *ETHTxTask) Run(_ context.Context, lggr logger.Logger, vars Vars, inputs
 		GasLimit:       uint64(gasLimit),
 		Meta:           &txMeta,
 		Strategy:       strategy,
+		UseForwarder:   bool(forwardingAllowed),
+	}
+
+	if t.GasPriceMultiplier != "" {
+		var gasPriceMultiplier DecimalParam
+		if perr := errors.Wrap(ResolveParam(&gasPriceMultiplier, From(VarExpr(t.GasPriceMultiplier, vars), NonemptyString(t.GasPriceMultiplier))), "gasPriceMultiplier"); perr != nil {
+			return Result{Error: perr}, runInfo
+		}
+		multiplier, _ := gasPriceMultiplier.Decimal().Float64()
+		if multiplier <= 0 {
+			return Result{Error: errors.Wrap(ErrBadInput, "gasPriceMultiplier must be greater than zero")}, runInfo
+		}
+		newTx.GasPriceMultiplier = &multiplier
+	}
+
+	if t.Priority != "" {
+		var priority StringParam
+		if perr := errors.Wrap(ResolveParam(&priority, From(VarExpr(t.Priority, vars), NonemptyString(t.Priority))), "priority"); perr != nil {
+			return Result{Error: perr}, runInfo
+		}
+		switch p := bulletprooftxmanager.TxPriority(priority); p {
+		case bulletprooftxmanager.TxPriorityLow, bulletprooftxmanager.TxPriorityNormal, bulletprooftxmanager.TxPriorityUrgent:
+			newTx.Priority = p
+		default:
+			return Result{Error: errors.Wrapf(ErrBadInput, "priority: unknown priority %q", priority)}, runInfo
+		}
+	}
+
+	if t.TTL != "" {
+		var ttlStr StringParam
+		if perr := errors.Wrap(ResolveParam(&ttlStr, From(VarExpr(t.TTL, vars), NonemptyString(t.TTL))), "ttl"); perr != nil {
+			return Result{Error: perr}, runInfo
+		}
+		ttl, perr := time.ParseDuration(string(ttlStr))
+		if perr != nil {
+			return Result{Error: errors.Wrapf(ErrBadInput, "ttl: %v", perr)}, runInfo
+		}
+		if ttl <= 0 {
+			return Result{Error: errors.Wrap(ErrBadInput, "ttl must be greater than zero")}, runInfo
+		}
+		expiresAt := time.Now().Add(ttl)
+		newTx.ExpiresAt = &expiresAt
 	}
 
 	if minConfirmations > 0 {
@@ -0,0 +1,174 @@
+package pipeline
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+
+	"github.com/gorilla/websocket"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// Return types:
+//
+//	string
+type WebSocketTask struct {
+	BaseTask `mapstructure:",squash"`
+	URL      string `json:"url"`
+	// SubscribeData is an optional JSON message sent after connecting, e.g. a
+	// subscription request.
+	SubscribeData string `json:"subscribeData"`
+	// Path is an optional keypath filter; messages in which the path does not
+	// resolve to a non-null value are skipped.
+	Path string `json:"path"`
+	// Index is the zero-based index of the matching message to emit (default
+	// 0, i.e. the first match).
+	Index                          string `json:"index"`
+	AllowUnrestrictedNetworkAccess string
+
+	config Config
+}
+
+var _ Task = (*WebSocketTask)(nil)
+
+func (t *WebSocketTask) Type() TaskType {
+	return TaskTypeWebSocket
+}
+
+func (t *WebSocketTask) Run(ctx context.Context, lggr logger.Logger, vars Vars, inputs []Result) (result Result, runInfo RunInfo) {
+	_, err := CheckInputs(inputs, -1, -1, 0)
+	if err != nil {
+		return Result{Error: errors.Wrap(err, "task inputs")}, runInfo
+	}
+
+	var (
+		url                            URLParam
+		subscribeData                  MapParam
+		path                           JSONPathParam
+		index                          Uint64Param
+		allowUnrestrictedNetworkAccess BoolParam
+	)
+	err = multierr.Combine(
+		errors.Wrap(ResolveParam(&url, From(VarExpr(t.URL, vars), NonemptyString(t.URL))), "url"),
+		errors.Wrap(ResolveParam(&subscribeData, From(VarExpr(t.SubscribeData, vars), JSONWithVarExprs(t.SubscribeData, vars, false), nil)), "subscribeData"),
+		errors.Wrap(ResolveParam(&path, From(VarExpr(t.Path, vars), t.Path)), "path"),
+		errors.Wrap(ResolveParam(&index, From(VarExpr(t.Index, vars), NonemptyString(t.Index), 0)), "index"),
+		errors.Wrap(ResolveParam(&allowUnrestrictedNetworkAccess, From(NonemptyString(t.AllowUnrestrictedNetworkAccess), !variableRegexp.MatchString(t.URL))), "allowUnrestrictedNetworkAccess"),
+	)
+	if err != nil {
+		return Result{Error: err}, runInfo
+	}
+	if s := url.Scheme; s != "ws" && s != "wss" {
+		return Result{Error: errors.Errorf(`url: unsupported scheme %q (must be "ws" or "wss")`, s)}, runInfo
+	}
+
+	timeoutCtx, cancel := context.WithTimeout(ctx, t.config.DefaultHTTPTimeout().Duration())
+	defer cancel()
+
+	dialer := *websocket.DefaultDialer
+	if !allowUnrestrictedNetworkAccess {
+		dialer.NetDialContext = utils.RestrictedDialContext
+	}
+	lggr.Debugw("WebSocket task: dialing",
+		"url", url.String(),
+		"path", []string(path),
+		"index", uint64(index),
+		"allowUnrestrictedNetworkAccess", allowUnrestrictedNetworkAccess,
+	)
+	conn, httpResponse, err := dialer.DialContext(timeoutCtx, url.String(), nil)
+	if err != nil {
+		if errors.Cause(err) == utils.ErrDisallowedIP {
+			err = errors.Wrap(err, "connections to local resources are disabled by default, if you are sure this is safe, you can enable on a per-task basis by setting allowUnrestrictedNetworkAccess=true in the pipeline task spec")
+		}
+		var statusCode int
+		if httpResponse != nil {
+			statusCode = httpResponse.StatusCode
+		}
+		return Result{Error: errors.Wrapf(err, "failed to connect to %s", url.String())}, RunInfo{IsRetryable: isRetryableHTTPError(statusCode, err)}
+	}
+	defer logger.ErrorIfClosing(conn, "websocket connection")
+
+	// ReadMessage does not respect the context, so close the connection to
+	// unblock it on timeout or cancellation.
+	go func() {
+		<-timeoutCtx.Done()
+		conn.Close()
+	}()
+
+	if subscribeData != nil {
+		subscribeDataJSON, err2 := json.Marshal(subscribeData)
+		if err2 != nil {
+			return Result{Error: errors.Wrap(err2, "failed to encode subscribeData as JSON")}, runInfo
+		}
+		if err2 = conn.WriteMessage(websocket.TextMessage, subscribeDataJSON); err2 != nil {
+			return Result{Error: errors.Wrap(err2, "failed to send subscribe message")}, runInfo
+		}
+	}
+
+	var matched uint64
+	for {
+		_, message, err2 := conn.ReadMessage()
+		if err2 != nil {
+			if timeoutCtx.Err() != nil {
+				return Result{Error: errors.Errorf("timed out or interrupted after %v matching messages", matched)}, RunInfo{IsRetryable: true}
+			}
+			return Result{Error: errors.Wrap(err2, "failed to read message")}, RunInfo{IsRetryable: true}
+		}
+		if !websocketMessageMatches(message, path) {
+			continue
+		}
+		if matched < uint64(index) {
+			matched++
+			continue
+		}
+
+		lggr.Debugw("WebSocket task got matching message",
+			"message", string(message),
+			"url", url.String(),
+			"dotID", t.DotID(),
+		)
+		return Result{Value: string(message)}, runInfo
+	}
+}
+
+// websocketMessageMatches returns true if path resolves to a non-null value
+// in message. An empty path matches every message.
+func websocketMessageMatches(message []byte, path []string) bool {
+	if len(path) == 0 {
+		return true
+	}
+	var decoded interface{}
+	if err := json.Unmarshal(message, &decoded); err != nil {
+		return false
+	}
+	for _, part := range path {
+		switch d := decoded.(type) {
+		case map[string]interface{}:
+			var exists bool
+			decoded, exists = d[part]
+			if !exists {
+				return false
+			}
+		case []interface{}:
+			bigindex, ok := big.NewInt(0).SetString(part, 10)
+			if !ok || !bigindex.IsInt64() {
+				return false
+			}
+			index := int(bigindex.Int64())
+			if index < 0 {
+				index = len(d) + index
+			}
+			if index < 0 || index >= len(d) {
+				return false
+			}
+			decoded = d[index]
+		default:
+			return false
+		}
+	}
+	return decoded != nil
+}
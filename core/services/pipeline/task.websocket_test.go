@@ -0,0 +1,107 @@
+package pipeline_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// newWebSocketEcho returns a ws:// URL for a server that, upon receiving any
+// message, responds with each of messages in order.
+func newWebSocketEcho(t *testing.T, messages []string) string {
+	t.Helper()
+
+	upgrader := websocket.Upgrader{}
+	s := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		conn, err := upgrader.Upgrade(w, r, nil)
+		require.NoError(t, err)
+		defer conn.Close()
+		_, _, err = conn.ReadMessage()
+		require.NoError(t, err)
+		for _, msg := range messages {
+			require.NoError(t, conn.WriteMessage(websocket.TextMessage, []byte(msg)))
+		}
+		// keep the connection open until the client hangs up
+		conn.ReadMessage()
+	}))
+	t.Cleanup(s.Close)
+	return "ws" + strings.TrimPrefix(s.URL, "http")
+}
+
+func TestWebSocketTask_Happy(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	url := newWebSocketEcho(t, []string{
+		`{"type":"subscribed"}`,
+		`{"type":"ticker","data":{"price":9700.1}}`,
+		`{"type":"ticker","data":{"price":9701.5}}`,
+	})
+
+	task := pipeline.WebSocketTask{
+		BaseTask:                       pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+		URL:                            url,
+		SubscribeData:                  `{"type":"subscribe","channel":"ticker"}`,
+		Path:                           "data,price",
+		AllowUnrestrictedNetworkAccess: "true",
+	}
+	task.HelperSetDependencies(config)
+
+	result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	assert.False(t, runInfo.IsPending)
+	assert.False(t, runInfo.IsRetryable)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"type":"ticker","data":{"price":9700.1}}`, result.Value)
+}
+
+func TestWebSocketTask_Index(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	url := newWebSocketEcho(t, []string{
+		`{"type":"ticker","data":{"price":9700.1}}`,
+		`{"type":"ticker","data":{"price":9701.5}}`,
+	})
+
+	task := pipeline.WebSocketTask{
+		BaseTask:                       pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+		URL:                            url,
+		SubscribeData:                  `{"type":"subscribe"}`,
+		Path:                           "data,price",
+		Index:                          "1",
+		AllowUnrestrictedNetworkAccess: "true",
+	}
+	task.HelperSetDependencies(config)
+
+	result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	assert.False(t, runInfo.IsRetryable)
+	require.NoError(t, result.Error)
+	assert.Equal(t, `{"type":"ticker","data":{"price":9701.5}}`, result.Value)
+}
+
+func TestWebSocketTask_UnsupportedScheme(t *testing.T) {
+	t.Parallel()
+
+	config := cltest.NewTestGeneralConfig(t)
+	task := pipeline.WebSocketTask{
+		BaseTask:                       pipeline.NewBaseTask(0, "ws", nil, nil, 0),
+		URL:                            "https://chain.link",
+		AllowUnrestrictedNetworkAccess: "true",
+	}
+	task.HelperSetDependencies(config)
+
+	result, runInfo := task.Run(context.Background(), logger.TestLogger(t), pipeline.NewVarsFrom(nil), nil)
+	assert.False(t, runInfo.IsRetryable)
+	require.Error(t, result.Error)
+	assert.Contains(t, result.Error.Error(), "unsupported scheme")
+}
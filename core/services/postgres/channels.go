@@ -8,4 +8,12 @@ const (
 
 	// Postgres channel to listen for new eth_txes
 	ChannelInsertOnEthTx = "insert_on_eth_txes"
+
+	// Postgres channel notified (by trigger) whenever a bridge is created,
+	// updated or deleted; the payload is the bridge name
+	ChannelBridgeUpdated = "bridge_updated"
+
+	// Postgres channel on which eth_tx lifecycle events (enqueued, broadcast,
+	// bumped, confirmed, reorged-out, expired, fatally errored) are published
+	ChannelTxLifecycle = "evm_tx_lifecycle"
 )
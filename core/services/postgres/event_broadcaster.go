@@ -9,6 +9,8 @@ import (
 
 	"github.com/lib/pq"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
@@ -19,6 +21,25 @@ import (
 	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
+var (
+	promPgNotificationsPublished = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pg_notifications_published",
+		Help: "Number of Postgres notifications published per channel",
+	}, []string{"channel"})
+	promPgNotificationsReceived = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pg_notifications_received",
+		Help: "Number of Postgres notifications received per channel",
+	}, []string{"channel"})
+	promPgEventsDelivered = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pg_events_delivered",
+		Help: "Number of Postgres events delivered to subscribers per channel",
+	}, []string{"channel"})
+	promPgEventsDropped = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "pg_events_dropped",
+		Help: "Number of Postgres events dropped because a subscriber was too slow to consume them",
+	}, []string{"channel"})
+)
+
 //go:generate mockery --name EventBroadcaster --output ./mocks/ --case=underscore
 //go:generate mockery --name Subscription --output ./mocks/ --case=underscore
 
@@ -136,6 +157,7 @@ func (b *eventBroadcaster) runLoop() {
 				"channel", notification.Channel,
 				"payload", notification.Extra,
 			)
+			promPgNotificationsReceived.WithLabelValues(notification.Channel).Inc()
 			b.broadcast(notification)
 		}
 	}
@@ -143,11 +165,17 @@ func (b *eventBroadcaster) runLoop() {
 
 func (b *eventBroadcaster) Notify(channel string, payload string) error {
 	_, err := b.db.Exec(`SELECT pg_notify($1, $2)`, channel, payload)
+	if err == nil {
+		promPgNotificationsPublished.WithLabelValues(channel).Inc()
+	}
 	return errors.Wrap(err, "Postgres event broadcaster could not notify")
 }
 
 func (b *eventBroadcaster) NotifyInsideGormTx(tx *gorm.DB, channel string, payload string) error {
 	err := tx.Exec(`SELECT pg_notify(?, ?)`, channel, payload).Error
+	if err == nil {
+		promPgNotificationsPublished.WithLabelValues(channel).Inc()
+	}
 	return errors.Wrap(err, "Postgres event broadcaster could not notify")
 }
 
@@ -271,7 +299,9 @@ func (sub *subscription) processQueue() {
 		}
 		select {
 		case sub.chEvents <- event:
+			promPgEventsDelivered.WithLabelValues(sub.channel).Inc()
 		case <-ctx.Done():
+			promPgEventsDropped.WithLabelValues(sub.channel).Inc()
 		case <-sub.chDone:
 		}
 	}
@@ -0,0 +1,82 @@
+package postgres
+
+import (
+	"encoding/json"
+	"sync"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// EventBus is a thin layer over the reconnect-safe EventBroadcaster that adds
+// typed JSON payloads and managed handler subscriptions, so that subsystems
+// publishing or consuming Postgres notifications (runs, txs, config changes)
+// share one connection and one delivery pipeline instead of rolling their
+// own LISTEN loops. It embeds the EventBroadcaster, so the raw string API
+// remains available where payloads are plain strings.
+type EventBus struct {
+	EventBroadcaster
+	lggr logger.Logger
+}
+
+func NewEventBus(eb EventBroadcaster, lggr logger.Logger) *EventBus {
+	return &EventBus{EventBroadcaster: eb, lggr: lggr.Named("EventBus")}
+}
+
+// PublishJSON marshals v and publishes it on channel. NOTIFY payloads are
+// limited to 8000 bytes, so v must be small.
+func (b *EventBus) PublishJSON(channel string, v interface{}) error {
+	payload, err := json.Marshal(v)
+	if err != nil {
+		return errors.Wrapf(err, "failed to marshal payload for channel %s", channel)
+	}
+	return b.Notify(channel, string(payload))
+}
+
+// SubscribeFunc subscribes to channel and invokes handler with each event on
+// a dedicated goroutine. The returned unsubscribe function closes the
+// subscription and waits for the goroutine to exit; it must be called exactly
+// once.
+func (b *EventBus) SubscribeFunc(channel, payloadFilter string, handler func(Event)) (unsubscribe func(), err error) {
+	sub, err := b.Subscribe(channel, payloadFilter)
+	if err != nil {
+		return nil, err
+	}
+	chStop := make(chan struct{})
+	var wg sync.WaitGroup
+	wg.Add(1)
+	go func() {
+		defer wg.Done()
+		for {
+			select {
+			case event, ok := <-sub.Events():
+				if !ok {
+					return
+				}
+				handler(event)
+			case <-chStop:
+				return
+			}
+		}
+	}()
+	return func() {
+		close(chStop)
+		sub.Close()
+		wg.Wait()
+	}, nil
+}
+
+// SubscribeJSONFunc is like SubscribeFunc but decodes each payload into a
+// fresh value from newPayload (which must return a pointer) before passing it
+// to handler. Events whose payload does not decode are dropped with a log.
+func (b *EventBus) SubscribeJSONFunc(channel string, newPayload func() interface{}, handler func(interface{})) (unsubscribe func(), err error) {
+	return b.SubscribeFunc(channel, "", func(event Event) {
+		v := newPayload()
+		if err := json.Unmarshal([]byte(event.Payload), v); err != nil {
+			b.lggr.Errorw("Failed to decode event payload", "channel", channel, "error", err)
+			return
+		}
+		handler(v)
+	})
+}
@@ -0,0 +1,48 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func TestEventBus_SubscribeJSONFunc(t *testing.T) {
+	t.Parallel()
+
+	type payload struct {
+		ID int64 `json:"id"`
+	}
+
+	eb := postgres.NewNullEventBroadcaster()
+	bus := postgres.NewEventBus(eb, logger.TestLogger(t))
+
+	received := make(chan interface{}, 1)
+	unsubscribe, err := bus.SubscribeJSONFunc("test_channel", func() interface{} { return new(payload) }, func(v interface{}) {
+		received <- v
+	})
+	require.NoError(t, err)
+	defer unsubscribe()
+
+	eb.Sub.Ch <- postgres.Event{Channel: "test_channel", Payload: `{"id":42}`}
+	select {
+	case v := <-received:
+		assert.Equal(t, &payload{ID: 42}, v)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+
+	// undecodable payloads are dropped
+	eb.Sub.Ch <- postgres.Event{Channel: "test_channel", Payload: `{`}
+	eb.Sub.Ch <- postgres.Event{Channel: "test_channel", Payload: `{"id":43}`}
+	select {
+	case v := <-received:
+		assert.Equal(t, &payload{ID: 43}, v)
+	case <-time.After(5 * time.Second):
+		t.Fatal("timed out waiting for event")
+	}
+}
@@ -0,0 +1,166 @@
+package postgres
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var promDBAvailable = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "db_available",
+	Help: "1 while the database is reachable, 0 during a failover or connection storm",
+})
+
+const (
+	// dbCheckInterval is how often the failover detector pings the database
+	dbCheckInterval = 5 * time.Second
+	// dbCheckTimeout bounds each ping
+	dbCheckTimeout = 2 * time.Second
+	// dbFailureThreshold is the number of consecutive failed pings after
+	// which the database is declared unavailable
+	dbFailureThreshold = 3
+)
+
+// dbAvailable is the process-wide availability flag, maintained by the
+// FailoverDetector and consulted by background services via DBAvailable
+var dbAvailable int32 = 1
+
+// DBAvailable reports whether the database is currently believed reachable.
+// Non-critical background services (reapers, maintenance) should skip their
+// work while it returns false rather than spamming connection errors.
+func DBAvailable() bool {
+	return atomic.LoadInt32(&dbAvailable) == 1
+}
+
+// FailoverDetector watches database connectivity so that a primary failover
+// or connection storm is detected in one place. While the database is down it
+// reports unhealthy (surfacing in /health) and flips the process-wide
+// DBAvailable flag; when connectivity returns it notifies registered
+// callbacks so subsystems can re-establish state that may have been lost
+// during the outage (e.g. caches fed by LISTEN/NOTIFY).
+type FailoverDetector struct {
+	utils.StartStopOnce
+	db     *sqlx.DB
+	lggr   logger.Logger
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+
+	mu        sync.Mutex
+	callbacks []func(available bool)
+
+	consecutiveFailures int
+	available           int32
+}
+
+var _ service.Service = (*FailoverDetector)(nil)
+
+func NewFailoverDetector(db *sqlx.DB, lggr logger.Logger) *FailoverDetector {
+	return &FailoverDetector{
+		db:        db,
+		lggr:      lggr.Named("DBFailoverDetector"),
+		chStop:    make(chan struct{}),
+		available: 1,
+	}
+}
+
+// OnAvailabilityChange registers a callback invoked with false when the
+// database is declared unavailable and with true when connectivity returns.
+// Callbacks must be registered before Start and must not block.
+func (d *FailoverDetector) OnAvailabilityChange(fn func(available bool)) {
+	d.mu.Lock()
+	defer d.mu.Unlock()
+	d.callbacks = append(d.callbacks, fn)
+}
+
+func (d *FailoverDetector) Start() error {
+	return d.StartOnce("DBFailoverDetector", func() error {
+		d.wgDone.Add(1)
+		go d.loop()
+		return nil
+	})
+}
+
+func (d *FailoverDetector) Close() error {
+	return d.StopOnce("DBFailoverDetector", func() error {
+		close(d.chStop)
+		d.wgDone.Wait()
+		return nil
+	})
+}
+
+// Healthy reports unhealthy while the database is unavailable, so a failover
+// shows up in the node's health checks rather than as scattered query errors
+func (d *FailoverDetector) Healthy() error {
+	if err := d.StartStopOnce.Healthy(); err != nil {
+		return err
+	}
+	if !d.Available() {
+		return errors.New("database is unavailable")
+	}
+	return nil
+}
+
+// Available reports whether the database is currently believed reachable
+func (d *FailoverDetector) Available() bool {
+	return atomic.LoadInt32(&d.available) == 1
+}
+
+func (d *FailoverDetector) loop() {
+	defer d.wgDone.Done()
+	ticker := time.NewTicker(utils.WithJitter(dbCheckInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			d.check()
+		case <-d.chStop:
+			return
+		}
+	}
+}
+
+func (d *FailoverDetector) check() {
+	ctx, cancel := context.WithTimeout(context.Background(), dbCheckTimeout)
+	defer cancel()
+	err := d.db.PingContext(ctx)
+	if err != nil {
+		d.consecutiveFailures++
+		d.lggr.Warnw("Database ping failed", "error", err, "consecutiveFailures", d.consecutiveFailures)
+		if d.consecutiveFailures == dbFailureThreshold && d.Available() {
+			d.lggr.Error("Database appears to be down or failing over; pausing non-critical background work until connectivity returns")
+			d.setAvailable(false)
+		}
+		return
+	}
+	d.consecutiveFailures = 0
+	if !d.Available() {
+		d.lggr.Info("Database connectivity restored; resuming background work")
+		d.setAvailable(true)
+	}
+}
+
+func (d *FailoverDetector) setAvailable(available bool) {
+	var v int32
+	if available {
+		v = 1
+	}
+	atomic.StoreInt32(&d.available, v)
+	atomic.StoreInt32(&dbAvailable, v)
+	promDBAvailable.Set(float64(v))
+	d.mu.Lock()
+	callbacks := d.callbacks
+	d.mu.Unlock()
+	for _, fn := range callbacks {
+		fn(available)
+	}
+}
@@ -0,0 +1,51 @@
+package postgres
+
+import (
+	"testing"
+
+	"github.com/smartcontractkit/sqlx"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+func TestFailoverDetector_Check(t *testing.T) {
+	// nothing is listening on port 1, so every ping fails fast
+	db, err := sqlx.Open("postgres", "postgresql://postgres@localhost:1/chainlink_test?sslmode=disable")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	d := NewFailoverDetector(db, logger.TestLogger(t))
+	t.Cleanup(func() { d.setAvailable(true) })
+
+	var transitions []bool
+	d.OnAvailabilityChange(func(available bool) {
+		transitions = append(transitions, available)
+	})
+
+	assert.True(t, d.Available())
+	assert.True(t, DBAvailable())
+
+	// a single failure is tolerated
+	d.check()
+	assert.True(t, d.Available())
+	assert.Empty(t, transitions)
+
+	for i := 0; i < dbFailureThreshold-1; i++ {
+		d.check()
+	}
+	assert.False(t, d.Available())
+	assert.False(t, DBAvailable())
+	assert.Error(t, d.Healthy())
+	assert.Equal(t, []bool{false}, transitions)
+
+	// further failures do not re-notify
+	d.check()
+	assert.Equal(t, []bool{false}, transitions)
+
+	d.setAvailable(true)
+	assert.True(t, d.Available())
+	assert.True(t, DBAvailable())
+	assert.Equal(t, []bool{false, true}, transitions)
+}
@@ -0,0 +1,112 @@
+package postgres
+
+import (
+	"database/sql"
+	"fmt"
+	"time"
+
+	"github.com/lib/pq"
+	"github.com/smartcontractkit/sqlx"
+)
+
+// longTxThreshold is the transaction age above which a transaction is
+// reported as long-running; old transactions hold back vacuum and are a
+// common precursor to bloat-driven outages
+const longTxThreshold = time.Minute
+
+// deadRowWarnFraction is the dead-row fraction above which a table is called
+// out as bloated
+const deadRowWarnFraction = 0.2
+
+// DBHealth aggregates operational health signals for the database, giving
+// operators early warning before the database becomes the outage
+type DBHealth struct {
+	PingLatency time.Duration
+	// ReplicationLag is nil when no read replica is configured
+	ReplicationLag *time.Duration
+	Tables         []DBTableHealth
+	LongRunningTxs []DBLongTx
+	Warnings       []string
+}
+
+// DBTableHealth is a cheap bloat estimate for one of the known-hot tables,
+// based on pg_stat_user_tables counters
+type DBTableHealth struct {
+	Table        string  `db:"relname"`
+	TotalBytes   int64   `db:"total_bytes"`
+	LiveRows     int64   `db:"n_live_tup"`
+	DeadRows     int64   `db:"n_dead_tup"`
+	DeadFraction float64 `db:"-"`
+}
+
+// DBLongTx describes a transaction that has been open longer than
+// longTxThreshold
+type DBLongTx struct {
+	PID      int           `db:"pid"`
+	Duration time.Duration `db:"-"`
+	Seconds  float64       `db:"seconds"`
+	State    string        `db:"state"`
+	Query    string        `db:"query"`
+}
+
+// CheckDBHealth gathers health signals from the primary and, if configured,
+// the read replica (which may be nil). Collection failures degrade to
+// warnings so that a partial report is still returned.
+func CheckDBHealth(db *sqlx.DB, replica *sqlx.DB) DBHealth {
+	var report DBHealth
+	ctx, cancel := DefaultQueryCtx()
+	defer cancel()
+
+	start := time.Now()
+	if err := db.PingContext(ctx); err != nil {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("failed to ping database: %v", err))
+	}
+	report.PingLatency = time.Since(start)
+
+	if err := db.SelectContext(ctx, &report.Tables, `
+		SELECT relname, n_live_tup, n_dead_tup, pg_total_relation_size(relid) AS total_bytes
+		FROM pg_stat_user_tables
+		WHERE relname = ANY($1)
+		ORDER BY relname
+	`, pq.StringArray(MaintenanceTables)); err != nil {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("failed to gather table stats: %v", err))
+	}
+	for i, table := range report.Tables {
+		if total := table.LiveRows + table.DeadRows; total > 0 {
+			report.Tables[i].DeadFraction = float64(table.DeadRows) / float64(total)
+		}
+		if report.Tables[i].DeadFraction > deadRowWarnFraction {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("table %s is ~%.0f%% dead rows; consider vacuuming (see DATABASE_MAINTENANCE_WINDOW)", table.Table, report.Tables[i].DeadFraction*100))
+		}
+	}
+
+	if err := db.SelectContext(ctx, &report.LongRunningTxs, `
+		SELECT pid, EXTRACT(EPOCH FROM (now() - xact_start))::float8 AS seconds, state, LEFT(query, 100) AS query
+		FROM pg_stat_activity
+		WHERE datname = current_database()
+		AND xact_start IS NOT NULL
+		AND now() - xact_start > make_interval(secs => $1)
+		ORDER BY xact_start
+	`, longTxThreshold.Seconds()); err != nil {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("failed to gather long-running transactions: %v", err))
+	}
+	for i, tx := range report.LongRunningTxs {
+		report.LongRunningTxs[i].Duration = time.Duration(tx.Seconds * float64(time.Second))
+	}
+	if n := len(report.LongRunningTxs); n > 0 {
+		report.Warnings = append(report.Warnings, fmt.Sprintf("%d transaction(s) have been open for more than %s; long-running transactions hold back vacuum", n, longTxThreshold))
+	}
+
+	if replica != nil {
+		// NULL means the replica has not replayed any transactions yet
+		var lagSeconds sql.NullFloat64
+		if err := replica.GetContext(ctx, &lagSeconds, `SELECT EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp()))`); err != nil {
+			report.Warnings = append(report.Warnings, fmt.Sprintf("failed to measure replication lag: %v", err))
+		} else if lagSeconds.Valid {
+			lag := time.Duration(lagSeconds.Float64 * float64(time.Second))
+			report.ReplicationLag = &lag
+		}
+	}
+
+	return report
+}
@@ -8,12 +8,25 @@ import (
 	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	uuid "github.com/satori/go.uuid"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/sqlx"
 )
 
+var (
+	promLeaseRenewals = promauto.NewCounter(prometheus.CounterOpts{
+		Name: "lease_lock_renewals",
+		Help: "Total number of times this node successfully renewed its database lease",
+	})
+	promLeaseAge = promauto.NewGauge(prometheus.GaugeOpts{
+		Name: "lease_lock_age_seconds",
+		Help: "Time since this node first took the database lease",
+	})
+)
+
 // LeaseLock handles taking an exclusive lease on database access. This is not
 // enforced by any database primitives, but rather voluntarily respected by
 // other instances of the Chainlink application.
@@ -64,8 +77,9 @@ type leaseLock struct {
 	leaseDuration   time.Duration
 	logger          logger.Logger
 
-	chStop chan struct{}
-	wg     sync.WaitGroup
+	takenAt time.Time
+	chStop  chan struct{}
+	wg      sync.WaitGroup
 }
 
 // NewLeaseLock creates a "leaseLock" - an entity that tries to take an exclusive lease on the database
@@ -73,7 +87,7 @@ func NewLeaseLock(db *sqlx.DB, appID uuid.UUID, lggr logger.Logger, refreshInter
 	if refreshInterval > leaseDuration/2 {
 		panic("refresh interval must be <= half the lease duration")
 	}
-	return &leaseLock{appID, db, refreshInterval, leaseDuration, lggr.Named("LeaseLock").With("appID", appID), make(chan struct{}), sync.WaitGroup{}}
+	return &leaseLock{appID, db, refreshInterval, leaseDuration, lggr.Named("LeaseLock").With("appID", appID), time.Time{}, make(chan struct{}), sync.WaitGroup{}}
 }
 
 // TakeAndHold will block and wait indefinitely until it can get its first lock
@@ -103,6 +117,7 @@ func (l *leaseLock) TakeAndHold() error {
 		}
 	}
 	l.logger.Debug("Got exclusive lease on database")
+	l.takenAt = time.Now()
 	l.wg.Add(1)
 	go l.loop()
 	return nil
@@ -143,6 +158,9 @@ func (l *leaseLock) loop() {
 				l.logger.Errorw("Error trying to refresh database lease", "err", err)
 			} else if !gotLease {
 				panic("another node has taken the lease")
+			} else {
+				promLeaseRenewals.Inc()
+				promLeaseAge.Set(time.Since(l.takenAt).Seconds())
 			}
 		}
 	}
@@ -150,6 +168,7 @@ func (l *leaseLock) loop() {
 
 var initialSQL = []string{
 	`CREATE TABLE IF NOT EXISTS lease_lock (client_id uuid NOT NULL, expires_at timestamptz NOT NULL)`,
+	`ALTER TABLE lease_lock ADD COLUMN IF NOT EXISTS updated_at timestamptz NOT NULL DEFAULT NOW()`,
 	`CREATE UNIQUE INDEX IF NOT EXISTS only_one_lease_lock ON lease_lock ((client_id IS NOT NULL))`,
 }
 
@@ -183,7 +202,7 @@ func (l *leaseLock) getLease(ctx context.Context, isInitial bool) (gotLease bool
 		err = tx.Get(&count, `SELECT count(*) FROM lease_lock`)
 		if count == 0 {
 			// first time anybody claimed a lock on this table
-			_, err = tx.Exec(`INSERT INTO lease_lock (client_id, expires_at) VALUES ($1, NOW()+$2::interval)`, l.id, leaseDuration)
+			_, err = tx.Exec(`INSERT INTO lease_lock (client_id, expires_at, updated_at) VALUES ($1, NOW()+$2::interval, NOW())`, l.id, leaseDuration)
 			gotLease = true
 			return errors.Wrap(err, "failed to create initial lease_lock")
 		} else if count > 1 {
@@ -192,7 +211,7 @@ func (l *leaseLock) getLease(ctx context.Context, isInitial bool) (gotLease bool
 		var res sql.Result
 		res, err = tx.Exec(`
 UPDATE lease_lock
-SET client_id = $1, expires_at = NOW()+$2::interval
+SET client_id = $1, expires_at = NOW()+$2::interval, updated_at = NOW()
 WHERE (
 	lease_lock.client_id = $1
 	OR
@@ -225,3 +244,50 @@ func logRetry(count int) {
 		logger.Infow("Still waiting for lock...", "failCount", count)
 	}
 }
+
+// LeaseLockInfo describes the current holder of the database lease
+type LeaseLockInfo struct {
+	ClientID  uuid.UUID `db:"client_id"`
+	UpdatedAt time.Time `db:"updated_at"`
+	ExpiresAt time.Time `db:"expires_at"`
+}
+
+// Expired is true if the lease has not been renewed in time and may be taken
+// over (or stolen)
+func (i LeaseLockInfo) Expired() bool {
+	return i.ExpiresAt.Before(time.Now())
+}
+
+// GetLeaseLockInfo returns the identity and age of the current database
+// lease. Returns sql.ErrNoRows if the lease has never been taken.
+func GetLeaseLockInfo(db *sqlx.DB) (info LeaseLockInfo, err error) {
+	q := NewQ(db)
+	err = q.Get(&info, `SELECT client_id, updated_at, expires_at FROM lease_lock`)
+	return info, errors.Wrap(err, "failed to query lease_lock")
+}
+
+// StealLeaseLock removes an expired lease left behind by a crashed node, so a
+// fresh node can start immediately instead of spinning until the lease times
+// out. It refuses to remove a lease that is still being renewed; killing a
+// live node's lease out from under it would make that node panic.
+func StealLeaseLock(db *sqlx.DB) error {
+	q := NewQ(db)
+	res, err := q.Exec(`DELETE FROM lease_lock WHERE expires_at < NOW()`)
+	if err != nil {
+		return errors.Wrap(err, "failed to delete expired lease_lock")
+	}
+	rowsAffected, err := res.RowsAffected()
+	if err != nil {
+		return errors.Wrap(err, "failed to delete expired lease_lock (RowsAffected)")
+	}
+	if rowsAffected == 0 {
+		info, err := GetLeaseLockInfo(db)
+		if errors.Is(err, sql.ErrNoRows) {
+			return errors.New("no lease lock found; nothing to steal")
+		} else if err != nil {
+			return err
+		}
+		return errors.Errorf("lease is still held by client %s (expires at %s); refusing to steal an active lease", info.ClientID, info.ExpiresAt)
+	}
+	return nil
+}
@@ -0,0 +1,210 @@
+package postgres
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/service"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promMaintenanceBytesReclaimed = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "db_maintenance_bytes_reclaimed",
+		Help: "Total number of bytes reclaimed from tables by database maintenance",
+	}, []string{"table"})
+	promMaintenanceDuration = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_maintenance_duration_seconds",
+		Help: "Duration of the most recent maintenance run per table",
+	}, []string{"table"})
+)
+
+// MaintenanceTables are the hot tables that accumulate bloat fastest and are
+// worth vacuuming during low-traffic windows
+var MaintenanceTables = []string{"pipeline_runs", "eth_tx_attempts", "log_broadcasts"}
+
+// maintenanceCheckInterval is how often the service checks whether the
+// maintenance window has opened
+const maintenanceCheckInterval = time.Minute
+
+// MaintenanceWindow is a daily window given as UTC offsets from midnight. It
+// may wrap past midnight (e.g. 22:00-04:00).
+type MaintenanceWindow struct {
+	start time.Duration
+	end   time.Duration
+}
+
+// ParseMaintenanceWindow parses a window of the form "HH:MM-HH:MM" (UTC)
+func ParseMaintenanceWindow(s string) (w MaintenanceWindow, err error) {
+	parts := strings.Split(s, "-")
+	if len(parts) != 2 {
+		return w, errors.Errorf("maintenance window must have the form HH:MM-HH:MM, got: %q", s)
+	}
+	parse := func(part string) (time.Duration, error) {
+		t, err2 := time.Parse("15:04", strings.TrimSpace(part))
+		if err2 != nil {
+			return 0, errors.Errorf("invalid time %q in maintenance window, must have the form HH:MM", part)
+		}
+		return time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute, nil
+	}
+	if w.start, err = parse(parts[0]); err != nil {
+		return w, err
+	}
+	if w.end, err = parse(parts[1]); err != nil {
+		return w, err
+	}
+	if w.start == w.end {
+		return w, errors.Errorf("maintenance window must not be empty: %q", s)
+	}
+	return w, nil
+}
+
+// Contains reports whether t falls inside the window
+func (w MaintenanceWindow) Contains(t time.Time) bool {
+	t = t.UTC()
+	offset := time.Duration(t.Hour())*time.Hour + time.Duration(t.Minute())*time.Minute
+	if w.start < w.end {
+		return offset >= w.start && offset < w.end
+	}
+	// wraps past midnight
+	return offset >= w.start || offset < w.end
+}
+
+// currentStart returns the start of the window occurrence containing t, used
+// to run at most once per occurrence
+func (w MaintenanceWindow) currentStart(t time.Time) time.Time {
+	t = t.UTC()
+	midnight := time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, time.UTC)
+	start := midnight.Add(w.start)
+	if start.After(t) {
+		start = start.AddDate(0, 0, -1)
+	}
+	return start
+}
+
+// Maintenance runs batched VACUUM (ANALYZE) over the hot tables once per
+// configured low-traffic window, reporting the bloat reclaimed. VACUUM only
+// takes a SHARE UPDATE EXCLUSIVE lock so normal operation continues, but it
+// is IO-heavy, hence the window.
+type Maintenance struct {
+	utils.StartStopOnce
+	db     *sqlx.DB
+	window MaintenanceWindow
+	lggr   logger.Logger
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+
+	lastWindowStart time.Time
+}
+
+var _ service.Service = (*Maintenance)(nil)
+
+func NewMaintenance(db *sqlx.DB, window MaintenanceWindow, lggr logger.Logger) *Maintenance {
+	return &Maintenance{
+		db:     db,
+		window: window,
+		lggr:   lggr.Named("DBMaintenance"),
+		chStop: make(chan struct{}),
+	}
+}
+
+func (m *Maintenance) Start() error {
+	return m.StartOnce("DBMaintenance", func() error {
+		m.wgDone.Add(1)
+		go m.loop()
+		return nil
+	})
+}
+
+func (m *Maintenance) Close() error {
+	return m.StopOnce("DBMaintenance", func() error {
+		close(m.chStop)
+		m.wgDone.Wait()
+		return nil
+	})
+}
+
+func (m *Maintenance) loop() {
+	defer m.wgDone.Done()
+	ticker := time.NewTicker(utils.WithJitter(maintenanceCheckInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ticker.C:
+			now := time.Now()
+			if !m.window.Contains(now) {
+				continue
+			}
+			if !DBAvailable() {
+				// wait for connectivity; VACUUM would only add load during
+				// a failover
+				continue
+			}
+			windowStart := m.window.currentStart(now)
+			if windowStart.Equal(m.lastWindowStart) {
+				// already ran in this occurrence of the window
+				continue
+			}
+			m.run()
+			m.lastWindowStart = windowStart
+		case <-m.chStop:
+			return
+		}
+	}
+}
+
+// run vacuums the maintenance tables one at a time, bailing out between
+// tables if the service is being shut down
+func (m *Maintenance) run() {
+	for _, table := range MaintenanceTables {
+		select {
+		case <-m.chStop:
+			return
+		default:
+		}
+		if err := m.vacuum(table); err != nil {
+			m.lggr.Errorw("Failed to vacuum table", "table", table, "error", err)
+		}
+	}
+}
+
+func (m *Maintenance) vacuum(table string) error {
+	before, err := m.tableSize(table)
+	if err != nil {
+		return err
+	}
+	if before < 0 {
+		// table does not exist in this schema
+		return nil
+	}
+	start := time.Now()
+	// the table name comes from the static MaintenanceTables list, not user input
+	if _, err = m.db.Exec(fmt.Sprintf(`VACUUM (ANALYZE) %s`, table)); err != nil {
+		return err
+	}
+	elapsed := time.Since(start)
+	after, err := m.tableSize(table)
+	if err != nil {
+		return err
+	}
+	reclaimed := before - after
+	if reclaimed > 0 {
+		promMaintenanceBytesReclaimed.WithLabelValues(table).Add(float64(reclaimed))
+	}
+	promMaintenanceDuration.WithLabelValues(table).Set(elapsed.Seconds())
+	m.lggr.Infow("Vacuumed table", "table", table, "durationMs", elapsed.Milliseconds(), "bytesReclaimed", reclaimed)
+	return nil
+}
+
+func (m *Maintenance) tableSize(table string) (size int64, err error) {
+	err = m.db.Get(&size, `SELECT COALESCE(pg_total_relation_size(to_regclass($1)), -1)`, table)
+	return size, errors.Wrapf(err, "failed to size table %s", table)
+}
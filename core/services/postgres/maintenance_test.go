@@ -0,0 +1,43 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func Test_ParseMaintenanceWindow(t *testing.T) {
+	t.Parallel()
+
+	at := func(hour, min int) time.Time {
+		return time.Date(2021, 10, 14, hour, min, 0, 0, time.UTC)
+	}
+
+	t.Run("same-day window", func(t *testing.T) {
+		w, err := postgres.ParseMaintenanceWindow("03:00-05:30")
+		require.NoError(t, err)
+		assert.False(t, w.Contains(at(2, 59)))
+		assert.True(t, w.Contains(at(3, 0)))
+		assert.True(t, w.Contains(at(5, 29)))
+		assert.False(t, w.Contains(at(5, 30)))
+	})
+
+	t.Run("window wrapping past midnight", func(t *testing.T) {
+		w, err := postgres.ParseMaintenanceWindow("22:00-04:00")
+		require.NoError(t, err)
+		assert.True(t, w.Contains(at(23, 0)))
+		assert.True(t, w.Contains(at(1, 0)))
+		assert.False(t, w.Contains(at(12, 0)))
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		for _, s := range []string{"", "03:00", "3pm-5pm", "03:00-03:00", "03:00-05:00-07:00"} {
+			_, err := postgres.ParseMaintenanceWindow(s)
+			assert.Error(t, err, s)
+		}
+	})
+}
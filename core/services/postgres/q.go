@@ -6,13 +6,85 @@ package postgres
 import (
 	"context"
 	"database/sql"
+	"reflect"
+	"runtime"
+	"strings"
+	"sync/atomic"
+	"time"
 
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/sqlx"
 )
 
+var promSQLQueryDuration = promauto.NewHistogramVec(prometheus.HistogramOpts{
+	Name:    "sql_query_duration_seconds",
+	Help:    "SQL query latency, labelled by the calling function",
+	Buckets: []float64{0.001, 0.005, 0.025, 0.1, 0.5, 1, 5, 30},
+}, []string{"query"})
+
+// DefaultSlowQueryThreshold applies until SetSlowQueryThreshold is called
+// with the configured value
+const DefaultSlowQueryThreshold = 1 * time.Second
+
+var slowQueryThresholdNanos = int64(DefaultSlowQueryThreshold)
+
+// SetSlowQueryThreshold sets the duration above which individual queries are
+// logged as slow; zero disables slow query logging
+func SetSlowQueryThreshold(d time.Duration) {
+	atomic.StoreInt64(&slowQueryThresholdNanos, int64(d))
+}
+
+// SlowQueryThreshold returns the current slow query logging threshold
+func SlowQueryThreshold() time.Duration {
+	return time.Duration(atomic.LoadInt64(&slowQueryThresholdNanos))
+}
+
+// postQuery records the latency of a finished query against the caller's
+// histogram and logs the query if it was slow. Bound arguments are
+// deliberately never logged since they may contain sensitive data. rows is -1
+// when the row count is unknown.
+func postQuery(start time.Time, query string, rows int64) {
+	elapsed := time.Since(start)
+	caller := queryCaller()
+	promSQLQueryDuration.WithLabelValues(caller).Observe(elapsed.Seconds())
+	if threshold := SlowQueryThreshold(); threshold > 0 && elapsed > threshold {
+		logger.Warnw("Slow SQL query", "caller", caller, "durationMs", elapsed.Milliseconds(), "rows", rows, "sql", query)
+	}
+}
+
+// queryCaller walks up the stack to the first frame outside this package,
+// i.e. the ORM method that issued the query
+func queryCaller() string {
+	var pcs [10]uintptr
+	n := runtime.Callers(3, pcs[:])
+	frames := runtime.CallersFrames(pcs[:n])
+	for {
+		frame, more := frames.Next()
+		if !strings.Contains(frame.Function, "/core/services/postgres") {
+			if i := strings.LastIndex(frame.Function, "/"); i >= 0 {
+				return frame.Function[i+1:]
+			}
+			return frame.Function
+		}
+		if !more {
+			return "unknown"
+		}
+	}
+}
+
+// sliceLen returns the number of rows scanned into dest if it is a pointer to
+// a slice, otherwise -1
+func sliceLen(dest interface{}) int64 {
+	if rv := reflect.ValueOf(dest); rv.Kind() == reflect.Ptr && rv.Elem().Kind() == reflect.Slice {
+		return int64(rv.Elem().Len())
+	}
+	return -1
+}
+
 // QOpt pattern for ORM methods aims to clarify usage and remove some common footguns, notably:
 //
 // 1. It should be easy and obvious how to pass a parent context or a transaction into an ORM method
@@ -62,6 +134,14 @@ func WithParentCtx(ctx context.Context) func(q *Q) {
 	}
 }
 
+// WithQueryClass sets the query class, which bounds statement runtime for any
+// transactions opened through this Q
+func WithQueryClass(class QueryClass) func(q *Q) {
+	return func(q *Q) {
+		q.QueryClass = class
+	}
+}
+
 var _ Queryer = Q{}
 
 // Q wraps an underlying queryer (either a *sqlx.DB or a *sqlx.Tx)
@@ -79,8 +159,9 @@ var _ Queryer = Q{}
 // can do.
 type Q struct {
 	Queryer
-	lggr      logger.Logger
-	ParentCtx context.Context
+	lggr       logger.Logger
+	ParentCtx  context.Context
+	QueryClass QueryClass
 }
 
 // NewQFromOpts is intended to be used in ORMs where the caller may wish to use
@@ -118,37 +199,63 @@ func (q Q) Context() (context.Context, context.CancelFunc) {
 func (q Q) Transaction(lggr logger.Logger, fc func(q Queryer) error) error {
 	ctx, cancel := q.Context()
 	defer cancel()
-	return SqlxTransaction(ctx, q.Queryer, lggr, fc)
+	return SqlxTransaction(ctx, q.Queryer, lggr, fc, q.QueryClass.TxOptions())
 }
 func (q Q) Query(query string, args ...interface{}) (*sql.Rows, error) {
 	ctx, cancel := q.Context()
 	defer cancel()
+	defer postQuery(time.Now(), query, -1)
 	return q.Queryer.QueryContext(ctx, query, args...)
 }
 func (q Q) Queryx(query string, args ...interface{}) (*sqlx.Rows, error) {
 	ctx, cancel := q.Context()
 	defer cancel()
+	defer postQuery(time.Now(), query, -1)
 	return q.Queryer.QueryxContext(ctx, query, args...)
 }
 func (q Q) QueryRowx(query string, args ...interface{}) *sqlx.Row {
 	ctx, cancel := q.Context()
 	defer cancel()
+	defer postQuery(time.Now(), query, -1)
 	return q.Queryer.QueryRowxContext(ctx, query, args...)
 }
 func (q Q) Exec(query string, args ...interface{}) (sql.Result, error) {
 	ctx, cancel := q.Context()
 	defer cancel()
-	return q.Queryer.ExecContext(ctx, query, args...)
+	start := time.Now()
+	res, err := q.Queryer.ExecContext(ctx, query, args...)
+	rows := int64(-1)
+	if err == nil && res != nil {
+		if ra, raErr := res.RowsAffected(); raErr == nil {
+			rows = ra
+		}
+	}
+	postQuery(start, query, rows)
+	return res, err
 }
 func (q Q) Select(dest interface{}, query string, args ...interface{}) error {
 	ctx, cancel := q.Context()
 	defer cancel()
-	return q.Queryer.SelectContext(ctx, dest, query, args...)
+	start := time.Now()
+	err := q.Queryer.SelectContext(ctx, dest, query, args...)
+	rows := int64(-1)
+	if err == nil {
+		rows = sliceLen(dest)
+	}
+	postQuery(start, query, rows)
+	return err
 }
 func (q Q) Get(dest interface{}, query string, args ...interface{}) error {
 	ctx, cancel := q.Context()
 	defer cancel()
-	return q.Queryer.GetContext(ctx, dest, query, args...)
+	start := time.Now()
+	err := q.Queryer.GetContext(ctx, dest, query, args...)
+	rows := int64(-1)
+	if err == nil {
+		rows = 1
+	}
+	postQuery(start, query, rows)
+	return err
 }
 
 func (q Q) GetNamed(sql string, dest interface{}, arg interface{}) error {
@@ -158,5 +265,12 @@ func (q Q) GetNamed(sql string, dest interface{}, arg interface{}) error {
 	}
 	ctx, cancel := q.Context()
 	defer cancel()
-	return errors.Wrap(q.GetContext(ctx, dest, query, args...), "error in get query")
+	start := time.Now()
+	err = q.GetContext(ctx, dest, query, args...)
+	rows := int64(-1)
+	if err == nil {
+		rows = 1
+	}
+	postQuery(start, query, rows)
+	return errors.Wrap(err, "error in get query")
 }
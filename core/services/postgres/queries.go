@@ -1,15 +1,74 @@
 package postgres
 
+import (
+	"sync/atomic"
+
+	"golang.org/x/sync/errgroup"
+)
+
 // BatchSize is the default number of DB records to access in one batch
 const BatchSize uint = 1000
 
+var defaultBatchSize = uint32(BatchSize)
+
+// SetDefaultBatchSize overrides the batch size used by Batch calls that do
+// not set one explicitly; zero restores the built-in default
+func SetDefaultBatchSize(size uint) {
+	if size == 0 {
+		size = BatchSize
+	}
+	atomic.StoreUint32(&defaultBatchSize, uint32(size))
+}
+
+// DefaultBatchSize returns the current default number of DB records to access
+// in one batch
+func DefaultBatchSize() uint {
+	return uint(atomic.LoadUint32(&defaultBatchSize))
+}
+
 // BatchFunc is the function to execute on each batch of records, should return the count of records affected
 type BatchFunc func(offset, limit uint) (count uint, err error)
 
+// BatchOpt configures an individual Batch call
+type BatchOpt func(*batchConfig)
+
+type batchConfig struct {
+	size    uint
+	workers uint
+}
+
+// WithBatchSize overrides the batch size for this call
+func WithBatchSize(size uint) BatchOpt {
+	return func(cfg *batchConfig) {
+		cfg.size = size
+	}
+}
+
+// WithBatchConcurrency executes up to workers batches in parallel. Batches
+// are dispatched in rounds and iteration stops after the first round that
+// contains a short batch, so this is only suitable for callbacks whose
+// batches are independent of each other (e.g. read-only paging)
+func WithBatchConcurrency(workers uint) BatchOpt {
+	return func(cfg *batchConfig) {
+		cfg.workers = workers
+	}
+}
+
 // Batch is an iterator for batches of records
-func Batch(cb BatchFunc) error {
+func Batch(cb BatchFunc, opts ...BatchOpt) error {
+	cfg := batchConfig{size: DefaultBatchSize(), workers: 1}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+	if cfg.size == 0 {
+		cfg.size = DefaultBatchSize()
+	}
+	if cfg.workers > 1 {
+		return batchConcurrently(cb, cfg)
+	}
+
 	offset := uint(0)
-	limit := BatchSize
+	limit := cfg.size
 
 	for {
 		count, err := cb(offset, limit)
@@ -24,3 +83,31 @@ func Batch(cb BatchFunc) error {
 		offset += limit
 	}
 }
+
+func batchConcurrently(cb BatchFunc, cfg batchConfig) error {
+	offset := uint(0)
+	for {
+		var g errgroup.Group
+		var short uint32
+		for i := uint(0); i < cfg.workers; i++ {
+			offset := offset + i*cfg.size
+			g.Go(func() error {
+				count, err := cb(offset, cfg.size)
+				if err != nil {
+					return err
+				}
+				if count < cfg.size {
+					atomic.StoreUint32(&short, 1)
+				}
+				return nil
+			})
+		}
+		if err := g.Wait(); err != nil {
+			return err
+		}
+		if atomic.LoadUint32(&short) == 1 {
+			return nil
+		}
+		offset += cfg.workers * cfg.size
+	}
+}
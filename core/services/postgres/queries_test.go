@@ -0,0 +1,56 @@
+package postgres_test
+
+import (
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func Test_Batch_WithBatchSize(t *testing.T) {
+	t.Parallel()
+
+	var offsets []uint
+	err := postgres.Batch(func(offset, limit uint) (count uint, err error) {
+		require.Equal(t, uint(10), limit)
+		offsets = append(offsets, offset)
+		if offset >= 20 {
+			return 5, nil // short batch terminates iteration
+		}
+		return limit, nil
+	}, postgres.WithBatchSize(10))
+	require.NoError(t, err)
+	assert.Equal(t, []uint{0, 10, 20}, offsets)
+}
+
+func Test_Batch_WithBatchConcurrency(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	seen := make(map[uint]bool)
+	err := postgres.Batch(func(offset, limit uint) (count uint, err error) {
+		mu.Lock()
+		seen[offset] = true
+		mu.Unlock()
+		if offset >= 30 {
+			return 0, nil
+		}
+		return limit, nil
+	}, postgres.WithBatchSize(10), postgres.WithBatchConcurrency(2))
+	require.NoError(t, err)
+	// two full rounds of two workers each; the second round contains the
+	// first short batch
+	assert.Equal(t, map[uint]bool{0: true, 10: true, 20: true, 30: true}, seen)
+}
+
+func Test_SetDefaultBatchSize(t *testing.T) {
+	postgres.SetDefaultBatchSize(123)
+	defer postgres.SetDefaultBatchSize(0)
+	assert.Equal(t, uint(123), postgres.DefaultBatchSize())
+
+	postgres.SetDefaultBatchSize(0)
+	assert.Equal(t, postgres.BatchSize, postgres.DefaultBatchSize())
+}
@@ -0,0 +1,49 @@
+package postgres
+
+import "time"
+
+// QueryClass buckets database queries by who is waiting on them. Each class
+// maps to a statement_timeout applied with SET LOCAL at the start of the
+// transaction, so that e.g. a slow analytics query is cancelled by the server
+// rather than holding locks that stall critical transaction-manager queries.
+type QueryClass string
+
+const (
+	// QueryClassInteractive is for queries serving an interactive API
+	// request; a user is actively waiting on these so they are cut off
+	// quickly.
+	QueryClassInteractive QueryClass = "interactive"
+	// QueryClassBatch is for background batch work such as exports, stats
+	// and reapers, which may legitimately run long but must never run away.
+	QueryClassBatch QueryClass = "batch"
+	// QueryClassCritical is for queries on the transaction-management hot
+	// path (broadcasting, confirming); these get some slack over interactive
+	// queries but are still bounded.
+	QueryClassCritical QueryClass = "critical"
+)
+
+// Statement timeouts applied per query class.
+const (
+	InteractiveStatementTimeout = DefaultQueryTimeout
+	BatchStatementTimeout       = 5 * time.Minute
+	CriticalStatementTimeout    = 30 * time.Second
+)
+
+// StatementTimeout returns the statement_timeout for the class. An unset
+// class returns zero, meaning no per-transaction timeout is applied.
+func (c QueryClass) StatementTimeout() time.Duration {
+	switch c {
+	case QueryClassInteractive:
+		return InteractiveStatementTimeout
+	case QueryClassBatch:
+		return BatchStatementTimeout
+	case QueryClassCritical:
+		return CriticalStatementTimeout
+	}
+	return 0
+}
+
+// TxOptions returns TxOptions that apply the class's statement timeout.
+func (c QueryClass) TxOptions() TxOptions {
+	return TxOptions{StatementTimeout: c.StatementTimeout()}
+}
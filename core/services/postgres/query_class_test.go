@@ -0,0 +1,19 @@
+package postgres_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+func Test_QueryClass_StatementTimeout(t *testing.T) {
+	assert.Equal(t, postgres.InteractiveStatementTimeout, postgres.QueryClassInteractive.StatementTimeout())
+	assert.Equal(t, postgres.BatchStatementTimeout, postgres.QueryClassBatch.StatementTimeout())
+	assert.Equal(t, postgres.CriticalStatementTimeout, postgres.QueryClassCritical.StatementTimeout())
+	assert.Equal(t, time.Duration(0), postgres.QueryClass("").StatementTimeout())
+
+	assert.Equal(t, postgres.TxOptions{StatementTimeout: postgres.BatchStatementTimeout}, postgres.QueryClassBatch.TxOptions())
+}
@@ -0,0 +1,87 @@
+package postgres
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// replicaLagCheckInterval is how often the replica's replication lag is
+// re-measured; between checks the previous verdict is reused so that routing
+// a query does not cost an extra round trip.
+const replicaLagCheckInterval = 10 * time.Second
+
+// ReadReplica routes read-only queries to a read replica while it is healthy,
+// falling back to the primary when the replica lags beyond maxLag or cannot
+// be reached. Writes must never go through a ReadReplica.
+type ReadReplica struct {
+	primary *sqlx.DB
+	replica *sqlx.DB
+	maxLag  time.Duration
+	lggr    logger.Logger
+
+	mu        sync.Mutex
+	lastCheck time.Time
+	lagging   bool
+}
+
+// NewReadReplica returns a ReadReplica that serves reads from replica with
+// fallback to primary.
+func NewReadReplica(primary, replica *sqlx.DB, maxLag time.Duration, lggr logger.Logger) *ReadReplica {
+	return &ReadReplica{
+		primary: primary,
+		replica: replica,
+		maxLag:  maxLag,
+		lggr:    lggr.Named("ReadReplica"),
+	}
+}
+
+// Queryer returns the connection that read queries should use right now: the
+// replica when healthy, the primary otherwise.
+func (r *ReadReplica) Queryer() Queryer {
+	if r.healthy() {
+		return r.replica
+	}
+	return r.primary
+}
+
+// healthy reports whether the replica is reachable and within maxLag of the
+// primary. The lag measurement is cached for replicaLagCheckInterval.
+func (r *ReadReplica) healthy() bool {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if time.Since(r.lastCheck) < replicaLagCheckInterval {
+		return !r.lagging
+	}
+	r.lastCheck = time.Now()
+
+	// NULL either means this is not a replica at all or that no transactions
+	// have been replayed yet (an idle primary); in both cases there is no lag
+	// to speak of.
+	var lagSeconds sql.NullFloat64
+	err := r.replica.Get(&lagSeconds, `SELECT EXTRACT(EPOCH FROM (now() - pg_last_xact_replay_timestamp()))`)
+	if err != nil {
+		if !r.lagging {
+			r.lggr.Warnw("Read replica is unreachable, falling back to primary for reads", "error", err)
+		}
+		r.lagging = true
+		return false
+	}
+	lag := time.Duration(lagSeconds.Float64 * float64(time.Second))
+	if lagSeconds.Valid && lag > r.maxLag {
+		if !r.lagging {
+			r.lggr.Warnw("Read replica is lagging, falling back to primary for reads", "lag", lag, "maxLag", r.maxLag)
+		}
+		r.lagging = true
+		return false
+	}
+	if r.lagging {
+		r.lggr.Infow("Read replica is healthy again, resuming reads from replica", "lag", lag)
+	}
+	r.lagging = false
+	return true
+}
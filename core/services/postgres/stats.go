@@ -0,0 +1,115 @@
+package postgres
+
+import (
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+var (
+	promDBConnsMaxOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_conns_max_open",
+		Help: "Maximum number of open connections allowed in the pool",
+	}, []string{"db"})
+	promDBConnsOpen = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_conns_open",
+		Help: "Number of established connections, both in use and idle",
+	}, []string{"db"})
+	promDBConnsInUse = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_conns_in_use",
+		Help: "Number of connections currently in use",
+	}, []string{"db"})
+	promDBConnsIdle = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_conns_idle",
+		Help: "Number of idle connections in the pool",
+	}, []string{"db"})
+	promDBWaitCount = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_wait_count",
+		Help: "Total number of times a query had to wait for a connection to become available",
+	}, []string{"db"})
+	promDBWaitSeconds = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "db_wait_time_seconds",
+		Help: "Total time spent blocked waiting for a connection to become available",
+	}, []string{"db"})
+)
+
+const dbStatsInterval = 10 * time.Second
+
+// StatsReporter periodically exports connection pool statistics for each
+// registered database so that pool exhaustion shows up in metrics rather than
+// as unexplained query latency.
+type StatsReporter struct {
+	utils.StartStopOnce
+	dbs    []namedDB
+	lggr   logger.Logger
+	chStop chan struct{}
+	wgDone sync.WaitGroup
+}
+
+type namedDB struct {
+	name string
+	db   *sql.DB
+}
+
+func NewStatsReporter(lggr logger.Logger) *StatsReporter {
+	return &StatsReporter{
+		lggr:   lggr.Named("DBStatsReporter"),
+		chStop: make(chan struct{}),
+	}
+}
+
+// RegisterDB adds a pool to be reported on under the given logical consumer
+// name. It must be called before Start.
+func (r *StatsReporter) RegisterDB(name string, db *sql.DB) {
+	r.dbs = append(r.dbs, namedDB{name: name, db: db})
+}
+
+func (r *StatsReporter) Start() error {
+	return r.StartOnce("DBStatsReporter", func() error {
+		r.wgDone.Add(1)
+		go r.loop()
+		return nil
+	})
+}
+
+func (r *StatsReporter) Close() error {
+	return r.StopOnce("DBStatsReporter", func() error {
+		close(r.chStop)
+		r.wgDone.Wait()
+		return nil
+	})
+}
+
+func (r *StatsReporter) loop() {
+	defer r.wgDone.Done()
+	ticker := time.NewTicker(utils.WithJitter(dbStatsInterval))
+	defer ticker.Stop()
+
+	r.report()
+	for {
+		select {
+		case <-ticker.C:
+			r.report()
+		case <-r.chStop:
+			return
+		}
+	}
+}
+
+func (r *StatsReporter) report() {
+	for _, ndb := range r.dbs {
+		stats := ndb.db.Stats()
+		promDBConnsMaxOpen.WithLabelValues(ndb.name).Set(float64(stats.MaxOpenConnections))
+		promDBConnsOpen.WithLabelValues(ndb.name).Set(float64(stats.OpenConnections))
+		promDBConnsInUse.WithLabelValues(ndb.name).Set(float64(stats.InUse))
+		promDBConnsIdle.WithLabelValues(ndb.name).Set(float64(stats.Idle))
+		promDBWaitCount.WithLabelValues(ndb.name).Set(float64(stats.WaitCount))
+		promDBWaitSeconds.WithLabelValues(ndb.name).Set(stats.WaitDuration.Seconds())
+	}
+}
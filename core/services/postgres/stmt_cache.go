@@ -0,0 +1,178 @@
+package postgres
+
+import (
+	"context"
+	"database/sql"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"github.com/smartcontractkit/sqlx"
+)
+
+var promPreparedStmts = promauto.NewGauge(prometheus.GaugeOpts{
+	Name: "db_prepared_stmts",
+	Help: "Number of distinct queries held in prepared statement caches",
+})
+
+// stmtCaches tracks every cache so that all prepared state can be dropped at
+// once, e.g. after a database failover
+var (
+	stmtCachesMu sync.Mutex
+	stmtCaches   []*StmtCache
+)
+
+// ClearStmtCaches drops all cached prepared statements process-wide. It is
+// called after a database failover, since statements prepared against the old
+// primary must be re-established against the new one.
+func ClearStmtCaches() {
+	stmtCachesMu.Lock()
+	caches := make([]*StmtCache, len(stmtCaches))
+	copy(caches, stmtCaches)
+	stmtCachesMu.Unlock()
+	for _, c := range caches {
+		c.Clear()
+	}
+}
+
+// StmtCache caches prepared statements for an ORM's highest-frequency
+// queries, avoiding repeated parse/plan overhead on the server. Statements
+// are prepared against the pool, so database/sql transparently re-prepares
+// them on each connection as needed; queries running inside a transaction are
+// rebound to the transaction's connection, which reuses the connection's
+// prepared statement when one exists.
+//
+// Use it only for queries with a fixed shape: statements built dynamically
+// (e.g. variable-length VALUES lists) would grow the cache without bound.
+type StmtCache struct {
+	db    *sqlx.DB
+	mu    sync.RWMutex
+	stmts map[string]*sqlx.Stmt
+}
+
+// DB returns the pool this cache prepares statements on
+func (c *StmtCache) DB() *sqlx.DB {
+	return c.db
+}
+
+func NewStmtCache(db *sqlx.DB) *StmtCache {
+	c := &StmtCache{db: db, stmts: make(map[string]*sqlx.Stmt)}
+	stmtCachesMu.Lock()
+	stmtCaches = append(stmtCaches, c)
+	stmtCachesMu.Unlock()
+	return c
+}
+
+// GetContext behaves like Queryer.GetContext, executing through a cached
+// prepared statement when possible
+func (c *StmtCache) GetContext(ctx context.Context, queryer Queryer, dest interface{}, query string, args ...interface{}) error {
+	stmt, err := c.bind(queryer, query)
+	if err != nil {
+		return err
+	}
+	if stmt == nil {
+		return queryer.GetContext(ctx, dest, query, args...)
+	}
+	defer postQuery(time.Now(), query, 1)
+	return stmt.GetContext(ctx, dest, args...)
+}
+
+// SelectContext behaves like Queryer.SelectContext, executing through a
+// cached prepared statement when possible
+func (c *StmtCache) SelectContext(ctx context.Context, queryer Queryer, dest interface{}, query string, args ...interface{}) error {
+	stmt, err := c.bind(queryer, query)
+	if err != nil {
+		return err
+	}
+	if stmt == nil {
+		return queryer.SelectContext(ctx, dest, query, args...)
+	}
+	start := time.Now()
+	err = stmt.SelectContext(ctx, dest, args...)
+	rows := int64(-1)
+	if err == nil {
+		rows = sliceLen(dest)
+	}
+	postQuery(start, query, rows)
+	return err
+}
+
+// ExecContext behaves like Queryer.ExecContext, executing through a cached
+// prepared statement when possible
+func (c *StmtCache) ExecContext(ctx context.Context, queryer Queryer, query string, args ...interface{}) (sql.Result, error) {
+	stmt, err := c.bind(queryer, query)
+	if err != nil {
+		return nil, err
+	}
+	if stmt == nil {
+		return queryer.ExecContext(ctx, query, args...)
+	}
+	start := time.Now()
+	res, err := stmt.ExecContext(ctx, args...)
+	rows := int64(-1)
+	if err == nil && res != nil {
+		if ra, raErr := res.RowsAffected(); raErr == nil {
+			rows = ra
+		}
+	}
+	postQuery(start, query, rows)
+	return res, err
+}
+
+// Clear closes and drops all cached statements. Subsequent queries re-prepare
+// on demand.
+func (c *StmtCache) Clear() {
+	c.mu.Lock()
+	stmts := c.stmts
+	c.stmts = make(map[string]*sqlx.Stmt)
+	c.mu.Unlock()
+	for _, stmt := range stmts {
+		stmt.Close()
+	}
+	promPreparedStmts.Sub(float64(len(stmts)))
+}
+
+// bind returns a prepared statement usable with queryer: the pool statement
+// itself when querying the cache's own pool, a rebind to the transaction's
+// connection for a *sqlx.Tx, or nil when queryer is anything else (a mock, or
+// a different pool), in which case the caller must fall back to unprepared
+// execution.
+func (c *StmtCache) bind(queryer Queryer, query string) (*sqlx.Stmt, error) {
+	switch q := queryer.(type) {
+	case *sqlx.DB:
+		if q != c.db {
+			return nil, nil
+		}
+		return c.prepare(query)
+	case *sqlx.Tx:
+		stmt, err := c.prepare(query)
+		if err != nil {
+			return nil, err
+		}
+		// closed automatically when the transaction completes
+		return q.Stmtx(stmt), nil
+	}
+	return nil, nil
+}
+
+func (c *StmtCache) prepare(query string) (*sqlx.Stmt, error) {
+	c.mu.RLock()
+	stmt, ok := c.stmts[query]
+	c.mu.RUnlock()
+	if ok {
+		return stmt, nil
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if stmt, ok = c.stmts[query]; ok {
+		return stmt, nil
+	}
+	stmt, err := c.db.Preparex(query)
+	if err != nil {
+		return nil, err
+	}
+	c.stmts[query] = stmt
+	promPreparedStmts.Inc()
+	return stmt, nil
+}
@@ -0,0 +1,39 @@
+package postgres_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/smartcontractkit/sqlx"
+	"github.com/stretchr/testify/mock"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/postgres/mocks"
+)
+
+func TestStmtCache_FallsBackForUnknownQueryers(t *testing.T) {
+	t.Parallel()
+
+	db, err := sqlx.Open("postgres", "postgresql://postgres@localhost:1/chainlink_test?sslmode=disable")
+	require.NoError(t, err)
+	t.Cleanup(func() { require.NoError(t, db.Close()) })
+
+	cache := postgres.NewStmtCache(db)
+	t.Cleanup(cache.Clear)
+
+	// a mock queryer is neither the cache's pool nor a transaction, so the
+	// query must be executed unprepared through the queryer itself
+	queryer := new(mocks.Queryer)
+	ctx := context.Background()
+
+	var dest int
+	queryer.On("GetContext", ctx, &dest, "SELECT 1", mock.Anything).Return(nil).Once()
+	require.NoError(t, cache.GetContext(ctx, queryer, &dest, "SELECT 1"))
+
+	queryer.On("ExecContext", ctx, "UPDATE foo SET bar = $1", mock.Anything).Return(nil, nil).Once()
+	_, err = cache.ExecContext(ctx, queryer, "UPDATE foo SET bar = $1", 42)
+	require.NoError(t, err)
+
+	queryer.AssertExpectations(t)
+}
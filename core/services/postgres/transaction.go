@@ -8,6 +8,7 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/tracing"
 	"github.com/smartcontractkit/sqlx"
 	"go.uber.org/multierr"
 	"gorm.io/gorm"
@@ -17,6 +18,9 @@ type TxOptions struct {
 	sql.TxOptions
 	LockTimeout            time.Duration
 	IdleInTxSessionTimeout time.Duration
+	// StatementTimeout aborts any single statement in the transaction that
+	// runs longer than this. Zero leaves the server default in place.
+	StatementTimeout time.Duration
 }
 
 // NOTE: In an ideal world the timeouts below would be set to something sane in
@@ -41,9 +45,10 @@ var (
 	ErrNoDeadlineSet = errors.New("no deadline set")
 )
 
-func applyDefaults(optss []TxOptions) (lockTimeout, idleInTxSessionTimeout time.Duration, txOpts sql.TxOptions) {
-	lockTimeout = DefaultLockTimeout
-	idleInTxSessionTimeout = DefaultIdleInTxSessionTimeout
+func applyDefaults(optss []TxOptions) (timeoutsSQL string, txOpts sql.TxOptions) {
+	lockTimeout := DefaultLockTimeout
+	idleInTxSessionTimeout := DefaultIdleInTxSessionTimeout
+	var statementTimeout time.Duration
 	txIsolation := DefaultIsolation
 	readOnly := false
 	if len(optss) > 0 {
@@ -54,11 +59,16 @@ func applyDefaults(optss []TxOptions) (lockTimeout, idleInTxSessionTimeout time.
 		if opts.IdleInTxSessionTimeout != 0 {
 			idleInTxSessionTimeout = opts.IdleInTxSessionTimeout
 		}
+		statementTimeout = opts.StatementTimeout
 		if opts.Isolation != 0 {
 			txIsolation = opts.Isolation
 		}
 		readOnly = opts.ReadOnly
 	}
+	timeoutsSQL = fmt.Sprintf(`SET LOCAL lock_timeout = %v; SET LOCAL idle_in_transaction_session_timeout = %v;`, lockTimeout.Milliseconds(), idleInTxSessionTimeout.Milliseconds())
+	if statementTimeout > 0 {
+		timeoutsSQL += fmt.Sprintf(` SET LOCAL statement_timeout = %v;`, statementTimeout.Milliseconds())
+	}
 	txOpts = sql.TxOptions{
 		Isolation: txIsolation,
 		ReadOnly:  readOnly,
@@ -67,9 +77,9 @@ func applyDefaults(optss []TxOptions) (lockTimeout, idleInTxSessionTimeout time.
 }
 
 func GormTransactionWithoutContext(db *gorm.DB, fn func(tx *gorm.DB) error, optss ...TxOptions) (err error) {
-	lockTimeout, idleInTxSessionTimeout, txOpts := applyDefaults(optss)
+	timeoutsSQL, txOpts := applyDefaults(optss)
 	return db.Transaction(func(tx *gorm.DB) error {
-		err = tx.Exec(fmt.Sprintf(`SET LOCAL lock_timeout = %v; SET LOCAL idle_in_transaction_session_timeout = %v;`, lockTimeout.Milliseconds(), idleInTxSessionTimeout.Milliseconds())).Error
+		err = tx.Exec(timeoutsSQL).Error
 		if err != nil {
 			return errors.Wrap(err, "error setting transaction timeouts")
 		}
@@ -78,12 +88,12 @@ func GormTransactionWithoutContext(db *gorm.DB, fn func(tx *gorm.DB) error, opts
 }
 
 func GormTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) error, optss ...TxOptions) (err error) {
-	lockTimeout, idleInTxSessionTimeout, txOpts := applyDefaults(optss)
+	timeoutsSQL, txOpts := applyDefaults(optss)
 	if _, set := ctx.Deadline(); !set {
 		return ErrNoDeadlineSet
 	}
 	return db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err = tx.Exec(fmt.Sprintf(`SET LOCAL lock_timeout = %v; SET LOCAL idle_in_transaction_session_timeout = %v;`, lockTimeout.Milliseconds(), idleInTxSessionTimeout.Milliseconds())).Error
+		err = tx.Exec(timeoutsSQL).Error
 		if err != nil {
 			return errors.Wrap(err, "error setting transaction timeouts")
 		}
@@ -92,11 +102,11 @@ func GormTransaction(ctx context.Context, db *gorm.DB, fn func(tx *gorm.DB) erro
 }
 
 func GormTransactionWithDefaultContext(db *gorm.DB, fn func(tx *gorm.DB) error, optss ...TxOptions) error {
-	lockTimeout, idleInTxSessionTimeout, txOpts := applyDefaults(optss)
+	timeoutsSQL, txOpts := applyDefaults(optss)
 	ctx, cancel := DefaultQueryCtx()
 	defer cancel()
 	err := db.WithContext(ctx).Transaction(func(tx *gorm.DB) error {
-		err := tx.Exec(fmt.Sprintf(`SET LOCAL lock_timeout = %v; SET LOCAL idle_in_transaction_session_timeout = %v;`, lockTimeout.Milliseconds(), idleInTxSessionTimeout.Milliseconds())).Error
+		err := tx.Exec(timeoutsSQL).Error
 		if err != nil {
 			return errors.Wrap(err, "error setting transaction timeouts")
 		}
@@ -128,7 +138,15 @@ func sqlxTransaction(ctx context.Context, db *sqlx.DB, lggr logger.Logger, fn fu
 }
 
 func sqlxTransactionQ(ctx context.Context, db *sqlx.DB, lggr logger.Logger, fn func(q Queryer) error, optss ...TxOptions) (err error) {
-	lockTimeout, idleInTxSessionTimeout, txOpts := applyDefaults(optss)
+	timeoutsSQL, txOpts := applyDefaults(optss)
+
+	ctx, span := tracing.StartSpan(ctx, "postgres", "db.transaction")
+	defer func() {
+		if err != nil {
+			span.RecordError(err)
+		}
+		span.End()
+	}()
 
 	tx, err := db.BeginTxx(ctx, &txOpts)
 	if err != nil {
@@ -164,7 +182,7 @@ func sqlxTransactionQ(ctx context.Context, db *sqlx.DB, lggr logger.Logger, fn f
 		}
 	}()
 
-	_, err = tx.Exec(fmt.Sprintf(`SET LOCAL lock_timeout = %v; SET LOCAL idle_in_transaction_session_timeout = %v;`, lockTimeout.Milliseconds(), idleInTxSessionTimeout.Milliseconds()))
+	_, err = tx.Exec(timeoutsSQL)
 	if err != nil {
 		return errors.Wrap(err, "error setting transaction timeouts")
 	}
@@ -0,0 +1,264 @@
+// Package evm implements the relay.Relayer interface for EVM chains, backed
+// by the node's existing chain set and transaction manager.
+package evm
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/chains/evm"
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/bulletprooftxmanager"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/services/relay"
+)
+
+// ocr2AggregatorABIJSON is the subset of the OCR2Aggregator contract ABI the
+// relayer needs. There is no generated wrapper for the OCR2 contracts yet, so
+// it is declared by hand here.
+const ocr2AggregatorABIJSON = `[
+	{
+		"name": "latestConfigDetails",
+		"type": "function",
+		"stateMutability": "view",
+		"inputs": [],
+		"outputs": [
+			{"name": "configCount", "type": "uint32"},
+			{"name": "blockNumber", "type": "uint32"},
+			{"name": "configDigest", "type": "bytes32"}
+		]
+	},
+	{
+		"name": "transmit",
+		"type": "function",
+		"stateMutability": "nonpayable",
+		"inputs": [
+			{"name": "reportContext", "type": "bytes32[3]"},
+			{"name": "report", "type": "bytes"},
+			{"name": "rs", "type": "bytes32[]"},
+			{"name": "ss", "type": "bytes32[]"},
+			{"name": "rawVs", "type": "bytes32"}
+		],
+		"outputs": []
+	},
+	{
+		"name": "ConfigSet",
+		"type": "event",
+		"anonymous": false,
+		"inputs": [
+			{"name": "previousConfigBlockNumber", "type": "uint32", "indexed": false},
+			{"name": "configDigest", "type": "bytes32", "indexed": false},
+			{"name": "configCount", "type": "uint64", "indexed": false},
+			{"name": "signers", "type": "address[]", "indexed": false},
+			{"name": "transmitters", "type": "address[]", "indexed": false},
+			{"name": "f", "type": "uint8", "indexed": false},
+			{"name": "onchainConfig", "type": "bytes", "indexed": false},
+			{"name": "offchainConfigVersion", "type": "uint64", "indexed": false},
+			{"name": "offchainConfig", "type": "bytes", "indexed": false}
+		]
+	}
+]`
+
+var ocr2AggregatorABI = eth.MustGetABI(ocr2AggregatorABIJSON)
+
+// OCR2Spec is the chain-specific subset of an OCR2 job spec the EVM relayer
+// needs, built by the offchainreporting2 delegate
+type OCR2Spec struct {
+	ID                 int32
+	ContractAddress    common.Address
+	TransmitterAddress *common.Address // nil for bootstrap jobs
+	ChainID            *big.Int
+}
+
+// Relayer implements relay.Relayer for EVM chains
+type Relayer struct {
+	chainSet evm.ChainSet
+	lggr     logger.Logger
+}
+
+var _ relay.Relayer = &Relayer{}
+
+func NewRelayer(chainSet evm.ChainSet, lggr logger.Logger) *Relayer {
+	return &Relayer{
+		chainSet: chainSet,
+		lggr:     lggr.Named("EVMRelayer"),
+	}
+}
+
+// Start is a no-op; the underlying chain set is managed by the application
+func (r *Relayer) Start() error { return nil }
+
+func (r *Relayer) Close() error { return nil }
+
+func (r *Relayer) Ready() error { return nil }
+
+func (r *Relayer) Healthy() error { return nil }
+
+func (r *Relayer) NewOCR2Provider(externalJobID uuid.UUID, s interface{}) (relay.OCR2Provider, error) {
+	spec, ok := s.(OCR2Spec)
+	if !ok {
+		return nil, errors.Errorf("unsuccessful cast to evm.OCR2Spec: %T", s)
+	}
+	chain, err := r.chainSet.Get(spec.ChainID)
+	if err != nil {
+		return nil, err
+	}
+
+	tracker := &contractConfigTracker{
+		address: spec.ContractAddress,
+		client:  chain.Client(),
+	}
+
+	var transmitter relay.ContractTransmitter
+	if spec.TransmitterAddress != nil {
+		strategy := bulletprooftxmanager.NewQueueingTxStrategy(externalJobID, chain.Config().OCRDefaultTransactionQueueDepth(), chain.Config().OCRSimulateTransactions())
+		transmitter = &contractTransmitter{
+			address:     spec.ContractAddress,
+			txm:         chain.TxManager(),
+			fromAddress: *spec.TransmitterAddress,
+			gasLimit:    chain.Config().EvmGasLimitDefault(),
+			strategy:    strategy,
+		}
+	}
+
+	return &ocr2Provider{
+		tracker:     tracker,
+		transmitter: transmitter,
+	}, nil
+}
+
+type ocr2Provider struct {
+	tracker     relay.ContractConfigTracker
+	transmitter relay.ContractTransmitter
+}
+
+// Start is a no-op; the tracker and transmitter are stateless and share the
+// chain's client and transaction manager
+func (p *ocr2Provider) Start() error { return nil }
+
+func (p *ocr2Provider) Close() error { return nil }
+
+func (p *ocr2Provider) ContractConfigTracker() relay.ContractConfigTracker {
+	return p.tracker
+}
+
+func (p *ocr2Provider) ContractTransmitter() relay.ContractTransmitter {
+	return p.transmitter
+}
+
+type contractConfigTracker struct {
+	address common.Address
+	client  eth.Client
+}
+
+func (t *contractConfigTracker) LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest [32]byte, err error) {
+	calldata, err := ocr2AggregatorABI.Pack("latestConfigDetails")
+	if err != nil {
+		return 0, configDigest, errors.Wrap(err, "failed to pack latestConfigDetails")
+	}
+	resp, err := t.client.CallContract(ctx, ethereum.CallMsg{
+		To:   &t.address,
+		Data: calldata,
+	}, nil)
+	if err != nil {
+		return 0, configDigest, errors.Wrap(err, "latestConfigDetails call failed")
+	}
+	out, err := ocr2AggregatorABI.Methods["latestConfigDetails"].Outputs.Unpack(resp)
+	if err != nil {
+		return 0, configDigest, errors.Wrap(err, "failed to unpack latestConfigDetails")
+	}
+	changedInBlock = uint64(out[1].(uint32))
+	configDigest = out[2].([32]byte)
+	return changedInBlock, configDigest, nil
+}
+
+func (t *contractConfigTracker) LatestConfig(ctx context.Context, changedInBlock uint64) (relay.ContractConfig, error) {
+	logs, err := t.client.FilterLogs(ctx, ethereum.FilterQuery{
+		FromBlock: new(big.Int).SetUint64(changedInBlock),
+		ToBlock:   new(big.Int).SetUint64(changedInBlock),
+		Addresses: []common.Address{t.address},
+		Topics:    [][]common.Hash{{ocr2AggregatorABI.Events["ConfigSet"].ID}},
+	})
+	if err != nil {
+		return relay.ContractConfig{}, errors.Wrap(err, "failed to fetch ConfigSet logs")
+	}
+	if len(logs) == 0 {
+		return relay.ContractConfig{}, errors.Errorf("no ConfigSet event found in block %d", changedInBlock)
+	}
+	// If the config changed multiple times in one block, the last event wins
+	latest := logs[len(logs)-1]
+	unpacked, err := ocr2AggregatorABI.Events["ConfigSet"].Inputs.Unpack(latest.Data)
+	if err != nil {
+		return relay.ContractConfig{}, errors.Wrap(err, "failed to unpack ConfigSet event")
+	}
+
+	var config relay.ContractConfig
+	config.ConfigDigest = unpacked[1].([32]byte)
+	config.ConfigCount = unpacked[2].(uint64)
+	for _, signer := range unpacked[3].([]common.Address) {
+		config.Signers = append(config.Signers, signer.Bytes())
+	}
+	for _, transmitter := range unpacked[4].([]common.Address) {
+		config.Transmitters = append(config.Transmitters, transmitter.Hex())
+	}
+	config.F = unpacked[5].(uint8)
+	config.OnchainConfig = unpacked[6].([]byte)
+	config.OffchainConfigVersion = unpacked[7].(uint64)
+	config.OffchainConfig = unpacked[8].([]byte)
+	return config, nil
+}
+
+type txManager interface {
+	CreateEthTransaction(newTx bulletprooftxmanager.NewTx, qopts ...postgres.QOpt) (etx bulletprooftxmanager.EthTx, err error)
+}
+
+type contractTransmitter struct {
+	address     common.Address
+	txm         txManager
+	fromAddress common.Address
+	gasLimit    uint64
+	strategy    bulletprooftxmanager.TxStrategy
+}
+
+// Transmit enqueues the report via the transaction manager. The signatures
+// are expected to be 65-byte (r, s, v) secp256k1 signatures.
+func (t *contractTransmitter) Transmit(ctx context.Context, reportCtx [3][32]byte, report []byte, signatures [][]byte) error {
+	var rs, ss [][32]byte
+	var rawVs [32]byte
+	if len(signatures) > 32 {
+		return errors.Errorf("too many signatures: %d", len(signatures))
+	}
+	for i, sig := range signatures {
+		if len(sig) != 65 {
+			return errors.Errorf("expected 65 byte signature, got %d bytes", len(sig))
+		}
+		var r, s [32]byte
+		copy(r[:], sig[:32])
+		copy(s[:], sig[32:64])
+		rs = append(rs, r)
+		ss = append(ss, s)
+		rawVs[i] = sig[64]
+	}
+	payload, err := ocr2AggregatorABI.Pack("transmit", reportCtx, report, rs, ss, rawVs)
+	if err != nil {
+		return errors.Wrap(err, "failed to pack transmit")
+	}
+	_, err = t.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+		FromAddress:    t.fromAddress,
+		ToAddress:      t.address,
+		EncodedPayload: payload,
+		GasLimit:       t.gasLimit,
+		Strategy:       t.strategy,
+	}, postgres.WithParentCtx(ctx))
+	return errors.Wrap(err, "Skipped OCR2 transmission")
+}
+
+func (t *contractTransmitter) FromAccount() string {
+	return t.fromAddress.Hex()
+}
@@ -0,0 +1,81 @@
+// Package relay abstracts the onchain interactions an OCR2 oracle needs —
+// config tracking, report transmission and key selection — behind a
+// per-network interface, so that additional chains (Solana, Terra) can be
+// supported without touching the job layer.
+package relay
+
+import (
+	"context"
+
+	uuid "github.com/satori/go.uuid"
+)
+
+// Network identifies the blockchain network a relayer serves
+type Network = string
+
+const (
+	EVM Network = "evm"
+)
+
+// SupportedRelays are the networks a relayer implementation exists for
+var SupportedRelays = map[Network]struct{}{
+	EVM: {},
+}
+
+// Relayer manages connections to a particular chain and constructs the
+// chain-specific components OCR2 jobs need. Implementations are registered
+// with the offchainreporting2 delegate, keyed by Network.
+type Relayer interface {
+	Start() error
+	Close() error
+	Ready() error
+	Healthy() error
+	// NewOCR2Provider constructs an OCR2Provider for the given spec. The spec
+	// is the relayer implementation's own spec type, built by the delegate
+	// from the job's contract ID, transmitter ID and relay config.
+	NewOCR2Provider(externalJobID uuid.UUID, spec interface{}) (OCR2Provider, error)
+}
+
+// OCR2Provider bundles the chain-specific components for a single OCR2 job.
+// It is returned to the job spawner as a job.Service so that any underlying
+// subscriptions are started and stopped with the job.
+type OCR2Provider interface {
+	Start() error
+	Close() error
+	ContractConfigTracker() ContractConfigTracker
+	ContractTransmitter() ContractTransmitter
+}
+
+// ContractConfig is the OCR2 configuration read from the onchain contract,
+// in a chain-agnostic representation
+type ContractConfig struct {
+	ConfigDigest          [32]byte
+	ConfigCount           uint64
+	Signers               [][]byte
+	Transmitters          []string
+	F                     uint8
+	OnchainConfig         []byte
+	OffchainConfigVersion uint64
+	OffchainConfig        []byte
+}
+
+// ContractConfigTracker tracks changes to the onchain OCR2 configuration. It
+// mirrors the libocr offchainreporting2 interface of the same name so the
+// protocol engine can be dropped in once it is available.
+type ContractConfigTracker interface {
+	// LatestConfigDetails returns the block the config last changed in and
+	// its digest, cheaply enough to be polled frequently
+	LatestConfigDetails(ctx context.Context) (changedInBlock uint64, configDigest [32]byte, err error)
+	// LatestConfig fetches the full config that changed in changedInBlock
+	LatestConfig(ctx context.Context, changedInBlock uint64) (ContractConfig, error)
+}
+
+// ContractTransmitter transmits signed protocol reports onchain
+type ContractTransmitter interface {
+	// Transmit enqueues a signed report for transmission. Signatures are in
+	// the chain's native format.
+	Transmit(ctx context.Context, reportCtx [3][32]byte, report []byte, signatures [][]byte) error
+	// FromAccount is the account transmissions are sent from, used for key
+	// bundle selection and duplicate-transmission checks
+	FromAccount() string
+}
@@ -9,7 +9,7 @@ import (
 
 // NewTestTelemetryIngressClient calls NewTelemetryIngressClient and injects telemClient.
 func NewTestTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, telemClient telemPb.TelemClient) TelemetryIngressClient {
-	tc := NewTelemetryIngressClient(url, serverPubKeyHex, ks, logging)
+	tc := NewTelemetryIngressClient(url, serverPubKeyHex, ks, logging, "")
 	tc.(*telemetryIngressClient).telemClient = telemClient
 	return tc
 }
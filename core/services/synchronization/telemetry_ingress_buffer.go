@@ -0,0 +1,115 @@
+package synchronization
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"os"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// maxTelemetryBufferBytes caps how large a telemetry buffer file may grow;
+// once reached, new messages are dropped (with a log) rather than filling the
+// disk
+const maxTelemetryBufferBytes = 16 * 1024 * 1024
+
+// telemetryBuffer is a disk-backed FIFO of telemetry payloads that could not
+// be delivered to the ingress server. Messages are appended as JSON lines and
+// resent (oldest first) once the connection recovers, so brief ingress
+// outages do not lose telemetry.
+type telemetryBuffer struct {
+	mu   sync.Mutex
+	path string
+}
+
+type bufferedTelem struct {
+	Telemetry       []byte `json:"telemetry"`
+	ContractAddress string `json:"contractAddress"`
+}
+
+func newTelemetryBuffer(path string) *telemetryBuffer {
+	return &telemetryBuffer{path: path}
+}
+
+// Append adds a payload to the end of the buffer
+func (b *telemetryBuffer) Append(payload TelemPayload) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	if info, err := os.Stat(b.path); err == nil && info.Size() >= maxTelemetryBufferBytes {
+		logger.Warnw("Telemetry buffer file is full, dropping message", "path", b.path, "sizeBytes", info.Size())
+		return
+	}
+	f, err := os.OpenFile(b.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0600)
+	if err != nil {
+		logger.Errorw("Failed to open telemetry buffer file", "path", b.path, "error", err)
+		return
+	}
+	defer func() { _ = f.Close() }()
+	line, err := json.Marshal(bufferedTelem{
+		Telemetry:       payload.Telemetry,
+		ContractAddress: payload.ContractAddress.String(),
+	})
+	if err != nil {
+		logger.Errorw("Failed to encode buffered telemetry", "error", err)
+		return
+	}
+	if _, err := f.Write(append(line, '\n')); err != nil {
+		logger.Errorw("Failed to write buffered telemetry", "path", b.path, "error", err)
+	}
+}
+
+// TakeAll removes and returns every buffered payload, oldest first. Payloads
+// that fail to resend should be handed back via Requeue.
+func (b *telemetryBuffer) TakeAll() []TelemPayload {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	f, err := os.Open(b.path)
+	if os.IsNotExist(err) {
+		return nil
+	} else if err != nil {
+		logger.Errorw("Failed to open telemetry buffer file", "path", b.path, "error", err)
+		return nil
+	}
+	var payloads []TelemPayload
+	scanner := bufio.NewScanner(f)
+	scanner.Buffer(nil, maxTelemetryBufferBytes)
+	for scanner.Scan() {
+		var entry bufferedTelem
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			logger.Errorw("Skipping corrupt buffered telemetry line", "path", b.path, "error", err)
+			continue
+		}
+		payloads = append(payloads, TelemPayload{
+			Ctx:             context.Background(),
+			Telemetry:       entry.Telemetry,
+			ContractAddress: common.HexToAddress(entry.ContractAddress),
+		})
+	}
+	if err := scanner.Err(); err != nil {
+		logger.Errorw("Failed to read telemetry buffer file", "path", b.path, "error", err)
+	}
+	_ = f.Close()
+	if err := os.Remove(b.path); err != nil {
+		logger.Errorw("Failed to truncate telemetry buffer file", "path", b.path, "error", err)
+	}
+	return payloads
+}
+
+// Requeue puts payloads back at the end of the buffer after a failed resend
+func (b *telemetryBuffer) Requeue(payloads []TelemPayload) {
+	for _, p := range payloads {
+		b.Append(p)
+	}
+}
+
+// Empty reports whether there is nothing buffered
+func (b *telemetryBuffer) Empty() bool {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	info, err := os.Stat(b.path)
+	return err != nil || info.Size() == 0
+}
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"net/url"
 	"sync"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -21,9 +22,15 @@ import (
 
 //go:generate mockery --dir ./telem --name TelemClient --output ./mocks/ --case=underscore
 
-// SendIngressBufferSize is the number of messages to keep in the buffer before dropping additional ones
+// SendIngressBufferSize is the number of messages to keep in the in-memory
+// buffer; additional ones spill to the disk buffer (if configured) or are
+// dropped
 const SendIngressBufferSize = 100
 
+// telemetryResendInterval is how often buffered telemetry is retried while
+// the ingress server is unreachable
+const telemetryResendInterval = 30 * time.Second
+
 // TelemetryIngressClient encapsulates all the functionality needed to
 // send telemetry to the ingress server using wsrpc
 type TelemetryIngressClient interface {
@@ -49,6 +56,7 @@ type telemetryIngressClient struct {
 
 	telemClient telemPb.TelemClient
 	logging     bool
+	buffer      *telemetryBuffer
 
 	wgDone           sync.WaitGroup
 	chDone           chan struct{}
@@ -63,9 +71,11 @@ type TelemPayload struct {
 }
 
 // NewTelemetryIngressClient returns a client backed by wsrpc that
-// can send telemetry to the telemetry ingress server
-func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool) TelemetryIngressClient {
-	return &telemetryIngressClient{
+// can send telemetry to the telemetry ingress server. If bufferPath is
+// non-empty, undeliverable telemetry is spilled to that file and resent once
+// the server is reachable again instead of being dropped.
+func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore.CSA, logging bool, bufferPath string) TelemetryIngressClient {
+	tc := &telemetryIngressClient{
 		url:             url,
 		ks:              ks,
 		serverPubKeyHex: serverPubKeyHex,
@@ -73,6 +83,10 @@ func NewTelemetryIngressClient(url *url.URL, serverPubKeyHex string, ks keystore
 		chTelemetry:     make(chan TelemPayload, SendIngressBufferSize),
 		chDone:          make(chan struct{}),
 	}
+	if bufferPath != "" {
+		tc.buffer = newTelemetryBuffer(bufferPath)
+	}
+	return tc
 }
 
 // Start connects the wsrpc client to the telemetry ingress server
@@ -130,19 +144,23 @@ func (tc *telemetryIngressClient) connect(clientPrivKey []byte) {
 
 func (tc *telemetryIngressClient) handleTelemetry() {
 	go func() {
+		retry := time.NewTicker(telemetryResendInterval)
+		defer retry.Stop()
 		for {
 			select {
 			case p := <-tc.chTelemetry:
-				// Send telemetry to the ingress server, log any errors
-				telemReq := &telemPb.TelemRequest{Telemetry: p.Telemetry, Address: p.ContractAddress.String()}
-				_, err := tc.telemClient.Telem(p.Ctx, telemReq)
-				if err != nil {
+				if err := tc.send(p); err != nil {
 					logger.Errorf("Could not send telemetry: %v", err)
+					if tc.buffer != nil {
+						tc.buffer.Append(p)
+					}
 					continue
 				}
-				if tc.logging {
-					logger.Debugw("successfully sent telemetry to ingress server", "contractAddress", p.ContractAddress.String(), "telemetry", p.Telemetry)
-				}
+				// The server is reachable; resend anything buffered during
+				// the outage
+				tc.flushBuffer()
+			case <-retry.C:
+				tc.flushBuffer()
 			case <-tc.chDone:
 				return
 			}
@@ -150,6 +168,34 @@ func (tc *telemetryIngressClient) handleTelemetry() {
 	}()
 }
 
+func (tc *telemetryIngressClient) send(p TelemPayload) error {
+	telemReq := &telemPb.TelemRequest{Telemetry: p.Telemetry, Address: p.ContractAddress.String()}
+	_, err := tc.telemClient.Telem(p.Ctx, telemReq)
+	if err != nil {
+		return err
+	}
+	if tc.logging {
+		logger.Debugw("successfully sent telemetry to ingress server", "contractAddress", p.ContractAddress.String(), "telemetry", p.Telemetry)
+	}
+	return nil
+}
+
+// flushBuffer resends buffered telemetry, oldest first, stopping (and
+// requeueing the remainder) on the first failure
+func (tc *telemetryIngressClient) flushBuffer() {
+	if tc.buffer == nil || tc.buffer.Empty() {
+		return
+	}
+	payloads := tc.buffer.TakeAll()
+	for i, p := range payloads {
+		if err := tc.send(p); err != nil {
+			logger.Errorf("Could not resend buffered telemetry: %v", err)
+			tc.buffer.Requeue(payloads[i:])
+			return
+		}
+	}
+}
+
 // logBufferFullWithExpBackoff logs messages at
 // 1
 // 2
@@ -184,8 +230,9 @@ func (tc *telemetryIngressClient) getCSAPrivateKey() (privkey []byte, err error)
 }
 
 // Send sends telemetry to the ingress server using wsrpc if the client is ready.
-// Also stores telemetry in a small buffer in case of backpressure from wsrpc,
-// throwing away messages once buffer is full
+// Also stores telemetry in a small buffer in case of backpressure from wsrpc;
+// once that buffer is full messages spill to the disk buffer if one is
+// configured, and are thrown away otherwise
 func (tc *telemetryIngressClient) Send(payload TelemPayload) {
 	select {
 	case tc.chTelemetry <- payload:
@@ -193,6 +240,10 @@ func (tc *telemetryIngressClient) Send(payload TelemPayload) {
 	case <-payload.Ctx.Done():
 		return
 	default:
+		if tc.buffer != nil {
+			tc.buffer.Append(payload)
+			return
+		}
 		tc.logBufferFullWithExpBackoff(payload)
 	}
 }
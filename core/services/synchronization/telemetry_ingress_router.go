@@ -0,0 +1,136 @@
+package synchronization
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"path/filepath"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	"go.uber.org/multierr"
+
+	"github.com/smartcontractkit/chainlink/core/services/keystore"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// TelemetryEndpoint describes one ingress server in the
+// TELEMETRY_INGRESS_ENDPOINTS JSON array. Payloads for the listed contracts
+// are routed to this endpoint; an endpoint with no contracts acts as the
+// catch-all for everything unrouted.
+type TelemetryEndpoint struct {
+	URL          string   `json:"url"`
+	ServerPubKey string   `json:"serverPubKey"`
+	Contracts    []string `json:"contracts,omitempty"`
+}
+
+// ParseTelemetryEndpoints parses the TELEMETRY_INGRESS_ENDPOINTS JSON array
+func ParseTelemetryEndpoints(jsonStr string) ([]TelemetryEndpoint, error) {
+	var endpoints []TelemetryEndpoint
+	if err := json.Unmarshal([]byte(jsonStr), &endpoints); err != nil {
+		return nil, errors.Wrap(err, "TELEMETRY_INGRESS_ENDPOINTS must be a JSON array of {url, serverPubKey, contracts}")
+	}
+	if len(endpoints) == 0 {
+		return nil, errors.New("TELEMETRY_INGRESS_ENDPOINTS must list at least one endpoint")
+	}
+	for i, e := range endpoints {
+		if e.URL == "" {
+			return nil, errors.Errorf("TELEMETRY_INGRESS_ENDPOINTS entry %d is missing a url", i)
+		}
+		if e.ServerPubKey == "" {
+			return nil, errors.Errorf("TELEMETRY_INGRESS_ENDPOINTS entry %d is missing a serverPubKey", i)
+		}
+	}
+	return endpoints, nil
+}
+
+// telemetryIngressRouter fans telemetry out over several ingress clients,
+// routing each payload by contract address. Each endpoint gets its own wsrpc
+// connection and its own disk buffer.
+type telemetryIngressRouter struct {
+	utils.StartStopOnce
+	clients  []TelemetryIngressClient
+	routes   map[common.Address]TelemetryIngressClient
+	fallback TelemetryIngressClient
+}
+
+var _ TelemetryIngressClient = (*telemetryIngressRouter)(nil)
+
+// NewTelemetryIngressRouter builds one ingress client per endpoint and routes
+// telemetry between them by contract address. If bufferDir is non-empty each
+// client buffers undeliverable telemetry to its own file underneath it.
+func NewTelemetryIngressRouter(endpoints []TelemetryEndpoint, ks keystore.CSA, logging bool, bufferDir string) (TelemetryIngressClient, error) {
+	router := &telemetryIngressRouter{
+		routes: make(map[common.Address]TelemetryIngressClient),
+	}
+	for i, endpoint := range endpoints {
+		u, err := url.Parse(endpoint.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid telemetry endpoint URL %q", endpoint.URL)
+		}
+		var bufferPath string
+		if bufferDir != "" {
+			bufferPath = filepath.Join(bufferDir, fmt.Sprintf("telemetry-%d-%s.jsonl", i, sanitizeHost(u.Host)))
+		}
+		client := NewTelemetryIngressClient(u, endpoint.ServerPubKey, ks, logging, bufferPath)
+		router.clients = append(router.clients, client)
+		if len(endpoint.Contracts) == 0 {
+			if router.fallback == nil {
+				router.fallback = client
+			}
+			continue
+		}
+		for _, contract := range endpoint.Contracts {
+			if !common.IsHexAddress(contract) {
+				return nil, errors.Errorf("invalid contract address %q for telemetry endpoint %q", contract, endpoint.URL)
+			}
+			router.routes[common.HexToAddress(contract)] = client
+		}
+	}
+	if router.fallback == nil {
+		// Without a catch-all, unrouted telemetry goes to the first endpoint
+		router.fallback = router.clients[0]
+	}
+	return router, nil
+}
+
+func sanitizeHost(host string) string {
+	return strings.Map(func(r rune) rune {
+		switch {
+		case r >= 'a' && r <= 'z', r >= 'A' && r <= 'Z', r >= '0' && r <= '9', r == '.', r == '-':
+			return r
+		}
+		return '_'
+	}, host)
+}
+
+// Start starts every underlying ingress client
+func (tr *telemetryIngressRouter) Start() error {
+	return tr.StartOnce("TelemetryIngressRouter", func() (err error) {
+		for _, client := range tr.clients {
+			err = multierr.Append(err, client.Start())
+		}
+		return
+	})
+}
+
+// Close closes every underlying ingress client
+func (tr *telemetryIngressRouter) Close() error {
+	return tr.StopOnce("TelemetryIngressRouter", func() (err error) {
+		for _, client := range tr.clients {
+			err = multierr.Append(err, client.Close())
+		}
+		return
+	})
+}
+
+// Send routes the payload to the client configured for its contract address,
+// falling back to the catch-all endpoint
+func (tr *telemetryIngressRouter) Send(payload TelemPayload) {
+	if client, ok := tr.routes[payload.ContractAddress]; ok {
+		client.Send(payload)
+		return
+	}
+	tr.fallback.Send(payload)
+}
@@ -0,0 +1,87 @@
+package synchronization
+
+import (
+	"context"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+func Test_ParseTelemetryEndpoints(t *testing.T) {
+	endpoints, err := ParseTelemetryEndpoints(`[
+		{"url": "ws://one.example.com", "serverPubKey": "aaaa", "contracts": ["0x2279B7A0a67DB372996a5FaB50D91eAA73d2eBe6"]},
+		{"url": "ws://two.example.com", "serverPubKey": "bbbb"}
+	]`)
+	require.NoError(t, err)
+	require.Len(t, endpoints, 2)
+	assert.Equal(t, "ws://one.example.com", endpoints[0].URL)
+	assert.Equal(t, []string{"0x2279B7A0a67DB372996a5FaB50D91eAA73d2eBe6"}, endpoints[0].Contracts)
+
+	_, err = ParseTelemetryEndpoints(`{}`)
+	require.Error(t, err)
+	_, err = ParseTelemetryEndpoints(`[]`)
+	require.Error(t, err)
+	_, err = ParseTelemetryEndpoints(`[{"serverPubKey": "aaaa"}]`)
+	require.Error(t, err)
+	_, err = ParseTelemetryEndpoints(`[{"url": "ws://one.example.com"}]`)
+	require.Error(t, err)
+}
+
+type recordingIngressClient struct {
+	utils.StartStopOnce
+	payloads []TelemPayload
+}
+
+func (c *recordingIngressClient) Start() error        { return nil }
+func (c *recordingIngressClient) Close() error        { return nil }
+func (c *recordingIngressClient) Send(p TelemPayload) { c.payloads = append(c.payloads, p) }
+
+func Test_TelemetryIngressRouter_Send(t *testing.T) {
+	routed := common.HexToAddress("0x2279B7A0a67DB372996a5FaB50D91eAA73d2eBe6")
+	other := common.HexToAddress("0xa")
+
+	routedClient := &recordingIngressClient{}
+	fallbackClient := &recordingIngressClient{}
+	router := &telemetryIngressRouter{
+		clients:  []TelemetryIngressClient{routedClient, fallbackClient},
+		routes:   map[common.Address]TelemetryIngressClient{routed: routedClient},
+		fallback: fallbackClient,
+	}
+
+	router.Send(TelemPayload{Ctx: context.Background(), Telemetry: []byte("a"), ContractAddress: routed})
+	router.Send(TelemPayload{Ctx: context.Background(), Telemetry: []byte("b"), ContractAddress: other})
+
+	require.Len(t, routedClient.payloads, 1)
+	assert.Equal(t, routed, routedClient.payloads[0].ContractAddress)
+	require.Len(t, fallbackClient.payloads, 1)
+	assert.Equal(t, other, fallbackClient.payloads[0].ContractAddress)
+}
+
+func Test_TelemetryBuffer_RoundTrip(t *testing.T) {
+	buffer := newTelemetryBuffer(filepath.Join(t.TempDir(), "telemetry.jsonl"))
+	assert.True(t, buffer.Empty())
+
+	first := TelemPayload{Ctx: context.Background(), Telemetry: []byte("first"), ContractAddress: common.HexToAddress("0xa")}
+	second := TelemPayload{Ctx: context.Background(), Telemetry: []byte("second"), ContractAddress: common.HexToAddress("0xb")}
+	buffer.Append(first)
+	buffer.Append(second)
+	assert.False(t, buffer.Empty())
+
+	payloads := buffer.TakeAll()
+	require.Len(t, payloads, 2)
+	assert.Equal(t, first.Telemetry, payloads[0].Telemetry)
+	assert.Equal(t, first.ContractAddress, payloads[0].ContractAddress)
+	assert.Equal(t, second.Telemetry, payloads[1].Telemetry)
+	assert.True(t, buffer.Empty())
+
+	// A failed resend puts the remainder back for the next attempt
+	buffer.Requeue(payloads[1:])
+	payloads = buffer.TakeAll()
+	require.Len(t, payloads, 1)
+	assert.Equal(t, second.Telemetry, payloads[0].Telemetry)
+}
@@ -0,0 +1,57 @@
+package vrf
+
+import (
+	gethabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+)
+
+const (
+	// defaultMaxBatchSize is used when the spec does not set maxBatchSize
+	defaultMaxBatchSize = 10
+	// defaultBatchGasBudget is used when the spec does not set batchGasBudget.
+	// It matches the typical coordinator maxGasLimit
+	defaultBatchGasBudget = 2_500_000
+	// batchOverheadGas is the fixed cost of the batch coordinator's loop and
+	// calldata on top of the individual fulfillments' gas limits
+	batchOverheadGas = 50_000
+)
+
+// batchCoordinatorV2ABIJSON is the fulfillRandomWords method of the batch VRF
+// coordinator contract, which loops over a batch of proofs and request
+// commitments and proxies each to the coordinator in a single transaction
+const batchCoordinatorV2ABIJSON = `[{"inputs":[{"components":[{"internalType":"uint256[2]","name":"pk","type":"uint256[2]"},{"internalType":"uint256[2]","name":"gamma","type":"uint256[2]"},{"internalType":"uint256","name":"c","type":"uint256"},{"internalType":"uint256","name":"s","type":"uint256"},{"internalType":"uint256","name":"seed","type":"uint256"},{"internalType":"address","name":"uWitness","type":"address"},{"internalType":"uint256[2]","name":"cGammaWitness","type":"uint256[2]"},{"internalType":"uint256[2]","name":"sHashWitness","type":"uint256[2]"},{"internalType":"uint256","name":"zInv","type":"uint256"}],"internalType":"struct VRF.Proof[]","name":"proofs","type":"tuple[]"},{"components":[{"internalType":"uint64","name":"blockNum","type":"uint64"},{"internalType":"uint64","name":"subId","type":"uint64"},{"internalType":"uint32","name":"callbackGasLimit","type":"uint32"},{"internalType":"uint32","name":"numWords","type":"uint32"},{"internalType":"address","name":"sender","type":"address"}],"internalType":"struct VRFTypes.RequestCommitment[]","name":"rcs","type":"tuple[]"}],"name":"fulfillRandomWords","outputs":[],"stateMutability":"nonpayable","type":"function"}]`
+
+var (
+	batchCoordinatorV2ABI = eth.MustGetABI(batchCoordinatorV2ABIJSON)
+	coordinatorV2ABI      = eth.MustGetABI(vrf_coordinator_v2.VRFCoordinatorV2ABI)
+)
+
+// decodeFulfillmentPayload unpacks a single coordinator fulfillRandomWords
+// calldata payload back into its proof and request commitment so they can be
+// repacked into a batch call.
+func decodeFulfillmentPayload(payload []byte) (vrf_coordinator_v2.VRFProof, vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment, error) {
+	var (
+		proof vrf_coordinator_v2.VRFProof
+		rc    vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+	)
+	if len(payload) < 4 {
+		return proof, rc, errors.New("payload too short to be fulfillRandomWords calldata")
+	}
+	method := coordinatorV2ABI.Methods["fulfillRandomWords"]
+	args, err := method.Inputs.Unpack(payload[4:])
+	if err != nil {
+		return proof, rc, errors.Wrap(err, "unpack fulfillRandomWords")
+	}
+	proof = *gethabi.ConvertType(args[0], new(vrf_coordinator_v2.VRFProof)).(*vrf_coordinator_v2.VRFProof)
+	rc = *gethabi.ConvertType(args[1], new(vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)).(*vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment)
+	return proof, rc, nil
+}
+
+// batchFulfillmentPayload packs the given proofs and request commitments into
+// a single batch coordinator fulfillRandomWords call.
+func batchFulfillmentPayload(proofs []vrf_coordinator_v2.VRFProof, rcs []vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment) ([]byte, error) {
+	return batchCoordinatorV2ABI.Pack("fulfillRandomWords", proofs, rcs)
+}
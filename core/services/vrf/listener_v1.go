@@ -359,6 +359,7 @@ func (lsn *listenerV1) ProcessRequest(req *solidity_vrf_coordinator_interface.VR
 			"externalJobID": lsn.job.ExternalJobID,
 			"name":          lsn.job.Name.ValueOrZero(),
 			"publicKey":     lsn.job.VRFSpec.PublicKey[:],
+			"params":        map[string]interface{}(lsn.job.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"logBlockHash":   req.Raw.BlockHash[:],
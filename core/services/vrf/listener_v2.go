@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"math/big"
+	"strconv"
 	"sync"
 	"time"
 
@@ -11,10 +12,13 @@ import (
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
 	"github.com/pkg/errors"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
 	heaps "github.com/theodesp/go-heaps"
 	"github.com/theodesp/go-heaps/pairing"
 	"gorm.io/gorm"
 
+	"github.com/smartcontractkit/chainlink/core/assets"
 	"github.com/smartcontractkit/chainlink/core/internal/gethwrappers/generated/vrf_coordinator_v2"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/null"
@@ -34,6 +38,17 @@ var (
 	_ job.Service  = &listenerV2{}
 )
 
+var (
+	promSubscriptionBalance = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "vrf_subscription_balance_juels",
+		Help: "LINK balance (in juels) of each VRF v2 subscription this node serves",
+	}, []string{"subID", "coordinator"})
+	promUnderfundedSubscriptions = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "vrf_subscription_underfunded",
+		Help: "Number of times fulfillments were withheld because a subscription's balance was below the configured minimum",
+	}, []string{"subID", "coordinator"})
+)
+
 const (
 	// Gas used after computing the payment
 	GasAfterPaymentCalculation = 21000 + // base cost of the transaction
@@ -207,6 +222,21 @@ func (lsn *listenerV2) processPendingVRFRequests() {
 			return
 		}
 		startBalance := sub.Balance
+		balance, _ := new(big.Float).SetInt(startBalance).Float64()
+		promSubscriptionBalance.WithLabelValues(strconv.FormatUint(subID, 10), lsn.coordinator.Address().Hex()).Set(balance)
+		if min := lsn.job.VRFSpec.MinSubscriptionBalance; min != nil && min.Cmp((*assets.Link)(startBalance)) > 0 {
+			lsn.l.Warnw("Subscription balance is below the configured minimum, leaving requests unprocessed until it is topped up",
+				"subID", subID,
+				"balance", startBalance.String(),
+				"minBalance", min.String(),
+			)
+			promUnderfundedSubscriptions.WithLabelValues(strconv.FormatUint(subID, 10), lsn.coordinator.Address().Hex()).Inc()
+			// Requeue so the requests are retried once the subscription is funded
+			lsn.reqsMu.Lock()
+			lsn.reqs = append(lsn.reqs, reqs...)
+			lsn.reqsMu.Unlock()
+			continue
+		}
 		lsn.processRequestsPerSub(fromAddress.Address(), startBalance, maxGasPrice, reqs)
 	}
 	lsn.pruneConfirmedRequestCounts()
@@ -255,6 +285,10 @@ func (a fulfilledReqV2) Compare(b heaps.Item) int {
 }
 
 func (lsn *listenerV2) processRequestsPerSub(fromAddress common.Address, startBalance *big.Int, maxGasPrice *big.Int, reqs []pendingRequest) {
+	if lsn.job.VRFSpec.BatchFulfillmentEnabled && lsn.job.VRFSpec.BatchCoordinatorAddress != nil {
+		lsn.processRequestsPerSubBatch(fromAddress, startBalance, maxGasPrice, reqs)
+		return
+	}
 	var err1 error
 	startBalanceNoReserveLink, err1 := MaybeSubtractReservedLink(lsn.l, lsn.db, fromAddress, startBalance)
 	if err1 != nil {
@@ -355,6 +389,173 @@ func (lsn *listenerV2) processRequestsPerSub(fromAddress common.Address, startBa
 
 }
 
+type batchEntry struct {
+	req      pendingRequest
+	run      pipeline.Run
+	maxLink  *big.Int
+	proof    vrf_coordinator_v2.VRFProof
+	rc       vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment
+	gasLimit uint64
+}
+
+// processRequestsPerSubBatch is the batching variant of processRequestsPerSub.
+// It bundles up to maxBatchSize fulfillments (or as many as fit in the batch
+// gas budget) into a single batch coordinator transaction per key,
+// dramatically reducing the per-fulfillment transaction overhead on busy
+// coordinators.
+func (lsn *listenerV2) processRequestsPerSubBatch(fromAddress common.Address, startBalance *big.Int, maxGasPrice *big.Int, reqs []pendingRequest) {
+	startBalanceNoReserveLink, err1 := MaybeSubtractReservedLink(lsn.l, lsn.db, fromAddress, startBalance)
+	if err1 != nil {
+		return
+	}
+	maxBatchSize := int(lsn.job.VRFSpec.MaxBatchSize)
+	if maxBatchSize == 0 {
+		maxBatchSize = defaultMaxBatchSize
+	}
+	gasBudget := lsn.job.VRFSpec.BatchGasBudget
+	if gasBudget == 0 {
+		gasBudget = defaultBatchGasBudget
+	}
+	lsn.l.Infow("Processing requests in batches",
+		"sub", reqs[0].req.SubId,
+		"maxGasPrice", maxGasPrice.String(),
+		"reqs", len(reqs),
+		"maxBatchSize", maxBatchSize,
+		"gasBudget", gasBudget,
+		"startBalance", startBalance.String(),
+		"startBalanceNoReservedLink", startBalanceNoReserveLink.String(),
+	)
+	var (
+		processed = make(map[string]struct{})
+		batch     []batchEntry
+		batchGas  = uint64(batchOverheadGas)
+	)
+	flush := func() {
+		if len(batch) == 0 {
+			return
+		}
+		var (
+			proofs    = make([]vrf_coordinator_v2.VRFProof, len(batch))
+			rcs       = make([]vrf_coordinator_v2.VRFCoordinatorV2RequestCommitment, len(batch))
+			reqIDs    = make([]common.Hash, len(batch))
+			totalLink = big.NewInt(0)
+		)
+		for i, e := range batch {
+			proofs[i] = e.proof
+			rcs[i] = e.rc
+			reqIDs[i] = common.BytesToHash(e.req.req.RequestId.Bytes())
+			totalLink.Add(totalLink, e.maxLink)
+		}
+		payload, err := batchFulfillmentPayload(proofs, rcs)
+		if err != nil {
+			lsn.l.Errorw("Unable to pack batch fulfillment, requeuing requests", "err", err, "reqIDs", reqIDs)
+			batch, batchGas = nil, batchOverheadGas
+			return
+		}
+		lsn.l.Infow("Enqueuing batch fulfillment", "reqs", len(batch), "gasLimit", batchGas, "maxLink", totalLink)
+		err = postgres.NewQ(postgres.UnwrapGormDB(lsn.db)).Transaction(lsn.l, func(tx postgres.Queryer) error {
+			for i := range batch {
+				if err = lsn.pipelineRunner.InsertFinishedRun(&batch[i].run, true, postgres.WithQueryer(tx)); err != nil {
+					return err
+				}
+				if err = lsn.logBroadcaster.MarkConsumed(batch[i].req.lb, postgres.WithQueryer(tx)); err != nil {
+					return err
+				}
+			}
+			_, err = lsn.txm.CreateEthTransaction(bulletprooftxmanager.NewTx{
+				FromAddress:    fromAddress,
+				ToAddress:      lsn.job.VRFSpec.BatchCoordinatorAddress.Address(),
+				EncodedPayload: payload,
+				GasLimit:       batchGas,
+				Meta: &bulletprooftxmanager.EthTxMeta{
+					RequestIDs: reqIDs,
+					MaxLink:    totalLink.String(),
+				},
+				MinConfirmations: null.Uint32From(uint32(lsn.cfg.MinRequiredOutgoingConfirmations())),
+				Strategy:         bulletprooftxmanager.NewSendEveryStrategy(false), // We already simd
+			}, postgres.WithQueryer(tx))
+			return err
+		})
+		if err != nil {
+			lsn.l.Errorw("Error enqueuing batch fulfillment, requeuing requests", "err", err, "reqIDs", reqIDs)
+		} else {
+			for _, e := range batch {
+				startBalanceNoReserveLink = startBalanceNoReserveLink.Sub(startBalanceNoReserveLink, e.maxLink)
+				processed[e.req.req.RequestId.String()] = struct{}{}
+			}
+		}
+		batch, batchGas = nil, batchOverheadGas
+	}
+	for _, req := range reqs {
+		// This check to see if the log was consumed needs to be in the same
+		// goroutine as the mark consumed to avoid processing duplicates.
+		if !lsn.shouldProcessLog(req.lb) {
+			continue
+		}
+		// Check if the vrf req has already been fulfilled
+		// If so we just mark it completed
+		callback, err := lsn.coordinator.GetCommitment(nil, req.req.RequestId)
+		if err != nil {
+			lsn.l.Errorw("Unable to check if already fulfilled, processing anyways", "err", err, "txHash", req.req.Raw.TxHash)
+		} else if utils.IsEmpty(callback[:]) {
+			// If seedAndBlockNumber is zero then the response has been fulfilled
+			// and we should skip it
+			lsn.l.Infow("Request already fulfilled", "txHash", req.req.Raw.TxHash, "subID", req.req.SubId, "callback", callback)
+			lsn.markLogAsConsumed(req.lb)
+			processed[req.req.RequestId.String()] = struct{}{}
+			continue
+		}
+		// Run the pipeline to determine the max link that could be billed at maxGasPrice.
+		// The ethcall will error if there is currently insufficient balance onchain.
+		bi, run, payload, gaslimit, err := lsn.getMaxLinkForFulfillment(maxGasPrice, req)
+		if err != nil {
+			continue
+		}
+		if startBalanceNoReserveLink.Cmp(bi) < 0 {
+			// Insufficient funds, have to wait for a user top up
+			// leave it unprocessed for now
+			lsn.l.Infow("Insufficient link balance to fulfill a request, breaking", "balance", startBalanceNoReserveLink, "maxLink", bi)
+			break
+		}
+		proof, rc, err := decodeFulfillmentPayload(hexutil.MustDecode(payload))
+		if err != nil {
+			lsn.l.Errorw("Unable to decode fulfillment payload for batching", "err", err, "reqID", req.req.RequestId)
+			continue
+		}
+		// Flush if adding this request would overflow the batch size or its gas budget
+		if len(batch) > 0 && (len(batch) >= maxBatchSize || batchGas+gaslimit > gasBudget) {
+			flush()
+		}
+		batch = append(batch, batchEntry{
+			req:      req,
+			run:      run,
+			maxLink:  bi,
+			proof:    proof,
+			rc:       rc,
+			gasLimit: gaslimit,
+		})
+		batchGas += gaslimit
+	}
+	flush()
+	// Remove all the confirmed logs
+	var toKeep []pendingRequest
+	for _, req := range reqs {
+		if _, ok := processed[req.req.RequestId.String()]; !ok {
+			toKeep = append(toKeep, req)
+		}
+	}
+	lsn.reqsMu.Lock()
+	// There could be logs accumulated to this slice while request processor is running,
+	// so we merged the new ones with the ones that need to be requeued.
+	lsn.reqs = append(lsn.reqs, toKeep...)
+	lsn.reqsMu.Unlock()
+	lsn.l.Infow("Finished processing for sub",
+		"sub", reqs[0].req.SubId,
+		"total reqs", len(reqs),
+		"total processed", len(processed),
+		"total remaining", len(toKeep))
+}
+
 // Here we use the pipeline to parse the log, generate a vrf response
 // then simulate the transaction at the max gas price to determine its maximum link cost.
 func (lsn *listenerV2) getMaxLinkForFulfillment(maxGasPrice *big.Int, req pendingRequest) (*big.Int, pipeline.Run, string, uint64, error) {
@@ -370,6 +571,7 @@ func (lsn *listenerV2) getMaxLinkForFulfillment(maxGasPrice *big.Int, req pendin
 			"name":          lsn.job.Name.ValueOrZero(),
 			"publicKey":     lsn.job.VRFSpec.PublicKey[:],
 			"maxGasPrice":   maxGasPrice.String(),
+			"params":        map[string]interface{}(lsn.job.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"logBlockHash":   req.req.Raw.BlockHash[:],
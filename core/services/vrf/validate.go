@@ -50,6 +50,9 @@ func ValidatedVRFSpec(tomlString string) (job.Job, error) {
 	if spec.CoordinatorAddress.String() == "" {
 		return jb, errors.Wrap(ErrKeyNotSet, "coordinatorAddress")
 	}
+	if spec.BatchFulfillmentEnabled && spec.BatchCoordinatorAddress == nil {
+		return jb, errors.Wrap(ErrKeyNotSet, "batchCoordinatorAddress")
+	}
 	var foundVRFTask bool
 	for _, t := range jb.Pipeline.Tasks {
 		if t.Type() == pipeline.TaskTypeVRF || t.Type() == pipeline.TaskTypeVRFV2 {
@@ -0,0 +1,91 @@
+package webhook
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+const (
+	// callbackAttempts is how many times a result callback is attempted
+	// before giving up
+	callbackAttempts = 5
+	// callbackTimeout bounds each individual callback attempt
+	callbackTimeout = 10 * time.Second
+)
+
+// ResultCallback is the payload POSTed to a webhook trigger's callback URL
+// when the run finishes, so callers do not have to poll the runs API for
+// completion
+type ResultCallback struct {
+	RunID         int64                     `json:"runId"`
+	ExternalJobID uuid.UUID                 `json:"externalJobID"`
+	Outputs       pipeline.JSONSerializable `json:"outputs"`
+	Errors        pipeline.RunErrors        `json:"errors"`
+}
+
+// deliverCallback POSTs the run's outputs and errors to the callback URL,
+// retrying with exponential backoff. The request is signed with the job's
+// shared secret if one is configured
+func (r *webhookJobRunner) deliverCallback(spec registeredJob, callbackURL string, run *pipeline.Run, lggr logger.Logger) {
+	payload, err := json.Marshal(ResultCallback{
+		RunID:         run.ID,
+		ExternalJobID: spec.ExternalJobID,
+		Outputs:       run.Outputs,
+		Errors:        run.FatalErrors,
+	})
+	if err != nil {
+		lggr.Errorw("Webhook callback: failed to marshal run results", "error", err, "runID", run.ID)
+		return
+	}
+
+	backoff := time.Second
+	for attempt := 1; attempt <= callbackAttempts; attempt++ {
+		err := r.postCallback(spec, callbackURL, payload)
+		if err == nil {
+			lggr.Debugw("Webhook callback delivered", "callbackURL", callbackURL, "runID", run.ID)
+			return
+		}
+		lggr.Warnw("Webhook callback attempt failed", "error", err, "callbackURL", callbackURL, "attempt", attempt)
+		select {
+		case <-spec.chRemove:
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+	lggr.Errorw(fmt.Sprintf("Webhook callback: giving up after %d attempts", callbackAttempts), "callbackURL", callbackURL, "runID", run.ID)
+}
+
+func (r *webhookJobRunner) postCallback(spec registeredJob, callbackURL string, payload []byte) error {
+	ctx, cancel := context.WithTimeout(context.Background(), callbackTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, callbackURL, bytes.NewReader(payload))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	if spec.WebhookSpec != nil && spec.WebhookSpec.SharedSecret != "" {
+		timestamp := fmt.Sprintf("%d", time.Now().Unix())
+		req.Header.Set(TimestampHeader, timestamp)
+		req.Header.Set(SignatureHeader, Sign(spec.WebhookSpec.SharedSecret, timestamp, payload))
+	}
+	resp, err := r.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return errors.Errorf("got unexpected status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -22,17 +22,17 @@ type (
 	}
 
 	JobRunner interface {
-		RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error)
+		RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable, callbackURL string) (int64, error)
 	}
 )
 
 var _ job.Delegate = (*Delegate)(nil)
 
-func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, lggr logger.Logger) *Delegate {
+func NewDelegate(runner pipeline.Runner, externalInitiatorManager ExternalInitiatorManager, httpClient HTTPClient, lggr logger.Logger) *Delegate {
 	lggr = lggr.Named("Webhook")
 	return &Delegate{
 		externalInitiatorManager: externalInitiatorManager,
-		webhookJobRunner:         newWebhookJobRunner(runner, lggr),
+		webhookJobRunner:         newWebhookJobRunner(runner, httpClient, lggr),
 		lggr:                     lggr,
 	}
 }
@@ -100,13 +100,15 @@ type webhookJobRunner struct {
 	specsByUUID   map[uuid.UUID]registeredJob
 	muSpecsByUUID sync.RWMutex
 	runner        pipeline.Runner
+	httpClient    HTTPClient
 	lggr          logger.Logger
 }
 
-func newWebhookJobRunner(runner pipeline.Runner, lggr logger.Logger) *webhookJobRunner {
+func newWebhookJobRunner(runner pipeline.Runner, httpClient HTTPClient, lggr logger.Logger) *webhookJobRunner {
 	return &webhookJobRunner{
 		specsByUUID: make(map[uuid.UUID]registeredJob),
 		runner:      runner,
+		httpClient:  httpClient,
 		lggr:        lggr.Named("JobRunner"),
 	}
 }
@@ -146,7 +148,7 @@ func (r *webhookJobRunner) spec(externalJobID uuid.UUID) (registeredJob, bool) {
 
 var ErrJobNotExists = errors.New("job does not exist")
 
-func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable) (int64, error) {
+func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, requestBody string, meta pipeline.JSONSerializable, callbackURL string) (int64, error) {
 	spec, exists := r.spec(jobUUID)
 	if !exists {
 		return 0, ErrJobNotExists
@@ -165,6 +167,7 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 			"databaseID":    spec.ID,
 			"externalJobID": spec.ExternalJobID,
 			"name":          spec.Name.ValueOrZero(),
+			"params":        map[string]interface{}(spec.Params),
 		},
 		"jobRun": map[string]interface{}{
 			"requestBody": requestBody,
@@ -182,5 +185,8 @@ func (r *webhookJobRunner) RunJob(ctx context.Context, jobUUID uuid.UUID, reques
 	if run.ID == 0 {
 		panic("expected run to have non-zero id")
 	}
+	if callbackURL != "" {
+		go r.deliverCallback(spec, callbackURL, &run, jobLggr)
+	}
 	return run.ID, nil
 }
@@ -38,6 +38,7 @@ func TestWebhookDelegate(t *testing.T) {
 				"databaseID":    spec.ID,
 				"externalJobID": spec.ExternalJobID,
 				"name":          spec.Name.ValueOrZero(),
+				"params":        map[string]interface{}(spec.Params),
 			},
 			"jobRun": map[string]interface{}{
 				"requestBody": requestBody,
@@ -46,7 +47,7 @@ func TestWebhookDelegate(t *testing.T) {
 		}
 		runner    = new(pipelinemocks.Runner)
 		eiManager = new(webhookmocks.ExternalInitiatorManager)
-		delegate  = webhook.NewDelegate(runner, eiManager, logger.TestLogger(t))
+		delegate  = webhook.NewDelegate(runner, eiManager, nil, logger.TestLogger(t))
 	)
 
 	services, err := delegate.ServicesForSpec(*spec)
@@ -55,7 +56,7 @@ func TestWebhookDelegate(t *testing.T) {
 	service := services[0]
 
 	// Should error before service is started
-	_, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta)
+	_, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta, "")
 	require.Error(t, err)
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 
@@ -72,7 +73,7 @@ func TestWebhookDelegate(t *testing.T) {
 			require.Equal(t, vars, run.Inputs.Val)
 		}).Once()
 
-	runID, err := delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta)
+	runID, err := delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta, "")
 	require.NoError(t, err)
 	require.Equal(t, int64(123), runID)
 
@@ -82,14 +83,14 @@ func TestWebhookDelegate(t *testing.T) {
 	runner.On("Run", mock.Anything, mock.AnythingOfType("*pipeline.Run"), mock.Anything, mock.Anything, mock.Anything).
 		Return(false, expectedErr).Once()
 
-	_, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta)
+	_, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta, "")
 	require.Equal(t, expectedErr, errors.Cause(err))
 
 	// Should error after service is stopped
 	err = service.Close()
 	require.NoError(t, err)
 
-	_, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta)
+	_, err = delegate.WebhookJobRunner().RunJob(context.Background(), spec.ExternalJobID, requestBody, meta, "")
 	require.Equal(t, webhook.ErrJobNotExists, errors.Cause(err))
 
 	runner.AssertExpectations(t)
@@ -5,10 +5,13 @@ import (
 	"encoding/json"
 	"fmt"
 	"net/http"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/job"
 	"go.uber.org/multierr"
+	null "gopkg.in/guregu/null.v4"
 
 	uuid "github.com/satori/go.uuid"
 
@@ -17,6 +20,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/static"
 	"github.com/smartcontractkit/chainlink/core/store/models"
+	"github.com/smartcontractkit/chainlink/core/utils"
 )
 
 //go:generate mockery --name ExternalInitiatorManager --output ./mocks/ --case=underscore
@@ -26,6 +30,7 @@ type ExternalInitiatorManager interface {
 	Notify(webhookSpecID int32) error
 	DeleteJob(webhookSpecID int32) error
 	FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error)
+	Notifications(offset, limit int) ([]Notification, int, error)
 }
 
 //go:generate mockery --name HTTPClient --output ./mocks/ --case=underscore
@@ -33,21 +38,97 @@ type HTTPClient interface {
 	Do(req *http.Request) (*http.Response, error)
 }
 
+// NotificationKind distinguishes job creation notices from job deletions
+type NotificationKind string
+
+const (
+	// NotificationKindNotify tells the external initiator a job was created
+	NotificationKindNotify NotificationKind = "notify"
+	// NotificationKindDelete tells the external initiator a job was deleted
+	NotificationKindDelete NotificationKind = "delete"
+)
+
+// Notification is a persisted outbound notice to an external initiator. Rows
+// are written when jobs referencing the initiator are created or deleted and
+// retried with backoff until the initiator acknowledges them, so registrations
+// cannot quietly drift out of sync with the node.
+type Notification struct {
+	ID                  int64
+	ExternalInitiatorID int64
+	ExternalInitiator   bridges.ExternalInitiator
+	Kind                NotificationKind
+	JobID               uuid.UUID
+	Payload             models.JSON
+	Attempts            int
+	LastError           null.String
+	NextAttemptAt       time.Time
+	DeliveredAt         null.Time
+	CreatedAt           time.Time
+	UpdatedAt           time.Time
+}
+
+// TableName returns the table that Notification is stored in
+func (Notification) TableName() string {
+	return "external_initiator_notifications"
+}
+
+const (
+	// how often the outbox is scanned for due notifications, independently of
+	// nudges from Notify/DeleteJob
+	notificationPollInterval = 10 * time.Second
+	// how many due notifications are attempted per scan
+	notificationBatchSize = 100
+	// base and cap for the per-notification retry backoff
+	notificationRetryBase = 5 * time.Second
+	notificationRetryMax  = 10 * time.Minute
+)
+
 type externalInitiatorManager struct {
+	utils.StartStopOnce
 	db         *gorm.DB
 	httpclient HTTPClient
+	lggr       logger.Logger
+
+	chTrigger chan struct{}
+	chStop    chan struct{}
+	chDone    chan struct{}
 }
 
 var _ ExternalInitiatorManager = (*externalInitiatorManager)(nil)
 
 // NewExternalInitiatorManager returns the concrete externalInitiatorManager
-func NewExternalInitiatorManager(db *gorm.DB, httpclient HTTPClient) *externalInitiatorManager {
-	return &externalInitiatorManager{db, httpclient}
+func NewExternalInitiatorManager(db *gorm.DB, httpclient HTTPClient, lggr logger.Logger) *externalInitiatorManager {
+	return &externalInitiatorManager{
+		db:         db,
+		httpclient: httpclient,
+		lggr:       lggr.Named("ExternalInitiatorManager"),
+		chTrigger:  make(chan struct{}, 1),
+		chStop:     make(chan struct{}),
+		chDone:     make(chan struct{}),
+	}
 }
 
-// Notify sends a POST notification to the External Initiator
-// responsible for initiating the Job Spec.
-func (m externalInitiatorManager) Notify(webhookSpecID int32) error {
+// Start starts the outbox delivery loop
+func (m *externalInitiatorManager) Start() error {
+	return m.StartOnce("ExternalInitiatorManager", func() error {
+		go m.run()
+		return nil
+	})
+}
+
+// Close stops the outbox delivery loop
+func (m *externalInitiatorManager) Close() error {
+	return m.StopOnce("ExternalInitiatorManager", func() error {
+		close(m.chStop)
+		<-m.chDone
+		return nil
+	})
+}
+
+// Notify enqueues a creation notice for every external initiator responsible
+// for initiating the job's webhook spec. Delivery happens asynchronously with
+// retries; an error is only returned if the notice cannot be persisted.
+func (m *externalInitiatorManager) Notify(webhookSpecID int32) error {
 	eiWebhookSpecs, jobID, err := m.Load(webhookSpecID)
 	if err != nil {
 		return err
@@ -66,25 +147,37 @@ func (m externalInitiatorManager) Notify(webhookSpecID int32) error {
 		if err != nil {
 			return errors.Wrap(err, "new Job Spec notification")
 		}
-		req, err := newNotifyHTTPRequest(buf, ei)
-		if err != nil {
-			return errors.Wrap(err, "creating notify HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
+		payload, err := models.ParseJSON(buf)
 		if err != nil {
-			return errors.Wrap(err, "could not notify '%s' (%s)")
+			return errors.Wrap(err, "new Job Spec notification")
 		}
-		if err := resp.Body.Close(); err != nil {
+		if err := m.enqueue(ei.ID, NotificationKindNotify, jobID, payload); err != nil {
 			return err
 		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
+	}
+	return nil
+}
+
+// DeleteJob enqueues a deletion notice for every external initiator
+// responsible for initiating the job's webhook spec.
+func (m *externalInitiatorManager) DeleteJob(webhookSpecID int32) error {
+	eiWebhookSpecs, jobID, err := m.Load(webhookSpecID)
+	if err != nil {
+		return err
+	}
+	for _, eiWebhookSpec := range eiWebhookSpecs {
+		ei := eiWebhookSpec.ExternalInitiator
+		if ei.URL == nil {
+			continue
+		}
+		if err := m.enqueue(ei.ID, NotificationKindDelete, jobID, models.JSON{}); err != nil {
+			return err
 		}
 	}
 	return nil
 }
 
-func (m externalInitiatorManager) Load(webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error) {
+func (m *externalInitiatorManager) Load(webhookSpecID int32) (eiWebhookSpecs []job.ExternalInitiatorWebhookSpec, jobID uuid.UUID, err error) {
 	row := m.db.Raw("SELECT external_job_id FROM jobs WHERE webhook_spec_id = ?", webhookSpecID).Row()
 	err = multierr.Combine(
 		errors.Wrapf(row.Scan(&jobID), "failed to load job ID from job for webhook spec with ID %d", webhookSpecID),
@@ -93,38 +186,169 @@ func (m externalInitiatorManager) Load(webhookSpecID int32) (eiWebhookSpecs []jo
 	return
 }
 
-func (m externalInitiatorManager) DeleteJob(webhookSpecID int32) error {
-	eiWebhookSpecs, jobID, err := m.Load(webhookSpecID)
-	if err != nil {
-		return err
+func (m *externalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
+	var exi bridges.ExternalInitiator
+	return exi, m.db.First(&exi, "lower(name) = lower(?)", name).Error
+}
+
+// Notifications returns a page of outbox entries, most recent first, along
+// with the total count
+func (m *externalInitiatorManager) Notifications(offset, limit int) ([]Notification, int, error) {
+	var count int64
+	if err := m.db.Model(&Notification{}).Count(&count).Error; err != nil {
+		return nil, 0, errors.Wrap(err, "failed to count external initiator notifications")
 	}
-	for _, eiWebhookSpec := range eiWebhookSpecs {
-		ei := eiWebhookSpec.ExternalInitiator
-		if ei.URL == nil {
-			continue
-		}
+	var notifications []Notification
+	err := m.db.
+		Preload("ExternalInitiator").
+		Order("id DESC").
+		Offset(offset).
+		Limit(limit).
+		Find(&notifications).Error
+	return notifications, int(count), errors.Wrap(err, "failed to load external initiator notifications")
+}
 
-		req, err := newDeleteJobFromExternalInitiatorHTTPRequest(ei, jobID)
-		if err != nil {
-			return errors.Wrap(err, "creating delete HTTP request")
-		}
-		resp, err := m.httpclient.Do(req)
-		if err != nil {
-			return errors.Wrapf(err, "could not delete job from remote external initiator at %s", req.URL)
+func (m *externalInitiatorManager) enqueue(externalInitiatorID int64, kind NotificationKind, jobID uuid.UUID, payload models.JSON) error {
+	now := time.Now()
+	notification := Notification{
+		ExternalInitiatorID: externalInitiatorID,
+		Kind:                kind,
+		JobID:               jobID,
+		Payload:             payload,
+		NextAttemptAt:       now,
+		CreatedAt:           now,
+		UpdatedAt:           now,
+	}
+	if err := m.db.Create(&notification).Error; err != nil {
+		return errors.Wrap(err, "failed to enqueue external initiator notification")
+	}
+	// Nudge the delivery loop so the first attempt happens immediately
+	select {
+	case m.chTrigger <- struct{}{}:
+	default:
+	}
+	return nil
+}
+
+func (m *externalInitiatorManager) run() {
+	defer close(m.chDone)
+	ticker := time.NewTicker(utils.WithJitter(notificationPollInterval))
+	defer ticker.Stop()
+	for {
+		select {
+		case <-m.chStop:
+			return
+		case <-m.chTrigger:
+		case <-ticker.C:
 		}
-		if err := resp.Body.Close(); err != nil {
-			return err
+		m.deliverDue()
+	}
+}
+
+// deliverDue attempts every undelivered notification whose next attempt is
+// due. Failures are recorded on the row and rescheduled with exponential
+// backoff; they never block other notifications.
+func (m *externalInitiatorManager) deliverDue() {
+	var due []Notification
+	err := m.db.
+		Preload("ExternalInitiator").
+		Where("delivered_at IS NULL AND next_attempt_at <= ?", time.Now()).
+		Order("id ASC").
+		Limit(notificationBatchSize).
+		Find(&due).Error
+	if err != nil {
+		m.lggr.Errorw("Failed to load due external initiator notifications", "error", err)
+		return
+	}
+	for _, notification := range due {
+		select {
+		case <-m.chStop:
+			return
+		default:
 		}
-		if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
-			return fmt.Errorf(" notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
+		if err := m.deliver(notification); err != nil {
+			m.markFailed(notification, err)
+		} else {
+			m.markDelivered(notification)
 		}
 	}
+}
+
+func (m *externalInitiatorManager) deliver(notification Notification) error {
+	ei := notification.ExternalInitiator
+	if ei.URL == nil {
+		// The initiator was reconfigured without a URL; nothing to send
+		return nil
+	}
+	var req *http.Request
+	var err error
+	switch notification.Kind {
+	case NotificationKindNotify:
+		req, err = newNotifyHTTPRequest(notification.Payload.Bytes(), ei)
+	case NotificationKindDelete:
+		req, err = newDeleteJobFromExternalInitiatorHTTPRequest(ei, notification.JobID)
+	default:
+		return errors.Errorf("unknown notification kind %q", notification.Kind)
+	}
+	if err != nil {
+		return errors.Wrap(err, "creating HTTP request")
+	}
+	resp, err := m.httpclient.Do(req)
+	if err != nil {
+		return errors.Wrapf(err, "could not reach '%s' (%s)", ei.Name, ei.URL)
+	}
+	if err := resp.Body.Close(); err != nil {
+		return err
+	}
+	if !(resp.StatusCode >= 200 && resp.StatusCode < 300) {
+		return fmt.Errorf("notify '%s' (%s) received bad response '%s'", ei.Name, ei.URL, resp.Status)
+	}
 	return nil
 }
 
-func (m externalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
-	var exi bridges.ExternalInitiator
-	return exi, m.db.First(&exi, "lower(name) = lower(?)", name).Error
+func (m *externalInitiatorManager) markDelivered(notification Notification) {
+	err := m.db.Model(&Notification{}).Where("id = ?", notification.ID).Updates(map[string]interface{}{
+		"attempts":     gorm.Expr("attempts + 1"),
+		"last_error":   nil,
+		"delivered_at": time.Now(),
+		"updated_at":   time.Now(),
+	}).Error
+	if err != nil {
+		m.lggr.Errorw("Failed to mark external initiator notification delivered", "notificationID", notification.ID, "error", err)
+	}
+}
+
+func (m *externalInitiatorManager) markFailed(notification Notification, deliveryErr error) {
+	m.lggr.Warnw("External initiator notification failed; will retry",
+		"notificationID", notification.ID,
+		"externalInitiator", notification.ExternalInitiator.Name,
+		"kind", notification.Kind,
+		"attempts", notification.Attempts+1,
+		"error", deliveryErr,
+	)
+	err := m.db.Model(&Notification{}).Where("id = ?", notification.ID).Updates(map[string]interface{}{
+		"attempts":        gorm.Expr("attempts + 1"),
+		"last_error":      deliveryErr.Error(),
+		"next_attempt_at": time.Now().Add(notificationBackoff(notification.Attempts)),
+		"updated_at":      time.Now(),
+	}).Error
+	if err != nil {
+		m.lggr.Errorw("Failed to reschedule external initiator notification", "notificationID", notification.ID, "error", err)
+	}
+}
+
+// notificationBackoff returns the delay before the attempt after `attempts`
+// failed ones: exponential from notificationRetryBase, capped at
+// notificationRetryMax
+func notificationBackoff(attempts int) time.Duration {
+	backoff := notificationRetryBase
+	for i := 0; i < attempts; i++ {
+		backoff *= 2
+		if backoff >= notificationRetryMax {
+			return notificationRetryMax
+		}
+	}
+	return backoff
 }
 
 // JobSpecNotice is sent to the External Initiator when JobSpecs are created.
@@ -169,3 +393,6 @@ func (NullExternalInitiatorManager) DeleteJob(int32) error { return nil }
 func (NullExternalInitiatorManager) FindExternalInitiatorByName(name string) (bridges.ExternalInitiator, error) {
 	return bridges.ExternalInitiator{}, nil
 }
+func (NullExternalInitiatorManager) Notifications(offset, limit int) ([]Notification, int, error) {
+	return nil, 0, nil
+}
@@ -8,12 +8,15 @@ import (
 	"net/http"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/tidwall/gjson"
 	"go.uber.org/multierr"
+	"gorm.io/gorm"
 
 	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
+	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	webhookmocks "github.com/smartcontractkit/chainlink/core/services/webhook/mocks"
 
@@ -39,7 +42,7 @@ func Test_ExternalInitiatorManager_Load(t *testing.T) {
 	)
 	require.NoError(t, err)
 
-	eim := webhook.NewExternalInitiatorManager(db, nil)
+	eim := webhook.NewExternalInitiatorManager(db, nil, logger.TestLogger(t))
 
 	eiWebhookSpecs, jobID, err := eim.Load(webhookSpecNoEIs.ID)
 	require.NoError(t, err)
@@ -79,10 +82,12 @@ func Test_ExternalInitiatorManager_Notify(t *testing.T) {
 	require.NoError(t, err)
 
 	client := new(webhookmocks.HTTPClient)
-	eim := webhook.NewExternalInitiatorManager(db, client)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
+	require.NoError(t, eim.Start())
+	defer func() { assert.NoError(t, eim.Close()) }()
 
 	// Does nothing with no EI
-	eim.Notify(webhookSpecNoEIs.ID)
+	require.NoError(t, eim.Notify(webhookSpecNoEIs.ID))
 
 	client.On("Do", mock.MatchedBy(func(r *http.Request) bool {
 		body, err := r.GetBody()
@@ -95,9 +100,11 @@ func Test_ExternalInitiatorManager_Notify(t *testing.T) {
 		assert.Equal(t, `{"ei":"foo","name":"webhookSpecTwoEIs"}`, gjson.GetBytes(b, "params").Raw)
 
 		return r.Method == "POST" && r.URL.String() == eiWithURL.URL.String() && r.Header["Content-Type"][0] == "application/json" && r.Header["X-Chainlink-Ea-Accesskey"][0] == "token" && r.Header["X-Chainlink-Ea-Secret"][0] == "secret"
-	})).Once().Return(&http.Response{Body: io.NopCloser(strings.NewReader(""))}, nil)
-	eim.Notify(webhookSpecTwoEIs.ID)
+	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.Notify(webhookSpecTwoEIs.ID))
 
+	// Delivery is asynchronous via the outbox
+	requireNotificationsDelivered(t, db, 1)
 	client.AssertExpectations(t)
 }
 
@@ -121,16 +128,59 @@ func Test_ExternalInitiatorManager_DeleteJob(t *testing.T) {
 	require.NoError(t, err)
 
 	client := new(webhookmocks.HTTPClient)
-	eim := webhook.NewExternalInitiatorManager(db, client)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
+	require.NoError(t, eim.Start())
+	defer func() { assert.NoError(t, eim.Close()) }()
 
 	// Does nothing with no EI
-	eim.DeleteJob(webhookSpecNoEIs.ID)
+	require.NoError(t, eim.DeleteJob(webhookSpecNoEIs.ID))
 
 	client.On("Do", mock.MatchedBy(func(r *http.Request) bool {
 		expectedURL := fmt.Sprintf("%s/%s", eiWithURL.URL.String(), jb.ExternalJobID.String())
 		return r.Method == "DELETE" && r.URL.String() == expectedURL && r.Header["Content-Type"][0] == "application/json" && r.Header["X-Chainlink-Ea-Accesskey"][0] == "token" && r.Header["X-Chainlink-Ea-Secret"][0] == "secret"
-	})).Once().Return(&http.Response{Body: io.NopCloser(strings.NewReader(""))}, nil)
-	eim.DeleteJob(webhookSpecTwoEIs.ID)
+	})).Once().Return(&http.Response{StatusCode: 200, Body: io.NopCloser(strings.NewReader(""))}, nil)
+	require.NoError(t, eim.DeleteJob(webhookSpecTwoEIs.ID))
 
+	// Delivery is asynchronous via the outbox
+	requireNotificationsDelivered(t, db, 1)
 	client.AssertExpectations(t)
 }
+
+func Test_ExternalInitiatorManager_RetriesFailedNotifications(t *testing.T) {
+	db := pgtest.NewGormDB(t)
+
+	eiWithURL := cltest.MustInsertExternalInitiatorWithOpts(t, db, cltest.ExternalInitiatorOpts{
+		URL:            cltest.MustWebURL(t, "http://example.com/foo"),
+		OutgoingSecret: "secret",
+		OutgoingToken:  "token",
+	})
+	_, webhookSpec := cltest.MustInsertWebhookSpec(t, db)
+	require.NoError(t, db.Exec(`INSERT INTO external_initiator_webhook_specs (external_initiator_id, webhook_spec_id, spec) VALUES (?,?,?)`, eiWithURL.ID, webhookSpec.ID, `{}`).Error)
+
+	client := new(webhookmocks.HTTPClient)
+	client.On("Do", mock.Anything).Return(&http.Response{StatusCode: 500, Status: "500 Internal Server Error", Body: io.NopCloser(strings.NewReader(""))}, nil)
+	eim := webhook.NewExternalInitiatorManager(db, client, logger.TestLogger(t))
+	require.NoError(t, eim.Start())
+	defer func() { assert.NoError(t, eim.Close()) }()
+
+	require.NoError(t, eim.Notify(webhookSpec.ID))
+
+	// The failure is recorded and the notification rescheduled, not dropped
+	require.Eventually(t, func() bool {
+		notifications, count, err := eim.Notifications(0, 10)
+		require.NoError(t, err)
+		return count == 1 && len(notifications) == 1 &&
+			notifications[0].Attempts > 0 &&
+			!notifications[0].DeliveredAt.Valid &&
+			notifications[0].LastError.Valid
+	}, cltest.DefaultWaitTimeout, 10*time.Millisecond)
+}
+
+func requireNotificationsDelivered(t *testing.T, db *gorm.DB, expected int64) {
+	t.Helper()
+	require.Eventually(t, func() bool {
+		var delivered int64
+		require.NoError(t, db.Raw(`SELECT count(*) FROM external_initiator_notifications WHERE delivered_at IS NOT NULL`).Scan(&delivered).Error)
+		return delivered == expected
+	}, cltest.DefaultWaitTimeout, 10*time.Millisecond)
+}
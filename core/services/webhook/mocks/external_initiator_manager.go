@@ -5,6 +5,8 @@ package mocks
 import (
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 	mock "github.com/stretchr/testify/mock"
+
+	webhook "github.com/smartcontractkit/chainlink/core/services/webhook"
 )
 
 // ExternalInitiatorManager is an autogenerated mock type for the ExternalInitiatorManager type
@@ -47,6 +49,36 @@ func (_m *ExternalInitiatorManager) FindExternalInitiatorByName(name string) (br
 	return r0, r1
 }
 
+// Notifications provides a mock function with given fields: offset, limit
+func (_m *ExternalInitiatorManager) Notifications(offset int, limit int) ([]webhook.Notification, int, error) {
+	ret := _m.Called(offset, limit)
+
+	var r0 []webhook.Notification
+	if rf, ok := ret.Get(0).(func(int, int) []webhook.Notification); ok {
+		r0 = rf(offset, limit)
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]webhook.Notification)
+		}
+	}
+
+	var r1 int
+	if rf, ok := ret.Get(1).(func(int, int) int); ok {
+		r1 = rf(offset, limit)
+	} else {
+		r1 = ret.Get(1).(int)
+	}
+
+	var r2 error
+	if rf, ok := ret.Get(2).(func(int, int) error); ok {
+		r2 = rf(offset, limit)
+	} else {
+		r2 = ret.Error(2)
+	}
+
+	return r0, r1, r2
+}
+
 // Notify provides a mock function with given fields: webhookSpecID
 func (_m *ExternalInitiatorManager) Notify(webhookSpecID int32) error {
 	ret := _m.Called(webhookSpecID)
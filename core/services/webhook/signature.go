@@ -0,0 +1,83 @@
+package webhook
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"database/sql"
+	"encoding/hex"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	uuid "github.com/satori/go.uuid"
+)
+
+const (
+	// SignatureHeader carries the hex-encoded HMAC-SHA256 of
+	// "<timestamp>.<body>" keyed with the webhook job's shared secret
+	SignatureHeader = "X-Chainlink-Signature"
+	// TimestampHeader carries the unix timestamp the signature was computed
+	// at, so that captured requests cannot be replayed later
+	TimestampHeader = "X-Chainlink-Timestamp"
+
+	// signatureTolerance is how far a signed timestamp may deviate from the
+	// node's clock before the request is considered stale
+	signatureTolerance = 5 * time.Minute
+)
+
+// ErrInvalidSignature is returned (wrapped with a reason) when a webhook
+// trigger fails signature verification
+var ErrInvalidSignature = errors.New("invalid webhook signature")
+
+// VerifySignature enforces the HMAC signature on an incoming webhook trigger
+// for jobs that have a shared secret configured. Jobs without a shared secret
+// accept unsigned requests, relying on external initiator auth alone
+func VerifySignature(ctx context.Context, db *sql.DB, jobUUID uuid.UUID, signature, timestamp string, body []byte) error {
+	var secret sql.NullString
+	row := db.QueryRowContext(ctx, `
+SELECT webhook_specs.shared_secret FROM webhook_specs
+JOIN jobs ON jobs.webhook_spec_id = webhook_specs.id
+AND jobs.external_job_id = $1`, jobUUID)
+	if err := row.Scan(&secret); errors.Is(err, sql.ErrNoRows) {
+		return ErrJobNotExists
+	} else if err != nil {
+		return err
+	}
+	if !secret.Valid || secret.String == "" {
+		return nil
+	}
+	return CheckSignature(secret.String, signature, timestamp, body, time.Now())
+}
+
+// CheckSignature verifies that signature is the hex-encoded HMAC-SHA256 of
+// "<timestamp>.<body>" keyed with secret, and that timestamp is a unix time
+// within signatureTolerance of now
+func CheckSignature(secret, signature, timestamp string, body []byte, now time.Time) error {
+	if signature == "" || timestamp == "" {
+		return errors.Wrap(ErrInvalidSignature, "request must be signed")
+	}
+	ts, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return errors.Wrapf(ErrInvalidSignature, "malformed timestamp %q", timestamp)
+	}
+	if d := now.Sub(time.Unix(ts, 0)); d > signatureTolerance || d < -signatureTolerance {
+		return errors.Wrapf(ErrInvalidSignature, "stale timestamp %q", timestamp)
+	}
+	expected := Sign(secret, timestamp, body)
+	if !hmac.Equal([]byte(expected), []byte(strings.ToLower(signature))) {
+		return errors.Wrap(ErrInvalidSignature, "signature does not match")
+	}
+	return nil
+}
+
+// Sign computes the hex-encoded HMAC-SHA256 of "<timestamp>.<body>" keyed
+// with secret
+func Sign(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
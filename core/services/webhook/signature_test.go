@@ -0,0 +1,63 @@
+package webhook_test
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func signWebhook(secret, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+func TestCheckSignature(t *testing.T) {
+	secret := "a-shared-secret"
+	body := []byte(`{"result":"42"}`)
+	now := time.Now()
+	timestamp := fmt.Sprintf("%d", now.Unix())
+
+	t.Run("accepts a valid signature", func(t *testing.T) {
+		sig := signWebhook(secret, timestamp, body)
+		require.NoError(t, webhook.CheckSignature(secret, sig, timestamp, body, now))
+	})
+
+	t.Run("rejects a missing signature", func(t *testing.T) {
+		err := webhook.CheckSignature(secret, "", timestamp, body, now)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, webhook.ErrInvalidSignature))
+	})
+
+	t.Run("rejects a stale timestamp", func(t *testing.T) {
+		staleTimestamp := fmt.Sprintf("%d", now.Add(-time.Hour).Unix())
+		sig := signWebhook(secret, staleTimestamp, body)
+		err := webhook.CheckSignature(secret, sig, staleTimestamp, body, now)
+		require.Error(t, err)
+		assert.Contains(t, err.Error(), "stale timestamp")
+	})
+
+	t.Run("rejects a signature keyed with the wrong secret", func(t *testing.T) {
+		sig := signWebhook("some-other-secret", timestamp, body)
+		err := webhook.CheckSignature(secret, sig, timestamp, body, now)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, webhook.ErrInvalidSignature))
+	})
+
+	t.Run("rejects a signature over a different body", func(t *testing.T) {
+		sig := signWebhook(secret, timestamp, []byte(`{"result":"43"}`))
+		err := webhook.CheckSignature(secret, sig, timestamp, body, now)
+		require.Error(t, err)
+		assert.True(t, errors.Is(err, webhook.ErrInvalidSignature))
+	})
+}
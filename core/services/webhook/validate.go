@@ -18,6 +18,7 @@ type TOMLWebhookSpecExternalInitiator struct {
 
 type TOMLWebhookSpec struct {
 	ExternalInitiators []TOMLWebhookSpecExternalInitiator `toml:"externalInitiators"`
+	SharedSecret       string                             `toml:"sharedSecret"`
 }
 
 func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalInitiatorManager) (jb job.Job, err error) {
@@ -61,6 +62,7 @@ func ValidatedWebhookSpec(tomlString string, externalInitiatorManager ExternalIn
 
 	jb.WebhookSpec = &job.WebhookSpec{
 		ExternalInitiatorWebhookSpecs: externalInitiatorWebhookSpecs,
+		SharedSecret:                  tomlSpec.SharedSecret,
 	}
 
 	return jb, nil
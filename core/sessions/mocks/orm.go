@@ -3,6 +3,8 @@
 package mocks
 
 import (
+	gin "github.com/gin-gonic/gin"
+
 	auth "github.com/smartcontractkit/chainlink/core/auth"
 	bridges "github.com/smartcontractkit/chainlink/core/bridges"
 
@@ -51,6 +53,55 @@ func (_m *ORM) ClearNonCurrentSessions(sessionID string) error {
 	return r0
 }
 
+// ConfirmTOTP provides a mock function with given fields: email, code
+func (_m *ORM) ConfirmTOTP(email string, code string) error {
+	ret := _m.Called(email, code)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string) error); ok {
+		r0 = rf(email, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// ConsumeExternalInitiatorNonce provides a mock function with given fields: externalInitiatorID, nonce
+func (_m *ORM) ConsumeExternalInitiatorNonce(externalInitiatorID int64, nonce string) error {
+	ret := _m.Called(externalInitiatorID, nonce)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(int64, string) error); ok {
+		r0 = rf(externalInitiatorID, nonce)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
+// CountSessions provides a mock function with given fields:
+func (_m *ORM) CountSessions() (int, error) {
+	ret := _m.Called()
+
+	var r0 int
+	if rf, ok := ret.Get(0).(func() int); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Get(0).(int)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateSession provides a mock function with given fields: sr
 func (_m *ORM) CreateSession(sr sessions.SessionRequest) (string, error) {
 	ret := _m.Called(sr)
@@ -72,6 +123,27 @@ func (_m *ORM) CreateSession(sr sessions.SessionRequest) (string, error) {
 	return r0, r1
 }
 
+// CreateSessionForUser provides a mock function with given fields: user, requestContext
+func (_m *ORM) CreateSessionForUser(user sessions.User, requestContext *gin.Context) (string, error) {
+	ret := _m.Called(user, requestContext)
+
+	var r0 string
+	if rf, ok := ret.Get(0).(func(sessions.User, *gin.Context) string); ok {
+		r0 = rf(user, requestContext)
+	} else {
+		r0 = ret.Get(0).(string)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(sessions.User, *gin.Context) error); ok {
+		r1 = rf(user, requestContext)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // CreateUser provides a mock function with given fields: user
 func (_m *ORM) CreateUser(user *sessions.User) error {
 	ret := _m.Called(user)
@@ -86,6 +158,20 @@ func (_m *ORM) CreateUser(user *sessions.User) error {
 	return r0
 }
 
+// DeleteAllSessions provides a mock function with given fields:
+func (_m *ORM) DeleteAllSessions() error {
+	ret := _m.Called()
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func() error); ok {
+		r0 = rf()
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // DeleteAuthToken provides a mock function with given fields: user
 func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	ret := _m.Called(user)
@@ -100,13 +186,13 @@ func (_m *ORM) DeleteAuthToken(user *sessions.User) error {
 	return r0
 }
 
-// DeleteUser provides a mock function with given fields:
-func (_m *ORM) DeleteUser() error {
-	ret := _m.Called()
+// DeleteUser provides a mock function with given fields: email
+func (_m *ORM) DeleteUser(email string) error {
+	ret := _m.Called(email)
 
 	var r0 error
-	if rf, ok := ret.Get(0).(func() error); ok {
-		r0 = rf()
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
 	} else {
 		r0 = ret.Error(0)
 	}
@@ -128,6 +214,20 @@ func (_m *ORM) DeleteUserSession(sessionID string) error {
 	return r0
 }
 
+// EnrollTOTP provides a mock function with given fields: email, secret, recoveryCodes
+func (_m *ORM) EnrollTOTP(email string, secret string, recoveryCodes []string) error {
+	ret := _m.Called(email, secret, recoveryCodes)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string, string, []string) error); ok {
+		r0 = rf(email, secret, recoveryCodes)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // FindExternalInitiator provides a mock function with given fields: eia
 func (_m *ORM) FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error) {
 	ret := _m.Called(eia)
@@ -172,6 +272,48 @@ func (_m *ORM) FindUser() (sessions.User, error) {
 	return r0, r1
 }
 
+// FindUserByAPIToken provides a mock function with given fields: apiToken
+func (_m *ORM) FindUserByAPIToken(apiToken string) (sessions.User, error) {
+	ret := _m.Called(apiToken)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(apiToken)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(apiToken)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// FindUserByEmail provides a mock function with given fields: email
+func (_m *ORM) FindUserByEmail(email string) (sessions.User, error) {
+	ret := _m.Called(email)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string) sessions.User); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string) error); ok {
+		r1 = rf(email)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // GetUserWebAuthn provides a mock function with given fields: email
 func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	ret := _m.Called(email)
@@ -195,6 +337,43 @@ func (_m *ORM) GetUserWebAuthn(email string) ([]sessions.WebAuthn, error) {
 	return r0, r1
 }
 
+// ListUsers provides a mock function with given fields:
+func (_m *ORM) ListUsers() ([]sessions.User, error) {
+	ret := _m.Called()
+
+	var r0 []sessions.User
+	if rf, ok := ret.Get(0).(func() []sessions.User); ok {
+		r0 = rf()
+	} else {
+		if ret.Get(0) != nil {
+			r0 = ret.Get(0).([]sessions.User)
+		}
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func() error); ok {
+		r1 = rf()
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// RemoveTOTP provides a mock function with given fields: email
+func (_m *ORM) RemoveTOTP(email string) error {
+	ret := _m.Called(email)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(string) error); ok {
+		r0 = rf(email)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
+
 // SaveWebAuthn provides a mock function with given fields: token
 func (_m *ORM) SaveWebAuthn(token *sessions.WebAuthn) error {
 	ret := _m.Called(token)
@@ -246,6 +425,27 @@ func (_m *ORM) SetAuthToken(user *sessions.User, token *auth.Token) error {
 	return r0
 }
 
+// SetDisabled provides a mock function with given fields: email, disabled
+func (_m *ORM) SetDisabled(email string, disabled bool) (sessions.User, error) {
+	ret := _m.Called(email, disabled)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string, bool) sessions.User); ok {
+		r0 = rf(email, disabled)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, bool) error); ok {
+		r1 = rf(email, disabled)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
 // SetPassword provides a mock function with given fields: user, newPassword
 func (_m *ORM) SetPassword(user *sessions.User, newPassword string) error {
 	ret := _m.Called(user, newPassword)
@@ -259,3 +459,38 @@ func (_m *ORM) SetPassword(user *sessions.User, newPassword string) error {
 
 	return r0
 }
+
+// UpdateRole provides a mock function with given fields: email, newRole
+func (_m *ORM) UpdateRole(email string, newRole string) (sessions.User, error) {
+	ret := _m.Called(email, newRole)
+
+	var r0 sessions.User
+	if rf, ok := ret.Get(0).(func(string, string) sessions.User); ok {
+		r0 = rf(email, newRole)
+	} else {
+		r0 = ret.Get(0).(sessions.User)
+	}
+
+	var r1 error
+	if rf, ok := ret.Get(1).(func(string, string) error); ok {
+		r1 = rf(email, newRole)
+	} else {
+		r1 = ret.Error(1)
+	}
+
+	return r0, r1
+}
+
+// VerifyUserTOTP provides a mock function with given fields: user, code
+func (_m *ORM) VerifyUserTOTP(user *sessions.User, code string) error {
+	ret := _m.Called(user, code)
+
+	var r0 error
+	if rf, ok := ret.Get(0).(func(*sessions.User, string) error); ok {
+		r0 = rf(user, code)
+	} else {
+		r0 = ret.Error(0)
+	}
+
+	return r0
+}
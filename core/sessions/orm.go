@@ -1,12 +1,12 @@
 package sessions
 
 import (
-	"crypto/subtle"
 	"database/sql"
 	"encoding/json"
 	"strings"
 	"time"
 
+	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -21,86 +21,152 @@ import (
 
 type ORM interface {
 	FindUser() (User, error)
+	FindUserByEmail(email string) (User, error)
+	FindUserByAPIToken(apiToken string) (User, error)
+	ListUsers() ([]User, error)
 	AuthorizedUserWithSession(sessionID string) (User, error)
-	DeleteUser() error
+	DeleteUser(email string) error
 	DeleteUserSession(sessionID string) error
+	DeleteAllSessions() error
 	CreateSession(sr SessionRequest) (string, error)
+	CreateSessionForUser(user User, requestContext *gin.Context) (string, error)
 	ClearNonCurrentSessions(sessionID string) error
 	CreateUser(user *User) error
+	UpdateRole(email, newRole string) (User, error)
+	SetDisabled(email string, disabled bool) (User, error)
 	SetAuthToken(user *User, token *auth.Token) error
 	DeleteAuthToken(user *User) error
 	SetPassword(user *User, newPassword string) error
+	EnrollTOTP(email, secret string, recoveryCodes []string) error
+	ConfirmTOTP(email, code string) error
+	RemoveTOTP(email string) error
+	VerifyUserTOTP(user *User, code string) error
 	Sessions(offset, limit int) ([]Session, error)
+	CountSessions() (int, error)
 	GetUserWebAuthn(email string) ([]WebAuthn, error)
 	SaveWebAuthn(token *WebAuthn) error
 
 	FindExternalInitiator(eia *auth.Token) (initiator *bridges.ExternalInitiator, err error)
+	ConsumeExternalInitiatorNonce(externalInitiatorID int64, nonce string) error
 }
 
 type orm struct {
-	db              *sqlx.DB
-	sessionDuration time.Duration
-	lggr            logger.Logger
+	db *sqlx.DB
+	// sessionDuration is the idle session lifetime, absoluteSessionDuration
+	// bounds the total session lifetime regardless of activity (0 = unbounded)
+	sessionDuration         time.Duration
+	absoluteSessionDuration time.Duration
+	// totpEncryptionKey encrypts users' TOTP secrets at rest
+	totpEncryptionKey string
+	lggr              logger.Logger
 }
 
 var _ ORM = (*orm)(nil)
 
-func NewORM(db *sqlx.DB, sessionDuration time.Duration, lggr logger.Logger) ORM {
-	return &orm{db, sessionDuration, lggr.Named("SessionsORM")}
+func NewORM(db *sqlx.DB, sessionDuration, absoluteSessionDuration time.Duration, totpEncryptionKey string, lggr logger.Logger) ORM {
+	return &orm{db, sessionDuration, absoluteSessionDuration, totpEncryptionKey, lggr.Named("SessionsORM")}
 }
 
-// FindUser will return the one API user, or an error.
-func (o *orm) FindUser() (User, error) {
-	return o.findUser()
-}
-
-func (o *orm) findUser() (user User, err error) {
+// FindUser will return the most recently created API user, or an error.
+// It exists to support bootstrapping a node that has no users yet.
+func (o *orm) FindUser() (user User, err error) {
 	sql := "SELECT * FROM users ORDER BY created_at desc LIMIT 1"
 	err = o.db.Get(&user, sql)
 	return
 }
 
-// AuthorizedUserWithSession will return the one API user if the Session ID exists
-// and hasn't expired, and update session's LastUsed field.
+// FindUserByEmail will return the API user with the given email, or an error.
+func (o *orm) FindUserByEmail(email string) (user User, err error) {
+	err = o.db.Get(&user, "SELECT * FROM users WHERE lower(email) = lower($1)", email)
+	return
+}
+
+// FindUserByAPIToken will return the API user with the given API token key.
+// Disabled users cannot authenticate, so they are reported as ErrUserDisabled.
+func (o *orm) FindUserByAPIToken(apiToken string) (User, error) {
+	var user User
+	err := o.db.Get(&user, "SELECT * FROM users WHERE token_key = $1", apiToken)
+	if err != nil {
+		return User{}, err
+	}
+	if user.Disabled {
+		return User{}, ErrUserDisabled
+	}
+	return user, nil
+}
+
+// ListUsers returns all API users.
+func (o *orm) ListUsers() (users []User, err error) {
+	err = o.db.Select(&users, "SELECT * FROM users ORDER BY email ASC")
+	return
+}
+
+// ErrUserDisabled is returned when a disabled user attempts to authenticate.
+var ErrUserDisabled = errors.New("user is disabled")
+
+// AuthorizedUserWithSession will return the API user the Session ID belongs to
+// if it exists and hasn't expired, and update session's LastUsed field.
 func (o *orm) AuthorizedUserWithSession(sessionID string) (User, error) {
 	if len(sessionID) == 0 {
 		return User{}, errors.New("Session ID cannot be empty")
 	}
 
-	result, err := o.db.Exec("UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()", sessionID, o.sessionDuration)
+	query := "UPDATE sessions SET last_used = now() WHERE id = $1 AND last_used + $2 >= now()"
+	args := []interface{}{sessionID, o.sessionDuration}
+	if o.absoluteSessionDuration > 0 {
+		query += " AND created_at + $3 >= now()"
+		args = append(args, o.absoluteSessionDuration)
+	}
+	query += " RETURNING email"
+
+	var email string
+	err := o.db.Get(&email, query, args...)
+	if errors.Is(err, sql.ErrNoRows) {
+		return User{}, sql.ErrNoRows
+	}
 	if err != nil {
 		return User{}, err
 	}
-	rowsAffected, err := result.RowsAffected()
+	user, err := o.FindUserByEmail(email)
 	if err != nil {
 		return User{}, err
 	}
-	if rowsAffected == 0 {
-		return User{}, sql.ErrNoRows
+	if user.Disabled {
+		return User{}, ErrUserDisabled
 	}
-	return o.FindUser()
+	return user, nil
 }
 
-// DeleteUser will delete the API User in the db.
-func (o *orm) DeleteUser() error {
-	ctx, cancel := postgres.DefaultQueryCtx()
-	defer cancel()
-	return postgres.SqlxTransaction(ctx, o.db, o.lggr, func(tx postgres.Queryer) error {
-		if _, err := tx.Exec("DELETE FROM users"); err != nil {
-			return err
-		}
-
-		_, err := tx.Exec("DELETE FROM sessions")
+// DeleteUser will delete the API User with the given email in the db, along
+// with their sessions (via the sessions email foreign key).
+func (o *orm) DeleteUser(email string) error {
+	result, err := o.db.Exec("DELETE FROM users WHERE lower(email) = lower($1)", email)
+	if err != nil {
 		return err
-	})
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
 }
 
-// DeleteUserSession will erase the session ID for the sole API User.
+// DeleteUserSession will erase the session with the given ID, logging out
+// whoever holds the matching cookie.
 func (o *orm) DeleteUserSession(sessionID string) error {
 	_, err := o.db.Exec("DELETE FROM sessions WHERE id = $1", sessionID)
 	return err
 }
 
+// DeleteAllSessions will erase every session, forcibly logging out all users.
+func (o *orm) DeleteAllSessions() error {
+	_, err := o.db.Exec("DELETE FROM sessions")
+	return err
+}
+
 // GetUserWebAuthn will return a list of structures representing all enrolled WebAuthn
 // tokens for the user. This list must be used when logging in (for obvious reasons) but
 // must also be used for registration to prevent the user from enrolling the same hardware
@@ -120,23 +186,40 @@ func (o *orm) GetUserWebAuthn(email string) ([]WebAuthn, error) {
 // the hashed API User password in the db. Also will check WebAuthn if it's
 // enabled for that user.
 func (o *orm) CreateSession(sr SessionRequest) (string, error) {
-	user, err := o.FindUser()
+	user, err := o.FindUserByEmail(sr.Email)
 	if err != nil {
-		return "", err
+		return "", errors.New("Invalid email")
 	}
 	lggr := o.lggr.With("user", user)
 	lggr.Debugw("Found user")
 
-	// Do email and password check first to prevent extra database look up
+	// Do the password check first to prevent an extra database look up
 	// for MFA tokens leaking if an account has MFA tokens or not.
-	if !constantTimeEmailCompare(sr.Email, user.Email) {
-		return "", errors.New("Invalid email")
-	}
-
 	if !utils.CheckPasswordHash(sr.Password, user.HashedPassword) {
 		return "", errors.New("Invalid password")
 	}
 
+	if user.Disabled {
+		return "", ErrUserDisabled
+	}
+
+	if user.TOTPEnabled() {
+		if sr.TOTPCode == "" {
+			return "", ErrTOTPCodeRequired
+		}
+		if err := o.VerifyUserTOTP(&user, sr.TOTPCode); err != nil {
+			return "", err
+		}
+	}
+
+	// Record where the session was established from, for the active sessions
+	// listing. The request context is not set when logging in via the CLI.
+	var ip, userAgent string
+	if sr.RequestContext != nil {
+		ip = sr.RequestContext.ClientIP()
+		userAgent = sr.RequestContext.Request.UserAgent()
+	}
+
 	// Load all valid MFA tokens associated with user's email
 	uwas, err := o.GetUserWebAuthn(user.Email)
 	if err != nil {
@@ -149,7 +232,7 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	if len(uwas) == 0 {
 		lggr.Infof("No MFA for user. Creating Session")
 		session := NewSession()
-		_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+		_, err = o.db.Exec("INSERT INTO sessions (id, email, ip, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, ip, userAgent)
 		return session.ID, err
 	}
 
@@ -187,19 +270,27 @@ func (o *orm) CreateSession(sr SessionRequest) (string, error) {
 	lggr.Infof("User passed MFA authentication and login will proceed")
 	// This is a success so we can create the sessions
 	session := NewSession()
-	_, err = o.db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	_, err = o.db.Exec("INSERT INTO sessions (id, email, ip, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, ip, userAgent)
 	return session.ID, err
 }
 
-const constantTimeEmailLength = 256
+// CreateSessionForUser establishes a session for a user who has already been
+// authenticated out of band, e.g. by an OIDC identity provider. It bypasses
+// the password and MFA checks but still refuses disabled users.
+func (o *orm) CreateSessionForUser(user User, requestContext *gin.Context) (string, error) {
+	if user.Disabled {
+		return "", ErrUserDisabled
+	}
+
+	var ip, userAgent string
+	if requestContext != nil {
+		ip = requestContext.ClientIP()
+		userAgent = requestContext.Request.UserAgent()
+	}
 
-func constantTimeEmailCompare(left, right string) bool {
-	length := utils.MaxInt(constantTimeEmailLength, len(left), len(right))
-	leftBytes := make([]byte, length)
-	rightBytes := make([]byte, length)
-	copy(leftBytes, left)
-	copy(rightBytes, right)
-	return subtle.ConstantTimeCompare(leftBytes, rightBytes) == 1
+	session := NewSession()
+	_, err := o.db.Exec("INSERT INTO sessions (id, email, ip, user_agent, last_used, created_at) VALUES ($1, $2, $3, $4, now(), now())", session.ID, user.Email, ip, userAgent)
+	return session.ID, err
 }
 
 // ClearNonCurrentSessions removes all sessions but the id passed in.
@@ -210,8 +301,39 @@ func (o *orm) ClearNonCurrentSessions(sessionID string) error {
 
 // Creates creates the user.
 func (o *orm) CreateUser(user *User) error {
-	sql := "INSERT INTO users (email, hashed_password, created_at, updated_at) VALUES ($1, $2, now(), now()) RETURNING *"
-	return o.db.Get(user, sql, user.Email, user.HashedPassword)
+	sql := "INSERT INTO users (email, hashed_password, role, created_at, updated_at) VALUES ($1, $2, $3, now(), now()) RETURNING *"
+	return o.db.Get(user, sql, user.Email, user.HashedPassword, user.Role)
+}
+
+// UpdateRole changes the role of the user identified by email.
+func (o *orm) UpdateRole(email, newRole string) (User, error) {
+	role, err := GetUserRole(newRole)
+	if err != nil {
+		return User{}, err
+	}
+	var user User
+	sql := "UPDATE users SET role = $1, updated_at = now() WHERE email = $2 RETURNING *"
+	err = o.db.Get(&user, sql, role, email)
+	return user, errors.Wrap(err, "failed to update role")
+}
+
+// SetDisabled enables or disables the user identified by email. Disabling a
+// user also invalidates any active sessions they hold.
+func (o *orm) SetDisabled(email string, disabled bool) (User, error) {
+	ctx, cancel := postgres.DefaultQueryCtx()
+	defer cancel()
+	var user User
+	err := postgres.SqlxTransaction(ctx, o.db, o.lggr, func(tx postgres.Queryer) error {
+		if err := tx.Get(&user, "UPDATE users SET disabled = $1, updated_at = now() WHERE lower(email) = lower($2) RETURNING *", disabled, email); err != nil {
+			return err
+		}
+		if !disabled {
+			return nil
+		}
+		_, err := tx.Exec("DELETE FROM sessions WHERE email = $1", user.Email)
+		return err
+	})
+	return user, errors.Wrap(err, "failed to update user")
 }
 
 // SetAuthToken updates the user to use the given Authentication Token.
@@ -241,6 +363,92 @@ func (o *orm) DeleteAuthToken(user *User) error {
 	return o.db.Get(user, sql, user.Email)
 }
 
+// EnrollTOTP stores an unconfirmed TOTP secret and recovery codes for the
+// user identified by email. The secret is encrypted at rest and only hashes
+// of the recovery codes are stored. Enforcement only begins once the
+// enrollment is confirmed via ConfirmTOTP.
+func (o *orm) EnrollTOTP(email, secret string, recoveryCodes []string) error {
+	encSecret, err := NewEncryptedTOTPSecret(secret, o.totpEncryptionKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to encrypt TOTP secret")
+	}
+	hashes := make([]string, len(recoveryCodes))
+	for i, code := range recoveryCodes {
+		if hashes[i], err = utils.HashPassword(code); err != nil {
+			return errors.Wrap(err, "failed to hash recovery code")
+		}
+	}
+	hashesJSON, err := json.Marshal(hashes)
+	if err != nil {
+		return err
+	}
+	result, err := o.db.Exec("UPDATE users SET totp_secret = $1, totp_confirmed = false, totp_recovery_codes = $2, updated_at = now() WHERE lower(email) = lower($3)", encSecret, hashesJSON, email)
+	if err != nil {
+		return errors.Wrap(err, "failed to enroll TOTP")
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return sql.ErrNoRows
+	}
+	return nil
+}
+
+// ConfirmTOTP completes TOTP enrollment by checking a code generated from the
+// pending secret, proving the authenticator app was set up correctly.
+func (o *orm) ConfirmTOTP(email, code string) error {
+	user, err := o.FindUserByEmail(email)
+	if err != nil {
+		return err
+	}
+	secret, err := user.TOTPSecret.Decrypt(o.totpEncryptionKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt TOTP secret")
+	}
+	if !VerifyTOTPCode(secret, code) {
+		return ErrInvalidTOTPCode
+	}
+	_, err = o.db.Exec("UPDATE users SET totp_confirmed = true, updated_at = now() WHERE email = $1", user.Email)
+	return err
+}
+
+// RemoveTOTP clears the user's TOTP enrollment and recovery codes.
+func (o *orm) RemoveTOTP(email string) error {
+	_, err := o.db.Exec("UPDATE users SET totp_secret = 'null', totp_confirmed = false, totp_recovery_codes = '[]', updated_at = now() WHERE lower(email) = lower($1)", email)
+	return err
+}
+
+// VerifyUserTOTP checks code against the user's TOTP secret, falling back to
+// the recovery codes. A matched recovery code is consumed and cannot be used
+// again.
+func (o *orm) VerifyUserTOTP(user *User, code string) error {
+	secret, err := user.TOTPSecret.Decrypt(o.totpEncryptionKey)
+	if err != nil {
+		return errors.Wrap(err, "failed to decrypt TOTP secret")
+	}
+	if VerifyTOTPCode(secret, code) {
+		return nil
+	}
+
+	var hashes []string
+	if err := json.Unmarshal(user.TOTPRecoveryCodes, &hashes); err != nil {
+		return errors.Wrap(err, "failed to unmarshal recovery codes")
+	}
+	for i, hash := range hashes {
+		if utils.CheckPasswordHash(code, hash) {
+			remaining, err := json.Marshal(append(hashes[:i], hashes[i+1:]...))
+			if err != nil {
+				return err
+			}
+			_, err = o.db.Exec("UPDATE users SET totp_recovery_codes = $1, updated_at = now() WHERE email = $2", remaining, user.Email)
+			return errors.Wrap(err, "failed to consume recovery code")
+		}
+	}
+	return ErrInvalidTOTPCode
+}
+
 // SaveWebAuthn saves new WebAuthn token information.
 func (o *orm) SaveWebAuthn(token *WebAuthn) error {
 	sql := "INSERT INTO web_authns (email, public_key_data) VALUES ($1, $2)"
@@ -257,6 +465,12 @@ func (o *orm) Sessions(offset, limit int) (sessions []Session, err error) {
 	return
 }
 
+// CountSessions returns the total number of sessions.
+func (o *orm) CountSessions() (count int, err error) {
+	err = o.db.Get(&count, "SELECT count(*) FROM sessions")
+	return
+}
+
 // NOTE: this is duplicated from the bridges ORM to appease the AuthStorer interface
 func (o *orm) FindExternalInitiator(
 	eia *auth.Token,
@@ -265,3 +479,22 @@ func (o *orm) FindExternalInitiator(
 	err := o.db.Get(exi, `SELECT * FROM external_initiators WHERE access_key = $1`, eia.AccessKey)
 	return exi, err
 }
+
+// ConsumeExternalInitiatorNonce records the nonce of a signed external
+// initiator request, erroring if it has been seen before. Old nonces are
+// garbage collected by the session reaper once their signatures can no
+// longer validate.
+func (o *orm) ConsumeExternalInitiatorNonce(externalInitiatorID int64, nonce string) error {
+	result, err := o.db.Exec(`INSERT INTO external_initiator_nonces (external_initiator_id, nonce, created_at) VALUES ($1, $2, now()) ON CONFLICT DO NOTHING`, externalInitiatorID, nonce)
+	if err != nil {
+		return err
+	}
+	rowsAffected, err := result.RowsAffected()
+	if err != nil {
+		return err
+	}
+	if rowsAffected == 0 {
+		return errors.New("external initiator nonce has already been used")
+	}
+	return nil
+}
@@ -18,7 +18,7 @@ func setupORM(t *testing.T) (*sqlx.DB, sessions.ORM) {
 	t.Helper()
 
 	db := pgtest.NewSqlxDB(t)
-	orm := sessions.NewORM(db, time.Minute, logger.TestLogger(t))
+	orm := sessions.NewORM(db, time.Minute, 0, "", logger.TestLogger(t))
 
 	return db, orm
 }
@@ -60,14 +60,14 @@ func TestORM_AuthorizedUserWithSession(t *testing.T) {
 	for _, test := range tests {
 		t.Run(test.name, func(t *testing.T) {
 			db := pgtest.NewSqlxDB(t)
-			orm := sessions.NewORM(db, test.sessionDuration, logger.TestLogger(t))
+			orm := sessions.NewORM(db, test.sessionDuration, 0, "", logger.TestLogger(t))
 
 			user := cltest.MustNewUser(t, "have@email", "password")
 			require.NoError(t, orm.CreateUser(&user))
 
 			prevSession := cltest.NewSession("correctID")
 			prevSession.LastUsed = time.Now().Add(-cltest.MustParseDuration(t, "2m"))
-			_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, $2, now())", prevSession.ID, prevSession.LastUsed)
+			_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, $3, now())", prevSession.ID, user.Email, prevSession.LastUsed)
 			require.NoError(t, err)
 
 			expectedTime := utils.ISO8601UTC(time.Now())
@@ -90,10 +90,10 @@ func TestORM_DeleteUser(t *testing.T) {
 	t.Parallel()
 	_, orm := setupORM(t)
 
-	_, err := orm.FindUser()
+	user, err := orm.FindUser()
 	require.NoError(t, err)
 
-	err = orm.DeleteUser()
+	err = orm.DeleteUser(user.Email)
 	require.NoError(t, err)
 
 	_, err = orm.FindUser()
@@ -106,7 +106,7 @@ func TestORM_DeleteUserSession(t *testing.T) {
 	db, orm := setupORM(t)
 
 	session := sessions.NewSession()
-	_, err := db.Exec("INSERT INTO sessions (id, last_used, created_at) VALUES ($1, now(), now())", session.ID)
+	_, err := db.Exec("INSERT INTO sessions (id, email, last_used, created_at) VALUES ($1, $2, now(), now())", session.ID, cltest.APIEmail)
 	require.NoError(t, err)
 
 	err = orm.DeleteUserSession(session.ID)
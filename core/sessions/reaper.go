@@ -4,6 +4,7 @@ import (
 	"database/sql"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/bridges"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 	"github.com/smartcontractkit/chainlink/core/utils"
@@ -16,6 +17,7 @@ type sessionReaper struct {
 
 type SessionReaperConfig interface {
 	SessionTimeout() models.Duration
+	SessionAbsoluteTimeout() models.Duration
 	ReaperExpiration() models.Duration
 }
 
@@ -34,6 +36,19 @@ func (sr *sessionReaper) Work() {
 	if err != nil {
 		logger.Error("unable to reap stale sessions: ", err)
 	}
+
+	if sr.config.SessionAbsoluteTimeout().Duration() > 0 {
+		absoluteStaleThreshold := sr.config.SessionAbsoluteTimeout().Before(time.Now())
+		err = sr.deleteExpiredSessions(absoluteStaleThreshold)
+		if err != nil {
+			logger.Error("unable to reap expired sessions: ", err)
+		}
+	}
+
+	err = sr.deleteStaleExternalInitiatorNonces(time.Now().Add(-2 * bridges.ExternalInitiatorSignatureMaxAge))
+	if err != nil {
+		logger.Error("unable to reap stale external initiator nonces: ", err)
+	}
 }
 
 // DeleteStaleSessions deletes all sessions before the passed time.
@@ -41,3 +56,17 @@ func (sr *sessionReaper) deleteStaleSessions(before time.Time) error {
 	_, err := sr.db.Exec("DELETE FROM sessions WHERE last_used < $1", before)
 	return err
 }
+
+// deleteExpiredSessions deletes all sessions created before the passed time,
+// enforcing the absolute session lifetime regardless of activity.
+func (sr *sessionReaper) deleteExpiredSessions(before time.Time) error {
+	_, err := sr.db.Exec("DELETE FROM sessions WHERE created_at < $1", before)
+	return err
+}
+
+// deleteStaleExternalInitiatorNonces deletes replay protection nonces too old
+// for their signatures to still be within the allowed timestamp window.
+func (sr *sessionReaper) deleteStaleExternalInitiatorNonces(before time.Time) error {
+	_, err := sr.db.Exec("DELETE FROM external_initiator_nonces WHERE created_at < $1", before)
+	return err
+}
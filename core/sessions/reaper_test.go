@@ -5,6 +5,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
 	"github.com/smartcontractkit/chainlink/core/internal/testutils/pgtest"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/sessions"
@@ -21,6 +22,10 @@ func (c sessionReaperConfig) SessionTimeout() models.Duration {
 	return models.MustMakeDuration(42 * time.Second)
 }
 
+func (c sessionReaperConfig) SessionAbsoluteTimeout() models.Duration {
+	return models.MustMakeDuration(0)
+}
+
 func (c sessionReaperConfig) ReaperExpiration() models.Duration {
 	return models.MustMakeDuration(142 * time.Second)
 }
@@ -30,7 +35,7 @@ func TestSessionReaper_ReapSessions(t *testing.T) {
 
 	db := pgtest.NewSqlxDB(t)
 	config := sessionReaperConfig{}
-	orm := sessions.NewORM(db, config.SessionTimeout().Duration(), logger.TestLogger(t))
+	orm := sessions.NewORM(db, config.SessionTimeout().Duration(), 0, "", logger.TestLogger(t))
 
 	r := sessions.NewSessionReaper(db.DB, config)
 	defer r.Stop()
@@ -53,7 +58,7 @@ func TestSessionReaper_ReapSessions(t *testing.T) {
 				clearSessions(t, db.DB)
 			})
 
-			_, err := db.Exec("INSERT INTO sessions (last_used, id, created_at) VALUES ($1, $2, now())", test.lastUsed, test.name)
+			_, err := db.Exec("INSERT INTO sessions (last_used, email, id, created_at) VALUES ($1, $2, $3, now())", test.lastUsed, cltest.APIEmail, test.name)
 			require.NoError(t, err)
 
 			r.WakeUp()
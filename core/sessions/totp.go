@@ -0,0 +1,188 @@
+package sessions
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/sha256"
+	"crypto/subtle"
+	"database/sql/driver"
+	"encoding/base32"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+// TOTP per RFC 6238 (HMAC-SHA1, 6 digits, 30 second period), compatible with
+// standard authenticator apps.
+const (
+	totpDigits = 6
+	totpPeriod = 30 * time.Second
+	// totpSkew is the number of periods of clock drift tolerated in either
+	// direction when verifying a code
+	totpSkew = 1
+
+	// TOTPRecoveryCodeCount is the number of single-use recovery codes
+	// generated at enrollment
+	TOTPRecoveryCodeCount = 10
+	totpRecoveryCodeBytes = 10
+	totpSecretBytes       = 20
+)
+
+var (
+	// ErrTOTPCodeRequired is returned when a user with TOTP enrolled attempts
+	// a protected action without supplying a code.
+	ErrTOTPCodeRequired = errors.New("TOTP code is required")
+	// ErrInvalidTOTPCode is returned when the supplied TOTP code matches
+	// neither the current code nor an unused recovery code.
+	ErrInvalidTOTPCode = errors.New("invalid TOTP code")
+)
+
+var totpBase32 = base32.StdEncoding.WithPadding(base32.NoPadding)
+
+// GenerateTOTPSecret returns a new random base32-encoded TOTP secret.
+func GenerateTOTPSecret() (string, error) {
+	b := make([]byte, totpSecretBytes)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return totpBase32.EncodeToString(b), nil
+}
+
+// TOTPProvisioningURI returns the otpauth:// URI encoding the secret, for
+// enrollment in an authenticator app.
+func TOTPProvisioningURI(secret, email string) string {
+	return fmt.Sprintf("otpauth://totp/Chainlink:%s?secret=%s&issuer=Chainlink", url.PathEscape(email), secret)
+}
+
+// totpCode derives the code for the period containing t.
+func totpCode(secret string, t time.Time) (string, error) {
+	key, err := totpBase32.DecodeString(strings.ToUpper(secret))
+	if err != nil {
+		return "", errors.Wrap(err, "invalid TOTP secret")
+	}
+
+	var counter [8]byte
+	binary.BigEndian.PutUint64(counter[:], uint64(t.Unix())/uint64(totpPeriod.Seconds()))
+
+	mac := hmac.New(sha1.New, key)
+	mac.Write(counter[:])
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation per RFC 4226 section 5.3
+	offset := sum[len(sum)-1] & 0xf
+	value := (uint32(sum[offset])&0x7f)<<24 |
+		uint32(sum[offset+1])<<16 |
+		uint32(sum[offset+2])<<8 |
+		uint32(sum[offset+3])
+
+	mod := uint32(1)
+	for i := 0; i < totpDigits; i++ {
+		mod *= 10
+	}
+	return fmt.Sprintf("%0*d", totpDigits, value%mod), nil
+}
+
+// VerifyTOTPCode checks code against secret, tolerating totpSkew periods of
+// clock drift in either direction.
+func VerifyTOTPCode(secret, code string) bool {
+	now := time.Now()
+	for i := -totpSkew; i <= totpSkew; i++ {
+		expected, err := totpCode(secret, now.Add(time.Duration(i)*totpPeriod))
+		if err != nil {
+			return false
+		}
+		if subtle.ConstantTimeCompare([]byte(expected), []byte(code)) == 1 {
+			return true
+		}
+	}
+	return false
+}
+
+// GenerateRecoveryCodes returns n random single-use recovery codes in plain
+// text. Only hashes of these are ever persisted.
+func GenerateRecoveryCodes(n int) ([]string, error) {
+	codes := make([]string, n)
+	for i := range codes {
+		b := make([]byte, totpRecoveryCodeBytes)
+		if _, err := rand.Read(b); err != nil {
+			return nil, err
+		}
+		codes[i] = hex.EncodeToString(b)
+	}
+	return codes, nil
+}
+
+// EncryptedTOTPSecret is a TOTP secret encrypted with AES-256-GCM under a key
+// derived from the node's session secret, stored as jsonb in the users table.
+type EncryptedTOTPSecret struct {
+	Nonce      string `json:"nonce"`
+	CipherText string `json:"cipherText"`
+}
+
+// NewEncryptedTOTPSecret encrypts secret under encryptionKey.
+func NewEncryptedTOTPSecret(secret, encryptionKey string) (EncryptedTOTPSecret, error) {
+	gcm, err := totpCipher(encryptionKey)
+	if err != nil {
+		return EncryptedTOTPSecret{}, err
+	}
+	nonce := make([]byte, gcm.NonceSize())
+	if _, err := rand.Read(nonce); err != nil {
+		return EncryptedTOTPSecret{}, err
+	}
+	cipherText := gcm.Seal(nil, nonce, []byte(secret), nil)
+	return EncryptedTOTPSecret{
+		Nonce:      hex.EncodeToString(nonce),
+		CipherText: hex.EncodeToString(cipherText),
+	}, nil
+}
+
+// Decrypt returns the plain text TOTP secret, or an error.
+func (s EncryptedTOTPSecret) Decrypt(encryptionKey string) (string, error) {
+	gcm, err := totpCipher(encryptionKey)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := hex.DecodeString(s.Nonce)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid nonce")
+	}
+	cipherText, err := hex.DecodeString(s.CipherText)
+	if err != nil {
+		return "", errors.Wrap(err, "invalid cipher text")
+	}
+	secret, err := gcm.Open(nil, nonce, cipherText, nil)
+	if err != nil {
+		return "", errors.Wrap(err, "could not decrypt TOTP secret")
+	}
+	return string(secret), nil
+}
+
+func totpCipher(encryptionKey string) (cipher.AEAD, error) {
+	key := sha256.Sum256([]byte(encryptionKey))
+	block, err := aes.NewCipher(key[:])
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+func (s *EncryptedTOTPSecret) Scan(value interface{}) error {
+	b, ok := value.([]byte)
+	if !ok {
+		return errors.New("type assertion to []byte failed")
+	}
+	return json.Unmarshal(b, s)
+}
+
+func (s EncryptedTOTPSecret) Value() (driver.Value, error) {
+	return json.Marshal(s)
+}
@@ -0,0 +1,80 @@
+package sessions
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestTOTPCode_RFC6238Vectors(t *testing.T) {
+	t.Parallel()
+
+	// base32 encoding of the RFC 6238 appendix B test secret "12345678901234567890"
+	secret := "GEZDGNBVGY3TQOJQGEZDGNBVGY3TQOJQ"
+
+	tests := []struct {
+		unix int64
+		want string
+	}{
+		{59, "287082"},
+		{1111111109, "081804"},
+		{1234567890, "005924"},
+		{2000000000, "279037"},
+	}
+	for _, test := range tests {
+		code, err := totpCode(secret, time.Unix(test.unix, 0))
+		require.NoError(t, err)
+		assert.Equal(t, test.want, code)
+	}
+}
+
+func TestTOTP_GenerateAndVerify(t *testing.T) {
+	t.Parallel()
+
+	secret, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+	require.NotEmpty(t, secret)
+
+	code, err := totpCode(secret, time.Now())
+	require.NoError(t, err)
+	require.Len(t, code, totpDigits)
+
+	assert.True(t, VerifyTOTPCode(secret, code))
+	assert.False(t, VerifyTOTPCode(secret, "000000"))
+	assert.False(t, VerifyTOTPCode("not-base32!", code))
+}
+
+func TestGenerateRecoveryCodes(t *testing.T) {
+	t.Parallel()
+
+	codes, err := GenerateRecoveryCodes(TOTPRecoveryCodeCount)
+	require.NoError(t, err)
+	require.Len(t, codes, TOTPRecoveryCodeCount)
+
+	seen := make(map[string]bool)
+	for _, code := range codes {
+		assert.NotEmpty(t, code)
+		assert.False(t, seen[code], "duplicate recovery code %s", code)
+		seen[code] = true
+	}
+}
+
+func TestEncryptedTOTPSecret_RoundTrip(t *testing.T) {
+	t.Parallel()
+
+	secret, err := GenerateTOTPSecret()
+	require.NoError(t, err)
+
+	enc, err := NewEncryptedTOTPSecret(secret, "encryption-key")
+	require.NoError(t, err)
+	assert.NotContains(t, enc.CipherText, secret)
+
+	decrypted, err := enc.Decrypt("encryption-key")
+	require.NoError(t, err)
+	assert.Equal(t, secret, decrypted)
+
+	_, err = enc.Decrypt("wrong-key")
+	require.Error(t, err)
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
+	sqlxTypes "github.com/smartcontractkit/sqlx/types"
 	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/auth"
@@ -18,13 +19,49 @@ import (
 type User struct {
 	Email             string `gorm:"primary_key"`
 	HashedPassword    string
+	Role              UserRole
+	Disabled          bool
 	CreatedAt         time.Time `gorm:"index"`
 	TokenKey          null.String
 	TokenSalt         null.String
 	TokenHashedSecret null.String
+	TOTPSecret        EncryptedTOTPSecret
+	TOTPConfirmed     bool
+	TOTPRecoveryCodes sqlxTypes.JSONText
 	UpdatedAt         time.Time
 }
 
+// TOTPEnabled returns true when the user has completed TOTP enrollment, and
+// so must supply a code at login and for sensitive actions.
+func (u *User) TOTPEnabled() bool {
+	return u.TOTPConfirmed
+}
+
+// UserRole is the role assigned to an API user, determining which actions the
+// user is authorized to perform
+type UserRole string
+
+const (
+	// UserRoleAdmin has full access, including user and node administration
+	UserRoleAdmin UserRole = "admin"
+	// UserRoleEdit can create, update and delete resources such as jobs and
+	// bridges
+	UserRoleEdit UserRole = "edit"
+	// UserRoleRun can trigger and resume job runs but not change resources
+	UserRoleRun UserRole = "run"
+	// UserRoleView has read-only access
+	UserRoleView UserRole = "view"
+)
+
+// GetUserRole parses role into a UserRole, erroring on anything unknown
+func GetUserRole(role string) (UserRole, error) {
+	switch UserRole(role) {
+	case UserRoleAdmin, UserRoleEdit, UserRoleRun, UserRoleView:
+		return UserRole(role), nil
+	}
+	return "", errors.Errorf("invalid role: %q, must be one of %q, %q, %q or %q", role, UserRoleAdmin, UserRoleEdit, UserRoleRun, UserRoleView)
+}
+
 // https://davidcel.is/posts/stop-validating-email-addresses-with-regex/
 var emailRegexp = regexp.MustCompile("^[a-zA-Z0-9.!#$%&'*+/=?^_`{|}~-]+@[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?(?:\\.[a-zA-Z0-9](?:[a-zA-Z0-9-]{0,61}[a-zA-Z0-9])?)*$")
 
@@ -55,6 +92,8 @@ func NewUser(email, plainPwd string) (User, error) {
 	return User{
 		Email:          email,
 		HashedPassword: pwd,
+		// the initial, locally created user administers the node
+		Role: UserRoleAdmin,
 	}, nil
 }
 
@@ -63,6 +102,7 @@ func NewUser(email, plainPwd string) (User, error) {
 type SessionRequest struct {
 	Email          string `json:"email"`
 	Password       string `json:"password"`
+	TOTPCode       string `json:"totpCode"`
 	WebAuthnData   string `json:"webauthndata"`
 	WebAuthnConfig WebAuthnConfiguration
 	SessionStore   *WebAuthnSessionStore
@@ -72,6 +112,9 @@ type SessionRequest struct {
 // Session holds the unique id for the authenticated session.
 type Session struct {
 	ID        string    `json:"id" gorm:"primary_key"`
+	Email     string    `json:"email"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"userAgent"`
 	LastUsed  time.Time `json:"lastUsed" gorm:"index"`
 	CreatedAt time.Time `json:"createdAt" gorm:"index"`
 }
@@ -67,3 +67,18 @@ func TestAuthenticateUserByToken(t *testing.T) {
 	require.NoError(t, err)
 	assert.False(t, ok, "authentication must fail with past token")
 }
+
+func TestGetUserRole(t *testing.T) {
+	t.Parallel()
+
+	for _, valid := range []string{"admin", "edit", "run", "view"} {
+		role, err := sessions.GetUserRole(valid)
+		require.NoError(t, err)
+		assert.Equal(t, sessions.UserRole(valid), role)
+	}
+
+	for _, invalid := range []string{"", "superuser", "Admin"} {
+		_, err := sessions.GetUserRole(invalid)
+		require.Error(t, err)
+	}
+}
@@ -25,6 +25,15 @@ const (
 	// ExternalInitiatorSecretHeader is the header name for the secret used by
 	// external initiators to authenticate
 	ExternalInitiatorSecretHeader = "X-Chainlink-EA-Secret"
+	// ExternalInitiatorSignatureHeader is the header name for the HMAC
+	// signature over the request timestamp, nonce and body
+	ExternalInitiatorSignatureHeader = "X-Chainlink-EA-Signature"
+	// ExternalInitiatorTimestampHeader is the header name for the unix
+	// timestamp covered by the request signature
+	ExternalInitiatorTimestampHeader = "X-Chainlink-EA-Timestamp"
+	// ExternalInitiatorNonceHeader is the header name for the single-use
+	// nonce covered by the request signature
+	ExternalInitiatorNonceHeader = "X-Chainlink-EA-Nonce"
 )
 
 func init() {
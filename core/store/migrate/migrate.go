@@ -103,7 +103,12 @@ func ensureMigrated(db *sql.DB, lggr logger.Logger) {
 
 func Migrate(db *sql.DB, lggr logger.Logger) error {
 	ensureMigrated(db, lggr)
-	return goose.Up(db, MIGRATIONS_DIR)
+	if err := goose.Up(db, MIGRATIONS_DIR); err != nil {
+		return err
+	}
+	// online migrations run last, outside the goose transactions, and resume
+	// from where they left off if interrupted
+	return runOnlineMigrations(db, lggr)
 }
 
 func Rollback(db *sql.DB, lggr logger.Logger, version null.Int) error {
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE feeds_managers ADD COLUMN chain_ids bigint[] NOT NULL DEFAULT '{}';
+ALTER TABLE job_proposals ADD COLUMN rejection_reason text;
+
+-- +goose Down
+ALTER TABLE job_proposals DROP COLUMN rejection_reason;
+ALTER TABLE feeds_managers DROP COLUMN chain_ids;
@@ -0,0 +1,6 @@
+-- +goose Up
+ALTER TABLE feeds_managers ADD COLUMN public_key_fingerprint text;
+UPDATE feeds_managers SET public_key_fingerprint = encode(sha256(public_key), 'hex');
+
+-- +goose Down
+ALTER TABLE feeds_managers DROP COLUMN public_key_fingerprint;
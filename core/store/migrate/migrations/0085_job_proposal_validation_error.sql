@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE job_proposals ADD COLUMN validation_error text;
+
+-- +goose Down
+ALTER TABLE job_proposals DROP COLUMN validation_error;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN gas_price_multiplier double precision CHECK (gas_price_multiplier IS NULL OR gas_price_multiplier > 0);
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN gas_price_multiplier;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN simulation_policy text CHECK (simulation_policy IS NULL OR simulation_policy IN ('abort', 'proceed'));
+ALTER TABLE eth_tx_attempts ADD COLUMN simulation_result jsonb;
+
+-- +goose Down
+ALTER TABLE eth_tx_attempts DROP COLUMN simulation_result;
+ALTER TABLE eth_txes DROP COLUMN simulation_policy;
@@ -0,0 +1,48 @@
+-- +goose NO TRANSACTION
+
+-- +goose Up
+ALTER TYPE eth_txes_state ADD VALUE 'expired';
+ALTER TABLE eth_txes ADD COLUMN expires_at timestamptz;
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+	state = 'unstarted' AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'in_progress' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'fatal_error' AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+	OR
+	state = 'unconfirmed' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed_missing_receipt' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'expired' AND nonce IS NOT NULL AND error IS NOT NULL AND broadcast_at IS NOT NULL
+);
+
+-- +goose Down
+ALTER TABLE eth_txes DROP CONSTRAINT chk_eth_txes_fsm;
+ALTER TABLE eth_txes DROP COLUMN expires_at;
+-- Unfortunately postgres does not support a way to remove a value from an
+-- enum so we must recreate the type. This will fail if any records are using
+-- the 'expired' enum; manually update these as we cannot decide what you want
+-- to do with them.
+ALTER TYPE eth_txes_state RENAME TO eth_txes_state_old;
+CREATE TYPE eth_txes_state AS ENUM ('unstarted', 'in_progress', 'fatal_error', 'unconfirmed', 'confirmed_missing_receipt', 'confirmed');
+ALTER TABLE eth_txes ALTER COLUMN state DROP DEFAULT;
+ALTER TABLE eth_txes ALTER COLUMN state TYPE eth_txes_state USING state::text::eth_txes_state;
+ALTER TABLE eth_txes ALTER COLUMN state SET DEFAULT 'unstarted'::eth_txes_state;
+DROP TYPE eth_txes_state_old;
+ALTER TABLE eth_txes ADD CONSTRAINT chk_eth_txes_fsm CHECK (
+	state = 'unstarted' AND nonce IS NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'in_progress' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NULL
+	OR
+	state = 'fatal_error' AND nonce IS NULL AND error IS NOT NULL AND broadcast_at IS NULL
+	OR
+	state = 'unconfirmed' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+	OR
+	state = 'confirmed_missing_receipt' AND nonce IS NOT NULL AND error IS NULL AND broadcast_at IS NOT NULL
+);
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN priority text NOT NULL DEFAULT 'normal' CHECK (priority IN ('low', 'normal', 'urgent'));
+
+-- +goose Down
+ALTER TABLE eth_txes DROP COLUMN priority;
@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE eth_tx_checkpoints (
+    from_address bytea NOT NULL CHECK (octet_length(from_address) = 20),
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE INITIALLY IMMEDIATE,
+    lowest_unconfirmed_nonce bigint,
+    last_processed_block_num bigint NOT NULL,
+    updated_at timestamptz NOT NULL,
+    PRIMARY KEY (from_address, evm_chain_id)
+);
+
+-- +goose Down
+DROP TABLE eth_tx_checkpoints;
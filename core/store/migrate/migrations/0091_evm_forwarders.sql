@@ -0,0 +1,12 @@
+-- +goose Up
+CREATE TABLE evm_forwarders (
+    id BIGSERIAL PRIMARY KEY,
+    address bytea NOT NULL UNIQUE CHECK (octet_length(address) = 20),
+    evm_chain_id numeric(78,0) NOT NULL REFERENCES evm_chains (id) DEFERRABLE INITIALLY IMMEDIATE,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+CREATE INDEX idx_evm_forwarders_evm_chain_id ON evm_forwarders (evm_chain_id);
+
+-- +goose Down
+DROP TABLE evm_forwarders;
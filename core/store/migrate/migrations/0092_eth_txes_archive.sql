@@ -0,0 +1,22 @@
+-- +goose Up
+-- Archive tables for reaped eth_txes, used when ETH_TX_REAPER_ARCHIVE_ENABLED
+-- is set. They are deliberately unconstrained copies of the live tables (plus
+-- an archived_at marker) so archival can never fail on constraint violations
+-- and the tables remain cheap to insert into.
+CREATE TABLE eth_txes_archive (
+    LIKE eth_txes,
+    archived_at timestamptz NOT NULL
+);
+CREATE TABLE eth_tx_attempts_archive (
+    LIKE eth_tx_attempts,
+    archived_at timestamptz NOT NULL
+);
+CREATE TABLE eth_receipts_archive (
+    LIKE eth_receipts,
+    archived_at timestamptz NOT NULL
+);
+
+-- +goose Down
+DROP TABLE eth_receipts_archive;
+DROP TABLE eth_tx_attempts_archive;
+DROP TABLE eth_txes_archive;
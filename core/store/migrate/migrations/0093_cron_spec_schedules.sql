@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE cron_specs ADD COLUMN cron_schedules jsonb;
+
+-- +goose Down
+ALTER TABLE cron_specs DROP COLUMN cron_schedules;
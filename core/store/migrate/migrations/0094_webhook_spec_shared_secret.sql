@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE webhook_specs ADD COLUMN shared_secret text;
+
+-- +goose Down
+ALTER TABLE webhook_specs DROP COLUMN shared_secret;
@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE keeper_specs ADD COLUMN turn_block_count integer NOT NULL DEFAULT 0;
+ALTER TABLE keeper_specs ADD COLUMN turn_buffer_blocks integer NOT NULL DEFAULT 0;
+ALTER TABLE keeper_specs ADD COLUMN gas_price_buffer_percent integer NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE keeper_specs DROP COLUMN turn_block_count;
+ALTER TABLE keeper_specs DROP COLUMN turn_buffer_blocks;
+ALTER TABLE keeper_specs DROP COLUMN gas_price_buffer_percent;
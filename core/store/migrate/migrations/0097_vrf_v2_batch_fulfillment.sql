@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+    ADD COLUMN batch_fulfillment_enabled boolean NOT NULL DEFAULT false,
+    ADD COLUMN batch_coordinator_address bytea,
+    ADD COLUMN max_batch_size integer NOT NULL DEFAULT 0,
+    ADD COLUMN batch_gas_budget bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE vrf_specs
+    DROP COLUMN batch_fulfillment_enabled,
+    DROP COLUMN batch_coordinator_address,
+    DROP COLUMN max_batch_size,
+    DROP COLUMN batch_gas_budget;
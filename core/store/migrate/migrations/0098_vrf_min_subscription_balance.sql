@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE vrf_specs
+    ADD COLUMN min_subscription_balance numeric(78, 0);
+
+-- +goose Down
+ALTER TABLE vrf_specs
+    DROP COLUMN min_subscription_balance;
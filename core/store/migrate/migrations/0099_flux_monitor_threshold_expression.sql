@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE flux_monitor_specs
+    ADD COLUMN threshold_expression text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE flux_monitor_specs
+    DROP COLUMN threshold_expression;
@@ -0,0 +1,32 @@
+-- +goose Up
+CREATE TABLE offchainreporting2_oracle_specs (
+    id SERIAL PRIMARY KEY,
+    relay text NOT NULL,
+    relay_config jsonb,
+    contract_id text NOT NULL,
+    p2p_bootstrap_peers text[],
+    is_bootstrap_peer boolean NOT NULL,
+    encrypted_ocr_key_bundle_id text,
+    transmitter_id text,
+    blockchain_timeout bigint,
+    contract_config_tracker_poll_interval bigint,
+    contract_config_confirmations integer NOT NULL,
+    created_at timestamp with time zone NOT NULL,
+    updated_at timestamp with time zone NOT NULL
+);
+
+ALTER TABLE jobs ADD COLUMN offchainreporting2_oracle_spec_id INT REFERENCES offchainreporting2_oracle_specs(id),
+DROP CONSTRAINT chk_only_one_spec,
+ADD CONSTRAINT chk_only_one_spec CHECK (
+    num_nonnulls(offchainreporting_oracle_spec_id, offchainreporting2_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id, keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id) = 1
+);
+
+-- +goose Down
+ALTER TABLE jobs DROP CONSTRAINT chk_only_one_spec,
+ADD CONSTRAINT chk_only_one_spec CHECK (
+    num_nonnulls(offchainreporting_oracle_spec_id, direct_request_spec_id, flux_monitor_spec_id, keeper_spec_id, cron_spec_id, vrf_spec_id, webhook_spec_id) = 1
+);
+
+ALTER TABLE jobs DROP COLUMN offchainreporting2_oracle_spec_id;
+
+DROP TABLE IF EXISTS offchainreporting2_oracle_specs;
@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN params jsonb;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN params;
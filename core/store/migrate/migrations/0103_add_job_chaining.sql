@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN on_success uuid, ADD COLUMN on_failure uuid;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN on_success, DROP COLUMN on_failure;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN quarantined_at timestamptz;
+ALTER TABLE jobs ADD COLUMN quarantine_reason text;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN quarantined_at;
+ALTER TABLE jobs DROP COLUMN quarantine_reason;
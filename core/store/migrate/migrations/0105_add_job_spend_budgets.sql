@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN gas_budget numeric(78, 0);
+ALTER TABLE jobs ADD COLUMN link_budget numeric(78, 0);
+ALTER TABLE jobs ADD COLUMN budget_window bigint;
+
+-- +goose Down
+ALTER TABLE jobs DROP COLUMN gas_budget;
+ALTER TABLE jobs DROP COLUMN link_budget;
+ALTER TABLE jobs DROP COLUMN budget_window;
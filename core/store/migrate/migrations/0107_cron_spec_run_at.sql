@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE cron_specs ADD COLUMN run_at timestamptz;
+
+-- +goose Down
+ALTER TABLE cron_specs DROP COLUMN run_at;
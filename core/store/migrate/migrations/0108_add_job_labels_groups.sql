@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE jobs ADD COLUMN labels text[];
+ALTER TABLE jobs ADD COLUMN group_name text;
+CREATE INDEX idx_jobs_group_name ON jobs (group_name) WHERE group_name IS NOT NULL;
+
+-- +goose Down
+DROP INDEX idx_jobs_group_name;
+ALTER TABLE jobs DROP COLUMN labels;
+ALTER TABLE jobs DROP COLUMN group_name;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE cron_specs ADD COLUMN catch_up text NOT NULL DEFAULT 'skip';
+ALTER TABLE cron_specs ADD COLUMN catch_up_limit bigint NOT NULL DEFAULT 0;
+
+-- +goose Down
+ALTER TABLE cron_specs DROP COLUMN catch_up;
+ALTER TABLE cron_specs DROP COLUMN catch_up_limit;
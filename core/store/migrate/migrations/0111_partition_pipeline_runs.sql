@@ -0,0 +1,80 @@
+-- +goose Up
+-- Rebuild pipeline_runs and pipeline_task_runs partitioned by month on
+-- created_at, so the run reaper can drop whole partitions (a metadata
+-- operation) instead of issuing huge row-level DELETEs.
+--
+-- Partitioned tables cannot carry a unique constraint that excludes the
+-- partition key, so the foreign keys into pipeline_runs(id) are dropped here.
+-- pipeline_task_runs are pruned partition-for-partition alongside their runs,
+-- and flux_monitor_round_stats_v2 rows simply keep a dangling run id once the
+-- run is reaped (the run was previously deleted out from under them by the
+-- cascade anyway).
+ALTER TABLE pipeline_task_runs DROP CONSTRAINT pipeline_task_runs_pipeline_run_id_fkey;
+ALTER TABLE flux_monitor_round_stats_v2 DROP CONSTRAINT flux_monitor_round_stats_v2_pipeline_run_id_fkey;
+
+ALTER TABLE pipeline_runs RENAME TO pipeline_runs_old;
+ALTER TABLE pipeline_task_runs RENAME TO pipeline_task_runs_old;
+
+CREATE TABLE pipeline_runs (LIKE pipeline_runs_old INCLUDING DEFAULTS INCLUDING CONSTRAINTS) PARTITION BY RANGE (created_at);
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_pkey PRIMARY KEY (id, created_at);
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_pipeline_spec_id_fkey FOREIGN KEY (pipeline_spec_id) REFERENCES pipeline_specs(id) ON DELETE CASCADE DEFERRABLE;
+-- The default partition catches rows for months whose partition has not been
+-- created yet; the ORM peels current and upcoming months off into their own
+-- partitions as part of the reaper cycle.
+CREATE TABLE pipeline_runs_default PARTITION OF pipeline_runs DEFAULT;
+
+CREATE TABLE pipeline_task_runs (LIKE pipeline_task_runs_old INCLUDING DEFAULTS INCLUDING CONSTRAINTS) PARTITION BY RANGE (created_at);
+ALTER TABLE pipeline_task_runs ADD CONSTRAINT pipeline_task_runs_pkey PRIMARY KEY (id, created_at);
+CREATE TABLE pipeline_task_runs_default PARTITION OF pipeline_task_runs DEFAULT;
+
+INSERT INTO pipeline_runs SELECT * FROM pipeline_runs_old;
+INSERT INTO pipeline_task_runs SELECT * FROM pipeline_task_runs_old;
+
+ALTER SEQUENCE pipeline_runs_id_seq OWNED BY pipeline_runs.id;
+DROP TABLE pipeline_runs_old;
+DROP TABLE pipeline_task_runs_old;
+
+CREATE INDEX idx_pipeline_runs_created_at ON pipeline_runs USING brin (created_at);
+CREATE INDEX idx_pipeline_runs_finished_at ON pipeline_runs USING brin (finished_at);
+CREATE INDEX idx_pipeline_runs_pipeline_spec_id ON pipeline_runs USING btree (pipeline_spec_id);
+CREATE INDEX idx_pipeline_runs_unfinished_runs ON pipeline_runs USING btree (id) WHERE finished_at IS NULL;
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+-- NOTE: the unique index must include the partition key, so created_at is
+-- appended; a task run's created_at never changes once written so upserts on
+-- (pipeline_run_id, dot_id, created_at) behave as before.
+CREATE UNIQUE INDEX idx_pipeline_task_runs_unique_dot_id_per_run ON pipeline_task_runs (pipeline_run_id, dot_id, created_at);
+CREATE INDEX idx_unfinished_pipeline_task_runs ON pipeline_task_runs (pipeline_run_id) WHERE finished_at IS NULL;
+
+-- +goose Down
+ALTER TABLE pipeline_runs RENAME TO pipeline_runs_partitioned;
+ALTER TABLE pipeline_task_runs RENAME TO pipeline_task_runs_partitioned;
+
+CREATE TABLE pipeline_runs (LIKE pipeline_runs_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_pkey PRIMARY KEY (id);
+ALTER TABLE pipeline_runs ADD CONSTRAINT pipeline_runs_pipeline_spec_id_fkey FOREIGN KEY (pipeline_spec_id) REFERENCES pipeline_specs(id) ON DELETE CASCADE DEFERRABLE;
+
+CREATE TABLE pipeline_task_runs (LIKE pipeline_task_runs_partitioned INCLUDING DEFAULTS INCLUDING CONSTRAINTS);
+ALTER TABLE pipeline_task_runs ADD CONSTRAINT pipeline_task_runs_pkey PRIMARY KEY (id);
+
+INSERT INTO pipeline_runs SELECT * FROM pipeline_runs_partitioned;
+INSERT INTO pipeline_task_runs SELECT * FROM pipeline_task_runs_partitioned;
+
+ALTER SEQUENCE pipeline_runs_id_seq OWNED BY pipeline_runs.id;
+DROP TABLE pipeline_runs_partitioned CASCADE;
+DROP TABLE pipeline_task_runs_partitioned CASCADE;
+
+CREATE INDEX idx_pipeline_runs_created_at ON pipeline_runs USING brin (created_at);
+CREATE INDEX idx_pipeline_runs_finished_at ON pipeline_runs USING brin (finished_at);
+CREATE INDEX idx_pipeline_runs_pipeline_spec_id ON pipeline_runs USING btree (pipeline_spec_id);
+CREATE INDEX idx_pipeline_runs_unfinished_runs ON pipeline_runs USING btree (id) WHERE finished_at IS NULL;
+CREATE INDEX pipeline_runs_suspended ON pipeline_runs (id) WHERE state = 'suspended';
+CREATE UNIQUE INDEX pipeline_task_runs_pipeline_run_id_dot_id_idx ON pipeline_task_runs (pipeline_run_id, dot_id);
+CREATE INDEX idx_unfinished_pipeline_task_runs ON pipeline_task_runs (pipeline_run_id) WHERE finished_at IS NULL;
+
+-- Partition pruning may have left dangling references; clear them before the
+-- foreign keys come back
+DELETE FROM pipeline_task_runs WHERE pipeline_run_id NOT IN (SELECT id FROM pipeline_runs);
+UPDATE flux_monitor_round_stats_v2 SET pipeline_run_id = NULL WHERE pipeline_run_id IS NOT NULL AND pipeline_run_id NOT IN (SELECT id FROM pipeline_runs);
+
+ALTER TABLE pipeline_task_runs ADD CONSTRAINT pipeline_task_runs_pipeline_run_id_fkey FOREIGN KEY (pipeline_run_id) REFERENCES pipeline_runs(id) ON DELETE CASCADE DEFERRABLE;
+ALTER TABLE flux_monitor_round_stats_v2 ADD CONSTRAINT flux_monitor_round_stats_v2_pipeline_run_id_fkey FOREIGN KEY (pipeline_run_id) REFERENCES pipeline_runs(id) ON DELETE CASCADE;
@@ -0,0 +1,20 @@
+-- +goose Up
+-- +goose StatementBegin
+CREATE FUNCTION notifybridgeupdated() RETURNS trigger
+    LANGUAGE plpgsql
+    AS $$
+	BEGIN
+		IF TG_OP = 'DELETE' THEN
+			PERFORM pg_notify('bridge_updated', OLD.name::text);
+			RETURN OLD;
+		END IF;
+		PERFORM pg_notify('bridge_updated', NEW.name::text);
+		RETURN NEW;
+	END
+	$$;
+-- +goose StatementEnd
+CREATE TRIGGER notify_bridge_updated AFTER INSERT OR UPDATE OR DELETE ON bridge_types FOR EACH ROW EXECUTE PROCEDURE notifybridgeupdated();
+
+-- +goose Down
+DROP TRIGGER notify_bridge_updated ON bridge_types;
+DROP FUNCTION notifybridgeupdated();
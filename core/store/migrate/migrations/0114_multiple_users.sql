@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN disabled boolean NOT NULL DEFAULT false;
+ALTER TABLE sessions ADD COLUMN email text;
+-- All pre-existing sessions belong to the sole bootstrap user
+UPDATE sessions SET email = (SELECT email FROM users ORDER BY created_at DESC LIMIT 1);
+DELETE FROM sessions WHERE email IS NULL;
+ALTER TABLE sessions ALTER COLUMN email SET NOT NULL;
+ALTER TABLE sessions ADD CONSTRAINT sessions_fk_email FOREIGN KEY (email) REFERENCES users (email) ON DELETE CASCADE;
+
+-- +goose Down
+ALTER TABLE sessions DROP CONSTRAINT sessions_fk_email;
+ALTER TABLE sessions DROP COLUMN email;
+ALTER TABLE users DROP COLUMN disabled;
@@ -0,0 +1,7 @@
+-- +goose Up
+ALTER TABLE sessions ADD COLUMN ip text NOT NULL DEFAULT '';
+ALTER TABLE sessions ADD COLUMN user_agent text NOT NULL DEFAULT '';
+
+-- +goose Down
+ALTER TABLE sessions DROP COLUMN user_agent;
+ALTER TABLE sessions DROP COLUMN ip;
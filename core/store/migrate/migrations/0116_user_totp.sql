@@ -0,0 +1,9 @@
+-- +goose Up
+ALTER TABLE users ADD COLUMN totp_secret jsonb NOT NULL DEFAULT 'null';
+ALTER TABLE users ADD COLUMN totp_confirmed boolean NOT NULL DEFAULT false;
+ALTER TABLE users ADD COLUMN totp_recovery_codes jsonb NOT NULL DEFAULT '[]';
+
+-- +goose Down
+ALTER TABLE users DROP COLUMN totp_recovery_codes;
+ALTER TABLE users DROP COLUMN totp_confirmed;
+ALTER TABLE users DROP COLUMN totp_secret;
@@ -0,0 +1,13 @@
+-- +goose Up
+ALTER TABLE external_initiators ADD COLUMN signing_key text NOT NULL DEFAULT '';
+
+CREATE TABLE external_initiator_nonces (
+    external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    nonce text NOT NULL,
+    created_at timestamptz NOT NULL,
+    PRIMARY KEY (external_initiator_id, nonce)
+);
+
+-- +goose Down
+DROP TABLE external_initiator_nonces;
+ALTER TABLE external_initiators DROP COLUMN signing_key;
@@ -0,0 +1,19 @@
+-- +goose Up
+CREATE TABLE external_initiator_notifications (
+    id BIGSERIAL PRIMARY KEY,
+    external_initiator_id bigint NOT NULL REFERENCES external_initiators (id) ON DELETE CASCADE,
+    kind text NOT NULL CHECK (kind IN ('notify', 'delete')),
+    job_id uuid NOT NULL,
+    payload jsonb NOT NULL DEFAULT '{}',
+    attempts int NOT NULL DEFAULT 0,
+    last_error text,
+    next_attempt_at timestamptz NOT NULL,
+    delivered_at timestamptz,
+    created_at timestamptz NOT NULL,
+    updated_at timestamptz NOT NULL
+);
+
+CREATE INDEX idx_external_initiator_notifications_pending ON external_initiator_notifications (next_attempt_at) WHERE delivered_at IS NULL;
+
+-- +goose Down
+DROP TABLE external_initiator_notifications;
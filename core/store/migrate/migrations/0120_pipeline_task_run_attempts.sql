@@ -0,0 +1,5 @@
+-- +goose Up
+ALTER TABLE pipeline_task_runs ADD COLUMN attempts int NOT NULL DEFAULT 1;
+
+-- +goose Down
+ALTER TABLE pipeline_task_runs DROP COLUMN attempts;
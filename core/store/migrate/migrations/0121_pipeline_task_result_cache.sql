@@ -0,0 +1,14 @@
+-- +goose Up
+CREATE TABLE pipeline_task_result_cache (
+    pipeline_spec_id int NOT NULL REFERENCES pipeline_specs (id) ON DELETE CASCADE,
+    task_dot_id text NOT NULL,
+    input_hash text NOT NULL,
+    value jsonb,
+    expires_at timestamptz NOT NULL,
+    created_at timestamptz NOT NULL DEFAULT now(),
+    PRIMARY KEY (pipeline_spec_id, task_dot_id, input_hash)
+);
+CREATE INDEX idx_pipeline_task_result_cache_expires_at ON pipeline_task_result_cache (expires_at);
+
+-- +goose Down
+DROP TABLE pipeline_task_result_cache;
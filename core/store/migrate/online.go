@@ -0,0 +1,132 @@
+package migrate
+
+import (
+	"database/sql"
+	"sort"
+
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// An OnlineMigration splits a heavy schema change into phases that run
+// outside the regular goose transaction, so that it can use non-transactional
+// statements (notably CREATE INDEX CONCURRENTLY) and batch its writes,
+// keeping lock windows short on large databases.
+//
+// Completed phases are recorded in online_migration_progress, so an
+// interrupted migration resumes from the first unfinished phase on the next
+// boot. Phases must therefore be idempotent.
+type OnlineMigration struct {
+	// Version of the goose migration this belongs to; online migrations run
+	// in version order after all goose migrations have been applied
+	Version int64
+	Name    string
+	Phases  []OnlinePhase
+}
+
+// OnlinePhase is a single resumable step of an OnlineMigration
+type OnlinePhase struct {
+	Name string
+	Run  func(db *sql.DB, lggr logger.Logger) error
+}
+
+var onlineMigrations []OnlineMigration
+
+// RegisterOnlineMigration is intended to be called from init functions in the
+// migrations package, mirroring goose.AddMigration
+func RegisterOnlineMigration(m OnlineMigration) {
+	for _, existing := range onlineMigrations {
+		if existing.Version == m.Version {
+			panic(errors.Errorf("online migration with version %d registered twice", m.Version))
+		}
+	}
+	onlineMigrations = append(onlineMigrations, m)
+}
+
+const createOnlineProgressTable = `
+CREATE TABLE IF NOT EXISTS online_migration_progress (
+	version bigint NOT NULL,
+	phase text NOT NULL,
+	completed_at timestamptz NOT NULL DEFAULT NOW(),
+	PRIMARY KEY (version, phase)
+);`
+
+func runOnlineMigrations(db *sql.DB, lggr logger.Logger) error {
+	if len(onlineMigrations) == 0 {
+		return nil
+	}
+	if _, err := db.Exec(createOnlineProgressTable); err != nil {
+		return errors.Wrap(err, "failed to create online_migration_progress table")
+	}
+	ms := make([]OnlineMigration, len(onlineMigrations))
+	copy(ms, onlineMigrations)
+	sort.Slice(ms, func(i, j int) bool { return ms[i].Version < ms[j].Version })
+
+	for _, m := range ms {
+		for _, p := range m.Phases {
+			var done bool
+			err := db.QueryRow(`SELECT EXISTS (SELECT 1 FROM online_migration_progress WHERE version = $1 AND phase = $2)`, m.Version, p.Name).Scan(&done)
+			if err != nil {
+				return errors.Wrap(err, "failed to read online migration progress")
+			}
+			if done {
+				continue
+			}
+			lggr.Infof("Online migration %v (%s): running phase %q", m.Version, m.Name, p.Name)
+			if err := p.Run(db, lggr); err != nil {
+				return errors.Wrapf(err, "online migration %v (%s) failed in phase %q; it will resume from this phase on the next run", m.Version, m.Name, p.Name)
+			}
+			if _, err := db.Exec(`INSERT INTO online_migration_progress (version, phase) VALUES ($1, $2) ON CONFLICT DO NOTHING`, m.Version, p.Name); err != nil {
+				return errors.Wrap(err, "failed to record online migration progress")
+			}
+		}
+	}
+	return nil
+}
+
+// BatchedUpdate repeatedly executes stmt, which must take the batch size as
+// its only bind parameter and affect at most that many rows per execution
+// (e.g. UPDATE ... WHERE id IN (SELECT id FROM ... LIMIT $1)), until no rows
+// are affected. This backfills large tables without holding row locks on more
+// than one batch at a time.
+func BatchedUpdate(db *sql.DB, lggr logger.Logger, stmt string, batchSize uint) error {
+	var total int64
+	for {
+		res, err := db.Exec(stmt, batchSize)
+		if err != nil {
+			return err
+		}
+		rows, err := res.RowsAffected()
+		if err != nil {
+			return err
+		}
+		if rows == 0 {
+			lggr.Infof("Batched update complete, %d rows updated in total", total)
+			return nil
+		}
+		total += rows
+		lggr.Debugf("Batched update in progress, %d rows updated so far", total)
+	}
+}
+
+// CreateIndexConcurrently builds the named index with stmt, which must use
+// CREATE INDEX CONCURRENTLY. If an earlier attempt was interrupted and left
+// an invalid index behind, it is dropped and rebuilt.
+func CreateIndexConcurrently(db *sql.DB, lggr logger.Logger, name string, stmt string) error {
+	var valid bool
+	err := db.QueryRow(`SELECT indisvalid FROM pg_index JOIN pg_class ON pg_class.oid = pg_index.indexrelid WHERE pg_class.relname = $1`, name).Scan(&valid)
+	if err == nil {
+		if valid {
+			return nil
+		}
+		lggr.Warnf("Dropping invalid index %s left behind by an interrupted build", name)
+		if _, err := db.Exec(`DROP INDEX ` + name); err != nil {
+			return errors.Wrapf(err, "failed to drop invalid index %s", name)
+		}
+	} else if err != sql.ErrNoRows {
+		return errors.Wrapf(err, "failed to check state of index %s", name)
+	}
+	_, err = db.Exec(stmt)
+	return errors.Wrapf(err, "failed to build index %s", name)
+}
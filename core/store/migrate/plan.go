@@ -0,0 +1,200 @@
+package migrate
+
+import (
+	"database/sql"
+	"math"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"github.com/pressly/goose/v3"
+
+	"github.com/smartcontractkit/chainlink/core/logger"
+)
+
+// TableLock describes a lock a pending migration statement is expected to
+// take, together with the current size of the locked table so that operators
+// can judge how long it will be held.
+type TableLock struct {
+	Table     string
+	Mode      string
+	SizeBytes int64 // -1 when the table does not exist yet
+	Statement string
+}
+
+// MigrationPlan summarises the expected lock impact of one pending migration
+type MigrationPlan struct {
+	Version int64
+	Source  string
+	// Go migrations cannot be statically analysed
+	Go    bool
+	Locks []TableLock
+}
+
+// Plan returns the expected lock impact of all pending migrations without
+// applying them, so that operators of large databases can budget maintenance
+// windows before upgrading. The analysis is heuristic: it flags the dominant
+// lock of each DDL/DML statement and sizes the affected table.
+func Plan(db *sql.DB, lggr logger.Logger) ([]MigrationPlan, error) {
+	ensureMigrated(db, lggr)
+	current, err := goose.GetDBVersion(db)
+	if err != nil {
+		return nil, err
+	}
+	pending, err := goose.CollectMigrations(MIGRATIONS_DIR, current, math.MaxInt64)
+	if err != nil {
+		return nil, err
+	}
+
+	var plans []MigrationPlan
+	for _, m := range pending {
+		plan := MigrationPlan{Version: m.Version, Source: filepath.Base(m.Source)}
+		if strings.HasSuffix(m.Source, ".go") {
+			plan.Go = true
+			plans = append(plans, plan)
+			continue
+		}
+		contents, err := embedMigrations.ReadFile(m.Source)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read migration %s", m.Source)
+		}
+		for _, stmt := range upStatements(string(contents)) {
+			table, mode, ok := analyzeStatement(stmt)
+			if !ok {
+				continue
+			}
+			size, err := tableSize(db, table)
+			if err != nil {
+				return nil, err
+			}
+			plan.Locks = append(plan.Locks, TableLock{
+				Table:     table,
+				Mode:      mode,
+				SizeBytes: size,
+				Statement: summarizeStatement(stmt),
+			})
+		}
+		plans = append(plans, plan)
+	}
+	return plans, nil
+}
+
+// upStatements extracts the statements of the Up section of a goose SQL
+// migration. A -- +goose StatementBegin/StatementEnd block counts as a single
+// statement; outside such blocks statements are terminated by semicolons.
+func upStatements(contents string) (stmts []string) {
+	if idx := strings.Index(contents, "-- +goose Down"); idx >= 0 {
+		contents = contents[:idx]
+	}
+	var current []string
+	inBlock := false
+	flush := func() {
+		stmt := strings.TrimSpace(strings.Join(current, "\n"))
+		if stmt != "" {
+			stmts = append(stmts, stmt)
+		}
+		current = nil
+	}
+	for _, line := range strings.Split(contents, "\n") {
+		trimmed := strings.TrimSpace(line)
+		switch {
+		case strings.HasPrefix(trimmed, "-- +goose StatementBegin"):
+			inBlock = true
+		case strings.HasPrefix(trimmed, "-- +goose StatementEnd"):
+			inBlock = false
+			flush()
+		case strings.HasPrefix(trimmed, "--"):
+		default:
+			current = append(current, line)
+			if !inBlock && strings.HasSuffix(trimmed, ";") {
+				flush()
+			}
+		}
+	}
+	flush()
+	return
+}
+
+// analyzeStatement heuristically determines the table a statement locks and
+// the heaviest lock mode it takes. Statements with no lock of interest (e.g.
+// CREATE TABLE of a new table) return ok=false.
+func analyzeStatement(stmt string) (table, mode string, ok bool) {
+	fields := strings.Fields(stmt)
+	upper := make([]string, len(fields))
+	for i, f := range fields {
+		upper[i] = strings.ToUpper(f)
+	}
+	word := func(i int) string {
+		if i < len(fields) {
+			return strings.TrimRight(strings.ToLower(fields[i]), ";(")
+		}
+		return ""
+	}
+	// index of the first occurrence of kw, or -1
+	find := func(kw string) int {
+		for i, f := range upper {
+			if f == kw {
+				return i
+			}
+		}
+		return -1
+	}
+	switch {
+	case len(upper) == 0:
+		return "", "", false
+	case upper[0] == "ALTER" && word(1) == "table":
+		i := 2
+		for word(i) == "if" || word(i) == "exists" || word(i) == "only" {
+			i++
+		}
+		return word(i), "ACCESS EXCLUSIVE", true
+	case upper[0] == "DROP" && word(1) == "table":
+		i := 2
+		for word(i) == "if" || word(i) == "exists" {
+			i++
+		}
+		return word(i), "ACCESS EXCLUSIVE", true
+	case upper[0] == "TRUNCATE":
+		i := 1
+		if word(i) == "table" {
+			i++
+		}
+		return word(i), "ACCESS EXCLUSIVE", true
+	case upper[0] == "CREATE" && find("INDEX") >= 0 && find("INDEX") <= 2:
+		on := find("ON")
+		if on < 0 {
+			return "", "", false
+		}
+		if find("CONCURRENTLY") >= 0 {
+			// still blocks other DDL, but not reads or writes
+			return word(on + 1), "SHARE UPDATE EXCLUSIVE", true
+		}
+		return word(on + 1), "SHARE", true
+	case upper[0] == "CREATE" && word(1) == "trigger":
+		if on := find("ON"); on >= 0 {
+			return word(on + 1), "SHARE ROW EXCLUSIVE", true
+		}
+		return "", "", false
+	case upper[0] == "UPDATE":
+		return word(1), "ROW EXCLUSIVE", true
+	case upper[0] == "DELETE" && word(1) == "from":
+		return word(2), "ROW EXCLUSIVE", true
+	case upper[0] == "INSERT" && word(1) == "into" && find("SELECT") >= 0:
+		// bulk copies are long-running on big source tables
+		return word(2), "ROW EXCLUSIVE", true
+	}
+	return "", "", false
+}
+
+// summarizeStatement returns the first line of a statement for display
+func summarizeStatement(stmt string) string {
+	if idx := strings.IndexByte(stmt, '\n'); idx >= 0 {
+		stmt = stmt[:idx]
+	}
+	return strings.TrimSpace(stmt)
+}
+
+func tableSize(db *sql.DB, table string) (size int64, err error) {
+	err = db.QueryRow(`SELECT COALESCE(pg_total_relation_size(to_regclass($1)), -1)`, table).Scan(&size)
+	return size, errors.Wrapf(err, "failed to size table %s", table)
+}
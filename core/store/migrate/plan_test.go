@@ -0,0 +1,64 @@
+package migrate
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestUpStatements(t *testing.T) {
+	t.Parallel()
+
+	contents := `-- +goose Up
+ALTER TABLE eth_txes ADD COLUMN foo text;
+-- +goose StatementBegin
+CREATE FUNCTION f() RETURNS trigger AS $$
+BEGIN
+	RETURN NEW;
+END
+$$ LANGUAGE plpgsql;
+-- +goose StatementEnd
+CREATE INDEX CONCURRENTLY idx_foo ON eth_txes (foo);
+
+-- +goose Down
+DROP INDEX idx_foo;
+`
+	stmts := upStatements(contents)
+	require.Len(t, stmts, 3)
+	assert.Equal(t, "ALTER TABLE eth_txes ADD COLUMN foo text;", stmts[0])
+	assert.Contains(t, stmts[1], "CREATE FUNCTION")
+	assert.Equal(t, "CREATE INDEX CONCURRENTLY idx_foo ON eth_txes (foo);", stmts[2])
+}
+
+func TestAnalyzeStatement(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		stmt  string
+		table string
+		mode  string
+		ok    bool
+	}{
+		{"ALTER TABLE eth_txes ADD COLUMN foo text;", "eth_txes", "ACCESS EXCLUSIVE", true},
+		{"ALTER TABLE IF EXISTS ONLY jobs DROP COLUMN foo;", "jobs", "ACCESS EXCLUSIVE", true},
+		{"DROP TABLE IF EXISTS pipeline_runs_old;", "pipeline_runs_old", "ACCESS EXCLUSIVE", true},
+		{"TRUNCATE heads;", "heads", "ACCESS EXCLUSIVE", true},
+		{"CREATE INDEX idx ON pipeline_runs (created_at);", "pipeline_runs", "SHARE", true},
+		{"CREATE UNIQUE INDEX idx ON pipeline_runs (id);", "pipeline_runs", "SHARE", true},
+		{"CREATE INDEX CONCURRENTLY idx ON pipeline_runs (id);", "pipeline_runs", "SHARE UPDATE EXCLUSIVE", true},
+		{"CREATE TRIGGER trg AFTER INSERT ON bridge_types FOR EACH ROW EXECUTE PROCEDURE f();", "bridge_types", "SHARE ROW EXCLUSIVE", true},
+		{"UPDATE eth_txes SET foo = 'bar';", "eth_txes", "ROW EXCLUSIVE", true},
+		{"DELETE FROM heads WHERE number < 0;", "heads", "ROW EXCLUSIVE", true},
+		{"INSERT INTO new_runs SELECT * FROM pipeline_runs_old;", "new_runs", "ROW EXCLUSIVE", true},
+		{"CREATE TABLE foo (id bigint);", "", "", false},
+		{"INSERT INTO foo (id) VALUES (1);", "", "", false},
+		{"SELECT 1;", "", "", false},
+	}
+	for _, tt := range tests {
+		table, mode, ok := analyzeStatement(tt.stmt)
+		assert.Equal(t, tt.ok, ok, tt.stmt)
+		assert.Equal(t, tt.table, table, tt.stmt)
+		assert.Equal(t, tt.mode, mode, tt.stmt)
+	}
+}
@@ -0,0 +1,13 @@
+// Package testkit is the supported entry point for integration testing
+// against an in-process Chainlink node.
+//
+// It exposes a curated subset of the helpers the node's own test suite uses
+// from core/internal, so that external adapter and integration developers do
+// not have to copy them: an in-process test application, fake external
+// adapter and HTTP servers, chain/RPC simulators, and pipeline run
+// assertions. Helpers not exported here remain internal and may change
+// without notice.
+//
+// Like the node's own test suite, most helpers require a postgres database
+// reachable via the DATABASE_URL environment variable.
+package testkit
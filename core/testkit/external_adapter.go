@@ -0,0 +1,71 @@
+package testkit
+
+import (
+	"bytes"
+	"encoding/json"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/sqlx"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+// NewExternalAdapter starts a fake external adapter that asserts every
+// request body equals expectedRequest and responds with response, both JSON
+// encoded. The server is closed when the test finishes.
+func NewExternalAdapter(t *testing.T, expectedRequest, response interface{}) *httptest.Server {
+	t.Helper()
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		defer r.Body.Close()
+
+		body, err := ioutil.ReadAll(r.Body)
+		require.NoError(t, err)
+
+		expectedBody := &bytes.Buffer{}
+		err = json.NewEncoder(expectedBody).Encode(expectedRequest)
+		require.NoError(t, err)
+		require.Equal(t, bytes.TrimSpace(expectedBody.Bytes()), body)
+
+		w.Header().Set("Content-Type", "application/json")
+		err = json.NewEncoder(w).Encode(response)
+		require.NoError(t, err)
+	}))
+	t.Cleanup(server.Close)
+	return server
+}
+
+// NewExternalAdapterBridge starts a fake external adapter and registers a
+// randomly named bridge pointing at it, returning the server and the bridge
+// name for use in a pipeline spec.
+func NewExternalAdapterBridge(t *testing.T, db *sqlx.DB, expectedRequest, response interface{}) (*httptest.Server, string) {
+	t.Helper()
+
+	server := NewExternalAdapter(t, expectedRequest, response)
+
+	bridgeFeedURL, err := url.ParseRequestURI(server.URL)
+	require.NoError(t, err)
+
+	_, bt := cltest.MustCreateBridge(t, db, cltest.BridgeOpts{URL: bridgeFeedURL.String()})
+
+	return server, bt.Name.String()
+}
+
+// NewHTTPMockServer starts a test server that asserts the request method,
+// responds with the given status and body, and requires that it was called
+// at least once by the end of the test.
+func NewHTTPMockServer(t *testing.T, status int, wantMethod string, response string, callback ...func(http.Header, string)) *httptest.Server {
+	return cltest.NewHTTPMockServer(t, status, wantMethod, response, callback...)
+}
+
+// NewHTTPMockServerWithRequest is like NewHTTPMockServer but makes each raw
+// request available in the callback.
+func NewHTTPMockServerWithRequest(t *testing.T, status int, response string, callback func(r *http.Request)) *httptest.Server {
+	return cltest.NewHTTPMockServerWithRequest(t, status, response, callback)
+}
@@ -0,0 +1,31 @@
+package testkit_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/testkit"
+)
+
+func TestNewExternalAdapter(t *testing.T) {
+	t.Parallel()
+
+	expectedRequest := map[string]interface{}{"data": map[string]interface{}{"from": "ETH", "to": "USD"}}
+	response := map[string]interface{}{"result": "1234.56"}
+	server := testkit.NewExternalAdapter(t, expectedRequest, response)
+
+	body, err := json.Marshal(expectedRequest)
+	require.NoError(t, err)
+	resp, err := http.Post(server.URL, "application/json", bytes.NewReader(body))
+	require.NoError(t, err)
+	defer resp.Body.Close()
+
+	var got map[string]interface{}
+	require.NoError(t, json.NewDecoder(resp.Body).Decode(&got))
+	assert.Equal(t, response, got)
+}
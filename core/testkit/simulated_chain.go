@@ -0,0 +1,44 @@
+package testkit
+
+import (
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind/backends"
+	"github.com/ethereum/go-ethereum/core"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+)
+
+// SimulatedBackendEVMChainID is the chain ID of simulated backends.
+const SimulatedBackendEVMChainID = cltest.SimulatedBackendEVMChainID
+
+// NewSimulatedBackend starts an in-process simulated ethereum backend with
+// the given genesis allocation, closed when the test finishes. Make sure any
+// application using it is stopped first, or shutdown can hang.
+func NewSimulatedBackend(t *testing.T, alloc core.GenesisAlloc, gasLimit uint64) *backends.SimulatedBackend {
+	return cltest.NewSimulatedBackend(t, alloc, gasLimit)
+}
+
+// NewSimulatedBackendIdentity returns a transactor for a fresh ethereum
+// account, for interacting with contract wrappers on a simulated backend.
+func NewSimulatedBackendIdentity(t *testing.T) *bind.TransactOpts {
+	return cltest.NewSimulatedBackendIdentity(t)
+}
+
+// NewApplicationOnSimulatedBlockchain creates a TestApplication with an
+// unlocked key whose eth client is backed by the given simulated backend, so
+// jobs can be exercised end to end without a real chain.
+func NewApplicationOnSimulatedBlockchain(t testing.TB, backend *backends.SimulatedBackend, flagsAndDeps ...interface{}) *TestApplication {
+	t.Helper()
+
+	return cltest.NewApplicationWithConfigAndKeyOnSimulatedBlockchain(t, cltest.NewTestGeneralConfig(t), backend, flagsAndDeps...)
+}
+
+// NewWSServer starts a websocket server that replies to every message with
+// msg, useful as a stand-in RPC endpoint. It returns the server and a ws://
+// URL for it.
+func NewWSServer(t *testing.T, msg string, callback func(data []byte)) (*httptest.Server, string) {
+	return cltest.NewWSServer(t, msg, callback)
+}
@@ -0,0 +1,63 @@
+package testkit
+
+import (
+	"testing"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+)
+
+// TestApplication is an in-process Chainlink node wired to a test database,
+// with Start/Stop lifecycle helpers and accessors for its services.
+type TestApplication = cltest.TestApplication
+
+const (
+	// APIEmail is the email of the fixture API user.
+	APIEmail = cltest.APIEmail
+	// Password unlocks the test keystore and authenticates the fixture API user.
+	Password = cltest.Password
+
+	// DefaultWaitTimeout is a sensible timeout for run assertions, long
+	// enough to survive parallel test execution pauses.
+	DefaultWaitTimeout = cltest.DefaultWaitTimeout
+	// DBPollingInterval is a sensible polling interval for run assertions
+	// that avoids DOSing the test database.
+	DBPollingInterval = cltest.DBPollingInterval
+)
+
+// NewApplication creates a TestApplication with a test config and a mocked
+// keystore holding no keys or accounts.
+func NewApplication(t testing.TB, flagsAndDeps ...interface{}) *TestApplication {
+	return cltest.NewApplication(t, flagsAndDeps...)
+}
+
+// NewApplicationWithKey creates a TestApplication with a test config, an
+// unlocked keystore and a funded fixture eth key.
+func NewApplicationWithKey(t *testing.T) *TestApplication {
+	return cltest.NewApplicationWithKey(t)
+}
+
+// NewApplicationEVMDisabled creates a TestApplication with ethereum disabled,
+// useful for testing anything that does not touch a chain.
+func NewApplicationEVMDisabled(t *testing.T) *TestApplication {
+	return cltest.NewApplicationEVMDisabled(t)
+}
+
+// AwaitJobActive waits until the spawner has the given job active.
+func AwaitJobActive(t testing.TB, jobSpawner job.Spawner, jobID int32, waitFor time.Duration) {
+	cltest.AwaitJobActive(t, jobSpawner, jobID, waitFor)
+}
+
+// WaitForPipelineComplete polls until the job has the expected number of
+// completed pipeline runs, and returns them.
+func WaitForPipelineComplete(t testing.TB, nodeID int, jobID int32, expectedPipelineRuns int, expectedTaskRuns int, jo job.ORM, timeout, poll time.Duration) []pipeline.Run {
+	return cltest.WaitForPipelineComplete(t, nodeID, jobID, expectedPipelineRuns, expectedTaskRuns, jo, timeout, poll)
+}
+
+// WaitForPipelineError polls until the job has the expected number of errored
+// pipeline runs, and returns them.
+func WaitForPipelineError(t testing.TB, nodeID int, jobID int32, expectedPipelineRuns int, expectedTaskRuns int, jo job.ORM, timeout, poll time.Duration) []pipeline.Run {
+	return cltest.WaitForPipelineError(t, nodeID, jobID, expectedPipelineRuns, expectedTaskRuns, jo, timeout, poll)
+}
@@ -0,0 +1,90 @@
+// Package tracing wires up OpenTelemetry so a slow feed update can be traced
+// end to end: incoming HTTP requests, pipeline runs, individual tasks and DB
+// transactions all produce spans, exported over OTLP gRPC.
+//
+// When tracing is disabled the package hands out no-op tracers, so callers
+// can instrument unconditionally.
+package tracing
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/smartcontractkit/chainlink/core/static"
+)
+
+// Config is the subset of the general config needed to start tracing.
+type Config interface {
+	TracingEnabled() bool
+	TracingCollectorTarget() string
+	TracingSamplingRatio() float32
+}
+
+// Start configures the global tracer provider to export spans to the
+// configured OTLP collector, returning a shutdown func that flushes any
+// buffered spans. It is a no-op when tracing is disabled.
+func Start(cfg Config) (shutdown func(ctx context.Context) error, err error) {
+	noop := func(context.Context) error { return nil }
+	if !cfg.TracingEnabled() {
+		return noop, nil
+	}
+	if cfg.TracingCollectorTarget() == "" {
+		return noop, errors.New("TRACING_ENABLED is set but TRACING_COLLECTOR_TARGET is not")
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	exporter, err := otlptracegrpc.New(ctx,
+		otlptracegrpc.WithEndpoint(cfg.TracingCollectorTarget()),
+		otlptracegrpc.WithInsecure(),
+	)
+	if err != nil {
+		return noop, errors.Wrap(err, "failed to create OTLP trace exporter")
+	}
+
+	res, err := resource.New(ctx,
+		resource.WithAttributes(
+			semconv.ServiceNameKey.String("chainlink-node"),
+			semconv.ServiceVersionKey.String(static.Version),
+		),
+	)
+	if err != nil {
+		return noop, errors.Wrap(err, "failed to create tracing resource")
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(float64(cfg.TracingSamplingRatio())))),
+	)
+	otel.SetTracerProvider(tp)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	return tp.Shutdown, nil
+}
+
+// Tracer returns a named tracer from the global provider, which is a no-op
+// unless Start has installed a real one.
+func Tracer(name string) trace.Tracer {
+	return otel.Tracer(name)
+}
+
+// StartSpan starts a span named name under the tracer scope, with the given
+// attributes. The returned context carries the span for propagation into
+// child calls.
+func StartSpan(ctx context.Context, scope, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	ctx, span := Tracer(scope).Start(ctx, name)
+	if len(attrs) > 0 {
+		span.SetAttributes(attrs...)
+	}
+	return ctx, span
+}
@@ -1,6 +1,7 @@
 package crypto
 
 import (
+	"crypto/sha256"
 	"database/sql/driver"
 	"encoding/hex"
 	"encoding/json"
@@ -26,6 +27,12 @@ func (k PublicKey) String() string {
 	return hex.EncodeToString(k)
 }
 
+// Fingerprint returns the hex encoded SHA-256 digest of the public key.
+func (k PublicKey) Fingerprint() string {
+	digest := sha256.Sum256(k)
+	return hex.EncodeToString(digest[:])
+}
+
 func (k PublicKey) MarshalJSON() ([]byte, error) {
 	return json.Marshal(hex.EncodeToString(k))
 }
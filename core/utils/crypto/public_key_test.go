@@ -22,6 +22,18 @@ func Test_PublicKey_String(t *testing.T) {
 	assert.Equal(t, expected, pk.String())
 }
 
+func Test_PublicKey_Fingerprint(t *testing.T) {
+	t.Parallel()
+
+	pk, err := PublicKeyFromHex("0f17c3bf72de8beef6e2d17a14c0a972f5d7e0e66e70722373f12b88382d40f9")
+	require.NoError(t, err)
+
+	fingerprint := pk.Fingerprint()
+	assert.Len(t, fingerprint, 64)
+	// The fingerprint is deterministic for a given key.
+	assert.Equal(t, fingerprint, pk.Fingerprint())
+}
+
 func Test_PublicKey_MarshalJSON(t *testing.T) {
 	t.Parallel()
 
@@ -56,6 +56,11 @@ func isRestrictedIP(ip net.IP) bool {
 
 var ErrDisallowedIP = errors.New("disallowed IP")
 
+// RestrictedDialContext dials an address, rejecting connections that resolve
+// to local/private or multicast IPs. It is exposed for clients (e.g.
+// websockets) that cannot use the restricted http.Client directly.
+var RestrictedDialContext = restrictedDialContext
+
 // restrictedDialContext wraps the Dialer such that after successful connection,
 // we check the IP.
 // If the resolved IP is restricted, close the connection and return an error.
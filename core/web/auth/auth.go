@@ -1,7 +1,9 @@
 package auth
 
 import (
+	"bytes"
 	"database/sql"
+	"io/ioutil"
 	"net/http"
 
 	"github.com/gin-gonic/contrib/sessions"
@@ -32,14 +34,19 @@ const (
 
 	// SessionExternalInitiatorKey is the External Initiator key in the session map
 	SessionExternalInitiatorKey = "external_initiator"
+
+	// TOTPHeader is the header name carrying the TOTP code for sensitive
+	// actions when the authenticated user has TOTP enrolled
+	TOTPHeader = "X-TOTP-Code"
 )
 
 // Authenticator defines the interface to authenticate requests against a
 // datastore.
 type Authenticator interface {
 	AuthorizedUserWithSession(sessionID string) (clsessions.User, error)
+	ConsumeExternalInitiatorNonce(externalInitiatorID int64, nonce string) error
 	FindExternalInitiator(eia *auth.Token) (*bridges.ExternalInitiator, error)
-	FindUser() (clsessions.User, error)
+	FindUserByAPIToken(apiToken string) (clsessions.User, error)
 }
 
 // authMethod defines a method which can be used to authenticate a request. This
@@ -78,7 +85,7 @@ func AuthenticateByToken(c *gin.Context, authr Authenticator) error {
 		Secret:    c.GetHeader(APISecret),
 	}
 
-	user, err := authr.FindUser()
+	user, err := authr.FindUserByAPIToken(token.AccessKey)
 	if err != nil {
 		if errors.Is(err, sql.ErrNoRows) {
 			return auth.ErrorAuthFailed
@@ -128,11 +135,45 @@ func AuthenticateExternalInitiator(c *gin.Context, store Authenticator) error {
 		return auth.ErrorAuthFailed
 	}
 
+	// Initiators registered with a signing key must additionally sign each
+	// request, since bearer credentials alone are replayable if intercepted.
+	// Initiators registered before signing keys existed are exempt.
+	if ei.SigningKey != "" {
+		if err := verifyExternalInitiatorSignature(c, store, ei); err != nil {
+			return err
+		}
+	}
+
 	c.Set(SessionExternalInitiatorKey, ei)
 
 	return nil
 }
 
+// verifyExternalInitiatorSignature checks the HMAC signature headers on a
+// request against the initiator's signing key and consumes the single-use
+// nonce to prevent replays.
+func verifyExternalInitiatorSignature(c *gin.Context, store Authenticator, ei *bridges.ExternalInitiator) error {
+	body, err := c.GetRawData()
+	if err != nil {
+		return errors.Wrap(err, "reading request body for external initiator signature check")
+	}
+	// Restore the body so downstream handlers can still bind it
+	c.Request.Body = ioutil.NopCloser(bytes.NewReader(body))
+
+	nonce := c.GetHeader(static.ExternalInitiatorNonceHeader)
+	err = ei.ValidateSignature(
+		c.GetHeader(static.ExternalInitiatorTimestampHeader),
+		nonce,
+		c.GetHeader(static.ExternalInitiatorSignatureHeader),
+		body,
+	)
+	if err != nil {
+		return err
+	}
+
+	return store.ConsumeExternalInitiatorNonce(ei.ID, nonce)
+}
+
 var _ authMethod = AuthenticateExternalInitiator
 
 // Authenticate is middleware which authenticates the request by attempting to
@@ -157,6 +198,81 @@ func Authenticate(store Authenticator, methods ...authMethod) gin.HandlerFunc {
 	}
 }
 
+// requiresRole wraps a handler so that it only runs when the authenticated
+// user holds one of the given roles, aborting with 403 Forbidden otherwise.
+// It must run after Authenticate in the handler chain.
+func requiresRole(handler gin.HandlerFunc, roles ...clsessions.UserRole) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, errors.New("not authenticated"))
+			return
+		}
+		for _, role := range roles {
+			if user.Role == role {
+				handler(c)
+				return
+			}
+		}
+		c.Abort()
+		jsonAPIError(c, http.StatusForbidden, errors.Errorf("%s role required", roles[len(roles)-1]))
+	}
+}
+
+// RequiresAdminRole restricts the handler to admin users
+func RequiresAdminRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return requiresRole(handler, clsessions.UserRoleAdmin)
+}
+
+// RequiresEditRole restricts the handler to users that may modify resources
+func RequiresEditRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return requiresRole(handler, clsessions.UserRoleAdmin, clsessions.UserRoleEdit)
+}
+
+// RequiresRunRole restricts the handler to users that may trigger job runs.
+// Requests authenticated as an external initiator carry no user and are
+// allowed through, since external initiators exist solely to trigger runs.
+func RequiresRunRole(handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		if _, ok := GetAuthenticatedExternalInitiator(c); ok {
+			handler(c)
+			return
+		}
+		requiresRole(handler, clsessions.UserRoleAdmin, clsessions.UserRoleEdit, clsessions.UserRoleRun)(c)
+	}
+}
+
+// TOTPVerifier verifies a user-supplied TOTP code.
+type TOTPVerifier interface {
+	VerifyUserTOTP(user *clsessions.User, code string) error
+}
+
+// RequiresTOTP wraps a handler for a sensitive action so that users with TOTP
+// enrolled must supply a valid code in the X-TOTP-Code header. Users without
+// TOTP enrolled pass through unchallenged.
+func RequiresTOTP(verifier TOTPVerifier, handler gin.HandlerFunc) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		user, ok := GetAuthenticatedUser(c)
+		if !ok || !user.TOTPEnabled() {
+			handler(c)
+			return
+		}
+		code := c.GetHeader(TOTPHeader)
+		if code == "" {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, clsessions.ErrTOTPCodeRequired)
+			return
+		}
+		if err := verifier.VerifyUserTOTP(user, code); err != nil {
+			c.Abort()
+			jsonAPIError(c, http.StatusUnauthorized, err)
+			return
+		}
+		handler(c)
+	}
+}
+
 // GetAuthenticatedUser extracts the authentication user from the context.
 func GetAuthenticatedUser(c *gin.Context) (*clsessions.User, bool) {
 	obj, ok := c.Get(SessionUserKey)
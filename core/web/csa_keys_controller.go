@@ -46,6 +46,24 @@ func (ctrl *CSAKeysController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKeys")
 }
 
+// Delete deletes a CSA key
+// Example:
+// "DELETE <application>/keys/csa/:keyID"
+func (ctrl *CSAKeysController) Delete(c *gin.Context) {
+	keyID := c.Param("keyID")
+	key, err := ctrl.App.GetKeyStore().CSA().Get(keyID)
+	if err != nil {
+		jsonAPIError(c, http.StatusNotFound, err)
+		return
+	}
+	_, err = ctrl.App.GetKeyStore().CSA().Delete(keyID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewCSAKeyResource(key), "csaKey")
+}
+
 // Import imports a CSA key
 func (ctrl *CSAKeysController) Import(c *gin.Context) {
 	defer ctrl.App.GetLogger().ErrorIfClosing(c.Request.Body, "Import request body")
@@ -0,0 +1,32 @@
+package web
+
+import (
+	"database/sql"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// DatabaseLockController reports who holds the database lease lock and for
+// how long
+type DatabaseLockController struct {
+	App chainlink.Application
+}
+
+// Show retrieves the current database lease holder
+func (dlc *DatabaseLockController) Show(c *gin.Context) {
+	info, err := postgres.GetLeaseLockInfo(dlc.App.GetSqlxDB())
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("no lease lock has been taken"))
+		return
+	} else if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	jsonAPIResponse(c, presenters.NewDatabaseLockResource(info), "databaseLock")
+}
@@ -0,0 +1,60 @@
+package web
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// DatabasePoolController allows inspecting and tuning the database connection
+// pool at runtime
+type DatabasePoolController struct {
+	App chainlink.Application
+}
+
+type DatabasePoolPatchRequest struct {
+	MaxOpenConns *int `json:"maxOpenConns"`
+	MaxIdleConns *int `json:"maxIdleConns"`
+}
+
+// Get retrieves the current connection pool statistics
+func (dpc *DatabasePoolController) Get(c *gin.Context) {
+	db := dpc.App.GetSqlxDB().DB
+	jsonAPIResponse(c, presenters.NewDatabasePoolResource(db.Stats()), "databasePool")
+}
+
+// Patch adjusts the connection pool limits
+func (dpc *DatabasePoolController) Patch(c *gin.Context) {
+	request := &DatabasePoolPatchRequest{}
+	if err := c.ShouldBindJSON(request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if request.MaxOpenConns == nil && request.MaxIdleConns == nil {
+		jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("please check request params, no params configured"))
+		return
+	}
+
+	db := dpc.App.GetSqlxDB().DB
+	if request.MaxOpenConns != nil {
+		if *request.MaxOpenConns < 1 {
+			jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("maxOpenConns must be at least 1"))
+			return
+		}
+		db.SetMaxOpenConns(*request.MaxOpenConns)
+	}
+	if request.MaxIdleConns != nil {
+		if *request.MaxIdleConns < 0 {
+			jsonAPIError(c, http.StatusBadRequest, fmt.Errorf("maxIdleConns must not be negative"))
+			return
+		}
+		db.SetMaxIdleConns(*request.MaxIdleConns)
+	}
+
+	jsonAPIResponse(c, presenters.NewDatabasePoolResource(db.Stats()), "databasePool")
+}
@@ -0,0 +1,68 @@
+package web_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/smartcontractkit/chainlink/core/internal/cltest"
+	"github.com/smartcontractkit/chainlink/core/web"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+func TestDatabasePoolController_Get(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	resp, cleanup := client.Get("/v2/database/pool")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var pool presenters.DatabasePoolResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &pool))
+	assert.Greater(t, pool.MaxOpenConns, 0)
+}
+
+func TestDatabasePoolController_Patch(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationEVMDisabled(t)
+	require.NoError(t, app.Start())
+
+	client := app.NewHTTPClient()
+
+	maxOpen, maxIdle := 42, 7
+	request, err := json.Marshal(web.DatabasePoolPatchRequest{
+		MaxOpenConns: &maxOpen,
+		MaxIdleConns: &maxIdle,
+	})
+	require.NoError(t, err)
+
+	resp, cleanup := client.Patch("/v2/database/pool", bytes.NewReader(request))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var pool presenters.DatabasePoolResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &pool))
+	assert.Equal(t, maxOpen, pool.MaxOpenConns)
+
+	// Empty requests and invalid limits are rejected
+	resp, cleanup = client.Patch("/v2/database/pool", bytes.NewReader([]byte(`{}`)))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+
+	badOpen := 0
+	request, err = json.Marshal(web.DatabasePoolPatchRequest{MaxOpenConns: &badOpen})
+	require.NoError(t, err)
+	resp, cleanup = client.Patch("/v2/database/pool", bytes.NewReader(request))
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusBadRequest)
+}
@@ -54,6 +54,19 @@ func (eic *ExternalInitiatorsController) Index(c *gin.Context, size, page, offse
 	paginatedResponse(c, "externalInitiators", size, page, resources, count, err)
 }
 
+// NotificationsIndex lists entries in the external initiator notification
+// outbox, most recent first, so operators can see deliveries that are pending
+// or failing.
+func (eic *ExternalInitiatorsController) NotificationsIndex(c *gin.Context, size, page, offset int) {
+	notifications, count, err := eic.App.GetExternalInitiatorManager().Notifications(offset, size)
+	var resources []presenters.ExternalInitiatorNotificationResource
+	for _, n := range notifications {
+		resources = append(resources, presenters.NewExternalInitiatorNotificationResource(n))
+	}
+
+	paginatedResponse(c, "externalInitiatorNotifications", size, page, resources, count, err)
+}
+
 // Create builds and saves a new external initiator
 func (eic *ExternalInitiatorsController) Create(c *gin.Context) {
 	eir := &bridges.ExternalInitiatorRequest{}
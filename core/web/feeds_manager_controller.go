@@ -25,6 +25,7 @@ type CreateFeedsManagerRequest struct {
 	Name                   string           `json:"name"`
 	URI                    string           `json:"uri"`
 	JobTypes               []string         `json:"jobTypes"`
+	ChainIDs               []int64          `json:"chainIDs"`
 	PublicKey              crypto.PublicKey `json:"publicKey"`
 	IsBootstrapPeer        bool             `json:"isBootstrapPeer"`
 	BootstrapPeerMultiaddr null.String      `json:"bootstrapPeerMultiaddr"`
@@ -45,6 +46,7 @@ func (fmc *FeedsManagerController) Create(c *gin.Context) {
 		Name:                      request.Name,
 		PublicKey:                 request.PublicKey,
 		JobTypes:                  request.JobTypes,
+		ChainIDs:                  request.ChainIDs,
 		IsOCRBootstrapPeer:        request.IsBootstrapPeer,
 		OCRBootstrapPeerMultiaddr: request.BootstrapPeerMultiaddr,
 	}
@@ -113,6 +115,7 @@ type UpdateFeedsManagerRequest struct {
 	Name                   string           `json:"name"`
 	URI                    string           `json:"uri"`
 	JobTypes               []string         `json:"jobTypes"`
+	ChainIDs               []int64          `json:"chainIDs"`
 	PublicKey              crypto.PublicKey `json:"publicKey"`
 	IsBootstrapPeer        bool             `json:"isBootstrapPeer"`
 	BootstrapPeerMultiaddr null.String      `json:"bootstrapPeerMultiaddr"`
@@ -140,6 +143,7 @@ func (fmc *FeedsManagerController) Update(c *gin.Context) {
 		Name:                      request.Name,
 		PublicKey:                 request.PublicKey,
 		JobTypes:                  request.JobTypes,
+		ChainIDs:                  request.ChainIDs,
 		IsOCRBootstrapPeer:        request.IsBootstrapPeer,
 		OCRBootstrapPeerMultiaddr: request.BootstrapPeerMultiaddr,
 	}
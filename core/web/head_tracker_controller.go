@@ -0,0 +1,88 @@
+package web
+
+import (
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// HeadTrackerController pauses and resumes a chain's head tracker, e.g. so
+// the node does not thrash on connection errors while the chain's RPC
+// provider is down for maintenance
+type HeadTrackerController struct {
+	App chainlink.Application
+}
+
+// Pause suspends head tracking for the given chain.
+// Example:
+//  "<application>/v2/chains/evm/:ID/head_tracker/pause"
+func (htc *HeadTrackerController) Pause(c *gin.Context) {
+	chain, err := getChain(htc.App.GetChainSet(), c.Param("ID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain.HeadTracker().Pause()
+
+	response := HeadTrackerStatusResponse{
+		Message:    "Head tracking paused",
+		EVMChainID: utils.NewBig(chain.ID()),
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
+// Resume resubscribes a paused chain to new heads; blocks missed while paused
+// are backfilled when the first new head arrives.
+// Example:
+//  "<application>/v2/chains/evm/:ID/head_tracker/resume"
+func (htc *HeadTrackerController) Resume(c *gin.Context) {
+	chain, err := getChain(htc.App.GetChainSet(), c.Param("ID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	chain.HeadTracker().Resume()
+
+	response := HeadTrackerStatusResponse{
+		Message:    "Head tracking resumed",
+		EVMChainID: utils.NewBig(chain.ID()),
+	}
+	jsonAPIResponse(c, &response, "response")
+}
+
+type HeadTrackerStatusResponse struct {
+	Message    string     `json:"message"`
+	EVMChainID *utils.Big `json:"evmChainID"`
+}
+
+// GetID returns the jsonapi ID.
+func (s HeadTrackerStatusResponse) GetID() string {
+	return "headTrackerStatusID"
+}
+
+// GetName returns the collection name for jsonapi.
+func (HeadTrackerStatusResponse) GetName() string {
+	return "head_tracker_status"
+}
+
+// SetID is used to conform to the UnmarshallIdentifier interface for
+// deserializing from jsonapi documents.
+func (*HeadTrackerStatusResponse) SetID(string) error {
+	return nil
+}
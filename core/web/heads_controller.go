@@ -0,0 +1,76 @@
+package web
+
+import (
+	"net/http"
+	"strconv"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// HeadsController returns the recent heads a chain's head tracker has
+// persisted, so operators can reconstruct what the node believed the chain
+// looked like during an incident
+type HeadsController struct {
+	App chainlink.Application
+}
+
+// Index returns the persisted heads for a chain, optionally bounded by the
+// from and to block number query parameters (inclusive). If to is omitted it
+// defaults to the highest seen block number.
+// Example:
+//  "<application>/v2/chains/evm/:ID/heads?from=100&to=200"
+func (hc *HeadsController) Index(c *gin.Context) {
+	chain, err := getChain(hc.App.GetChainSet(), c.Param("ID"))
+	switch err {
+	case ErrInvalidChainID, ErrMultipleChains, ErrMissingChainID:
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	case nil:
+		break
+	default:
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	var from, to int64
+	if s := c.Query("from"); s != "" {
+		from, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || from < 0 {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid 'from' parameter: %q", s))
+			return
+		}
+	}
+	if s := c.Query("to"); s != "" {
+		to, err = strconv.ParseInt(s, 10, 64)
+		if err != nil || to < from {
+			jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid 'to' parameter: %q", s))
+			return
+		}
+	} else {
+		latest, err2 := chain.HeadTracker().HighestSeenHeadFromDB(c.Request.Context())
+		if err2 != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err2)
+			return
+		}
+		if latest == nil {
+			jsonAPIResponse(c, []presenters.HeadResource{}, "heads")
+			return
+		}
+		to = latest.Number
+	}
+
+	heads, err := chain.HeadTracker().HeadsFromDB(c.Request.Context(), from, to)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	resources := make([]presenters.HeadResource, len(heads))
+	for i, head := range heads {
+		resources[i] = presenters.NewHeadResource(head)
+	}
+	jsonAPIResponse(c, resources, "heads")
+}
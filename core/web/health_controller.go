@@ -6,6 +6,7 @@ import (
 	"github.com/gin-gonic/gin"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/services/health"
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -57,6 +58,14 @@ func (hc *HealthController) Readyz(c *gin.Context) {
 	jsonAPIResponse(c, checks, "checks")
 }
 
+// DB reports database health: connection latency, replication lag (when a
+// read replica is configured), bloat estimates for the hot tables and
+// long-running transactions
+func (hc *HealthController) DB(c *gin.Context) {
+	report := postgres.CheckDBHealth(hc.App.GetSqlxDB(), hc.App.GetReadReplicaDB())
+	jsonAPIResponse(c, presenters.NewDatabaseHealthResource(report), "databaseHealth")
+}
+
 func (hc *HealthController) Health(c *gin.Context) {
 	status := http.StatusOK
 
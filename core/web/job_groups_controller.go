@@ -0,0 +1,118 @@
+package web
+
+import (
+	"fmt"
+	"math"
+	"net/http"
+
+	"github.com/gin-gonic/gin"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
+)
+
+// JobGroupsController applies bulk operations to every job in a named group.
+type JobGroupsController struct {
+	App chainlink.Application
+}
+
+// Pause quarantines every job in the group, stopping their services until
+// they are resumed.
+// Example:
+// "POST <application>/job_groups/:name/pause"
+func (jgc *JobGroupsController) Pause(c *gin.Context) {
+	name := c.Param("name")
+	jobs, _, err := jgc.App.JobORM().FindJobsByGroupOrLabels(name, nil, 0, math.MaxInt32)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(jobs) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("no jobs in group %q", name))
+		return
+	}
+
+	reason := fmt.Sprintf("paused by operator (group %s)", name)
+	for _, jb := range jobs {
+		if err := jgc.App.JobSpawner().PauseJob(c.Request.Context(), jb.ID, reason); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	jgc.respondWithGroup(c, name)
+}
+
+// Resume clears the quarantine state of every job in the group and restarts
+// their services.
+// Example:
+// "POST <application>/job_groups/:name/resume"
+func (jgc *JobGroupsController) Resume(c *gin.Context) {
+	name := c.Param("name")
+	jobs, _, err := jgc.App.JobORM().FindJobsByGroupOrLabels(name, nil, 0, math.MaxInt32)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(jobs) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("no jobs in group %q", name))
+		return
+	}
+
+	for _, jb := range jobs {
+		if !jb.QuarantinedAt.Valid {
+			continue
+		}
+		if err := jgc.App.JobORM().ResumeJob(jb.ID); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		jobSpec, err := jgc.App.JobORM().FindJobTx(jb.ID)
+		if err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+		if err := jgc.App.JobSpawner().RestartService(jobSpec); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	jgc.respondWithGroup(c, name)
+}
+
+// Delete archives every job in the group.
+// Example:
+// "DELETE <application>/job_groups/:name"
+func (jgc *JobGroupsController) Delete(c *gin.Context) {
+	name := c.Param("name")
+	jobs, _, err := jgc.App.JobORM().FindJobsByGroupOrLabels(name, nil, 0, math.MaxInt32)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if len(jobs) == 0 {
+		jsonAPIError(c, http.StatusNotFound, errors.Errorf("no jobs in group %q", name))
+		return
+	}
+
+	for _, jb := range jobs {
+		if err := jgc.App.ArchiveJob(c.Request.Context(), jb.ID); err != nil {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+			return
+		}
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
+}
+
+func (jgc *JobGroupsController) respondWithGroup(c *gin.Context, name string) {
+	jobs, _, err := jgc.App.JobORM().FindJobsByGroupOrLabels(name, nil, 0, math.MaxInt32)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	resources := presenters.NewJobResources(jobs)
+	jsonAPIResponse(c, resources, "jobs")
+}
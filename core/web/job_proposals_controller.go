@@ -178,6 +178,64 @@ func (jpc *JobProposalsController) Cancel(c *gin.Context) {
 	)
 }
 
+type BulkJobProposalsRequest struct {
+	IDs []int64 `json:"ids"`
+}
+
+// BulkApprove approves multiple job proposals, reporting the outcome for each
+// proposal individually.
+// Example:
+// "POST <application>/bulk_job_proposals/approve"
+func (jpc *JobProposalsController) BulkApprove(c *gin.Context) {
+	request := BulkJobProposalsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if len(request.IDs) == 0 {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("must provide at least one job proposal id"))
+		return
+	}
+
+	feedsSvc := jpc.App.GetFeedsService()
+
+	results := feedsSvc.BulkApproveJobProposals(c.Request.Context(), request.IDs)
+
+	jsonAPIResponseWithStatus(c,
+		presenters.NewJobProposalBulkResultResources(results),
+		"job_proposal_bulk_results",
+		http.StatusOK,
+	)
+}
+
+// BulkReject rejects multiple job proposals, reporting the outcome for each
+// proposal individually.
+// Example:
+// "POST <application>/bulk_job_proposals/reject"
+func (jpc *JobProposalsController) BulkReject(c *gin.Context) {
+	request := BulkJobProposalsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	if len(request.IDs) == 0 {
+		jsonAPIError(c, http.StatusBadRequest, errors.New("must provide at least one job proposal id"))
+		return
+	}
+
+	feedsSvc := jpc.App.GetFeedsService()
+
+	results := feedsSvc.BulkRejectJobProposals(c.Request.Context(), request.IDs)
+
+	jsonAPIResponseWithStatus(c,
+		presenters.NewJobProposalBulkResultResources(results),
+		"job_proposal_bulk_results",
+		http.StatusOK,
+	)
+}
+
 type UpdateSpecRequest struct {
 	Spec string `json:"spec"`
 }
@@ -0,0 +1,224 @@
+package web
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+
+	"github.com/pelletier/go-toml"
+	"github.com/pkg/errors"
+
+	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/blockhashstore"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/services/cron"
+	"github.com/smartcontractkit/chainlink/core/services/directrequest"
+	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
+	"github.com/smartcontractkit/chainlink/core/services/job"
+	"github.com/smartcontractkit/chainlink/core/services/keeper"
+	"github.com/smartcontractkit/chainlink/core/services/ocrbootstrap"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
+	"github.com/smartcontractkit/chainlink/core/services/offchainreporting2"
+	"github.com/smartcontractkit/chainlink/core/services/pipeline"
+	"github.com/smartcontractkit/chainlink/core/services/vrf"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// JobValidationProblem is one problem found while validating a job spec.
+// Field names the TOML key or pipeline task the problem relates to, when
+// known.
+type JobValidationProblem struct {
+	Field   string `json:"field,omitempty"`
+	Message string `json:"message"`
+}
+
+// validatedJobFromType runs the type-specific spec validation for the given
+// job type
+func validatedJobFromType(app chainlink.Application, jobType job.Type, ts string) (job.Job, error) {
+	switch jobType {
+	case job.OffchainReporting:
+		return offchainreporting.ValidatedOracleSpecToml(app.GetChainSet(), ts)
+	case job.OffchainReporting2:
+		return offchainreporting2.ValidatedOracleSpecToml(ts)
+	case job.Bootstrap:
+		return ocrbootstrap.ValidatedBootstrapSpecToml(ts)
+	case job.DirectRequest:
+		return directrequest.ValidatedDirectRequestSpec(ts)
+	case job.FluxMonitor:
+		return fluxmonitorv2.ValidatedFluxMonitorSpec(app.GetConfig(), ts)
+	case job.Keeper:
+		return keeper.ValidatedKeeperSpec(ts)
+	case job.Cron:
+		return cron.ValidatedCronSpec(ts)
+	case job.VRF:
+		return vrf.ValidatedVRFSpec(ts)
+	case job.Webhook:
+		return webhook.ValidatedWebhookSpec(ts, app.GetExternalInitiatorManager())
+	case job.BlockhashStore:
+		return blockhashstore.ValidatedSpec(ts)
+	default:
+		return job.Job{}, errors.Errorf("unknown job type: %s", jobType)
+	}
+}
+
+// jobSpecProblems validates a TOML job spec without creating a job,
+// collecting every problem found rather than stopping at the first error:
+// TOML syntax errors (with line and column), unknown fields, pipeline errors
+// naming the offending task, and references to keys, bridges or chains that
+// do not exist.
+func jobSpecProblems(app chainlink.Application, ts string) []JobValidationProblem {
+	problems := []JobValidationProblem{}
+
+	tree, err := toml.Load(ts)
+	if err != nil {
+		// go-toml syntax errors are prefixed with "(line, column):"
+		return append(problems, JobValidationProblem{Message: fmt.Sprintf("toml error: %v", err)})
+	}
+	var jb job.Job
+	if err = tree.Unmarshal(&jb); err != nil {
+		return append(problems, JobValidationProblem{Message: err.Error()})
+	}
+
+	for _, key := range tree.Keys() {
+		if !isKnownTOMLKey(jb.Type, key) {
+			problems = append(problems, JobValidationProblem{Field: key, Message: "unknown field"})
+		}
+	}
+
+	jobType, err := job.ValidateSpec(ts)
+	if err != nil {
+		return append(problems, JobValidationProblem{Field: "type", Message: err.Error()})
+	}
+
+	validated, err := validatedJobFromType(app, jobType, ts)
+	if err != nil {
+		problems = append(problems, JobValidationProblem{Message: err.Error()})
+	} else {
+		jb = validated
+	}
+
+	if jb.Pipeline.Source != "" {
+		p, err := pipeline.Parse(jb.Pipeline.Source)
+		if err != nil {
+			problems = append(problems, JobValidationProblem{Field: "observationSource", Message: err.Error()})
+		} else {
+			problems = append(problems, pipelineTaskProblems(app, p)...)
+		}
+	}
+
+	return append(problems, keyAndChainProblems(app, jb)...)
+}
+
+// pipelineTaskProblems checks each parsed pipeline task's external
+// references, naming the offending task in the problem
+func pipelineTaskProblems(app chainlink.Application, p *pipeline.Pipeline) (problems []JobValidationProblem) {
+	for _, task := range p.Tasks {
+		if task.Type() != pipeline.TaskTypeBridge {
+			continue
+		}
+		name := task.(*pipeline.BridgeTask).Name
+		if _, err := app.BridgeORM().FindBridge(bridges.TaskType(name)); err != nil {
+			problems = append(problems, JobValidationProblem{
+				Field:   task.DotID(),
+				Message: fmt.Sprintf("bridge %q does not exist", name),
+			})
+		}
+	}
+	return
+}
+
+// keyAndChainProblems checks that the keys and EVM chain the spec references
+// actually exist on this node
+func keyAndChainProblems(app chainlink.Application, jb job.Job) (problems []JobValidationProblem) {
+	keyStore := app.GetKeyStore()
+	var chainID *utils.Big
+	switch jb.Type {
+	case job.OffchainReporting:
+		spec := jb.OffchainreportingOracleSpec
+		if spec == nil {
+			return
+		}
+		if spec.EncryptedOCRKeyBundleID != nil {
+			if _, err := keyStore.OCR().Get(spec.EncryptedOCRKeyBundleID.String()); err != nil {
+				problems = append(problems, JobValidationProblem{Field: "keyBundleID", Message: fmt.Sprintf("no key bundle with ID %v", spec.EncryptedOCRKeyBundleID)})
+			}
+		}
+		if spec.TransmitterAddress != nil {
+			if _, err := keyStore.Eth().Get(spec.TransmitterAddress.Hex()); err != nil {
+				problems = append(problems, JobValidationProblem{Field: "transmitterAddress", Message: fmt.Sprintf("no eth key with address %v", spec.TransmitterAddress)})
+			}
+		}
+		chainID = spec.EVMChainID
+	case job.FluxMonitor:
+		if jb.FluxMonitorSpec != nil {
+			chainID = jb.FluxMonitorSpec.EVMChainID
+		}
+	case job.DirectRequest:
+		if jb.DirectRequestSpec != nil {
+			chainID = jb.DirectRequestSpec.EVMChainID
+		}
+	case job.Keeper:
+		if jb.KeeperSpec != nil {
+			chainID = jb.KeeperSpec.EVMChainID
+		}
+	case job.VRF:
+		if jb.VRFSpec == nil {
+			return
+		}
+		if _, err := keyStore.VRF().Get(jb.VRFSpec.PublicKey.String()); err != nil {
+			problems = append(problems, JobValidationProblem{Field: "publicKey", Message: fmt.Sprintf("no vrf key with public key %s", jb.VRFSpec.PublicKey.String())})
+		}
+		chainID = jb.VRFSpec.EVMChainID
+	case job.BlockhashStore:
+		if jb.BlockhashStoreSpec != nil {
+			chainID = jb.BlockhashStoreSpec.EVMChainID
+		}
+	}
+	if chainID != nil {
+		if _, err := app.GetChainSet().Get(chainID.ToInt()); err != nil {
+			problems = append(problems, JobValidationProblem{Field: "evmChainID", Message: err.Error()})
+		}
+	}
+	return
+}
+
+// specTypeForJobType is used to derive the accepted TOML keys per job type
+var specTypeForJobType = map[job.Type]interface{}{
+	job.Cron:               job.CronSpec{},
+	job.DirectRequest:      job.DirectRequestSpec{},
+	job.FluxMonitor:        job.FluxMonitorSpec{},
+	job.OffchainReporting:  job.OffchainReportingOracleSpec{},
+	job.OffchainReporting2: job.OffchainReporting2OracleSpec{},
+	job.Bootstrap:          job.BootstrapSpec{},
+	job.Keeper:             job.KeeperSpec{},
+	job.VRF:                job.VRFSpec{},
+	job.Webhook:            job.WebhookSpec{},
+	job.BlockhashStore:     job.BlockhashStoreSpec{},
+}
+
+// isKnownTOMLKey reports whether the top-level TOML key maps onto a field of
+// the job model or of the spec struct for the job type. Matching is
+// case-insensitive like go-toml's unmarshalling.
+func isKnownTOMLKey(jobType job.Type, key string) bool {
+	structs := []interface{}{job.Job{}}
+	if spec, exists := specTypeForJobType[jobType]; exists {
+		structs = append(structs, spec)
+	}
+	for _, v := range structs {
+		t := reflect.TypeOf(v)
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			name := f.Name
+			if tag := f.Tag.Get("toml"); tag == "-" {
+				continue
+			} else if tag != "" {
+				name = tag
+			}
+			if strings.EqualFold(name, key) {
+				return true
+			}
+		}
+	}
+	return false
+}
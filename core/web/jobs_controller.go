@@ -3,23 +3,20 @@ package web
 import (
 	"context"
 	"database/sql"
+	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
+	"github.com/ethereum/go-ethereum/common"
 	"github.com/gin-gonic/gin"
+	"github.com/pelletier/go-toml"
 	"github.com/pkg/errors"
 	uuid "github.com/satori/go.uuid"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
-	"github.com/smartcontractkit/chainlink/core/services/cron"
-	"github.com/smartcontractkit/chainlink/core/services/directrequest"
-	"github.com/smartcontractkit/chainlink/core/services/fluxmonitorv2"
 	"github.com/smartcontractkit/chainlink/core/services/job"
-	"github.com/smartcontractkit/chainlink/core/services/keeper"
 	"github.com/smartcontractkit/chainlink/core/services/keystore"
-	"github.com/smartcontractkit/chainlink/core/services/offchainreporting"
-	"github.com/smartcontractkit/chainlink/core/services/vrf"
-	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
@@ -28,16 +25,46 @@ type JobsController struct {
 	App chainlink.Application
 }
 
-// Index lists all jobs
+// Index lists all jobs, optionally filtered by the contract address and/or
+// transmitter address referenced by their specs, or by group and labels.
 // Example:
 // "GET <application>/jobs"
+// "GET <application>/jobs?contract=0x...&transmitter=0x..."
+// "GET <application>/jobs?group=mainnet-feeds&label=ocr,eth"
 func (jc *JobsController) Index(c *gin.Context, size, page, offset int) {
 	// Temporary: if no size is passed in, use a large page size. Remove once frontend can handle pagination
 	if c.Query("size") == "" {
 		size = 1000
 	}
 
-	jobs, count, err := jc.App.JobORM().FindJobs(offset, size)
+	var contract, transmitter *common.Address
+	for param, addr := range map[string]**common.Address{"contract": &contract, "transmitter": &transmitter} {
+		if s := c.Query(param); s != "" {
+			if !common.IsHexAddress(s) {
+				jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid %s address: %s", param, s))
+				return
+			}
+			a := common.HexToAddress(s)
+			*addr = &a
+		}
+	}
+
+	group := c.Query("group")
+	var labels []string
+	if s := c.Query("label"); s != "" {
+		labels = strings.Split(s, ",")
+	}
+
+	var jobs []job.Job
+	var count int
+	var err error
+	if contract != nil || transmitter != nil {
+		jobs, count, err = jc.App.JobORM().SearchJobs(contract, transmitter, offset, size)
+	} else if group != "" || len(labels) > 0 {
+		jobs, count, err = jc.App.JobORM().FindJobsByGroupOrLabels(group, labels, offset, size)
+	} else {
+		jobs, count, err = jc.App.JobORM().FindJobs(offset, size)
+	}
 	if err != nil {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
@@ -79,6 +106,44 @@ func (jc *JobsController) Show(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
 }
 
+// Export returns the job's original TOML spec for backup or re-import on
+// another node. The externalJobID is prepended when the original spec omitted
+// it, so a re-imported spec maps back onto this job.
+// :ID could be both job ID and external job ID
+// Example:
+// "GET <application>/jobs/:ID/export"
+func (jc *JobsController) Export(c *gin.Context) {
+	var err error
+	jobSpec := job.Job{}
+	if externalJobID, pErr := uuid.FromString(c.Param("ID")); pErr == nil {
+		jobSpec, err = jc.App.JobORM().FindJobByExternalJobID(c.Request.Context(), externalJobID)
+	} else if pErr = jobSpec.SetID(c.Param("ID")); pErr == nil {
+		jobSpec, err = jc.App.JobORM().FindJobTx(jobSpec.ID)
+	} else {
+		jsonAPIError(c, http.StatusUnprocessableEntity, pErr)
+		return
+	}
+	if err != nil {
+		if errors.Cause(err) == sql.ErrNoRows {
+			jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		} else {
+			jsonAPIError(c, http.StatusInternalServerError, err)
+		}
+		return
+	}
+
+	tomlSpec := jobSpec.SpecTOML
+	if tomlSpec == "" {
+		jsonAPIError(c, http.StatusUnprocessableEntity, errors.New("job has no stored TOML spec: it was created before specs were retained"))
+		return
+	}
+	if tree, terr := toml.Load(tomlSpec); terr == nil && !tree.Has("externalJobID") {
+		tomlSpec = fmt.Sprintf("externalJobID = %q\n%s", jobSpec.ExternalJobID, tomlSpec)
+	}
+
+	c.Data(http.StatusOK, "application/toml", []byte(tomlSpec))
+}
+
 // CreateJobRequest represents a request to create and start a job (V2).
 type CreateJobRequest struct {
 	TOML string `json:"toml"`
@@ -100,35 +165,21 @@ func (jc *JobsController) Create(c *gin.Context) {
 		return
 	}
 
-	var jb job.Job
 	config := jc.App.GetConfig()
 	switch jobType {
-	case job.OffchainReporting:
-		jb, err = offchainreporting.ValidatedOracleSpecToml(jc.App.GetChainSet(), request.TOML)
+	case job.OffchainReporting, job.OffchainReporting2, job.Bootstrap:
 		if !config.Dev() && !config.FeatureOffchainReporting() {
 			jsonAPIError(c, http.StatusNotImplemented, errors.New("The Offchain Reporting feature is disabled by configuration"))
 			return
 		}
-	case job.DirectRequest:
-		jb, err = directrequest.ValidatedDirectRequestSpec(request.TOML)
-	case job.FluxMonitor:
-		jb, err = fluxmonitorv2.ValidatedFluxMonitorSpec(jc.App.GetConfig(), request.TOML)
-	case job.Keeper:
-		jb, err = keeper.ValidatedKeeperSpec(request.TOML)
-	case job.Cron:
-		jb, err = cron.ValidatedCronSpec(request.TOML)
-	case job.VRF:
-		jb, err = vrf.ValidatedVRFSpec(request.TOML)
-	case job.Webhook:
-		jb, err = webhook.ValidatedWebhookSpec(request.TOML, jc.App.GetExternalInitiatorManager())
-	default:
-		jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("unknown job type: %s", jobType))
-		return
 	}
+	jb, err := validatedJobFromType(jc.App, jobType, request.TOML)
 	if err != nil {
 		jsonAPIError(c, http.StatusBadRequest, err)
 		return
 	}
+	// Keep the original TOML so the spec can be exported later
+	jb.SpecTOML = request.TOML
 
 	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 	defer cancel()
@@ -145,7 +196,135 @@ func (jc *JobsController) Create(c *gin.Context) {
 	jsonAPIResponse(c, presenters.NewJobResource(jb), jb.Type.String())
 }
 
-// Delete hard deletes a job spec.
+// Validate validates a job spec TOML without creating the job, returning
+// every problem found rather than only the first error.
+// Example:
+// "POST <application>/jobs/validate"
+func (jc *JobsController) Validate(c *gin.Context) {
+	request := CreateJobRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	problems := jobSpecProblems(jc.App, request.TOML)
+	c.JSON(http.StatusOK, gin.H{"valid": len(problems) == 0, "problems": problems})
+}
+
+// UpdateJobParamsRequest represents a request to replace a job's runtime params.
+type UpdateJobParamsRequest struct {
+	Params job.JSONConfig `json:"params"`
+}
+
+// UpdateParams replaces the job's runtime params and restarts its services so
+// subsequent pipeline runs see the new values via $(jobSpec.params.X).
+// Example:
+// "PATCH <application>/jobs/:ID"
+func (jc *JobsController) UpdateParams(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+	request := UpdateJobParamsRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := jc.App.JobORM().SetJobParams(j.ID, request.Params)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jobSpec, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err := jc.App.JobSpawner().RestartService(jobSpec); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// Spend returns the job's on-chain spend over its budget window.
+// Example:
+// "GET <application>/jobs/:ID/spend"
+func (jc *JobsController) Spend(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	jobSpec, err := jc.App.JobORM().FindJobTx(j.ID)
+	if errors.Cause(err) == sql.ErrNoRows {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	window := jobSpec.BudgetWindow.Duration()
+	if window == 0 {
+		window = job.DefaultBudgetWindow
+	}
+	since := time.Now().Add(-window)
+	report, err := jc.App.JobORM().JobSpend(j.ID, since)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobSpendReport(jobSpec, report, window, since), "jobSpendReports")
+}
+
+// Resume clears a job's quarantine state and restarts its services.
+// Example:
+// "POST <application>/jobs/:ID/resume"
+func (jc *JobsController) Resume(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := jc.App.JobORM().ResumeJob(j.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jobSpec, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err := jc.App.JobSpawner().RestartService(jobSpec); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// Delete archives a job spec: its services are stopped and it is hidden from
+// default listings, but its spec, runs and transactions are preserved. Use
+// Purge for true deletion.
 // Example:
 // "DELETE <application>/specs/:ID"
 func (jc *JobsController) Delete(c *gin.Context) {
@@ -156,7 +335,66 @@ func (jc *JobsController) Delete(c *gin.Context) {
 		return
 	}
 
-	// Delete the job
+	// Archive the job
+	err = jc.App.ArchiveJob(c.Request.Context(), j.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
+
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "job", http.StatusNoContent)
+}
+
+// Restore clears a job's archived state and restarts its services.
+// Example:
+// "POST <application>/jobs/:ID/restore"
+func (jc *JobsController) Restore(c *gin.Context) {
+	j := job.Job{}
+	if err := j.SetID(c.Param("ID")); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	err := jc.App.JobORM().RestoreJob(j.ID)
+	if errors.Is(err, sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("archived job not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jobSpec, err := jc.App.JobORM().FindJobTx(j.ID)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	if err := jc.App.JobSpawner().StartService(jobSpec); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewJobResource(jobSpec), "jobs")
+}
+
+// Purge hard deletes a job spec along with its runs.
+// Example:
+// "DELETE <application>/jobs/:ID/purge"
+func (jc *JobsController) Purge(c *gin.Context) {
+	j := job.Job{}
+	err := j.SetID(c.Param("ID"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
 	err = jc.App.DeleteJob(c.Request.Context(), j.ID)
 	if errors.Is(err, sql.ErrNoRows) {
 		jsonAPIError(c, http.StatusNotFound, errors.New("JobSpec not found"))
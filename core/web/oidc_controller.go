@@ -0,0 +1,149 @@
+package web
+
+import (
+	"database/sql"
+	"errors"
+	"fmt"
+	"net/http"
+
+	"github.com/gin-gonic/contrib/sessions"
+	"github.com/gin-gonic/gin"
+
+	"github.com/smartcontractkit/chainlink/core/audit"
+	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// oidcStateKey is the browser session key holding the state nonce for an
+// in-flight OIDC login.
+const oidcStateKey = "oidc_state"
+
+// OIDCController runs the OpenID Connect login flow, allowing operators to
+// put node access behind their identity provider alongside password auth.
+type OIDCController struct {
+	App chainlink.Application
+}
+
+// Login redirects the browser to the identity provider's authorization
+// endpoint, recording a state nonce to bind the eventual callback to this
+// browser session.
+func (oc *OIDCController) Login(c *gin.Context) {
+	cfg := oc.App.GetConfig()
+	if cfg.OIDCIssuer() == "" {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OIDC login is not configured"))
+		return
+	}
+
+	discovery, err := clsessions.FetchOIDCDiscovery(cfg.OIDCIssuer())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	state := utils.NewSecret(24)
+	session := sessions.Default(c)
+	session.Set(oidcStateKey, state)
+	if err := session.Save(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	c.Redirect(http.StatusFound, clsessions.OIDCAuthCodeURL(discovery, cfg, oc.redirectURL(c), state))
+}
+
+// Callback handles the redirect back from the identity provider, exchanging
+// the authorization code for the user's identity and establishing a session.
+// Users are provisioned on first login with the role derived from their
+// identity provider groups.
+func (oc *OIDCController) Callback(c *gin.Context) {
+	defer oc.App.WakeSessionReaper()
+
+	cfg := oc.App.GetConfig()
+	if cfg.OIDCIssuer() == "" {
+		jsonAPIError(c, http.StatusNotFound, errors.New("OIDC login is not configured"))
+		return
+	}
+
+	session := sessions.Default(c)
+	state, _ := session.Get(oidcStateKey).(string)
+	session.Delete(oidcStateKey)
+	if state == "" || c.Query("state") != state {
+		jsonAPIError(c, http.StatusUnauthorized, errors.New("invalid OIDC state"))
+		return
+	}
+
+	discovery, err := clsessions.FetchOIDCDiscovery(cfg.OIDCIssuer())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	claims, err := clsessions.ExchangeOIDCCode(cfg, discovery, oc.redirectURL(c), c.Query("code"))
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+
+	if !clsessions.OIDCEmailAllowed(claims.Email, cfg.OIDCAllowedDomains()) {
+		oc.App.GetAuditLogger().Audit(audit.EventLoginFailed, claims.Email, map[string]interface{}{"method": "oidc", "ip": c.ClientIP()})
+		jsonAPIError(c, http.StatusForbidden, fmt.Errorf("email domain of %s is not allowed to log in", claims.Email))
+		return
+	}
+
+	role, err := clsessions.OIDCRoleForGroups(claims.Groups, cfg.OIDCRoleMapping(), cfg.OIDCDefaultRole())
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	orm := oc.App.SessionORM()
+	user, err := orm.FindUserByEmail(claims.Email)
+	provisioned := errors.Is(err, sql.ErrNoRows)
+	if provisioned {
+		// Provision the user on first login. The identity provider is the
+		// source of truth for these accounts, so the local password is an
+		// unguessable placeholder.
+		user, err = clsessions.NewUser(claims.Email, utils.NewSecret(24))
+		if err == nil {
+			user.Role = role
+			err = orm.CreateUser(&user)
+		}
+	} else if err == nil && user.Role != role {
+		// Keep the role in sync with the identity provider's groups.
+		user, err = orm.UpdateRole(user.Email, string(role))
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	sid, err := orm.CreateSessionForUser(user, c)
+	if err != nil {
+		jsonAPIError(c, http.StatusUnauthorized, err)
+		return
+	}
+	if err := saveSessionID(session, sid); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	if provisioned {
+		oc.App.GetAuditLogger().Audit(audit.EventUserProvisioned, user.Email, map[string]interface{}{"role": string(user.Role)})
+	}
+	oc.App.GetAuditLogger().Audit(audit.EventLogin, user.Email, map[string]interface{}{"method": "oidc", "ip": c.ClientIP()})
+	c.Redirect(http.StatusFound, "/")
+}
+
+// redirectURL is the callback URL registered with the identity provider,
+// derived from the incoming request unless explicitly configured.
+func (oc *OIDCController) redirectURL(c *gin.Context) string {
+	if override := oc.App.GetConfig().OIDCRedirectURL(); override != "" {
+		return override
+	}
+	scheme := "http"
+	if c.Request.TLS != nil {
+		scheme = "https"
+	}
+	return fmt.Sprintf("%s://%s/oidc/callback", scheme, c.Request.Host)
+}
@@ -5,7 +5,9 @@ import (
 	"encoding/json"
 	"io/ioutil"
 	"net/http"
+	"net/url"
 	"strconv"
+	"time"
 
 	"github.com/gin-gonic/gin"
 	"github.com/pkg/errors"
@@ -41,7 +43,13 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	var err error
 
 	if id == "" {
-		pipelineRuns, count, err = prc.App.JobORM().PipelineRuns(nil, offset, size)
+		var filter pipeline.RunFilter
+		filter, err = runFilterFromQuery(c)
+		if err != nil {
+			jsonAPIError(c, http.StatusUnprocessableEntity, err)
+			return
+		}
+		pipelineRuns, count, err = prc.App.PipelineORM().GetRuns(filter, offset, size)
 	} else {
 		jobSpec := job.Job{}
 		err = jobSpec.SetID(c.Param("ID"))
@@ -62,6 +70,43 @@ func (prc *PipelineRunsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "pipelineRun", size, page, res, count, err)
 }
 
+// runFilterFromQuery builds a pipeline.RunFilter from the supported query
+// parameters: specId, state, createdAfter, createdBefore (RFC3339) and
+// errored.
+func runFilterFromQuery(c *gin.Context) (filter pipeline.RunFilter, err error) {
+	if specID := c.Query("specId"); specID != "" {
+		id, err2 := strconv.ParseInt(specID, 10, 32)
+		if err2 != nil {
+			return filter, errors.Wrapf(err2, "invalid specId %q", specID)
+		}
+		filter.PipelineSpecID = int32(id)
+	}
+	if state := c.Query("state"); state != "" {
+		s := pipeline.RunStatus(state)
+		filter.State = &s
+	}
+	if createdAfter := c.Query("createdAfter"); createdAfter != "" {
+		filter.CreatedAfter, err = time.Parse(time.RFC3339, createdAfter)
+		if err != nil {
+			return filter, errors.Wrapf(err, "invalid createdAfter %q", createdAfter)
+		}
+	}
+	if createdBefore := c.Query("createdBefore"); createdBefore != "" {
+		filter.CreatedBefore, err = time.Parse(time.RFC3339, createdBefore)
+		if err != nil {
+			return filter, errors.Wrapf(err, "invalid createdBefore %q", createdBefore)
+		}
+	}
+	if errored := c.Query("errored"); errored != "" {
+		b, err2 := strconv.ParseBool(errored)
+		if err2 != nil {
+			return filter, errors.Wrapf(err2, "invalid errored %q", errored)
+		}
+		filter.Errored = &b
+	}
+	return filter, nil
+}
+
 // Show returns a specified pipeline run.
 // Example:
 // "GET <application>/jobs/:ID/runs/:runID"
@@ -106,7 +151,8 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 
 	user, isUser := auth.GetAuthenticatedUser(c)
 	ei, _ := auth.GetAuthenticatedExternalInitiator(c)
-	authorizer := webhook.NewAuthorizer(postgres.UnwrapGormDB(prc.App.GetDB()).DB, user, ei)
+	db := postgres.UnwrapGormDB(prc.App.GetDB()).DB
+	authorizer := webhook.NewAuthorizer(db, user, ei)
 
 	// Is it a UUID? Then process it as a webhook job
 	jobUUID, err := uuid.FromString(idStr)
@@ -117,7 +163,25 @@ func (prc *PipelineRunsController) Create(c *gin.Context) {
 			return
 		}
 		if canRun {
-			jobRunID, err3 := prc.App.RunWebhookJobV2(c.Request.Context(), jobUUID, string(bodyBytes), pipeline.JSONSerializable{})
+			callbackURL := c.Query("callbackURL")
+			if callbackURL != "" {
+				if u, err3 := url.ParseRequestURI(callbackURL); err3 != nil || (u.Scheme != "http" && u.Scheme != "https") {
+					jsonAPIError(c, http.StatusUnprocessableEntity, errors.Errorf("invalid callbackURL %q", callbackURL))
+					return
+				}
+			}
+			err3 := webhook.VerifySignature(c.Request.Context(), db, jobUUID, c.GetHeader(webhook.SignatureHeader), c.GetHeader(webhook.TimestampHeader), bodyBytes)
+			if errors.Is(err3, webhook.ErrJobNotExists) {
+				jsonAPIError(c, http.StatusNotFound, err3)
+				return
+			} else if errors.Is(err3, webhook.ErrInvalidSignature) {
+				jsonAPIError(c, http.StatusUnauthorized, err3)
+				return
+			} else if err3 != nil {
+				jsonAPIError(c, http.StatusInternalServerError, err3)
+				return
+			}
+			jobRunID, err3 := prc.App.RunWebhookJobV2(c.Request.Context(), jobUUID, string(bodyBytes), pipeline.JSONSerializable{}, callbackURL)
 			if errors.Is(err3, webhook.ErrJobNotExists) {
 				jsonAPIError(c, http.StatusNotFound, err3)
 				return
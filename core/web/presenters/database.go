@@ -0,0 +1,130 @@
+package presenters
+
+import (
+	"database/sql"
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/postgres"
+)
+
+// DatabasePoolResource reports the state of the database connection pool
+type DatabasePoolResource struct {
+	JAID
+	MaxOpenConns int     `json:"maxOpenConns"`
+	Open         int     `json:"open"`
+	InUse        int     `json:"inUse"`
+	Idle         int     `json:"idle"`
+	WaitCount    int64   `json:"waitCount"`
+	WaitSeconds  float64 `json:"waitSeconds"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DatabasePoolResource) GetName() string {
+	return "databasePool"
+}
+
+// DatabaseLockResource reports the holder of the database lease lock
+type DatabaseLockResource struct {
+	JAID
+	ClientID  string    `json:"clientID"`
+	UpdatedAt time.Time `json:"updatedAt"`
+	ExpiresAt time.Time `json:"expiresAt"`
+	Expired   bool      `json:"expired"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DatabaseLockResource) GetName() string {
+	return "databaseLock"
+}
+
+// NewDatabaseLockResource returns a new DatabaseLockResource from lease info
+func NewDatabaseLockResource(info postgres.LeaseLockInfo) DatabaseLockResource {
+	return DatabaseLockResource{
+		JAID:      JAID{ID: "lock"},
+		ClientID:  info.ClientID.String(),
+		UpdatedAt: info.UpdatedAt,
+		ExpiresAt: info.ExpiresAt,
+		Expired:   info.Expired(),
+	}
+}
+
+// DatabaseHealthResource reports operational health signals for the database
+type DatabaseHealthResource struct {
+	JAID
+	PingLatencyMs float64 `json:"pingLatencyMs"`
+	// ReplicationLagSeconds is null when no read replica is configured
+	ReplicationLagSeconds *float64                `json:"replicationLagSeconds"`
+	Tables                []DatabaseTableHealth   `json:"tables"`
+	LongRunningTxs        []DatabaseLongRunningTx `json:"longRunningTransactions"`
+	Warnings              []string                `json:"warnings"`
+}
+
+// DatabaseTableHealth is a bloat estimate for a known-hot table
+type DatabaseTableHealth struct {
+	Table        string  `json:"table"`
+	TotalBytes   int64   `json:"totalBytes"`
+	LiveRows     int64   `json:"liveRows"`
+	DeadRows     int64   `json:"deadRows"`
+	DeadFraction float64 `json:"deadFraction"`
+}
+
+// DatabaseLongRunningTx describes a transaction open for longer than the
+// reporting threshold
+type DatabaseLongRunningTx struct {
+	PID             int     `json:"pid"`
+	DurationSeconds float64 `json:"durationSeconds"`
+	State           string  `json:"state"`
+	Query           string  `json:"query"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r DatabaseHealthResource) GetName() string {
+	return "databaseHealth"
+}
+
+// NewDatabaseHealthResource returns a new DatabaseHealthResource from a
+// health report
+func NewDatabaseHealthResource(report postgres.DBHealth) DatabaseHealthResource {
+	r := DatabaseHealthResource{
+		JAID:           JAID{ID: "health"},
+		PingLatencyMs:  float64(report.PingLatency.Nanoseconds()) / 1e6,
+		Tables:         make([]DatabaseTableHealth, len(report.Tables)),
+		LongRunningTxs: make([]DatabaseLongRunningTx, len(report.LongRunningTxs)),
+		Warnings:       report.Warnings,
+	}
+	if report.ReplicationLag != nil {
+		lag := report.ReplicationLag.Seconds()
+		r.ReplicationLagSeconds = &lag
+	}
+	for i, table := range report.Tables {
+		r.Tables[i] = DatabaseTableHealth{
+			Table:        table.Table,
+			TotalBytes:   table.TotalBytes,
+			LiveRows:     table.LiveRows,
+			DeadRows:     table.DeadRows,
+			DeadFraction: table.DeadFraction,
+		}
+	}
+	for i, tx := range report.LongRunningTxs {
+		r.LongRunningTxs[i] = DatabaseLongRunningTx{
+			PID:             tx.PID,
+			DurationSeconds: tx.Duration.Seconds(),
+			State:           tx.State,
+			Query:           tx.Query,
+		}
+	}
+	return r
+}
+
+// NewDatabasePoolResource returns a new DatabasePoolResource from pool stats
+func NewDatabasePoolResource(stats sql.DBStats) DatabasePoolResource {
+	return DatabasePoolResource{
+		JAID:         JAID{ID: "pool"},
+		MaxOpenConns: stats.MaxOpenConnections,
+		Open:         stats.OpenConnections,
+		InUse:        stats.InUse,
+		Idle:         stats.Idle,
+		WaitCount:    stats.WaitCount,
+		WaitSeconds:  stats.WaitDuration.Seconds(),
+	}
+}
@@ -1,7 +1,9 @@
 package presenters
 
 import (
+	"fmt"
 	"strconv"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -48,6 +50,103 @@ func NewEthTxResource(tx bulletprooftxmanager.EthTx) EthTxResource {
 	}
 }
 
+// InflightTxAttemptResource is an attempt nested within an inflight
+// transaction.
+type InflightTxAttemptResource struct {
+	Hash                    common.Hash `json:"hash"`
+	State                   string      `json:"state"`
+	GasPrice                string      `json:"gasPrice,omitempty"`
+	GasTipCap               string      `json:"gasTipCap,omitempty"`
+	GasFeeCap               string      `json:"gasFeeCap,omitempty"`
+	BroadcastBeforeBlockNum *int64      `json:"broadcastBeforeBlockNum"`
+	CreatedAt               time.Time   `json:"createdAt"`
+}
+
+// InflightTxResource is a single unconfirmed transaction nested within a
+// group.
+type InflightTxResource struct {
+	ID        string                      `json:"id"`
+	Nonce     string                      `json:"nonce"`
+	State     string                      `json:"state"`
+	CreatedAt time.Time                   `json:"createdAt"`
+	Age       string                      `json:"age"`
+	Attempts  []InflightTxAttemptResource `json:"attempts"`
+}
+
+// InflightTxGroupResource groups the unconfirmed transactions of a single
+// key/chain pair, with summary counts per state.
+type InflightTxGroupResource struct {
+	JAID
+	FromAddress             common.Address       `json:"fromAddress"`
+	EVMChainID              utils.Big            `json:"evmChainID"`
+	InProgress              int                  `json:"inProgress"`
+	Unconfirmed             int                  `json:"unconfirmed"`
+	ConfirmedMissingReceipt int                  `json:"confirmedMissingReceipt"`
+	Transactions            []InflightTxResource `json:"transactions"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (InflightTxGroupResource) GetName() string {
+	return "inflight_transactions"
+}
+
+// NewInflightTxGroupResources groups unconfirmed transactions per key and
+// chain, preserving the order of the given transactions.
+func NewInflightTxGroupResources(txs []bulletprooftxmanager.EthTx, now time.Time) []InflightTxGroupResource {
+	groups := []InflightTxGroupResource{}
+	idxs := map[string]int{}
+	for _, tx := range txs {
+		id := fmt.Sprintf("%s-%s", tx.FromAddress.Hex(), tx.EVMChainID.String())
+		i, exists := idxs[id]
+		if !exists {
+			groups = append(groups, InflightTxGroupResource{
+				JAID:        NewJAID(id),
+				FromAddress: tx.FromAddress,
+				EVMChainID:  tx.EVMChainID,
+			})
+			i = len(groups) - 1
+			idxs[id] = i
+		}
+		switch tx.State {
+		case bulletprooftxmanager.EthTxInProgress:
+			groups[i].InProgress++
+		case bulletprooftxmanager.EthTxUnconfirmed:
+			groups[i].Unconfirmed++
+		case bulletprooftxmanager.EthTxConfirmedMissingReceipt:
+			groups[i].ConfirmedMissingReceipt++
+		}
+		r := InflightTxResource{
+			ID:        tx.GetID(),
+			State:     string(tx.State),
+			CreatedAt: tx.CreatedAt,
+			Age:       now.Sub(tx.CreatedAt).Truncate(time.Second).String(),
+		}
+		if tx.Nonce != nil {
+			r.Nonce = strconv.FormatInt(*tx.Nonce, 10)
+		}
+		for _, txa := range tx.EthTxAttempts {
+			a := InflightTxAttemptResource{
+				Hash:                    txa.Hash,
+				State:                   string(txa.State),
+				BroadcastBeforeBlockNum: txa.BroadcastBeforeBlockNum,
+				CreatedAt:               txa.CreatedAt,
+			}
+			if txa.GasPrice != nil {
+				a.GasPrice = txa.GasPrice.String()
+			}
+			if txa.GasTipCap != nil {
+				a.GasTipCap = txa.GasTipCap.String()
+			}
+			if txa.GasFeeCap != nil {
+				a.GasFeeCap = txa.GasFeeCap.String()
+			}
+			r.Attempts = append(r.Attempts, a)
+		}
+		groups[i].Transactions = append(groups[i].Transactions, r)
+	}
+	return groups
+}
+
 func NewEthTxResourceFromAttempt(txa bulletprooftxmanager.EthTxAttempt) EthTxResource {
 	tx := txa.EthTx
 
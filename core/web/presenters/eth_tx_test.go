@@ -2,6 +2,7 @@ package presenters
 
 import (
 	"testing"
+	"time"
 
 	"github.com/ethereum/go-ethereum/common"
 	"github.com/ethereum/go-ethereum/common/hexutil"
@@ -104,3 +105,62 @@ func TestEthTxResource(t *testing.T) {
 
 	assert.JSONEq(t, expected, string(b))
 }
+
+func TestInflightTxGroupResources(t *testing.T) {
+	t.Parallel()
+
+	from1 := common.HexToAddress("0x1")
+	from2 := common.HexToAddress("0x2")
+	nonce1 := int64(100)
+	nonce2 := int64(101)
+	now := time.Unix(1000, 0)
+
+	txs := []bulletprooftxmanager.EthTx{
+		{
+			ID:          1,
+			Nonce:       &nonce1,
+			FromAddress: from1,
+			State:       bulletprooftxmanager.EthTxUnconfirmed,
+			CreatedAt:   time.Unix(700, 0),
+			EthTxAttempts: []bulletprooftxmanager.EthTxAttempt{
+				{
+					Hash:     common.HexToHash("0xaa"),
+					State:    bulletprooftxmanager.EthTxAttemptBroadcast,
+					GasPrice: utils.NewBigI(1000),
+				},
+			},
+		},
+		{
+			ID:          2,
+			Nonce:       &nonce2,
+			FromAddress: from1,
+			State:       bulletprooftxmanager.EthTxInProgress,
+			CreatedAt:   time.Unix(900, 0),
+		},
+		{
+			ID:          3,
+			Nonce:       &nonce1,
+			FromAddress: from2,
+			State:       bulletprooftxmanager.EthTxUnconfirmed,
+			CreatedAt:   time.Unix(995, 0),
+		},
+	}
+
+	groups := NewInflightTxGroupResources(txs, now)
+	require.Len(t, groups, 2)
+
+	assert.Equal(t, from1, groups[0].FromAddress)
+	assert.Equal(t, 1, groups[0].Unconfirmed)
+	assert.Equal(t, 1, groups[0].InProgress)
+	assert.Equal(t, 0, groups[0].ConfirmedMissingReceipt)
+	require.Len(t, groups[0].Transactions, 2)
+	assert.Equal(t, "100", groups[0].Transactions[0].Nonce)
+	assert.Equal(t, "5m0s", groups[0].Transactions[0].Age)
+	require.Len(t, groups[0].Transactions[0].Attempts, 1)
+	assert.Equal(t, "1000", groups[0].Transactions[0].Attempts[0].GasPrice)
+
+	assert.Equal(t, from2, groups[1].FromAddress)
+	assert.Equal(t, 1, groups[1].Unconfirmed)
+	require.Len(t, groups[1].Transactions, 1)
+	assert.Equal(t, "5s", groups[1].Transactions[0].Age)
+}
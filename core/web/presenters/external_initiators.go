@@ -4,8 +4,11 @@ import (
 	"fmt"
 	"time"
 
+	null "gopkg.in/guregu/null.v4"
+
 	"github.com/smartcontractkit/chainlink/core/auth"
 	"github.com/smartcontractkit/chainlink/core/bridges"
+	"github.com/smartcontractkit/chainlink/core/services/webhook"
 	"github.com/smartcontractkit/chainlink/core/store/models"
 )
 
@@ -15,6 +18,7 @@ type ExternalInitiatorAuthentication struct {
 	URL            models.WebURL `json:"url,omitempty"`
 	AccessKey      string        `json:"incomingAccessKey,omitempty"`
 	Secret         string        `json:"incomingSecret,omitempty"`
+	SigningKey     string        `json:"incomingSigningKey,omitempty"`
 	OutgoingToken  string        `json:"outgoingToken,omitempty"`
 	OutgoingSecret string        `json:"outgoingSecret,omitempty"`
 }
@@ -28,6 +32,7 @@ func NewExternalInitiatorAuthentication(
 		Name:           ei.Name,
 		AccessKey:      ei.AccessKey,
 		Secret:         eia.Secret,
+		SigningKey:     ei.SigningKey,
 		OutgoingToken:  ei.OutgoingToken,
 		OutgoingSecret: ei.OutgoingSecret,
 	}
@@ -80,3 +85,36 @@ func NewExternalInitiatorResource(ei bridges.ExternalInitiator) ExternalInitiato
 func (ExternalInitiatorResource) GetName() string {
 	return "externalInitiators"
 }
+
+// ExternalInitiatorNotificationResource is an entry in the outbound
+// notification outbox for an external initiator.
+type ExternalInitiatorNotificationResource struct {
+	JAID
+	ExternalInitiator string      `json:"externalInitiator"`
+	Kind              string      `json:"kind"`
+	JobID             string      `json:"jobId"`
+	Attempts          int         `json:"attempts"`
+	LastError         null.String `json:"lastError"`
+	NextAttemptAt     time.Time   `json:"nextAttemptAt"`
+	DeliveredAt       null.Time   `json:"deliveredAt"`
+	CreatedAt         time.Time   `json:"createdAt"`
+}
+
+func NewExternalInitiatorNotificationResource(n webhook.Notification) ExternalInitiatorNotificationResource {
+	return ExternalInitiatorNotificationResource{
+		JAID:              NewJAID(fmt.Sprintf("%d", n.ID)),
+		ExternalInitiator: n.ExternalInitiator.Name,
+		Kind:              string(n.Kind),
+		JobID:             n.JobID.String(),
+		Attempts:          n.Attempts,
+		LastError:         n.LastError,
+		NextAttemptAt:     n.NextAttemptAt,
+		DeliveredAt:       n.DeliveredAt,
+		CreatedAt:         n.CreatedAt,
+	}
+}
+
+// GetName returns the collection name for jsonapi.
+func (ExternalInitiatorNotificationResource) GetName() string {
+	return "externalInitiatorNotifications"
+}
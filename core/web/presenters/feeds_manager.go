@@ -15,6 +15,7 @@ type FeedsManagerResource struct {
 	URI                    string           `json:"uri"`
 	PublicKey              crypto.PublicKey `json:"publicKey"`
 	JobTypes               []string         `json:"jobTypes"`
+	ChainIDs               []int64          `json:"chainIDs"`
 	IsBootstrapPeer        bool             `json:"isBootstrapPeer"`
 	BootstrapPeerMultiaddr null.String      `json:"bootstrapPeerMultiaddr"`
 	IsConnectionActive     bool             `json:"isConnectionActive"`
@@ -34,6 +35,7 @@ func NewFeedsManagerResource(ms feeds.FeedsManager) *FeedsManagerResource {
 		URI:                    ms.URI,
 		PublicKey:              ms.PublicKey,
 		JobTypes:               ms.JobTypes,
+		ChainIDs:               ms.ChainIDs,
 		IsBootstrapPeer:        ms.IsOCRBootstrapPeer,
 		BootstrapPeerMultiaddr: ms.OCRBootstrapPeerMultiaddr,
 		IsConnectionActive:     ms.IsConnectionActive,
@@ -0,0 +1,36 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/services/eth"
+	"github.com/smartcontractkit/chainlink/core/utils"
+)
+
+// HeadResource represents a head JSONAPI resource
+type HeadResource struct {
+	JAID
+	Number     int64      `json:"number"`
+	Hash       string     `json:"hash"`
+	ParentHash string     `json:"parentHash"`
+	EVMChainID *utils.Big `json:"evmChainID"`
+	Timestamp  time.Time  `json:"timestamp"`
+	ReceivedAt time.Time  `json:"receivedAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r HeadResource) GetName() string {
+	return "head"
+}
+
+func NewHeadResource(head *eth.Head) HeadResource {
+	return HeadResource{
+		JAID:       NewJAIDInt64(int64(head.ID)),
+		Number:     head.Number,
+		Hash:       head.Hash.Hex(),
+		ParentHash: head.ParentHash.Hex(),
+		EVMChainID: head.EVMChainID,
+		Timestamp:  head.Timestamp,
+		ReceivedAt: head.CreatedAt,
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	"github.com/lib/pq"
 	uuid "github.com/satori/go.uuid"
+	"gopkg.in/guregu/null.v4"
 
 	"github.com/smartcontractkit/chainlink/core/assets"
 	clnull "github.com/smartcontractkit/chainlink/core/null"
@@ -32,6 +33,7 @@ const (
 	CronJobSpec              JobSpecType = "cron"
 	VRFJobSpec               JobSpecType = "vrf"
 	WebhookJobSpec           JobSpecType = "webhook"
+	BlockhashStoreJobSpec    JobSpecType = "blockhashstore"
 )
 
 // DirectRequestSpec defines the spec details of a DirectRequest Job
@@ -70,6 +72,7 @@ type FluxMonitorSpec struct {
 	ContractAddress     ethkey.EIP55Address `json:"contractAddress"`
 	Threshold           float32             `json:"threshold"`
 	AbsoluteThreshold   float32             `json:"absoluteThreshold"`
+	ThresholdExpression string              `json:"thresholdExpression"`
 	PollTimerPeriod     string              `json:"pollTimerPeriod"`
 	PollTimerDisabled   bool                `json:"pollTimerDisabled"`
 	IdleTimerPeriod     string              `json:"idleTimerPeriod"`
@@ -99,6 +102,7 @@ func NewFluxMonitorSpec(spec *job.FluxMonitorSpec) *FluxMonitorSpec {
 		ContractAddress:     spec.ContractAddress,
 		Threshold:           spec.Threshold,
 		AbsoluteThreshold:   spec.AbsoluteThreshold,
+		ThresholdExpression: spec.ThresholdExpression,
 		PollTimerPeriod:     spec.PollTimerPeriod.String(),
 		PollTimerDisabled:   spec.PollTimerDisabled,
 		IdleTimerPeriod:     spec.IdleTimerPeriod.String(),
@@ -180,23 +184,90 @@ func NewPipelineSpec(spec *pipeline.Spec) PipelineSpec {
 	}
 }
 
+// OffChainReporting2Spec defines the spec details of an OCR2 Job
+type OffChainReporting2Spec struct {
+	Relay                             string                 `json:"relay"`
+	RelayConfig                       map[string]interface{} `json:"relayConfig"`
+	ContractID                        string                 `json:"contractID"`
+	P2PBootstrapPeers                 pq.StringArray         `json:"p2pBootstrapPeers"`
+	IsBootstrapPeer                   bool                   `json:"isBootstrapPeer"`
+	EncryptedOCRKeyBundleID           null.String            `json:"keyBundleID"`
+	TransmitterID                     null.String            `json:"transmitterID"`
+	BlockchainTimeout                 models.Interval        `json:"blockchainTimeout"`
+	ContractConfigTrackerPollInterval models.Interval        `json:"contractConfigTrackerPollInterval"`
+	ContractConfigConfirmations       uint16                 `json:"contractConfigConfirmations"`
+	CreatedAt                         time.Time              `json:"createdAt"`
+	UpdatedAt                         time.Time              `json:"updatedAt"`
+}
+
+// NewOffChainReporting2Spec initializes a new OffChainReporting2Spec from a
+// job.OffchainReporting2OracleSpec
+func NewOffChainReporting2Spec(spec *job.OffchainReporting2OracleSpec) *OffChainReporting2Spec {
+	return &OffChainReporting2Spec{
+		Relay:                             spec.Relay,
+		RelayConfig:                       spec.RelayConfig,
+		ContractID:                        spec.ContractID,
+		P2PBootstrapPeers:                 spec.P2PBootstrapPeers,
+		IsBootstrapPeer:                   spec.IsBootstrapPeer,
+		EncryptedOCRKeyBundleID:           spec.EncryptedOCRKeyBundleID,
+		TransmitterID:                     spec.TransmitterID,
+		BlockchainTimeout:                 spec.BlockchainTimeout,
+		ContractConfigTrackerPollInterval: spec.ContractConfigTrackerPollInterval,
+		ContractConfigConfirmations:       spec.ContractConfigConfirmations,
+		CreatedAt:                         spec.CreatedAt,
+		UpdatedAt:                         spec.UpdatedAt,
+	}
+}
+
+// BootstrapSpec defines the spec details of a Bootstrap Job
+type BootstrapSpec struct {
+	ContractID                        string                 `json:"contractID"`
+	Relay                             string                 `json:"relay"`
+	RelayConfig                       map[string]interface{} `json:"relayConfig"`
+	BlockchainTimeout                 models.Interval        `json:"blockchainTimeout"`
+	ContractConfigTrackerPollInterval models.Interval        `json:"contractConfigTrackerPollInterval"`
+	ContractConfigConfirmations       uint16                 `json:"contractConfigConfirmations"`
+	CreatedAt                         time.Time              `json:"createdAt"`
+	UpdatedAt                         time.Time              `json:"updatedAt"`
+}
+
+// NewBootstrapSpec initializes a new BootstrapSpec from a job.BootstrapSpec
+func NewBootstrapSpec(spec *job.BootstrapSpec) *BootstrapSpec {
+	return &BootstrapSpec{
+		ContractID:                        spec.ContractID,
+		Relay:                             spec.Relay,
+		RelayConfig:                       spec.RelayConfig,
+		BlockchainTimeout:                 spec.BlockchainTimeout,
+		ContractConfigTrackerPollInterval: spec.ContractConfigTrackerPollInterval,
+		ContractConfigConfirmations:       spec.ContractConfigConfirmations,
+		CreatedAt:                         spec.CreatedAt,
+		UpdatedAt:                         spec.UpdatedAt,
+	}
+}
+
 // KeeperSpec defines the spec details of a Keeper Job
 type KeeperSpec struct {
-	ContractAddress ethkey.EIP55Address `json:"contractAddress"`
-	FromAddress     ethkey.EIP55Address `json:"fromAddress"`
-	CreatedAt       time.Time           `json:"createdAt"`
-	UpdatedAt       time.Time           `json:"updatedAt"`
-	EVMChainID      *utils.Big          `json:"evmChainID"`
+	ContractAddress       ethkey.EIP55Address `json:"contractAddress"`
+	FromAddress           ethkey.EIP55Address `json:"fromAddress"`
+	TurnBlockCount        int32               `json:"turnBlockCount"`
+	TurnBufferBlocks      int32               `json:"turnBufferBlocks"`
+	GasPriceBufferPercent uint32              `json:"gasPriceBufferPercent"`
+	CreatedAt             time.Time           `json:"createdAt"`
+	UpdatedAt             time.Time           `json:"updatedAt"`
+	EVMChainID            *utils.Big          `json:"evmChainID"`
 }
 
 // NewKeeperSpec generates a new KeeperSpec from a job.KeeperSpec
 func NewKeeperSpec(spec *job.KeeperSpec) *KeeperSpec {
 	return &KeeperSpec{
-		ContractAddress: spec.ContractAddress,
-		FromAddress:     spec.FromAddress,
-		CreatedAt:       spec.CreatedAt,
-		UpdatedAt:       spec.UpdatedAt,
-		EVMChainID:      spec.EVMChainID,
+		ContractAddress:       spec.ContractAddress,
+		FromAddress:           spec.FromAddress,
+		TurnBlockCount:        spec.TurnBlockCount,
+		TurnBufferBlocks:      spec.TurnBufferBlocks,
+		GasPriceBufferPercent: spec.GasPriceBufferPercent,
+		CreatedAt:             spec.CreatedAt,
+		UpdatedAt:             spec.UpdatedAt,
+		EVMChainID:            spec.EVMChainID,
 	}
 }
 
@@ -216,41 +287,85 @@ func NewWebhookSpec(spec *job.WebhookSpec) *WebhookSpec {
 
 // CronSpec defines the spec details of a Cron Job
 type CronSpec struct {
-	CronSchedule string    `json:"schedule" tom:"schedule"`
-	CreatedAt    time.Time `json:"createdAt"`
-	UpdatedAt    time.Time `json:"updatedAt"`
+	CronSchedule  string            `json:"schedule" tom:"schedule"`
+	CronSchedules job.CronSchedules `json:"schedules,omitempty"`
+	CreatedAt     time.Time         `json:"createdAt"`
+	UpdatedAt     time.Time         `json:"updatedAt"`
 }
 
 // NewCronSpec generates a new CronSpec from a job.CronSpec
 func NewCronSpec(spec *job.CronSpec) *CronSpec {
 	return &CronSpec{
-		CronSchedule: spec.CronSchedule,
-		CreatedAt:    spec.CreatedAt,
-		UpdatedAt:    spec.UpdatedAt,
+		CronSchedule:  spec.CronSchedule,
+		CronSchedules: spec.CronSchedules,
+		CreatedAt:     spec.CreatedAt,
+		UpdatedAt:     spec.UpdatedAt,
 	}
 }
 
 type VRFSpec struct {
-	CoordinatorAddress ethkey.EIP55Address  `json:"coordinatorAddress"`
-	PublicKey          secp256k1.PublicKey  `json:"publicKey"`
-	FromAddress        *ethkey.EIP55Address `json:"fromAddress"`
-	PollPeriod         models.Duration      `json:"pollPeriod"`
-	Confirmations      uint32               `json:"confirmations"`
-	CreatedAt          time.Time            `json:"createdAt"`
-	UpdatedAt          time.Time            `json:"updatedAt"`
-	EVMChainID         *utils.Big           `json:"evmChainID"`
+	CoordinatorAddress      ethkey.EIP55Address  `json:"coordinatorAddress"`
+	PublicKey               secp256k1.PublicKey  `json:"publicKey"`
+	FromAddress             *ethkey.EIP55Address `json:"fromAddress"`
+	PollPeriod              models.Duration      `json:"pollPeriod"`
+	Confirmations           uint32               `json:"confirmations"`
+	BatchFulfillmentEnabled bool                 `json:"batchFulfillmentEnabled"`
+	BatchCoordinatorAddress *ethkey.EIP55Address `json:"batchCoordinatorAddress"`
+	MaxBatchSize            uint32               `json:"maxBatchSize"`
+	BatchGasBudget          uint64               `json:"batchGasBudget"`
+	MinSubscriptionBalance  *assets.Link         `json:"minSubscriptionBalanceLinkJuels"`
+	CreatedAt               time.Time            `json:"createdAt"`
+	UpdatedAt               time.Time            `json:"updatedAt"`
+	EVMChainID              *utils.Big           `json:"evmChainID"`
 }
 
 func NewVRFSpec(spec *job.VRFSpec) *VRFSpec {
 	return &VRFSpec{
-		CoordinatorAddress: spec.CoordinatorAddress,
-		PublicKey:          spec.PublicKey,
-		FromAddress:        spec.FromAddress,
-		PollPeriod:         models.MustMakeDuration(spec.PollPeriod),
-		Confirmations:      spec.Confirmations,
-		CreatedAt:          spec.CreatedAt,
-		UpdatedAt:          spec.UpdatedAt,
-		EVMChainID:         spec.EVMChainID,
+		CoordinatorAddress:      spec.CoordinatorAddress,
+		PublicKey:               spec.PublicKey,
+		FromAddress:             spec.FromAddress,
+		PollPeriod:              models.MustMakeDuration(spec.PollPeriod),
+		Confirmations:           spec.Confirmations,
+		BatchFulfillmentEnabled: spec.BatchFulfillmentEnabled,
+		BatchCoordinatorAddress: spec.BatchCoordinatorAddress,
+		MaxBatchSize:            spec.MaxBatchSize,
+		BatchGasBudget:          spec.BatchGasBudget,
+		MinSubscriptionBalance:  spec.MinSubscriptionBalance,
+		CreatedAt:               spec.CreatedAt,
+		UpdatedAt:               spec.UpdatedAt,
+		EVMChainID:              spec.EVMChainID,
+	}
+}
+
+// BlockhashStoreSpec defines the job parameters for a blockhash store feeder job.
+type BlockhashStoreSpec struct {
+	CoordinatorV1Address  *ethkey.EIP55Address `json:"coordinatorV1Address"`
+	CoordinatorV2Address  *ethkey.EIP55Address `json:"coordinatorV2Address"`
+	WaitBlocks            int32                `json:"waitBlocks"`
+	LookbackBlocks        int32                `json:"lookbackBlocks"`
+	BlockhashStoreAddress ethkey.EIP55Address  `json:"blockhashStoreAddress"`
+	PollPeriod            time.Duration        `json:"pollPeriod"`
+	RunTimeout            time.Duration        `json:"runTimeout"`
+	EVMChainID            *utils.Big           `json:"evmChainID"`
+	FromAddress           *ethkey.EIP55Address `json:"fromAddress"`
+	CreatedAt             time.Time            `json:"createdAt"`
+	UpdatedAt             time.Time            `json:"updatedAt"`
+}
+
+// NewBlockhashStoreSpec creates a new BlockhashStoreSpec for the given parameters.
+func NewBlockhashStoreSpec(spec *job.BlockhashStoreSpec) *BlockhashStoreSpec {
+	return &BlockhashStoreSpec{
+		CoordinatorV1Address:  spec.CoordinatorV1Address,
+		CoordinatorV2Address:  spec.CoordinatorV2Address,
+		WaitBlocks:            spec.WaitBlocks,
+		LookbackBlocks:        spec.LookbackBlocks,
+		BlockhashStoreAddress: spec.BlockhashStoreAddress,
+		PollPeriod:            spec.PollPeriod,
+		RunTimeout:            spec.RunTimeout,
+		EVMChainID:            spec.EVMChainID,
+		FromAddress:           spec.FromAddress,
+		CreatedAt:             spec.CreatedAt,
+		UpdatedAt:             spec.UpdatedAt,
 	}
 }
 
@@ -276,32 +391,45 @@ func NewJobError(e job.SpecError) JobError {
 // JobResource represents a JobResource
 type JobResource struct {
 	JAID
-	Name                  string                 `json:"name"`
-	Type                  JobSpecType            `json:"type"`
-	SchemaVersion         uint32                 `json:"schemaVersion"`
-	MaxTaskDuration       models.Interval        `json:"maxTaskDuration"`
-	ExternalJobID         uuid.UUID              `json:"externalJobID"`
-	DirectRequestSpec     *DirectRequestSpec     `json:"directRequestSpec"`
-	FluxMonitorSpec       *FluxMonitorSpec       `json:"fluxMonitorSpec"`
-	CronSpec              *CronSpec              `json:"cronSpec"`
-	OffChainReportingSpec *OffChainReportingSpec `json:"offChainReportingOracleSpec"`
-	KeeperSpec            *KeeperSpec            `json:"keeperSpec"`
-	VRFSpec               *VRFSpec               `json:"vrfSpec"`
-	WebhookSpec           *WebhookSpec           `json:"webhookSpec"`
-	PipelineSpec          PipelineSpec           `json:"pipelineSpec"`
-	Errors                []JobError             `json:"errors"`
+	Name                   string                  `json:"name"`
+	Type                   JobSpecType             `json:"type"`
+	SchemaVersion          uint32                  `json:"schemaVersion"`
+	MaxTaskDuration        models.Interval         `json:"maxTaskDuration"`
+	ExternalJobID          uuid.UUID               `json:"externalJobID"`
+	Params                 map[string]interface{}  `json:"params"`
+	Labels                 []string                `json:"labels"`
+	GroupName              string                  `json:"groupName"`
+	QuarantinedAt          null.Time               `json:"quarantinedAt"`
+	QuarantineReason       null.String             `json:"quarantineReason"`
+	DirectRequestSpec      *DirectRequestSpec      `json:"directRequestSpec"`
+	FluxMonitorSpec        *FluxMonitorSpec        `json:"fluxMonitorSpec"`
+	CronSpec               *CronSpec               `json:"cronSpec"`
+	OffChainReportingSpec  *OffChainReportingSpec  `json:"offChainReportingOracleSpec"`
+	OffChainReporting2Spec *OffChainReporting2Spec `json:"offChainReporting2OracleSpec"`
+	BootstrapSpec          *BootstrapSpec          `json:"bootstrapSpec"`
+	KeeperSpec             *KeeperSpec             `json:"keeperSpec"`
+	VRFSpec                *VRFSpec                `json:"vrfSpec"`
+	WebhookSpec            *WebhookSpec            `json:"webhookSpec"`
+	BlockhashStoreSpec     *BlockhashStoreSpec     `json:"blockhashStoreSpec"`
+	PipelineSpec           PipelineSpec            `json:"pipelineSpec"`
+	Errors                 []JobError              `json:"errors"`
 }
 
 // NewJobResource initializes a new JSONAPI job resource
 func NewJobResource(j job.Job) *JobResource {
 	resource := &JobResource{
-		JAID:            NewJAIDInt32(j.ID),
-		Name:            j.Name.ValueOrZero(),
-		Type:            JobSpecType(j.Type),
-		SchemaVersion:   j.SchemaVersion,
-		MaxTaskDuration: j.MaxTaskDuration,
-		PipelineSpec:    NewPipelineSpec(j.PipelineSpec),
-		ExternalJobID:   j.ExternalJobID,
+		JAID:             NewJAIDInt32(j.ID),
+		Name:             j.Name.ValueOrZero(),
+		Type:             JobSpecType(j.Type),
+		SchemaVersion:    j.SchemaVersion,
+		MaxTaskDuration:  j.MaxTaskDuration,
+		PipelineSpec:     NewPipelineSpec(j.PipelineSpec),
+		ExternalJobID:    j.ExternalJobID,
+		Params:           j.Params,
+		Labels:           j.Labels,
+		GroupName:        j.GroupName.ValueOrZero(),
+		QuarantinedAt:    j.QuarantinedAt,
+		QuarantineReason: j.QuarantineReason,
 	}
 
 	switch j.Type {
@@ -313,12 +441,18 @@ func NewJobResource(j job.Job) *JobResource {
 		resource.CronSpec = NewCronSpec(j.CronSpec)
 	case job.OffchainReporting:
 		resource.OffChainReportingSpec = NewOffChainReportingSpec(j.OffchainreportingOracleSpec)
+	case job.OffchainReporting2:
+		resource.OffChainReporting2Spec = NewOffChainReporting2Spec(j.Offchainreporting2OracleSpec)
+	case job.Bootstrap:
+		resource.BootstrapSpec = NewBootstrapSpec(j.BootstrapSpec)
 	case job.Keeper:
 		resource.KeeperSpec = NewKeeperSpec(j.KeeperSpec)
 	case job.VRF:
 		resource.VRFSpec = NewVRFSpec(j.VRFSpec)
 	case job.Webhook:
 		resource.WebhookSpec = NewWebhookSpec(j.WebhookSpec)
+	case job.BlockhashStore:
+		resource.BlockhashStoreSpec = NewBlockhashStoreSpec(j.BlockhashStoreSpec)
 	}
 
 	jes := []JobError{}
@@ -345,3 +479,32 @@ func NewJobResources(js []job.Job) []JobResource {
 func (r JobResource) GetName() string {
 	return "jobs"
 }
+
+// JobSpendReport reports a job's on-chain spend over its budget window.
+type JobSpendReport struct {
+	JAID
+	GasSpentWei  *utils.Big      `json:"gasSpentWei"`
+	GasBudgetWei *utils.Big      `json:"gasBudgetWei"`
+	LinkSpent    *assets.Link    `json:"linkSpent"`
+	LinkBudget   *assets.Link    `json:"linkBudget"`
+	Window       models.Interval `json:"window"`
+	Since        time.Time       `json:"since"`
+}
+
+// NewJobSpendReport initializes a new JSONAPI job spend report resource
+func NewJobSpendReport(j job.Job, report job.SpendReport, window time.Duration, since time.Time) *JobSpendReport {
+	return &JobSpendReport{
+		JAID:         NewJAIDInt32(j.ID),
+		GasSpentWei:  report.GasSpent,
+		GasBudgetWei: j.GasBudget,
+		LinkSpent:    report.LinkSpent,
+		LinkBudget:   j.LinkBudget,
+		Window:       models.Interval(window),
+		Since:        since,
+	}
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobSpendReport) GetName() string {
+	return "jobSpendReports"
+}
@@ -10,13 +10,15 @@ import (
 // JobProposalResource represents a job proposal JSONAPI resource.
 type JobProposalResource struct {
 	JAID
-	Spec           string                  `json:"spec"`
-	Status         feeds.JobProposalStatus `json:"status"`
-	ExternalJobID  *string                 `json:"external_job_id"`
-	FeedsManagerID string                  `json:"feeds_manager_id"`
-	Multiaddrs     []string                `json:"multiaddrs"`
-	ProposedAt     time.Time               `json:"proposedAt"`
-	CreatedAt      time.Time               `json:"createdAt"`
+	Spec            string                  `json:"spec"`
+	Status          feeds.JobProposalStatus `json:"status"`
+	ExternalJobID   *string                 `json:"external_job_id"`
+	FeedsManagerID  string                  `json:"feeds_manager_id"`
+	Multiaddrs      []string                `json:"multiaddrs"`
+	RejectionReason *string                 `json:"rejectionReason"`
+	ValidationError *string                 `json:"validationError"`
+	ProposedAt      time.Time               `json:"proposedAt"`
+	CreatedAt       time.Time               `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -27,13 +29,15 @@ func (r JobProposalResource) GetName() string {
 // NewJobProposalResource constructs a new JobProposalResource.
 func NewJobProposalResource(jp feeds.JobProposal) *JobProposalResource {
 	res := &JobProposalResource{
-		JAID:           NewJAIDInt64(jp.ID),
-		Status:         jp.Status,
-		Spec:           jp.Spec,
-		FeedsManagerID: strconv.FormatInt(jp.FeedsManagerID, 10),
-		Multiaddrs:     jp.Multiaddrs,
-		ProposedAt:     jp.ProposedAt,
-		CreatedAt:      jp.CreatedAt,
+		JAID:            NewJAIDInt64(jp.ID),
+		Status:          jp.Status,
+		Spec:            jp.Spec,
+		FeedsManagerID:  strconv.FormatInt(jp.FeedsManagerID, 10),
+		Multiaddrs:      jp.Multiaddrs,
+		RejectionReason: jp.RejectionReason.Ptr(),
+		ValidationError: jp.ValidationError.Ptr(),
+		ProposedAt:      jp.ProposedAt,
+		CreatedAt:       jp.CreatedAt,
 	}
 
 	if jp.ExternalJobID.Valid {
@@ -54,3 +58,32 @@ func NewJobProposalResources(jps []feeds.JobProposal) []JobProposalResource {
 
 	return rs
 }
+
+// JobProposalBulkResultResource represents the outcome for a single proposal
+// within a bulk operation as a JSONAPI resource.
+type JobProposalBulkResultResource struct {
+	JAID
+	Success bool   `json:"success"`
+	Error   string `json:"error,omitempty"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r JobProposalBulkResultResource) GetName() string {
+	return "job_proposal_bulk_results"
+}
+
+// NewJobProposalBulkResultResources initializes a slice of JSONAPI bulk
+// operation result resources.
+func NewJobProposalBulkResultResources(results []feeds.JobProposalBulkResult) []JobProposalBulkResultResource {
+	rs := []JobProposalBulkResultResource{}
+
+	for _, result := range results {
+		rs = append(rs, JobProposalBulkResultResource{
+			JAID:    NewJAIDInt64(result.ProposalID),
+			Success: result.Success,
+			Error:   result.Error,
+		})
+	}
+
+	return rs
+}
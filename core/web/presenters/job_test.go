@@ -84,6 +84,7 @@ func TestJob(t *testing.T) {
 						"type": "directrequest",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"blockhashStoreSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -99,8 +100,15 @@ func TestJob(t *testing.T) {
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
 						},
+						"offChainReporting2OracleSpec": null,
 						"offChainReportingOracleSpec": null,
 						"fluxMonitorSpec": null,
+						"params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -147,6 +155,7 @@ func TestJob(t *testing.T) {
 						"type": "fluxmonitor",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"blockhashStoreSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
@@ -156,6 +165,7 @@ func TestJob(t *testing.T) {
 							"contractAddress": "%s",
 							"threshold": 0.5,
 							"absoluteThreshold": 0,
+							"thresholdExpression": "",
 							"idleTimerPeriod": "1m0s",
 							"idleTimerDisabled": false,
 							"pollTimerPeriod": "1s",
@@ -168,8 +178,15 @@ func TestJob(t *testing.T) {
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
 						},
+						"offChainReporting2OracleSpec": null,
 						"offChainReportingOracleSpec": null,
 						"directRequestSpec": null,
+						"params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -220,11 +237,13 @@ func TestJob(t *testing.T) {
 						"type": "offchainreporting",
 						"maxTaskDuration": "1m0s",
 					  "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"blockhashStoreSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "ds1 [type=http method=GET url=\"https://pricesource1.com\"",
 							"jobID": 0
 						},
+						"offChainReporting2OracleSpec": null,
 						"offChainReportingOracleSpec": {
 							"contractAddress": "%s",
 							"p2pPeerID": "p2p_%s",
@@ -243,6 +262,12 @@ func TestJob(t *testing.T) {
 						},
 						"fluxMonitorSpec": null,
 						"directRequestSpec": null,
+						"params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
 						"keeperSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -284,14 +309,24 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"blockhashStoreSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
 							"jobID": 0
 						},
+						"params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"turnBlockCount": 0,
+							"turnBufferBlocks": 0,
+							"gasPriceBufferPercent": 0,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -300,6 +335,7 @@ func TestJob(t *testing.T) {
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
+						"offChainReporting2OracleSpec": null,
 						"offChainReportingOracleSpec": null,
                         "cronSpec": null,
                         "vrfSpec": null,
@@ -338,6 +374,7 @@ func TestJob(t *testing.T) {
                         "type": "cron",
                         "maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+                        "blockhashStoreSpec": null,
                         "pipelineSpec": {
                             "id": 1,
                             "dotDagSource": "",
@@ -350,8 +387,15 @@ func TestJob(t *testing.T) {
                         },
                         "fluxMonitorSpec": null,
                         "directRequestSpec": null,
-                        "keeperSpec": null,
-                        "offChainReportingOracleSpec": null,
+                        "params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
+						"keeperSpec": null,
+                        "offChainReporting2OracleSpec": null,
+						"offChainReportingOracleSpec": null,
 						"vrfSpec": null,
                         "webhookSpec": null,
                         "errors": []
@@ -388,6 +432,7 @@ func TestJob(t *testing.T) {
 						"type": "webhook",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"blockhashStoreSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
@@ -399,8 +444,15 @@ func TestJob(t *testing.T) {
 						},
 						"fluxMonitorSpec": null,
 						"directRequestSpec": null,
+						"params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
 						"keeperSpec": null,
 						"cronSpec": null,
+						"offChainReporting2OracleSpec": null,
 						"offChainReportingOracleSpec": null,
                         "vrfSpec": null,
 						"errors": []
@@ -450,14 +502,24 @@ func TestJob(t *testing.T) {
 						"type": "keeper",
 						"maxTaskDuration": "1m0s",
 					    "externalJobID":"0eec7e1d-d0d2-476c-a1a8-72dfb6633f46",
+						"blockhashStoreSpec": null,
 						"pipelineSpec": {
 							"id": 1,
 							"dotDagSource": "",
 							"jobID": 0
 						},
+						"params": null,
+						"labels": null,
+						"groupName": "",
+						"quarantinedAt": null,
+						"quarantineReason": null,
+						"bootstrapSpec": null,
 						"keeperSpec": {
 							"contractAddress": "%s",
 							"fromAddress": "%s",
+							"turnBlockCount": 0,
+							"turnBufferBlocks": 0,
+							"gasPriceBufferPercent": 0,
 							"createdAt":"2000-01-01T00:00:00Z",
 							"updatedAt":"2000-01-01T00:00:00Z",
 							"evmChainID": "42"
@@ -466,6 +528,7 @@ func TestJob(t *testing.T) {
 						"directRequestSpec": null,
 						"cronSpec": null,
 						"webhookSpec": null,
+						"offChainReporting2OracleSpec": null,
 						"offChainReportingOracleSpec": null,
 						"vrfSpec": null,
 						"errors": [{
@@ -114,6 +114,7 @@ type PipelineTaskRunResource struct {
 	Output     *string           `json:"output"`
 	Error      *string           `json:"error"`
 	DotID      string            `json:"dotId"`
+	Attempts   uint32            `json:"attempts"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -139,6 +140,7 @@ func NewPipelineTaskRunResource(tr pipeline.TaskRun) PipelineTaskRunResource {
 		Output:     output,
 		Error:      error,
 		DotID:      tr.GetDotID(),
+		Attempts:   tr.Attempts,
 	}
 }
 
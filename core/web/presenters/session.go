@@ -0,0 +1,34 @@
+package presenters
+
+import (
+	"time"
+
+	"github.com/smartcontractkit/chainlink/core/sessions"
+)
+
+// SessionResource represents an active user session JSONAPI resource.
+type SessionResource struct {
+	JAID
+	Email     string    `json:"email"`
+	IP        string    `json:"ip"`
+	UserAgent string    `json:"userAgent"`
+	LastUsed  time.Time `json:"lastUsed"`
+	CreatedAt time.Time `json:"createdAt"`
+}
+
+// GetName implements the api2go EntityNamer interface
+func (r SessionResource) GetName() string {
+	return "sessions"
+}
+
+// NewSessionResource constructs a new SessionResource.
+func NewSessionResource(s sessions.Session) SessionResource {
+	return SessionResource{
+		JAID:      NewJAID(s.ID),
+		Email:     s.Email,
+		IP:        s.IP,
+		UserAgent: s.UserAgent,
+		LastUsed:  s.LastUsed,
+		CreatedAt: s.CreatedAt,
+	}
+}
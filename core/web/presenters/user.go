@@ -9,8 +9,10 @@ import (
 // UserResource represents a User JSONAPI resource.
 type UserResource struct {
 	JAID
-	Email     string    `json:"email"`
-	CreatedAt time.Time `json:"createdAt"`
+	Email     string            `json:"email"`
+	Role      sessions.UserRole `json:"role"`
+	Disabled  bool              `json:"disabled"`
+	CreatedAt time.Time         `json:"createdAt"`
 }
 
 // GetName implements the api2go EntityNamer interface
@@ -25,6 +27,8 @@ func NewUserResource(u sessions.User) *UserResource {
 	return &UserResource{
 		JAID:      NewJAID(u.Email),
 		Email:     u.Email,
+		Role:      u.Role,
+		Disabled:  u.Disabled,
 		CreatedAt: u.CreatedAt,
 	}
 }
@@ -3,6 +3,7 @@ package resolver
 import (
 	"context"
 
+	"github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 )
 
@@ -16,6 +17,48 @@ func authenticateUser(ctx context.Context) error {
 	return nil
 }
 
+// authenticateUserRole authenticates the user from the session cookie and
+// checks that they hold one of the given roles.
+func authenticateUserRole(ctx context.Context, roles ...sessions.UserRole) error {
+	user, ok := auth.GetGQLAuthenticatedUser(ctx)
+	if !ok {
+		return unauthorizedError{}
+	}
+	for _, role := range roles {
+		if user.Role == role {
+			return nil
+		}
+	}
+	return forbiddenError{}
+}
+
+// authenticateUserCanEdit requires a role that may modify resources.
+func authenticateUserCanEdit(ctx context.Context) error {
+	return authenticateUserRole(ctx, sessions.UserRoleAdmin, sessions.UserRoleEdit)
+}
+
+// authenticateUserCanRun requires a role that may trigger job runs.
+func authenticateUserCanRun(ctx context.Context) error {
+	return authenticateUserRole(ctx, sessions.UserRoleAdmin, sessions.UserRoleEdit, sessions.UserRoleRun)
+}
+
+// authenticateUserIsAdmin requires the admin role.
+func authenticateUserIsAdmin(ctx context.Context) error {
+	return authenticateUserRole(ctx, sessions.UserRoleAdmin)
+}
+
+type forbiddenError struct{}
+
+func (e forbiddenError) Error() string {
+	return "Not permitted with current role"
+}
+
+func (e forbiddenError) Extensions() map[string]interface{} {
+	return map[string]interface{}{
+		"code": "FORBIDDEN",
+	}
+}
+
 type unauthorizedError struct{}
 
 func (e unauthorizedError) Error() string {
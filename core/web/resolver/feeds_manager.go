@@ -312,3 +312,64 @@ func NewUpdateFeedsManagerSuccessResolver(mgr feeds.FeedsManager) *UpdateFeedsMa
 func (r *UpdateFeedsManagerSuccessResolver) FeedsManager() *FeedsManagerResolver {
 	return NewFeedsManager(r.mgr)
 }
+
+// -- RotateFeedsManagerKey Mutation --
+
+// RotateFeedsManagerKeyPayloadResolver
+type RotateFeedsManagerKeyPayloadResolver struct {
+	mgr       *feeds.FeedsManager
+	err       error
+	inputErrs map[string]string
+}
+
+func NewRotateFeedsManagerKeyPayload(mgr *feeds.FeedsManager, err error, inputErrs map[string]string) *RotateFeedsManagerKeyPayloadResolver {
+	return &RotateFeedsManagerKeyPayloadResolver{
+		mgr:       mgr,
+		err:       err,
+		inputErrs: inputErrs,
+	}
+}
+
+func (r *RotateFeedsManagerKeyPayloadResolver) ToRotateFeedsManagerKeySuccess() (*RotateFeedsManagerKeySuccessResolver, bool) {
+	if r.mgr != nil {
+		return NewRotateFeedsManagerKeySuccessResolver(*r.mgr), true
+	}
+
+	return nil, false
+}
+
+func (r *RotateFeedsManagerKeyPayloadResolver) ToNotFoundError() (*NotFoundErrorResolver, bool) {
+	if r.err != nil && errors.Is(r.err, sql.ErrNoRows) {
+		return NewNotFoundError("feeds manager not found"), true
+	}
+
+	return nil, false
+}
+
+func (r *RotateFeedsManagerKeyPayloadResolver) ToInputErrors() (*InputErrorsResolver, bool) {
+	if r.inputErrs != nil {
+		errs := []*InputErrorResolver{}
+
+		for path, message := range r.inputErrs {
+			errs = append(errs, NewInputError(path, message))
+		}
+
+		return NewInputErrors(errs), true
+	}
+
+	return nil, false
+}
+
+type RotateFeedsManagerKeySuccessResolver struct {
+	mgr feeds.FeedsManager
+}
+
+func NewRotateFeedsManagerKeySuccessResolver(mgr feeds.FeedsManager) *RotateFeedsManagerKeySuccessResolver {
+	return &RotateFeedsManagerKeySuccessResolver{
+		mgr: mgr,
+	}
+}
+
+func (r *RotateFeedsManagerKeySuccessResolver) FeedsManager() *FeedsManagerResolver {
+	return NewFeedsManager(r.mgr)
+}
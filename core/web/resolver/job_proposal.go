@@ -0,0 +1,76 @@
+package resolver
+
+import (
+	"strconv"
+
+	"github.com/graph-gophers/graphql-go"
+
+	"github.com/smartcontractkit/chainlink/core/services/feeds"
+)
+
+// JobProposalBulkOperationResultResolver resolves the outcome of a single
+// proposal within a bulk approve or reject.
+type JobProposalBulkOperationResultResolver struct {
+	result feeds.JobProposalBulkResult
+}
+
+// ID resolves the job proposal's unique identifier.
+func (r *JobProposalBulkOperationResultResolver) ID() graphql.ID {
+	return graphql.ID(strconv.FormatInt(r.result.ProposalID, 10))
+}
+
+// Success resolves whether the operation succeeded for the proposal.
+func (r *JobProposalBulkOperationResultResolver) Success() bool {
+	return r.result.Success
+}
+
+// Error resolves the failure message for the proposal, if any.
+func (r *JobProposalBulkOperationResultResolver) Error() *string {
+	if r.result.Error == "" {
+		return nil
+	}
+
+	return &r.result.Error
+}
+
+// -- ApproveJobProposals Mutation --
+
+// ApproveJobProposalsPayloadResolver resolves the bulk approve payload.
+type ApproveJobProposalsPayloadResolver struct {
+	results []feeds.JobProposalBulkResult
+}
+
+func NewApproveJobProposalsPayload(results []feeds.JobProposalBulkResult) *ApproveJobProposalsPayloadResolver {
+	return &ApproveJobProposalsPayloadResolver{results: results}
+}
+
+// Results resolves the per-proposal outcomes.
+func (r *ApproveJobProposalsPayloadResolver) Results() []*JobProposalBulkOperationResultResolver {
+	resolvers := []*JobProposalBulkOperationResultResolver{}
+	for _, result := range r.results {
+		resolvers = append(resolvers, &JobProposalBulkOperationResultResolver{result: result})
+	}
+
+	return resolvers
+}
+
+// -- RejectJobProposals Mutation --
+
+// RejectJobProposalsPayloadResolver resolves the bulk reject payload.
+type RejectJobProposalsPayloadResolver struct {
+	results []feeds.JobProposalBulkResult
+}
+
+func NewRejectJobProposalsPayload(results []feeds.JobProposalBulkResult) *RejectJobProposalsPayloadResolver {
+	return &RejectJobProposalsPayloadResolver{results: results}
+}
+
+// Results resolves the per-proposal outcomes.
+func (r *RejectJobProposalsPayloadResolver) Results() []*JobProposalBulkOperationResultResolver {
+	resolvers := []*JobProposalBulkOperationResultResolver{}
+	for _, result := range r.results {
+		resolvers = append(resolvers, &JobProposalBulkOperationResultResolver{result: result})
+	}
+
+	return resolvers
+}
@@ -32,7 +32,7 @@ type createBridgeInput struct {
 
 // Bridge retrieves a bridges by name.
 func (r *Resolver) CreateBridge(ctx context.Context, args struct{ Input createBridgeInput }) (*CreateBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -86,7 +86,7 @@ type createFeedsManagerInput struct {
 func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	Input *createFeedsManagerInput
 }) (*CreateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -135,6 +135,101 @@ func (r *Resolver) CreateFeedsManager(ctx context.Context, args struct {
 	return NewCreateFeedsManagerPayload(mgr, nil, nil), nil
 }
 
+func (r *Resolver) RotateFeedsManagerKey(ctx context.Context, args struct {
+	ID        graphql.ID
+	PublicKey string
+}) (*RotateFeedsManagerKeyPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	id, err := strconv.ParseInt(string(args.ID), 10, 32)
+	if err != nil {
+		return nil, err
+	}
+
+	publicKey, err := crypto.PublicKeyFromHex(args.PublicKey)
+	if err != nil {
+		return NewRotateFeedsManagerKeyPayload(nil, nil, map[string]string{
+			"publicKey": "invalid hex value",
+		}), nil
+	}
+
+	feedsService := r.App.GetFeedsService()
+
+	if err = feedsService.RotateFeedsManagerKey(ctx, id, *publicKey); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRotateFeedsManagerKeyPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	mgr, err := feedsService.GetManager(id)
+	if err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			return NewRotateFeedsManagerKeyPayload(nil, err, nil), nil
+		}
+
+		return nil, err
+	}
+
+	return NewRotateFeedsManagerKeyPayload(mgr, nil, nil), nil
+}
+
+// ApproveJobProposals approves multiple job proposals, reporting the outcome
+// for each proposal individually.
+func (r *Resolver) ApproveJobProposals(ctx context.Context, args struct {
+	Ids []graphql.ID
+}) (*ApproveJobProposalsPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	ids, err := jobProposalIDs(args.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := r.App.GetFeedsService().BulkApproveJobProposals(ctx, ids)
+
+	return NewApproveJobProposalsPayload(results), nil
+}
+
+// RejectJobProposals rejects multiple job proposals, reporting the outcome
+// for each proposal individually.
+func (r *Resolver) RejectJobProposals(ctx context.Context, args struct {
+	Ids []graphql.ID
+}) (*RejectJobProposalsPayloadResolver, error) {
+	if err := authenticateUserCanEdit(ctx); err != nil {
+		return nil, err
+	}
+
+	ids, err := jobProposalIDs(args.Ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := r.App.GetFeedsService().BulkRejectJobProposals(ctx, ids)
+
+	return NewRejectJobProposalsPayload(results), nil
+}
+
+// jobProposalIDs parses a list of GraphQL ids into job proposal ids.
+func jobProposalIDs(gids []graphql.ID) ([]int64, error) {
+	ids := make([]int64, 0, len(gids))
+	for _, gid := range gids {
+		id, err := strconv.ParseInt(string(gid), 10, 64)
+		if err != nil {
+			return nil, err
+		}
+
+		ids = append(ids, id)
+	}
+
+	return ids, nil
+}
+
 type updateBridgeInput struct {
 	Name                   string
 	URL                    string
@@ -146,7 +241,7 @@ func (r *Resolver) UpdateBridge(ctx context.Context, args struct {
 	Name  string
 	Input updateBridgeInput
 }) (*UpdateBridgePayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -210,7 +305,7 @@ func (r *Resolver) UpdateFeedsManager(ctx context.Context, args struct {
 	ID    graphql.ID
 	Input *updateFeedsManagerInput
 }) (*UpdateFeedsManagerPayloadResolver, error) {
-	if err := authenticateUser(ctx); err != nil {
+	if err := authenticateUserCanEdit(ctx); err != nil {
 		return nil, err
 	}
 
@@ -111,7 +111,7 @@ func (f *gqlTestFramework) Timestamp() time.Time {
 func (f *gqlTestFramework) injectAuthenticatedUser() {
 	f.t.Helper()
 
-	user := clsessions.User{Email: "gqltester@chain.link"}
+	user := clsessions.User{Email: "gqltester@chain.link", Role: clsessions.UserRoleAdmin}
 
 	f.Ctx = auth.SetGQLAuthenticatedUser(f.Ctx, user)
 }
@@ -30,10 +30,15 @@ import (
 	mgin "github.com/ulule/limiter/drivers/middleware/gin"
 	"github.com/ulule/limiter/drivers/store/memory"
 	"github.com/unrolled/secure"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/config"
 	"github.com/smartcontractkit/chainlink/core/logger"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/tracing"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/loader"
 	"github.com/smartcontractkit/chainlink/core/web/resolver"
@@ -57,6 +62,7 @@ func Router(app chainlink.Application, prometheus *ginprom.Prometheus) *gin.Engi
 
 	engine.Use(
 		limits.RequestSizeLimiter(config.DefaultHTTPLimit()),
+		tracingFunc(),
 		loggerFunc(app.GetLogger()),
 		gin.Recovery(),
 		cors,
@@ -194,6 +200,9 @@ func sessionRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	))
 	sc := NewSessionsController(app)
 	unauth.POST("/sessions", sc.Create)
+	oc := OIDCController{app}
+	unauth.GET("/oidc/login", oc.Login)
+	unauth.GET("/oidc/callback", oc.Callback)
 	auth := r.Group("/", auth.Authenticate(app.SessionORM(), auth.AuthenticateBySession))
 	auth.DELETE("/sessions", sc.Destroy)
 }
@@ -202,6 +211,7 @@ func healthRoutes(app chainlink.Application, r *gin.RouterGroup) {
 	hc := HealthController{app}
 	r.GET("/readyz", hc.Readyz)
 	r.GET("/health", hc.Health)
+	r.GET("/health/db", hc.DB)
 }
 
 func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
@@ -214,12 +224,25 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 	authv2 := r.Group("/v2", auth.Authenticate(app.SessionORM(),
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auditFunc(app))
 	{
 		uc := UserController{app}
-		authv2.PATCH("/user/password", uc.UpdatePassword)
+		authv2.PATCH("/user/password", auth.RequiresTOTP(app.SessionORM(), uc.UpdatePassword))
+		authv2.PATCH("/user/role", auth.RequiresAdminRole(uc.UpdateRole))
 		authv2.POST("/user/token", uc.NewAPIToken)
 		authv2.POST("/user/token/delete", uc.DeleteAPIToken)
+		authv2.POST("/user/totp", uc.CreateTOTP)
+		authv2.POST("/user/totp/confirm", uc.ConfirmTOTP)
+		authv2.POST("/user/totp/delete", uc.DeleteTOTP)
+		authv2.GET("/users", auth.RequiresAdminRole(uc.Index))
+		authv2.POST("/users", auth.RequiresAdminRole(uc.Create))
+		authv2.PATCH("/users/:email", auth.RequiresAdminRole(uc.UpdateDisabled))
+		authv2.DELETE("/users/:email", auth.RequiresAdminRole(uc.Destroy))
+
+		sc := NewSessionsController(app)
+		authv2.GET("/sessions", auth.RequiresAdminRole(paginatedRequest(sc.Index)))
+		authv2.DELETE("/sessions", auth.RequiresAdminRole(sc.RevokeAll))
+		authv2.DELETE("/sessions/:id", auth.RequiresAdminRole(sc.Revoke))
 
 		wa := WebAuthnController{app, nil}
 		authv2.GET("/enroll_webauthn", wa.BeginRegistration)
@@ -227,28 +250,29 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 
 		eia := ExternalInitiatorsController{app}
 		authv2.GET("/external_initiators", paginatedRequest(eia.Index))
-		authv2.POST("/external_initiators", eia.Create)
-		authv2.DELETE("/external_initiators/:Name", eia.Destroy)
+		authv2.GET("/external_initiators/notifications", paginatedRequest(eia.NotificationsIndex))
+		authv2.POST("/external_initiators", auth.RequiresEditRole(eia.Create))
+		authv2.DELETE("/external_initiators/:Name", auth.RequiresEditRole(eia.Destroy))
 
 		bt := BridgeTypesController{app}
 		authv2.GET("/bridge_types", paginatedRequest(bt.Index))
-		authv2.POST("/bridge_types", bt.Create)
+		authv2.POST("/bridge_types", auth.RequiresEditRole(bt.Create))
 		authv2.GET("/bridge_types/:BridgeName", bt.Show)
-		authv2.PATCH("/bridge_types/:BridgeName", bt.Update)
-		authv2.DELETE("/bridge_types/:BridgeName", bt.Destroy)
+		authv2.PATCH("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Update))
+		authv2.DELETE("/bridge_types/:BridgeName", auth.RequiresEditRole(bt.Destroy))
 
 		ts := TransfersController{app}
-		authv2.POST("/transfers", ts.Create)
+		authv2.POST("/transfers", auth.RequiresAdminRole(ts.Create))
 
 		cc := ConfigController{app}
 		authv2.GET("/config", cc.Show)
-		authv2.PATCH("/config", cc.Patch)
+		authv2.PATCH("/config", auth.RequiresAdminRole(cc.Patch))
 
 		feedsMgrCtlr := FeedsManagerController{app}
 		authv2.GET("/feeds_managers", feedsMgrCtlr.List)
-		authv2.POST("/feeds_managers", feedsMgrCtlr.Create)
+		authv2.POST("/feeds_managers", auth.RequiresEditRole(feedsMgrCtlr.Create))
 		authv2.GET("/feeds_managers/:id", feedsMgrCtlr.Show)
-		authv2.PATCH("/feeds_managers/:id", feedsMgrCtlr.Update)
+		authv2.PATCH("/feeds_managers/:id", auth.RequiresEditRole(feedsMgrCtlr.Update))
 
 		tas := TxAttemptsController{app}
 		authv2.GET("/tx_attempts", paginatedRequest(tas.Index))
@@ -256,58 +280,76 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		txs := TransactionsController{app}
 		authv2.GET("/transactions", paginatedRequest(txs.Index))
 		authv2.GET("/transactions/:TxHash", txs.Show)
+		authv2.POST("/transactions/:id/bump", auth.RequiresEditRole(txs.Bump))
 
 		rc := ReplayController{app}
-		authv2.POST("/replay_from_block/:number", rc.ReplayFromBlock)
+		authv2.POST("/replay_from_block/:number", auth.RequiresRunRole(rc.ReplayFromBlock))
 
 		ekc := ETHKeysController{app}
 		authv2.GET("/keys/eth", ekc.Index)
-		authv2.POST("/keys/eth", ekc.Create)
-		authv2.PUT("/keys/eth/:keyID", ekc.Update)
-		authv2.DELETE("/keys/eth/:keyID", ekc.Delete)
-		authv2.POST("/keys/eth/import", ekc.Import)
-		authv2.POST("/keys/eth/export/:address", ekc.Export)
+		authv2.POST("/keys/eth", auth.RequiresAdminRole(ekc.Create))
+		authv2.PUT("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Update))
+		authv2.DELETE("/keys/eth/:keyID", auth.RequiresAdminRole(ekc.Delete))
+		authv2.POST("/keys/eth/import", auth.RequiresAdminRole(ekc.Import))
+		authv2.POST("/keys/eth/export/:address", auth.RequiresAdminRole(auth.RequiresTOTP(app.SessionORM(), ekc.Export)))
 
 		ocrkc := OCRKeysController{app}
 		authv2.GET("/keys/ocr", ocrkc.Index)
-		authv2.POST("/keys/ocr", ocrkc.Create)
-		authv2.DELETE("/keys/ocr/:keyID", ocrkc.Delete)
-		authv2.POST("/keys/ocr/import", ocrkc.Import)
-		authv2.POST("/keys/ocr/export/:ID", ocrkc.Export)
+		authv2.POST("/keys/ocr", auth.RequiresAdminRole(ocrkc.Create))
+		authv2.DELETE("/keys/ocr/:keyID", auth.RequiresAdminRole(ocrkc.Delete))
+		authv2.POST("/keys/ocr/import", auth.RequiresAdminRole(ocrkc.Import))
+		authv2.POST("/keys/ocr/export/:ID", auth.RequiresAdminRole(auth.RequiresTOTP(app.SessionORM(), ocrkc.Export)))
 
 		p2pkc := P2PKeysController{app}
 		authv2.GET("/keys/p2p", p2pkc.Index)
-		authv2.POST("/keys/p2p", p2pkc.Create)
-		authv2.DELETE("/keys/p2p/:keyID", p2pkc.Delete)
-		authv2.POST("/keys/p2p/import", p2pkc.Import)
-		authv2.POST("/keys/p2p/export/:ID", p2pkc.Export)
+		authv2.POST("/keys/p2p", auth.RequiresAdminRole(p2pkc.Create))
+		authv2.DELETE("/keys/p2p/:keyID", auth.RequiresAdminRole(p2pkc.Delete))
+		authv2.POST("/keys/p2p/import", auth.RequiresAdminRole(p2pkc.Import))
+		authv2.POST("/keys/p2p/export/:ID", auth.RequiresAdminRole(auth.RequiresTOTP(app.SessionORM(), p2pkc.Export)))
 
 		csakc := CSAKeysController{app}
 		authv2.GET("/keys/csa", csakc.Index)
-		authv2.POST("/keys/csa", csakc.Create)
-		authv2.POST("/keys/csa/import", csakc.Import)
-		authv2.POST("/keys/csa/export/:ID", csakc.Export)
+		authv2.POST("/keys/csa", auth.RequiresAdminRole(csakc.Create))
+		authv2.DELETE("/keys/csa/:keyID", auth.RequiresAdminRole(csakc.Delete))
+		authv2.POST("/keys/csa/import", auth.RequiresAdminRole(csakc.Import))
+		authv2.POST("/keys/csa/export/:ID", auth.RequiresAdminRole(auth.RequiresTOTP(app.SessionORM(), csakc.Export)))
 
 		vrfkc := VRFKeysController{app}
 		authv2.GET("/keys/vrf", vrfkc.Index)
-		authv2.POST("/keys/vrf", vrfkc.Create)
-		authv2.DELETE("/keys/vrf/:keyID", vrfkc.Delete)
-		authv2.POST("/keys/vrf/import", vrfkc.Import)
-		authv2.POST("/keys/vrf/export/:keyID", vrfkc.Export)
+		authv2.POST("/keys/vrf", auth.RequiresAdminRole(vrfkc.Create))
+		authv2.DELETE("/keys/vrf/:keyID", auth.RequiresAdminRole(vrfkc.Delete))
+		authv2.POST("/keys/vrf/import", auth.RequiresAdminRole(vrfkc.Import))
+		authv2.POST("/keys/vrf/export/:keyID", auth.RequiresAdminRole(auth.RequiresTOTP(app.SessionORM(), vrfkc.Export)))
 
 		jc := JobsController{app}
 		authv2.GET("/jobs", paginatedRequest(jc.Index))
 		authv2.GET("/jobs/:ID", jc.Show)
-		authv2.POST("/jobs", jc.Create)
-		authv2.DELETE("/jobs/:ID", jc.Delete)
+		authv2.GET("/jobs/:ID/export", jc.Export)
+		authv2.POST("/jobs", auth.RequiresEditRole(jc.Create))
+		authv2.POST("/jobs/validate", jc.Validate)
+		authv2.PATCH("/jobs/:ID", auth.RequiresEditRole(jc.UpdateParams))
+		authv2.POST("/jobs/:ID/resume", auth.RequiresRunRole(jc.Resume))
+		authv2.GET("/jobs/:ID/spend", jc.Spend)
+		authv2.DELETE("/jobs/:ID", auth.RequiresEditRole(jc.Delete))
+		authv2.POST("/jobs/:ID/restore", auth.RequiresEditRole(jc.Restore))
+		authv2.DELETE("/jobs/:ID/purge", auth.RequiresEditRole(jc.Purge))
+
+		jgc := JobGroupsController{app}
+		authv2.POST("/job_groups/:name/pause", auth.RequiresEditRole(jgc.Pause))
+		authv2.POST("/job_groups/:name/resume", auth.RequiresEditRole(jgc.Resume))
+		authv2.DELETE("/job_groups/:name", auth.RequiresEditRole(jgc.Delete))
 
 		jpc := JobProposalsController{app}
 		authv2.GET("/job_proposals", jpc.Index)
 		authv2.GET("/job_proposals/:id", jpc.Show)
-		authv2.POST("/job_proposals/:id/approve", jpc.Approve)
-		authv2.POST("/job_proposals/:id/cancel", jpc.Cancel)
-		authv2.POST("/job_proposals/:id/reject", jpc.Reject)
-		authv2.PATCH("/job_proposals/:id/spec", jpc.UpdateSpec)
+		authv2.POST("/job_proposals/:id/approve", auth.RequiresEditRole(jpc.Approve))
+		authv2.POST("/job_proposals/:id/cancel", auth.RequiresEditRole(jpc.Cancel))
+		authv2.POST("/job_proposals/:id/reject", auth.RequiresEditRole(jpc.Reject))
+		authv2.PATCH("/job_proposals/:id/spec", auth.RequiresEditRole(jpc.UpdateSpec))
+		// Bulk routes are registered under a separate prefix because gin does
+		// not allow static segments alongside the ":id" wildcard.
+		authv2.POST("/bulk_job_proposals/approve", auth.RequiresEditRole(jpc.BulkApprove))
+		authv2.POST("/bulk_job_proposals/reject", auth.RequiresEditRole(jpc.BulkReject))
 
 		// PipelineRunsController
 		authv2.GET("/pipeline/runs", paginatedRequest(prc.Index))
@@ -319,24 +361,38 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		authv2.GET("/features", fc.Index)
 
 		// PipelineJobSpecErrorsController
-		authv2.DELETE("/pipeline/job_spec_errors/:ID", psec.Destroy)
+		authv2.DELETE("/pipeline/job_spec_errors/:ID", auth.RequiresEditRole(psec.Destroy))
 
 		lgc := LogController{app}
 		authv2.GET("/log", lgc.Get)
-		authv2.PATCH("/log", lgc.Patch)
+		authv2.PATCH("/log", auth.RequiresAdminRole(lgc.Patch))
+
+		dpc := DatabasePoolController{app}
+		authv2.GET("/database/pool", dpc.Get)
+		authv2.PATCH("/database/pool", auth.RequiresAdminRole(dpc.Patch))
+
+		dlc := DatabaseLockController{app}
+		authv2.GET("/database/lock", dlc.Show)
 
 		chc := ChainsController{app}
 		authv2.GET("/chains/evm", paginatedRequest(chc.Index))
-		authv2.POST("/chains/evm", chc.Create)
+		authv2.POST("/chains/evm", auth.RequiresAdminRole(chc.Create))
 		authv2.GET("/chains/evm/:ID", chc.Show)
-		authv2.PATCH("/chains/evm/:ID", chc.Update)
-		authv2.DELETE("/chains/evm/:ID", chc.Delete)
+		authv2.PATCH("/chains/evm/:ID", auth.RequiresAdminRole(chc.Update))
+		authv2.DELETE("/chains/evm/:ID", auth.RequiresAdminRole(chc.Delete))
+
+		hdc := HeadsController{app}
+		authv2.GET("/chains/evm/:ID/heads", hdc.Index)
+
+		htc := HeadTrackerController{app}
+		authv2.POST("/chains/evm/:ID/head_tracker/pause", auth.RequiresEditRole(htc.Pause))
+		authv2.POST("/chains/evm/:ID/head_tracker/resume", auth.RequiresEditRole(htc.Resume))
 
 		nc := NodesController{app}
 		authv2.GET("/nodes", paginatedRequest(nc.Index))
 		authv2.GET("/chains/evm/:ID/nodes", paginatedRequest(nc.Index))
-		authv2.POST("/nodes", nc.Create)
-		authv2.DELETE("/nodes/:ID", nc.Delete)
+		authv2.POST("/nodes", auth.RequiresAdminRole(nc.Create))
+		authv2.DELETE("/nodes/:ID", auth.RequiresAdminRole(nc.Delete))
 	}
 
 	ping := PingController{app}
@@ -344,9 +400,9 @@ func v2Routes(app chainlink.Application, r *gin.RouterGroup) {
 		auth.AuthenticateExternalInitiator,
 		auth.AuthenticateByToken,
 		auth.AuthenticateBySession,
-	))
+	), auditFunc(app))
 	userOrEI.GET("/ping", ping.Show)
-	userOrEI.POST("/jobs/:ID/runs", prc.Create)
+	userOrEI.POST("/jobs/:ID/runs", auth.RequiresRunRole(prc.Create))
 }
 
 // This is higher because it serves main.js and any static images. There are
@@ -423,6 +479,51 @@ func guiAssetRoutes(box packr.Box, engine *gin.Engine, config config.GeneralConf
 	engine.NoRoute(noRouteHandlers...)
 }
 
+// auditFunc returns a middleware that records an audit event for every
+// authenticated API call that changes state, covering key operations, job
+// changes, config updates and the like in one place.
+func auditFunc(app chainlink.Application) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		c.Next()
+
+		if c.Request.Method == http.MethodGet || c.Request.Method == http.MethodHead {
+			return
+		}
+		if c.Writer.Status() >= http.StatusBadRequest {
+			return
+		}
+		email := ""
+		if user, ok := auth.GetAuthenticatedUser(c); ok {
+			email = user.Email
+		}
+		app.GetAuditLogger().Audit(audit.EventMutation, email, map[string]interface{}{
+			"method": c.Request.Method,
+			"path":   c.Request.URL.Path,
+			"status": c.Writer.Status(),
+			"ip":     c.ClientIP(),
+		})
+	}
+}
+
+// tracingFunc returns a middleware that records a span for each request,
+// picking up any trace context propagated by the caller so node-internal
+// spans join the caller's trace.
+func tracingFunc() gin.HandlerFunc {
+	return func(c *gin.Context) {
+		ctx := otel.GetTextMapPropagator().Extract(c.Request.Context(), propagation.HeaderCarrier(c.Request.Header))
+		ctx, span := tracing.StartSpan(ctx, "web", c.Request.Method+" "+c.Request.URL.Path,
+			attribute.String("http.method", c.Request.Method),
+			attribute.String("http.target", c.Request.URL.Path),
+		)
+		defer span.End()
+
+		c.Request = c.Request.WithContext(ctx)
+		c.Next()
+
+		span.SetAttributes(attribute.Int("http.status_code", c.Writer.Status()))
+	}
+}
+
 // Inspired by https://github.com/gin-gonic/gin/issues/961
 func loggerFunc(lggr logger.Logger) gin.HandlerFunc {
 	return func(c *gin.Context) {
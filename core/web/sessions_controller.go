@@ -9,9 +9,11 @@ import (
 	"github.com/gin-gonic/gin"
 	"go.uber.org/multierr"
 
+	"github.com/smartcontractkit/chainlink/core/audit"
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	clsessions "github.com/smartcontractkit/chainlink/core/sessions"
 	"github.com/smartcontractkit/chainlink/core/web/auth"
+	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
 // SessionsController manages session requests.
@@ -45,16 +47,20 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		return
 	}
 
-	// If the user has registered MFA tokens, then populate our session store and context
+	// The request context is used to record the IP and user agent the session
+	// was established from
+	sr.RequestContext = c
+
+	// If the user has registered MFA tokens, then populate our session store
 	// required for successful WebAuthn authentication
 	if len(userWebAuthnTokens) > 0 {
 		sr.SessionStore = sc.sessions
-		sr.RequestContext = c
 		sr.WebAuthnConfig = sc.App.GetWebAuthnConfiguration()
 	}
 
 	sid, err := sc.App.SessionORM().CreateSession(sr)
 	if err != nil {
+		sc.App.GetAuditLogger().Audit(audit.EventLoginFailed, sr.Email, map[string]interface{}{"ip": c.ClientIP()})
 		jsonAPIError(c, http.StatusUnauthorized, err)
 		return
 	}
@@ -64,6 +70,7 @@ func (sc *SessionsController) Create(c *gin.Context) {
 		return
 	}
 
+	sc.App.GetAuditLogger().Audit(audit.EventLogin, sr.Email, map[string]interface{}{"ip": c.ClientIP()})
 	jsonAPIResponse(c, Session{Authenticated: true}, "session")
 }
 
@@ -82,10 +89,56 @@ func (sc *SessionsController) Destroy(c *gin.Context) {
 		jsonAPIError(c, http.StatusInternalServerError, err)
 		return
 	}
+	if user, ok := auth.GetAuthenticatedUser(c); ok {
+		sc.App.GetAuditLogger().Audit(audit.EventLogout, user.Email, nil)
+	}
 
 	jsonAPIResponse(c, Session{Authenticated: false}, "session")
 }
 
+// Index lists all active sessions, along with the IP and user agent they were
+// established from.
+func (sc *SessionsController) Index(c *gin.Context, size, page, offset int) {
+	sessions, err := sc.App.SessionORM().Sessions(offset, size)
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+	count, err := sc.App.SessionORM().CountSessions()
+	var resources []presenters.SessionResource
+	for _, s := range sessions {
+		resources = append(resources, presenters.NewSessionResource(s))
+	}
+
+	paginatedResponse(c, "sessions", size, page, resources, count, err)
+}
+
+// Revoke erases the session with the given ID, invalidating the matching
+// cookie without requiring access to it.
+func (sc *SessionsController) Revoke(c *gin.Context) {
+	defer sc.App.WakeSessionReaper()
+
+	if err := sc.App.SessionORM().DeleteUserSession(c.Param("id")); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "session", http.StatusNoContent)
+}
+
+// RevokeAll erases every session, forcibly logging out all users including
+// the caller.
+func (sc *SessionsController) RevokeAll(c *gin.Context) {
+	defer sc.App.WakeSessionReaper()
+
+	if err := sc.App.SessionORM().DeleteAllSessions(); err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(c, nil, "session", http.StatusNoContent)
+}
+
 func saveSessionID(session sessions.Session, sessionID string) error {
 	session.Set(auth.SessionIDKey, sessionID)
 	return session.Save()
@@ -2,9 +2,13 @@ package web
 
 import (
 	"database/sql"
+	"math/big"
 	"net/http"
+	"strconv"
+	"time"
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
+	"github.com/smartcontractkit/chainlink/core/utils"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 
 	"github.com/ethereum/go-ethereum/common"
@@ -28,10 +32,31 @@ func (tc *TransactionsController) Index(c *gin.Context, size, page, offset int)
 	paginatedResponse(c, "transactions", size, page, ptxs, count, err)
 }
 
+// Inflight returns all transactions that have not yet been confirmed,
+// grouped per key and chain with summary counts per state.
+// Example:
+//  "GET <application>/transactions/inflight"
+func (tc *TransactionsController) Inflight(c *gin.Context) {
+	txs, err := tc.App.BPTXMORM().InflightEthTransactions()
+	if err != nil {
+		jsonAPIError(c, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewInflightTxGroupResources(txs, time.Now()), "inflight_transactions")
+}
+
 // Show returns the details of a Ethereum Transasction details.
 // Example:
 //  "<application>/transactions/:TxHash"
 func (tc *TransactionsController) Show(c *gin.Context) {
+	// gin cannot register a static route alongside the :TxHash wildcard, so
+	// the inflight dashboard is dispatched from here
+	if c.Param("TxHash") == "inflight" {
+		tc.Inflight(c)
+		return
+	}
+
 	hash := common.HexToHash(c.Param("TxHash"))
 
 	ethTxAttempt, err := tc.App.BPTXMORM().FindEthTxAttempt(hash)
@@ -46,3 +71,57 @@ func (tc *TransactionsController) Show(c *gin.Context) {
 
 	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(*ethTxAttempt), "transaction")
 }
+
+// BumpTransactionRequest is the request body to bump the gas of a pending
+// transaction. Both fields are optional; with no gas price the bump is
+// computed from the configured bump parameters, and with no chain id the
+// default chain is used.
+type BumpTransactionRequest struct {
+	GasPriceWei *utils.Big `json:"gasPriceWei"`
+	EVMChainID  *utils.Big `json:"evmChainID"`
+}
+
+// Bump creates a replacement attempt at a higher gas price for an unconfirmed
+// transaction.
+// Example:
+//  "POST <application>/transactions/:id/bump"
+func (tc *TransactionsController) Bump(c *gin.Context) {
+	request := BumpTransactionRequest{}
+	if err := c.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(c, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	id, err := strconv.ParseInt(c.Param("id"), 10, 64)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	chainIDstr := ""
+	if request.EVMChainID != nil {
+		chainIDstr = request.EVMChainID.String()
+	}
+	chain, err := getChain(tc.App.GetChainSet(), chainIDstr)
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	var gasPriceWei *big.Int
+	if request.GasPriceWei != nil {
+		gasPriceWei = request.GasPriceWei.ToInt()
+	}
+
+	attempt, err := chain.TxManager().BumpGasForEthTx(c.Request.Context(), id, gasPriceWei)
+	if errors.Is(errors.Cause(err), sql.ErrNoRows) {
+		jsonAPIError(c, http.StatusNotFound, errors.New("Transaction not found"))
+		return
+	}
+	if err != nil {
+		jsonAPIError(c, http.StatusBadRequest, err)
+		return
+	}
+
+	jsonAPIResponse(c, presenters.NewEthTxResourceFromAttempt(attempt), "transaction")
+}
@@ -74,6 +74,37 @@ func TestTransactionsController_Index_Error(t *testing.T) {
 	cltest.AssertServerResponse(t, resp, 422)
 }
 
+func TestTransactionsController_Inflight(t *testing.T) {
+	t.Parallel()
+
+	app := cltest.NewApplicationWithKey(t)
+	require.NoError(t, app.Start())
+
+	db := app.GetDB()
+	client := app.NewHTTPClient()
+	_, from := cltest.MustInsertRandomKey(t, app.KeyStore.Eth(), 0)
+
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 1, from)
+	cltest.MustInsertUnconfirmedEthTxWithBroadcastLegacyAttempt(t, db, 2, from)
+	cltest.MustInsertConfirmedEthTxWithLegacyAttempt(t, db, 3, 1, from)
+
+	resp, cleanup := client.Get("/v2/transactions/inflight")
+	t.Cleanup(cleanup)
+	cltest.AssertServerResponse(t, resp, http.StatusOK)
+
+	var groups []presenters.InflightTxGroupResource
+	require.NoError(t, cltest.ParseJSONAPIResponse(t, resp, &groups))
+
+	require.Len(t, groups, 1)
+	assert.Equal(t, from, groups[0].FromAddress)
+	assert.Equal(t, 2, groups[0].Unconfirmed)
+	assert.Equal(t, 0, groups[0].InProgress)
+	require.Len(t, groups[0].Transactions, 2)
+	assert.Equal(t, "1", groups[0].Transactions[0].Nonce)
+	require.Len(t, groups[0].Transactions[0].Attempts, 1)
+	assert.NotEmpty(t, groups[0].Transactions[0].Attempts[0].GasPrice)
+}
+
 func TestTransactionsController_Show_Success(t *testing.T) {
 	t.Parallel()
 
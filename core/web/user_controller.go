@@ -1,9 +1,11 @@
 package web
 
 import (
+	"database/sql"
 	"errors"
 	"fmt"
 	"net/http"
+	"strings"
 
 	"github.com/gin-gonic/contrib/sessions"
 	"github.com/gin-gonic/gin"
@@ -16,11 +18,113 @@ import (
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 )
 
-// UserController manages the current Session's User User.
+// UserController manages the web node users, as well as the current Session's
+// User.
 type UserController struct {
 	App chainlink.Application
 }
 
+// Index lists all API users.
+func (c *UserController) Index(ctx *gin.Context) {
+	users, err := c.App.SessionORM().ListUsers()
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	resources := []presenters.UserResource{}
+	for _, user := range users {
+		resources = append(resources, *presenters.NewUserResource(user))
+	}
+	jsonAPIResponse(ctx, resources, "users")
+}
+
+// CreateUserRequest defines the request to create a new user with the given
+// email, password and role.
+type CreateUserRequest struct {
+	Email    string `json:"email"`
+	Password string `json:"password"`
+	Role     string `json:"role"`
+}
+
+// Create adds a new API user with the given role.
+func (c *UserController) Create(ctx *gin.Context) {
+	var request CreateUserRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	role, err := clsession.GetUserRole(request.Role)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+	user, err := clsession.NewUser(request.Email, request.Password)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+	user.Role = role
+
+	if err := c.App.SessionORM().CreateUser(&user); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// Destroy deletes the user identified by the email path parameter. Deleting
+// the account you are logged in as is rejected.
+func (c *UserController) Destroy(ctx *gin.Context) {
+	email := ctx.Param("email")
+	if user, ok := webauth.GetAuthenticatedUser(ctx); ok && strings.EqualFold(user.Email, email) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("cannot delete the account you are logged in as"))
+		return
+	}
+
+	if err := c.App.SessionORM().DeleteUser(email); err != nil {
+		if errors.Is(err, sql.ErrNoRows) {
+			jsonAPIError(ctx, http.StatusNotFound, errors.New("user not found"))
+			return
+		}
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "user", http.StatusNoContent)
+}
+
+// UpdateDisabledRequest defines the request to enable or disable a user.
+type UpdateDisabledRequest struct {
+	Disabled bool `json:"disabled"`
+}
+
+// UpdateDisabled enables or disables the user identified by the email path
+// parameter. Disabling the account you are logged in as is rejected, so a node
+// always retains at least one usable admin.
+func (c *UserController) UpdateDisabled(ctx *gin.Context) {
+	var request UpdateDisabledRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	email := ctx.Param("email")
+	if user, ok := webauth.GetAuthenticatedUser(ctx); ok && request.Disabled && strings.EqualFold(user.Email, email) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("cannot disable the account you are logged in as"))
+		return
+	}
+
+	user, err := c.App.SessionORM().SetDisabled(email, request.Disabled)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
 // UpdatePasswordRequest defines the request to set a new password for the
 // current session's User.
 type UpdatePasswordRequest struct {
@@ -36,11 +140,12 @@ func (c *UserController) UpdatePassword(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
-	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
 		return
 	}
+	user := *sessionUser
 	if !utils.CheckPasswordHash(request.OldPassword, user.HashedPassword) {
 		jsonAPIError(ctx, http.StatusConflict, errors.New("old password does not match"))
 		return
@@ -61,11 +166,12 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
-	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
 		return
 	}
+	user := *sessionUser
 	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
 		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
@@ -79,6 +185,168 @@ func (c *UserController) NewAPIToken(ctx *gin.Context) {
 	jsonAPIResponseWithStatus(ctx, newToken, "auth_token", http.StatusCreated)
 }
 
+// UpdateRoleRequest defines the request to change a user's role.
+type UpdateRoleRequest struct {
+	Email   string `json:"email"`
+	NewRole string `json:"newRole"`
+}
+
+// UpdateRole changes the role of the user identified by email. Changing the
+// role of the account you are logged in as is rejected, so a node always
+// retains at least one admin.
+func (c *UserController) UpdateRole(ctx *gin.Context) {
+	var request UpdateRoleRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	email := request.Email
+	if user, ok := webauth.GetAuthenticatedUser(ctx); ok && (email == "" || strings.EqualFold(user.Email, email)) {
+		jsonAPIError(ctx, http.StatusBadRequest, errors.New("cannot change the role of the account you are logged in as"))
+		return
+	}
+
+	user, err := c.App.SessionORM().UpdateRole(email, request.NewRole)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponse(ctx, presenters.NewUserResource(user), "user")
+}
+
+// TOTPEnrollment is the one-time response to a TOTP enrollment request. The
+// secret and recovery codes are never retrievable again.
+type TOTPEnrollment struct {
+	Secret          string   `json:"secret"`
+	ProvisioningURI string   `json:"provisioningUri"`
+	RecoveryCodes   []string `json:"recoveryCodes"`
+}
+
+// GetID returns the jsonapi ID.
+func (TOTPEnrollment) GetID() string {
+	return "totp"
+}
+
+// GetName returns the collection name for jsonapi.
+func (TOTPEnrollment) GetName() string {
+	return "totpEnrollment"
+}
+
+// CreateTOTP begins TOTP enrollment for the current user, returning the
+// secret and recovery codes. Enforcement starts once ConfirmTOTP succeeds.
+func (c *UserController) CreateTOTP(ctx *gin.Context) {
+	var request clsession.ChangeAuthTokenRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+
+	secret, err := clsession.GenerateTOTPSecret()
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	recoveryCodes, err := clsession.GenerateRecoveryCodes(clsession.TOTPRecoveryCodeCount)
+	if err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+	if err := c.App.SessionORM().EnrollTOTP(user.Email, secret, recoveryCodes); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	enrollment := TOTPEnrollment{
+		Secret:          secret,
+		ProvisioningURI: clsession.TOTPProvisioningURI(secret, user.Email),
+		RecoveryCodes:   recoveryCodes,
+	}
+	jsonAPIResponseWithStatus(ctx, enrollment, "totpEnrollment", http.StatusCreated)
+}
+
+// ConfirmTOTPRequest defines the request to complete TOTP enrollment.
+type ConfirmTOTPRequest struct {
+	Code string `json:"code"`
+}
+
+// ConfirmTOTP completes TOTP enrollment for the current user by verifying a
+// code from their authenticator app.
+func (c *UserController) ConfirmTOTP(ctx *gin.Context) {
+	var request ConfirmTOTPRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
+		return
+	}
+
+	if err := c.App.SessionORM().ConfirmTOTP(user.Email, request.Code); err != nil {
+		if errors.Is(err, clsession.ErrInvalidTOTPCode) {
+			jsonAPIError(ctx, http.StatusUnauthorized, err)
+			return
+		}
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "totpEnrollment", http.StatusNoContent)
+}
+
+// DeleteTOTPRequest defines the request to remove TOTP from the current user.
+type DeleteTOTPRequest struct {
+	Password string `json:"password"`
+	Code     string `json:"code"`
+}
+
+// DeleteTOTP removes TOTP enrollment from the current user, requiring their
+// password and a valid code (or recovery code).
+func (c *UserController) DeleteTOTP(ctx *gin.Context) {
+	var request DeleteTOTPRequest
+	if err := ctx.ShouldBindJSON(&request); err != nil {
+		jsonAPIError(ctx, http.StatusUnprocessableEntity, err)
+		return
+	}
+
+	user, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
+		return
+	}
+	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
+		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
+		return
+	}
+	if user.TOTPEnabled() {
+		if err := c.App.SessionORM().VerifyUserTOTP(user, request.Code); err != nil {
+			jsonAPIError(ctx, http.StatusUnauthorized, err)
+			return
+		}
+	}
+
+	if err := c.App.SessionORM().RemoveTOTP(user.Email); err != nil {
+		jsonAPIError(ctx, http.StatusInternalServerError, err)
+		return
+	}
+
+	jsonAPIResponseWithStatus(ctx, nil, "totpEnrollment", http.StatusNoContent)
+}
+
 // DeleteAPIToken deletes and disables a user's API token.
 func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 	var request clsession.ChangeAuthTokenRequest
@@ -87,11 +355,12 @@ func (c *UserController) DeleteAPIToken(ctx *gin.Context) {
 		return
 	}
 
-	user, err := c.App.SessionORM().FindUser()
-	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
 		return
 	}
+	user := *sessionUser
 	if !utils.CheckPasswordHash(request.Password, user.HashedPassword) {
 		jsonAPIError(ctx, http.StatusUnauthorized, errors.New("incorrect password"))
 		return
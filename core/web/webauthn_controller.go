@@ -11,6 +11,7 @@ import (
 
 	"github.com/smartcontractkit/chainlink/core/services/chainlink"
 	"github.com/smartcontractkit/chainlink/core/sessions"
+	webauth "github.com/smartcontractkit/chainlink/core/web/auth"
 	"github.com/smartcontractkit/chainlink/core/web/presenters"
 	sqlxTypes "github.com/smartcontractkit/sqlx/types"
 )
@@ -28,11 +29,12 @@ func (c *WebAuthnController) BeginRegistration(ctx *gin.Context) {
 	}
 
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
-	if err != nil {
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
 		return
 	}
+	user := *sessionUser
 
 	uwas, err := orm.GetUserWebAuthn(user.Email)
 	if err != nil {
@@ -62,12 +64,12 @@ func (c *WebAuthnController) FinishRegistration(ctx *gin.Context) {
 	}
 
 	orm := c.App.SessionORM()
-	user, err := orm.FindUser()
-	if err != nil {
-		c.App.GetLogger().Errorf("error finding user: %s", err)
-		jsonAPIError(ctx, http.StatusInternalServerError, fmt.Errorf("failed to obtain current user record: %+v", err))
+	sessionUser, ok := webauth.GetAuthenticatedUser(ctx)
+	if !ok {
+		jsonAPIError(ctx, http.StatusInternalServerError, errors.New("failed to obtain current user record"))
 		return
 	}
+	user := *sessionUser
 
 	uwas, err := orm.GetUserWebAuthn(user.Email)
 	if err != nil {